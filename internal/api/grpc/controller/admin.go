@@ -0,0 +1,414 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/api/grpc/reponses"
+	"github.com/netbill/auth-svc/internal/api/grpc/scope"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/admin"
+	"github.com/netbill/auth-svc/internal/modules/session"
+	"github.com/netbill/auth-svc/internal/modules/user"
+	"github.com/netbill/auth-svc/pkg/pb"
+	"github.com/netbill/restkit/pagi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AdminCore interface {
+	ListUsers(ctx context.Context, actor models.UserActor, params user.FilterParams, limit, offset uint) (pagi.Page[[]models.User], error)
+	GetUser(ctx context.Context, actor models.UserActor, userID uuid.UUID) (models.User, models.UserEmail, error)
+	ListUserSessions(ctx context.Context, actor models.UserActor, userID uuid.UUID, opts ...session.ListSessionsOption) (pagi.Page[[]models.Session], error)
+	UpdateUserRole(ctx context.Context, actor models.UserActor, userID uuid.UUID, role string) (models.User, error)
+	SuspendUser(ctx context.Context, actor models.UserActor, userID uuid.UUID, params admin.SuspendParams) (models.User, error)
+	UnsuspendUser(ctx context.Context, actor models.UserActor, userID uuid.UUID) (models.User, error)
+	RestoreUser(ctx context.Context, actor models.UserActor, userID uuid.UUID) (models.User, error)
+	RevokeUserSessions(ctx context.Context, actor models.UserActor, userID uuid.UUID) error
+}
+
+type AdminServer struct {
+	pb.UnimplementedAdminServiceServer
+	admin AdminCore
+}
+
+func NewAdminServer(admin AdminCore) *AdminServer {
+	return &AdminServer{admin: admin}
+}
+
+const operationAdminListUsers = "admin_list_users"
+
+func (s *AdminServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationAdminListUsers)
+
+	page, perPage := pbPagination(req.Pagination)
+
+	params := user.FilterParams{Deleted: pbToUserDeletedFilter(req.Filter)}
+	if text := strings.TrimSpace(req.Text); text != "" {
+		params.Text = &text
+	}
+
+	result, err := s.admin.ListUsers(ctx, scope.UserActor(ctx), params, uint(perPage), uint((page-1)*perPage))
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.Warn("user is not an admin", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "admin role required")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		users := make([]*pb.User, len(result.Data))
+		for i, u := range result.Data {
+			users[i] = reponses.AdminUser(u)
+		}
+
+		return &pb.ListUsersResponse{
+			Users:    users,
+			PageInfo: pbPageInfo(page, perPage, result.Total),
+		}, nil
+	}
+}
+
+const operationAdminGetUser = "admin_get_user"
+
+func (s *AdminServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationAdminGetUser)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		log.Warn("invalid user_id", "user_id", req.UserId)
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	u, email, err := s.admin.GetUser(ctx, scope.UserActor(ctx), userID)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.Warn("user is not an admin", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "admin role required")
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.Info("user not found", "error", err)
+		return nil, status.Error(codes.NotFound, "user not found")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		return &pb.GetUserResponse{
+			User:  reponses.AdminUser(u),
+			Email: reponses.UserEmail(email),
+		}, nil
+	}
+}
+
+const operationAdminListUserSessions = "admin_list_user_sessions"
+
+func (s *AdminServer) ListUserSessions(
+	ctx context.Context,
+	req *pb.ListUserSessionsRequest,
+) (*pb.ListUserSessionsResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationAdminListUserSessions)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		log.Warn("invalid user_id", "user_id", req.UserId)
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	page, perPage := pbPagination(req.Pagination)
+
+	opts := []session.ListSessionsOption{
+		session.WithLimit(uint(perPage)),
+		session.WithOffset(uint((page - 1) * perPage)),
+		session.WithDeleted(pbToDeletedFilter(req.Filter)),
+		session.WithLastUsedOrder(pbToLastUsedOrder(req.Order)),
+	}
+
+	result, err := s.admin.ListUserSessions(ctx, scope.UserActor(ctx), userID, opts...)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.Warn("user is not an admin", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "admin role required")
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.Info("user not found", "error", err)
+		return nil, status.Error(codes.NotFound, "user not found")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		sessions := make([]*pb.Session, len(result.Data))
+		for i, sess := range result.Data {
+			sessions[i] = reponses.Session(sess)
+		}
+
+		return &pb.ListUserSessionsResponse{
+			Sessions: sessions,
+			PageInfo: pbPageInfo(page, perPage, result.Total),
+		}, nil
+	}
+}
+
+const operationAdminUpdateUserRole = "admin_update_user_role"
+
+func (s *AdminServer) UpdateUserRole(ctx context.Context, req *pb.UpdateUserRoleRequest) (*pb.UpdateUserRoleResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationAdminUpdateUserRole)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		log.Warn("invalid user_id", "user_id", req.UserId)
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	u, err := s.admin.UpdateUserRole(ctx, scope.UserActor(ctx), userID, req.Role)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.Warn("user is not an admin", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "admin role required")
+	case errors.Is(err, errx.ErrorRoleNotSupported):
+		log.Info("role is not supported", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "role is not supported")
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.Info("user not found", "error", err)
+		return nil, status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, errx.ErrorSelfModeration):
+		log.Info("admins cannot moderate themselves", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "admins cannot moderate themselves")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("user role updated", "target_user_id", userID, "role", u.Role)
+		return &pb.UpdateUserRoleResponse{User: reponses.AdminUser(u)}, nil
+	}
+}
+
+const operationAdminSuspendUser = "admin_suspend_user"
+
+func (s *AdminServer) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*pb.SuspendUserResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationAdminSuspendUser)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		log.Warn("invalid user_id", "user_id", req.UserId)
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if req.Reason != nil && utf8.RuneCountInString(*req.Reason) > 1024 {
+		log.Warn("reason is too long")
+		return nil, status.Error(codes.InvalidArgument, "reason must be at most 1024 characters")
+	}
+
+	params := admin.SuspendParams{Reason: req.Reason}
+	if req.EndsAt != nil {
+		until := req.EndsAt.AsTime()
+		params.Until = &until
+	}
+
+	u, err := s.admin.SuspendUser(ctx, scope.UserActor(ctx), userID, params)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.Warn("user is not an admin", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "admin role required")
+	case errors.Is(err, errx.ErrorSuspensionEndInvalid):
+		log.Info("suspension end is not in the future", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "ends_at must be in the future")
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.Info("user not found", "error", err)
+		return nil, status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, errx.ErrorSelfModeration):
+		log.Info("admins cannot moderate themselves", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "admins cannot moderate themselves")
+	case errors.Is(err, errx.ErrorUserAlreadySuspended):
+		log.Info("user is already suspended", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "user is already suspended")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("user suspended", "target_user_id", userID)
+		return &pb.SuspendUserResponse{User: reponses.AdminUser(u)}, nil
+	}
+}
+
+const operationAdminUnsuspendUser = "admin_unsuspend_user"
+
+func (s *AdminServer) UnsuspendUser(ctx context.Context, req *pb.UnsuspendUserRequest) (*pb.UnsuspendUserResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationAdminUnsuspendUser)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		log.Warn("invalid user_id", "user_id", req.UserId)
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	u, err := s.admin.UnsuspendUser(ctx, scope.UserActor(ctx), userID)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.Warn("user is not an admin", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "admin role required")
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.Info("user not found", "error", err)
+		return nil, status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, errx.ErrorUserNotSuspended):
+		log.Info("user is not suspended", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "user is not suspended")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("user suspension lifted", "target_user_id", userID)
+		return &pb.UnsuspendUserResponse{User: reponses.AdminUser(u)}, nil
+	}
+}
+
+const operationAdminRestoreUser = "admin_restore_user"
+
+func (s *AdminServer) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationAdminRestoreUser)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		log.Warn("invalid user_id", "user_id", req.UserId)
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	u, err := s.admin.RestoreUser(ctx, scope.UserActor(ctx), userID)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.Warn("user is not an admin", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "admin role required")
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.Info("user not found", "error", err)
+		return nil, status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, errx.ErrorUserNotDeleted):
+		log.Info("user is not deleted", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "user is not deleted")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("user restored", "target_user_id", userID)
+		return &pb.RestoreUserResponse{User: reponses.AdminUser(u)}, nil
+	}
+}
+
+const operationAdminRevokeUserSessions = "admin_revoke_user_sessions"
+
+func (s *AdminServer) RevokeUserSessions(ctx context.Context, req *pb.RevokeUserSessionsRequest) (*emptypb.Empty, error) {
+	log := scope.Log(ctx).WithOperation(operationAdminRevokeUserSessions)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		log.Warn("invalid user_id", "user_id", req.UserId)
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	err = s.admin.RevokeUserSessions(ctx, scope.UserActor(ctx), userID)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.Warn("user is not an admin", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "admin role required")
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.Info("user not found", "error", err)
+		return nil, status.Error(codes.NotFound, "user not found")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("user sessions revoked", "target_user_id", userID)
+		return &emptypb.Empty{}, nil
+	}
+}
+
+func pbToUserDeletedFilter(f pb.UserDeletedFilter) user.DeletedFilter {
+	switch f {
+	case pb.UserDeletedFilter_USER_DELETED_FILTER_ALL:
+		return user.DeletedFilterAll
+	case pb.UserDeletedFilter_USER_DELETED_FILTER_DELETED:
+		return user.DeletedFilterDeleted
+	default:
+		return user.DeletedFilterActive
+	}
+}
+
+// pbPagination applies the defaults of pb.Pagination: page 1, 20 per page.
+func pbPagination(p *pb.Pagination) (page, perPage uint32) {
+	page, perPage = 1, 20
+	if p != nil {
+		if p.Page > 0 {
+			page = uint32(p.Page)
+		}
+		if p.PerPage > 0 {
+			perPage = uint32(p.PerPage)
+		}
+	}
+	return page, perPage
+}
+
+func pbPageInfo(page, perPage uint32, total uint) *pb.PageInfo {
+	return &pb.PageInfo{
+		Total:      int32(total),
+		Page:       int32(page),
+		PerPage:    int32(perPage),
+		TotalPages: int32((total + uint(perPage) - 1) / uint(perPage)),
+	}
+}
@@ -22,35 +22,56 @@ type authCore interface {
 
 type AuthServer struct {
 	pb.UnimplementedAuthServiceServer
-	auth     authCore
-	tokenMgr interceptors.TokenParser
+	auth        authCore
+	tokenMgr    interceptors.TokenParser
+	revocations interceptors.RevocationChecker
 }
 
-func NewAuthServer(auth authCore, tokenMgr interceptors.TokenParser) *AuthServer {
-	return &AuthServer{auth: auth, tokenMgr: tokenMgr}
+func NewAuthServer(
+	auth authCore,
+	tokenMgr interceptors.TokenParser,
+	revocations interceptors.RevocationChecker,
+) *AuthServer {
+	return &AuthServer{auth: auth, tokenMgr: tokenMgr, revocations: revocations}
 }
 
 const operationValidateSession = "validate_session"
 
-func (s *AuthServer) ValidateSession(ctx context.Context, _ *pb.ValidateSessionRequest) (*pb.ValidateSessionResponse, error) {
+// ValidateSession checks the user's access token from the request. Callers
+// authenticated by a service token must pass it there; legacy callers
+// without one pass it as the authorization metadata.
+func (s *AuthServer) ValidateSession(ctx context.Context, req *pb.ValidateSessionRequest) (*pb.ValidateSessionResponse, error) {
 	log := scope.Log(ctx).WithOperation(operationValidateSession)
 
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "missing metadata")
-	}
+	accessToken := req.GetAccessToken()
+	if accessToken == "" {
+		if _, ok := scope.ServiceClaims(ctx); ok {
+			return nil, status.Error(codes.InvalidArgument, "access_token is required")
+		}
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		}
 
-	values := md["authorization"]
-	if len(values) == 0 {
-		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		values := md["authorization"]
+		if len(values) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		}
+		accessToken = strings.TrimPrefix(values[0], "Bearer ")
 	}
 
-	claims, err := s.tokenMgr.ParseUserAuthAccess(strings.TrimPrefix(values[0], "Bearer "))
+	claims, err := s.tokenMgr.ParseUserAuthAccess(accessToken)
 	if err != nil {
 		log.Debug("validate session: invalid token", "error", err)
 		return nil, status.Error(codes.Unauthenticated, "invalid token")
 	}
 
+	if s.revocations.IsRevoked(ctx, claims.SessionID) {
+		log.Debug("validate session: session revoked", "session_id", claims.SessionID)
+		return nil, status.Error(codes.Unauthenticated, "session revoked")
+	}
+
 	actor := models.UserActor{
 		ID:        claims.GetAccountID(),
 		SessionID: claims.GetSessionID(),
@@ -59,6 +80,9 @@ func (s *AuthServer) ValidateSession(ctx context.Context, _ *pb.ValidateSessionR
 
 	user, sess, err := s.auth.ValidateSession(ctx, actor)
 	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("validate session: user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
 	case errors.Is(err, errx.ErrorUserNotFound),
 		errors.Is(err, errx.ErrorUserDeleted),
 		errors.Is(err, errx.ErrorSessionNotFound),
@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/netbill/auth-svc/internal/api/grpc/scope"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type MFACore interface {
+	GetMyStatus(ctx context.Context, actor models.UserActor) (models.MFAStatus, error)
+	EnrollTOTP(ctx context.Context, actor models.UserActor) (models.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, actor models.UserActor, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, actor models.UserActor, password, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, actor models.UserActor, password, code string) ([]string, error)
+}
+
+type MFAServer struct {
+	pb.UnimplementedMfaServiceServer
+	mfa MFACore
+}
+
+func NewMFAServer(mfa MFACore) *MFAServer {
+	return &MFAServer{mfa: mfa}
+}
+
+const operationGetMyMFA = "get_my_mfa"
+
+func (s *MFAServer) GetMyMfa(ctx context.Context, _ *pb.GetMyMfaRequest) (*pb.GetMyMfaResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationGetMyMFA)
+
+	st, err := s.mfa.GetMyStatus(ctx, scope.UserActor(ctx))
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	log.Info("mfa status retrieved")
+
+	resp := &pb.GetMyMfaResponse{
+		Enabled:                st.Enabled,
+		RecoveryCodesRemaining: int32(st.RecoveryCodesRemaining),
+	}
+	if st.EnabledAt != nil {
+		resp.EnabledAt = timestamppb.New(*st.EnabledAt)
+	}
+
+	return resp, nil
+}
+
+const operationEnrollTOTP = "enroll_totp"
+
+func (s *MFAServer) EnrollTotp(ctx context.Context, _ *pb.EnrollTotpRequest) (*pb.EnrollTotpResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationEnrollTOTP)
+
+	enrollment, err := s.mfa.EnrollTOTP(ctx, scope.UserActor(ctx))
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorMFAAlreadyEnabled):
+		log.Warn("mfa already enabled", "error", err)
+		return nil, status.Error(codes.AlreadyExists, "mfa is already enabled")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("totp enrollment started")
+		return &pb.EnrollTotpResponse{Secret: enrollment.Secret, Uri: enrollment.URI}, nil
+	}
+}
+
+const operationConfirmTOTP = "confirm_totp"
+
+func (s *MFAServer) ConfirmTotp(ctx context.Context, req *pb.ConfirmTotpRequest) (*pb.RecoveryCodesResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationConfirmTOTP)
+
+	if req.Code == "" {
+		log.Warn("empty code")
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	recoveryCodes, err := s.mfa.ConfirmTOTP(ctx, scope.UserActor(ctx), req.Code)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		log.Warn("invalid totp code", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	case errors.Is(err, errx.ErrorMFANotEnabled):
+		log.Warn("no pending totp enrollment", "error", err)
+		return nil, status.Error(codes.NotFound, "no pending totp enrollment")
+	case errors.Is(err, errx.ErrorMFAAlreadyEnabled):
+		log.Warn("mfa already enabled", "error", err)
+		return nil, status.Error(codes.AlreadyExists, "mfa is already enabled")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("mfa enabled")
+		return &pb.RecoveryCodesResponse{Codes: recoveryCodes}, nil
+	}
+}
+
+const operationDisableTOTP = "disable_totp"
+
+func (s *MFAServer) DisableTotp(ctx context.Context, req *pb.DisableTotpRequest) (*emptypb.Empty, error) {
+	log := scope.Log(ctx).WithOperation(operationDisableTOTP)
+
+	err := s.mfa.DisableTOTP(ctx, scope.UserActor(ctx), req.Password, req.Code)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorPasswordInvalid):
+		log.Warn("invalid password", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid password")
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		log.Warn("invalid mfa code", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid mfa code")
+	case errors.Is(err, errx.ErrorTooManyLoginAttempts):
+		log.Warn("mfa locked out", "error", err)
+		return nil, retryLater(err, "too many mfa attempts")
+	case errors.Is(err, errx.ErrorPasswordNotSet):
+		log.Warn("password not set", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "password is not set, confirm with an mfa code")
+	case errors.Is(err, errx.ErrorMFANotEnabled):
+		log.Warn("mfa not enabled", "error", err)
+		return nil, status.Error(codes.NotFound, "mfa is not enabled")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("mfa disabled")
+		return &emptypb.Empty{}, nil
+	}
+}
+
+const operationRegenerateRecoveryCodes = "regenerate_recovery_codes"
+
+func (s *MFAServer) RegenerateRecoveryCodes(
+	ctx context.Context,
+	req *pb.RegenerateRecoveryCodesRequest,
+) (*pb.RecoveryCodesResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationRegenerateRecoveryCodes)
+
+	recoveryCodes, err := s.mfa.RegenerateRecoveryCodes(ctx, scope.UserActor(ctx), req.Password, req.Code)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case errors.Is(err, errx.ErrorPasswordInvalid):
+		log.Warn("invalid password", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid password")
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		log.Warn("invalid mfa code", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid mfa code")
+	case errors.Is(err, errx.ErrorTooManyLoginAttempts):
+		log.Warn("mfa locked out", "error", err)
+		return nil, retryLater(err, "too many mfa attempts")
+	case errors.Is(err, errx.ErrorPasswordNotSet):
+		log.Warn("password not set", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "password is not set, confirm with an mfa code")
+	case errors.Is(err, errx.ErrorMFANotEnabled):
+		log.Warn("mfa not enabled", "error", err)
+		return nil, status.Error(codes.NotFound, "mfa is not enabled")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("recovery codes regenerated")
+		return &pb.RecoveryCodesResponse{Codes: recoveryCodes}, nil
+	}
+}
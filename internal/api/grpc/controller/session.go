@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"errors"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/api/grpc/reponses"
@@ -10,46 +11,52 @@ import (
 	"github.com/netbill/auth-svc/internal/errx"
 	"github.com/netbill/auth-svc/internal/models"
 	"github.com/netbill/auth-svc/internal/modules/session"
+	"github.com/netbill/auth-svc/pkg/oidcprovider"
 	"github.com/netbill/auth-svc/pkg/pb"
 	"github.com/netbill/restkit/pagi"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type SessionCore interface {
-	LoginByEmail(ctx context.Context, email, password string) (models.TokensPair, error)
-	LoginByGoogle(ctx context.Context, email string) (models.TokensPair, error)
-	Refresh(ctx context.Context, oldRefreshToken string) (models.TokensPair, error)
+	LoginByEmail(ctx context.Context, email, password string, client models.SessionClient) (models.LoginResult, error)
+	LoginByOIDC(ctx context.Context, identity models.ExternalIdentity, client models.SessionClient) (models.LoginResult, error)
+	LoginByMFA(ctx context.Context, challenge, code string, client models.SessionClient) (models.TokensPair, error)
+	Refresh(ctx context.Context, oldRefreshToken string, client models.SessionClient) (models.TokensPair, error)
 	GetMySession(ctx context.Context, actor models.UserActor, sessionID uuid.UUID) (models.Session, error)
 	GetMySessions(ctx context.Context, actor models.UserActor, opts ...session.ListSessionsOption) (pagi.Page[[]models.Session], error)
+	UpdateMySession(ctx context.Context, actor models.UserActor, sessionID uuid.UUID, params session.UpdateSessionParams) (models.Session, error)
 	Logout(ctx context.Context, actor models.UserActor) error
 	DeleteMySession(ctx context.Context, actor models.UserActor, sessionID uuid.UUID) error
-	DeleteMySessions(ctx context.Context, actor models.UserActor) error
+	DeleteMySessions(ctx context.Context, actor models.UserActor, params session.DeleteSessionsParams) (int, error)
 }
 
 type SessionMetrics interface {
 	RecordEmailLogin(ctx context.Context, err *error)
-	RecordGoogleLogin(ctx context.Context, err *error)
+	RecordOAuthLogin(ctx context.Context, provider string, err *error)
+	RecordMFALogin(ctx context.Context, err *error)
 	RecordTokenRefresh(ctx context.Context, err *error)
 	RecordSessionDeleted(ctx context.Context, scope string, err *error)
 }
 
-// GoogleIDVerifier verifies a Google-issued OpenID Connect ID token and
-// returns the verified email address it carries.
-type GoogleIDVerifier interface {
-	Verify(ctx context.Context, idToken string) (email string, err error)
+// OIDCVerifier verifies an ID token of a configured external provider and
+// returns the provider account it was issued for.
+type OIDCVerifier interface {
+	Verify(ctx context.Context, provider, idToken string) (oidcprovider.Identity, error)
 }
 
 type SessionServer struct {
 	pb.UnimplementedSessionServiceServer
-	sessions SessionCore
-	metrics  SessionMetrics
-	google   GoogleIDVerifier
+	sessions  SessionCore
+	metrics   SessionMetrics
+	providers OIDCVerifier
 }
 
-func NewSessionServer(sessions SessionCore, m SessionMetrics, google GoogleIDVerifier) *SessionServer {
-	return &SessionServer{sessions: sessions, metrics: m, google: google}
+func NewSessionServer(sessions SessionCore, m SessionMetrics, providers OIDCVerifier) *SessionServer {
+	return &SessionServer{sessions: sessions, metrics: m, providers: providers}
 }
 
 const operationLoginByEmail = "login_by_email"
@@ -58,7 +65,7 @@ func (s *SessionServer) LoginByEmail(ctx context.Context, req *pb.LoginByEmailRe
 	log := scope.Log(ctx).WithOperation(operationLoginByEmail)
 	defer s.metrics.RecordEmailLogin(ctx, &err)
 
-	pair, err := s.sessions.LoginByEmail(ctx, req.Email, req.Password)
+	result, err := s.sessions.LoginByEmail(ctx, req.Email, req.Password, scope.Client(ctx))
 	switch {
 	case errors.Is(err, errx.ErrorUserNotFound),
 		errors.Is(err, errx.ErrorUserDeleted):
@@ -67,33 +74,105 @@ func (s *SessionServer) LoginByEmail(ctx context.Context, req *pb.LoginByEmailRe
 	case errors.Is(err, errx.ErrorPasswordInvalid):
 		log.Warn("invalid password", "error", err)
 		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	case errors.Is(err, errx.ErrorTooManyLoginAttempts):
+		log.Warn("login locked out", "error", err)
+		return nil, retryLater(err, "too many login attempts")
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
 	case err != nil:
 		log.Error("unexpected error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	default:
-		log.Info("login successful")
-		return &pb.LoginResponse{Tokens: reponses.TokensPair(pair)}, nil
+		log.Info("login successful", "mfa_required", result.Challenge != nil)
+		return reponses.LoginResult(result), nil
 	}
 }
 
+const operationLoginByOIDC = "login_by_oidc"
+
+func (s *SessionServer) LoginByOidc(ctx context.Context, req *pb.LoginByOidcRequest) (*pb.LoginResponse, error) {
+	return s.loginByOIDC(ctx, operationLoginByOIDC, req.Provider, req.IdToken)
+}
+
 const operationLoginByGoogle = "login_by_google"
 
-func (s *SessionServer) LoginByGoogle(ctx context.Context, req *pb.LoginByGoogleRequest) (_ *pb.LoginResponse, err error) {
-	log := scope.Log(ctx).WithOperation(operationLoginByGoogle)
-	defer s.metrics.RecordGoogleLogin(ctx, &err)
+func (s *SessionServer) LoginByGoogle(ctx context.Context, req *pb.LoginByGoogleRequest) (*pb.LoginResponse, error) {
+	return s.loginByOIDC(ctx, operationLoginByGoogle, models.IdentityProviderGoogle, req.IdToken)
+}
+
+func (s *SessionServer) loginByOIDC(
+	ctx context.Context,
+	operation, provider, idToken string,
+) (_ *pb.LoginResponse, err error) {
+	log := scope.Log(ctx).WithOperation(operation).WithField("provider", provider)
 
-	email, err := s.google.Verify(ctx, req.IdToken)
-	if err != nil {
-		log.Warn("invalid google id token", "error", err)
-		return nil, status.Error(codes.Unauthenticated, "invalid google id token")
+	account, err := s.providers.Verify(ctx, provider, idToken)
+	switch {
+	case errors.Is(err, oidcprovider.ErrUnknownProvider):
+		log.Warn("unknown oidc provider", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "unknown provider")
+	case err != nil:
+		log.Warn("invalid id token", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid id token")
+	}
+
+	defer s.metrics.RecordOAuthLogin(ctx, provider, &err)
+	result, err := s.sessions.LoginByOIDC(ctx, models.ExternalIdentity{
+		Provider:      provider,
+		Subject:       account.Subject,
+		Email:         account.Email,
+		EmailVerified: account.EmailVerified,
+	}, scope.Client(ctx))
+	switch {
+	case errors.Is(err, errx.ErrorUserNotFound),
+		errors.Is(err, errx.ErrorUserDeleted):
+		log.Warn("user not found", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	case errors.Is(err, errx.ErrorIdentityNotLinked):
+		log.Warn("provider account not linked", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "provider account is not linked, sign in and link it first")
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("login successful", "mfa_required", result.Challenge != nil)
+		return reponses.LoginResult(result), nil
+	}
+}
+
+const operationLoginByMFA = "login_by_mfa"
+
+func (s *SessionServer) LoginByMfa(ctx context.Context, req *pb.LoginByMfaRequest) (_ *pb.LoginResponse, err error) {
+	log := scope.Log(ctx).WithOperation(operationLoginByMFA)
+	defer s.metrics.RecordMFALogin(ctx, &err)
+
+	if req.ChallengeToken == "" || req.Code == "" {
+		log.Warn("empty challenge token or code")
+		return nil, status.Error(codes.InvalidArgument, "challenge_token and code are required")
 	}
 
-	pair, err := s.sessions.LoginByGoogle(ctx, email)
+	pair, err := s.sessions.LoginByMFA(ctx, req.ChallengeToken, req.Code, scope.Client(ctx))
 	switch {
+	case errors.Is(err, errx.ErrorMFAChallengeInvalid):
+		log.Warn("invalid mfa challenge", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid mfa challenge")
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		log.Warn("invalid mfa code", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid mfa code")
+	case errors.Is(err, errx.ErrorTooManyLoginAttempts):
+		log.Warn("mfa locked out", "error", err)
+		return nil, retryLater(err, "too many login attempts")
 	case errors.Is(err, errx.ErrorUserNotFound),
 		errors.Is(err, errx.ErrorUserDeleted):
 		log.Warn("user not found", "error", err)
 		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
 	case err != nil:
 		log.Error("unexpected error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
@@ -109,14 +188,25 @@ func (s *SessionServer) Refresh(ctx context.Context, req *pb.RefreshRequest) (_
 	log := scope.Log(ctx).WithOperation(operationRefresh)
 	defer s.metrics.RecordTokenRefresh(ctx, &err)
 
-	pair, err := s.sessions.Refresh(ctx, req.RefreshToken)
+	pair, err := s.sessions.Refresh(ctx, req.RefreshToken, scope.Client(ctx))
 	switch {
+	case errors.Is(err, errx.ErrorSessionTokenReused):
+		log.Warn("refresh token reuse detected, sessions revoked", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	case errors.Is(err, errx.ErrorSessionIdleTimeout),
+		errors.Is(err, errx.ErrorSessionLifetimeExceeded),
+		errors.Is(err, errx.ErrorSessionEvicted):
+		log.Info("session ended by session policy", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "session ended")
 	case errors.Is(err, errx.ErrorSessionExpired),
 		errors.Is(err, errx.ErrorSessionTokenMismatch),
 		errors.Is(err, errx.ErrorSessionNotFound),
 		errors.Is(err, errx.ErrorUserNotFound):
 		log.Warn("invalid refresh token", "error", err)
 		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
 	case err != nil:
 		log.Error("unexpected error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
@@ -199,6 +289,44 @@ func (s *SessionServer) GetMySessions(ctx context.Context, req *pb.GetMySessions
 	}
 }
 
+const operationUpdateMySession = "update_my_session"
+
+func (s *SessionServer) UpdateMySession(ctx context.Context, req *pb.UpdateMySessionRequest) (*pb.UpdateMySessionResponse, error) {
+	log := scope.Log(ctx).WithOperation(operationUpdateMySession)
+
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		log.Warn("invalid session_id", "session_id", req.SessionId)
+		return nil, status.Error(codes.InvalidArgument, "invalid session_id")
+	}
+
+	if utf8.RuneCountInString(req.DeviceName) > 64 {
+		log.Warn("device_name is too long")
+		return nil, status.Error(codes.InvalidArgument, "device_name must be at most 64 characters")
+	}
+
+	sess, err := s.sessions.UpdateMySession(ctx, scope.UserActor(ctx), sessionID, session.UpdateSessionParams{
+		DeviceName: req.DeviceName,
+	})
+	switch {
+	case errors.Is(err, errx.ErrorSessionNotFound):
+		log.Warn("session not found", "error", err)
+		return nil, status.Error(codes.NotFound, "session not found")
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.Warn("invalid session", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid session")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("session updated")
+		return &pb.UpdateMySessionResponse{Session: reponses.Session(sess)}, nil
+	}
+}
+
 const operationLogout = "logout"
 
 func (s *SessionServer) Logout(ctx context.Context, _ *pb.LogoutRequest) (*emptypb.Empty, error) {
@@ -234,6 +362,9 @@ func (s *SessionServer) DeleteMySession(ctx context.Context, req *pb.DeleteMySes
 	case errors.Is(err, errx.ErrorSessionNotFound):
 		log.Warn("session not found", "error", err)
 		return nil, status.Error(codes.NotFound, "session not found")
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
 	case errors.Is(err, errx.ErrorUserInvalidSession):
 		log.Warn("invalid session", "error", err)
 		return nil, status.Error(codes.Unauthenticated, "invalid session")
@@ -248,11 +379,35 @@ func (s *SessionServer) DeleteMySession(ctx context.Context, req *pb.DeleteMySes
 
 const operationDeleteMySessions = "delete_my_sessions"
 
-func (s *SessionServer) DeleteMySessions(ctx context.Context, _ *pb.DeleteMySessionsRequest) (*emptypb.Empty, error) {
+func (s *SessionServer) DeleteMySessions(
+	ctx context.Context,
+	req *pb.DeleteMySessionsRequest,
+) (*pb.DeleteMySessionsResponse, error) {
 	log := scope.Log(ctx).WithOperation(operationDeleteMySessions)
 
-	err := s.sessions.DeleteMySessions(ctx, scope.UserActor(ctx))
+	params := session.DeleteSessionsParams{KeepCurrent: req.KeepCurrent}
+	if req.LastUsedBefore != nil {
+		before := req.LastUsedBefore.AsTime()
+		params.LastUsedBefore = &before
+	}
+	if req.CreatedBefore != nil {
+		before := req.CreatedBefore.AsTime()
+		params.CreatedBefore = &before
+	}
+	for _, raw := range req.SessionIds {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			log.Warn("invalid session_ids entry", "session_id", raw)
+			return nil, status.Error(codes.InvalidArgument, "invalid session_ids entry")
+		}
+		params.SessionIDs = append(params.SessionIDs, id)
+	}
+
+	revoked, err := s.sessions.DeleteMySessions(ctx, scope.UserActor(ctx), params)
 	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
 	case errors.Is(err, errx.ErrorUserInvalidSession),
 		errors.Is(err, errx.ErrorSessionNotFound):
 		log.Warn("invalid session", "error", err)
@@ -261,11 +416,25 @@ func (s *SessionServer) DeleteMySessions(ctx context.Context, _ *pb.DeleteMySess
 		log.Error("unexpected error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	default:
-		log.Info("sessions deleted")
-		return &emptypb.Empty{}, nil
+		log.Info("sessions deleted", "count", revoked)
+		return &pb.DeleteMySessionsResponse{Revoked: int32(revoked)}, nil
 	}
 }
 
+// retryLater builds a ResourceExhausted status carrying the wait hint of err
+// as RetryInfo, the gRPC counterpart of the REST Retry-After header.
+func retryLater(err error, msg string) error {
+	st := status.New(codes.ResourceExhausted, msg)
+
+	if d, ok := errx.RetryAfter(err); ok {
+		if detailed, derr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(d)}); derr == nil {
+			st = detailed
+		}
+	}
+
+	return st.Err()
+}
+
 func pbToDeletedFilter(f pb.SessionDeletedFilter) session.DeletedFilter {
 	switch f {
 	case pb.SessionDeletedFilter_SESSION_DELETED_FILTER_ACTIVE:
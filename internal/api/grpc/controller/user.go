@@ -21,6 +21,8 @@ type UserCore interface {
 	GetMyUserByID(ctx context.Context, actor models.UserActor) (models.User, error)
 	GetMyEmailByID(ctx context.Context, actor models.UserActor) (models.UserEmail, error)
 	UpdatePassword(ctx context.Context, actor models.UserActor, oldPassword, newPassword string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, token, newPassword string, client models.SessionClient) error
 	DeleteMyUser(ctx context.Context, actor models.UserActor) error
 }
 
@@ -127,6 +129,9 @@ func (s *UserServer) UpdatePassword(ctx context.Context, req *pb.UpdatePasswordR
 	case errors.Is(err, errx.ErrorCannotChangePasswordYet):
 		log.Warn("cannot change password yet", "error", err)
 		return nil, status.Error(codes.FailedPrecondition, "cannot change password yet")
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
 	case errors.Is(err, errx.ErrorUserInvalidSession):
 		log.Warn("invalid session", "error", err)
 		return nil, status.Error(codes.Unauthenticated, "invalid session")
@@ -139,6 +144,49 @@ func (s *UserServer) UpdatePassword(ctx context.Context, req *pb.UpdatePasswordR
 	}
 }
 
+const operationRequestPasswordReset = "request_password_reset"
+
+func (s *UserServer) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	log := scope.Log(ctx).WithOperation(operationRequestPasswordReset)
+
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	// The response is the same whether the email is known or not.
+	err := s.users.RequestPasswordReset(ctx, req.Email)
+	switch {
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("password reset requested")
+		return &emptypb.Empty{}, nil
+	}
+}
+
+const operationConfirmPasswordReset = "confirm_password_reset"
+
+func (s *UserServer) ConfirmPasswordReset(ctx context.Context, req *pb.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	log := scope.Log(ctx).WithOperation(operationConfirmPasswordReset)
+
+	err := s.users.ConfirmPasswordReset(ctx, req.Token, req.NewPassword, scope.Client(ctx))
+	switch {
+	case errors.Is(err, errx.ErrorPasswordResetTokenInvalid):
+		log.Warn("invalid password reset token", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "token is invalid, expired or already used")
+	case errors.Is(err, errx.ErrorPasswordIsNotAllowed):
+		log.Warn("password is not allowed", "error", err)
+		return nil, status.Error(codes.InvalidArgument, "password is not allowed")
+	case err != nil:
+		log.Error("unexpected error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	default:
+		log.Info("password reset")
+		return &emptypb.Empty{}, nil
+	}
+}
+
 const operationDeleteMyUser = "delete_my_user"
 
 func (s *UserServer) DeleteMyUser(ctx context.Context, _ *pb.DeleteMyUserRequest) (*emptypb.Empty, error) {
@@ -146,6 +194,9 @@ func (s *UserServer) DeleteMyUser(ctx context.Context, _ *pb.DeleteMyUserRequest
 
 	err := s.users.DeleteMyUser(ctx, scope.UserActor(ctx))
 	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.Warn("user is suspended", "error", err)
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
 	case errors.Is(err, errx.ErrorUserInvalidSession):
 		log.Warn("invalid session", "error", err)
 		return nil, status.Error(codes.Unauthenticated, "invalid session")
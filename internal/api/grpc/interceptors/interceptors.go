@@ -4,25 +4,54 @@ import (
 	"context"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/api/grpc/scope"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/clientip"
 	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/netbill/auth-svc/pkg/tokenmanager"
 	"github.com/netbill/restkit/tokens"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
 var publicMethods = map[string]struct{}{
-	"/auth.v1.UserService/CreateUser":       {},
-	"/auth.v1.SessionService/LoginByEmail":  {},
-	"/auth.v1.SessionService/LoginByGoogle": {},
-	"/auth.v1.SessionService/Refresh":       {},
-	"/auth.v1.AuthService/ValidateSession":  {},
+	"/auth.v1.UserService/CreateUser":           {},
+	"/auth.v1.UserService/RequestPasswordReset": {},
+	"/auth.v1.UserService/ConfirmPasswordReset": {},
+	"/auth.v1.SessionService/LoginByEmail":      {},
+	"/auth.v1.SessionService/LoginByOidc":       {},
+	"/auth.v1.SessionService/LoginByGoogle":     {},
+	"/auth.v1.SessionService/LoginByMfa":        {},
+	"/auth.v1.SessionService/Refresh":           {},
 }
 
+const validateSessionMethod = "/auth.v1.AuthService/ValidateSession"
+
+// serviceMethods are the methods service tokens may call, with the scope
+// each of them needs. They take service tokens only; every other method
+// takes user tokens only.
+var serviceMethods = map[string]string{
+	validateSessionMethod: models.ScopeSessionsValidate,
+}
+
+// adminService is the prefix of the methods only admins may call. The
+// service checks the stored role again; this only turns away everyone else
+// early.
+const adminService = "/auth.v1.AdminService/"
+
 type TokenParser interface {
 	ParseUserAuthAccess(tokenStr string) (tokens.AccountAuthClaims, error)
+	ParseServiceAccess(tokenStr string) (tokenmanager.ServiceClaims, error)
+}
+
+// RevocationChecker tells whether a session was revoked after its access
+// tokens were issued.
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, sessionID uuid.UUID) bool
 }
 
 func LogInterceptor(logger *log.Logger) grpc.UnaryServerInterceptor {
@@ -33,30 +62,102 @@ func LogInterceptor(logger *log.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
-func AuthInterceptor(tokenMgr TokenParser) grpc.UnaryServerInterceptor {
+// ClientIPInterceptor resolves the caller's address from the peer, and from
+// x-forwarded-for and x-real-ip metadata only when the peer is a trusted
+// proxy.
+func ClientIPInterceptor(resolver *clientip.Resolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var peerAddr string
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			peerAddr = p.Addr.String()
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		var realIP string
+		if v := md.Get("x-real-ip"); len(v) > 0 {
+			realIP = v[0]
+		}
+		ip := resolver.Resolve(peerAddr, strings.Join(md.Get("x-forwarded-for"), ","), realIP)
+
+		return handler(scope.CtxWithClientIP(ctx, ip), req)
+	}
+}
+
+type AuthConfig struct {
+	// LegacyValidateSession lets ValidateSession run without a service
+	// token, for callers that still pass the user's access token as the
+	// authorization metadata. The method is then unauthenticated.
+	LegacyValidateSession bool
+}
+
+// AuthInterceptor authenticates the caller from the authorization metadata.
+// A service token is accepted on serviceMethods and must carry the method's
+// scope. A public method that is also a service method runs without
+// credentials too, or with a token that is not a service token, which the
+// handler reads itself. A user token of a revoked session is refused.
+func AuthInterceptor(tokenMgr TokenParser, revocations RevocationChecker, cfg AuthConfig) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		if _, ok := publicMethods[info.FullMethod]; ok {
+		_, public := publicMethods[info.FullMethod]
+		if cfg.LegacyValidateSession && info.FullMethod == validateSessionMethod {
+			public = true
+		}
+		requiredScope, serviceMethod := serviceMethods[info.FullMethod]
+		if public && !serviceMethod {
 			return handler(ctx, req)
 		}
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		tokenStr, err := bearerToken(ctx)
+		if err != nil {
+			if public {
+				return handler(ctx, req)
+			}
+			return nil, err
 		}
 
-		values := md["authorization"]
-		if len(values) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		if serviceMethod {
+			if claims, err := tokenMgr.ParseServiceAccess(tokenStr); err == nil {
+				if !claims.HasScope(requiredScope) {
+					return nil, status.Errorf(codes.PermissionDenied, "service token lacks scope %s", requiredScope)
+				}
+				return handler(scope.CtxWithServiceClaims(ctx, claims), req)
+			}
+		}
+		if public {
+			return handler(ctx, req)
+		}
+		if serviceMethod {
+			return nil, status.Error(codes.Unauthenticated, "service token required")
 		}
 
-		tokenStr := strings.TrimPrefix(values[0], "Bearer ")
 		claims, err := tokenMgr.ParseUserAuthAccess(tokenStr)
 		if err != nil {
 			return nil, status.Error(codes.Unauthenticated, "invalid token")
 		}
 
+		if revocations.IsRevoked(ctx, claims.SessionID) {
+			return nil, status.Error(codes.Unauthenticated, "session revoked")
+		}
+
+		if strings.HasPrefix(info.FullMethod, adminService) && claims.GetRole() != tokens.RoleSystemAdmin {
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
 		ctx = scope.CtxWithClaims(ctx, claims)
 
 		return handler(ctx, req)
 	}
 }
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	values := md["authorization"]
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	return strings.TrimPrefix(values[0], "Bearer "), nil
+}
@@ -17,6 +17,24 @@ func User(a models.User) *pb.User {
 	if a.DeletedAt != nil {
 		out.DeletedAt = timestamppb.New(*a.DeletedAt)
 	}
+	if a.SuspendedAt != nil {
+		out.SuspendedAt = timestamppb.New(*a.SuspendedAt)
+	}
+	if a.SuspendedUntil != nil {
+		out.SuspendedUntil = timestamppb.New(*a.SuspendedUntil)
+	}
+	return out
+}
+
+// AdminUser is User with who suspended the user and why, which only admins
+// get to see.
+func AdminUser(a models.User) *pb.User {
+	out := User(a)
+	if a.SuspendedBy != nil {
+		by := a.SuspendedBy.String()
+		out.SuspendedBy = &by
+	}
+	out.SuspensionReason = a.SuspensionReason
 	return out
 }
 
@@ -43,6 +61,12 @@ func Session(s models.Session) *pb.Session {
 		CreatedAt: timestamppb.New(s.CreatedAt),
 		UpdatedAt: timestamppb.New(s.UpdatedAt),
 		LastUsed:  timestamppb.New(s.LastUsed),
+
+		UserAgent:  s.UserAgent,
+		ClientIp:   s.ClientIP,
+		Platform:   s.Platform,
+		Browser:    s.Browser,
+		DeviceName: s.DeviceName,
 	}
 	if s.DeletedAt != nil {
 		out.DeletedAt = timestamppb.New(*s.DeletedAt)
@@ -57,3 +81,16 @@ func TokensPair(t models.TokensPair) *pb.TokensPair {
 		RefreshToken: t.Refresh,
 	}
 }
+
+func LoginResult(r models.LoginResult) *pb.LoginResponse {
+	if r.Challenge != nil {
+		return &pb.LoginResponse{
+			MfaChallenge: &pb.MfaChallenge{
+				Token:     r.Challenge.Token,
+				ExpiresAt: timestamppb.New(r.Challenge.ExpiresAt),
+			},
+		}
+	}
+
+	return &pb.LoginResponse{Tokens: TokensPair(r.Tokens)}
+}
@@ -0,0 +1,46 @@
+package scope
+
+import (
+	"context"
+	"net"
+	"net/netip"
+
+	"github.com/netbill/auth-svc/internal/models"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Client describes the caller for session bookkeeping from the incoming
+// metadata. As in the REST API, the address keys the per-IP login limiter,
+// so it is the one CtxWithClientIP stored, which honours x-forwarded-for
+// only from trusted proxies; without it the peer address is used.
+func Client(ctx context.Context) models.SessionClient {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var client models.SessionClient
+	if ua := md.Get("user-agent"); len(ua) > 0 {
+		client.UserAgent = ua[0]
+	}
+
+	if ip, ok := ctx.Value(ClientIPCtxKey).(string); ok {
+		client.IP = ip
+		return client
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		if addr, err := netip.ParseAddr(host); err == nil {
+			client.IP = addr.Unmap().String()
+		}
+	}
+
+	return client
+}
+
+// CtxWithClientIP stores the resolved address of the caller.
+func CtxWithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, ClientIPCtxKey, ip)
+}
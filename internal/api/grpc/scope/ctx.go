@@ -5,6 +5,7 @@ import (
 
 	"github.com/netbill/auth-svc/internal/models"
 	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/netbill/auth-svc/pkg/tokenmanager"
 	"github.com/netbill/restkit/tokens"
 )
 
@@ -13,18 +14,32 @@ type ctxKey int
 const (
 	UserDataCtxKey ctxKey = iota
 	LogCtxKey
+	ServiceDataCtxKey
+	ClientIPCtxKey
 )
 
 func CtxWithClaims(ctx context.Context, claims tokens.AccountAuthClaims) context.Context {
 	return context.WithValue(ctx, UserDataCtxKey, claims)
 }
 
+func CtxWithServiceClaims(ctx context.Context, claims tokenmanager.ServiceClaims) context.Context {
+	return context.WithValue(ctx, ServiceDataCtxKey, claims)
+}
+
+// ServiceClaims returns the claims of the calling service, if it
+// authenticated with a service token.
+func ServiceClaims(ctx context.Context) (tokenmanager.ServiceClaims, bool) {
+	claims, ok := ctx.Value(ServiceDataCtxKey).(tokenmanager.ServiceClaims)
+	return claims, ok
+}
+
 func UserActor(ctx context.Context) models.UserActor {
 	claims := ctx.Value(UserDataCtxKey).(tokens.AccountAuthClaims)
 	return models.UserActor{
 		ID:        claims.GetAccountID(),
 		SessionID: claims.GetSessionID(),
 		Role:      claims.GetRole(),
+		Client:    Client(ctx),
 	}
 }
 
@@ -40,5 +55,9 @@ func Log(ctx context.Context) *log.Logger {
 		log = log.WithUserAuthClaims(authClaims)
 	}
 
+	if serviceClaims, ok := ServiceClaims(ctx); ok {
+		log = log.WithServiceAuthClaims(serviceClaims)
+	}
+
 	return log
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/netbill/auth-svc/internal/api/grpc/interceptors"
 	"github.com/netbill/auth-svc/internal/modules/auth"
 	"github.com/netbill/auth-svc/internal/observability/metrics"
+	"github.com/netbill/auth-svc/pkg/clientip"
 	"github.com/netbill/auth-svc/pkg/log"
 	"github.com/netbill/auth-svc/pkg/pb"
 	"google.golang.org/grpc"
@@ -19,8 +20,12 @@ type Server struct {
 	auth     *auth.Service
 	users    controller.UserCore
 	sessions controller.SessionCore
-	google   controller.GoogleIDVerifier
+	mfa      controller.MFACore
+	admin    controller.AdminCore
+	oidc     controller.OIDCVerifier
 	tokenMgr interceptors.TokenParser
+	revoked  interceptors.RevocationChecker
+	clientIP *clientip.Resolver
 	metrics  *metrics.Metrics
 	log      *log.Logger
 }
@@ -29,14 +34,21 @@ type ServerDeps struct {
 	Auth     *auth.Service
 	Users    controller.UserCore
 	Sessions controller.SessionCore
-	Google   controller.GoogleIDVerifier
+	MFA      controller.MFACore
+	Admin    controller.AdminCore
+	OIDC     controller.OIDCVerifier
 	TokenMgr interceptors.TokenParser
+	Revoked  interceptors.RevocationChecker
+	ClientIP *clientip.Resolver
 	Metrics  *metrics.Metrics
 	Log      *log.Logger
 }
 
 type Config struct {
 	Port int
+
+	// LegacyValidateSession is interceptors.AuthConfig.LegacyValidateSession.
+	LegacyValidateSession bool
 }
 
 func New(deps ServerDeps) *Server {
@@ -44,9 +56,13 @@ func New(deps ServerDeps) *Server {
 		auth:     deps.Auth,
 		users:    deps.Users,
 		sessions: deps.Sessions,
-		google:   deps.Google,
+		mfa:      deps.MFA,
+		admin:    deps.Admin,
+		oidc:     deps.OIDC,
 		metrics:  deps.Metrics,
 		tokenMgr: deps.TokenMgr,
+		revoked:  deps.Revoked,
+		clientIP: deps.ClientIP,
 		log:      deps.Log,
 	}
 }
@@ -61,12 +77,17 @@ func (s *Server) Run(ctx context.Context, cfg Config) {
 	srv := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			interceptors.LogInterceptor(s.log),
-			interceptors.AuthInterceptor(s.tokenMgr),
+			interceptors.ClientIPInterceptor(s.clientIP),
+			interceptors.AuthInterceptor(s.tokenMgr, s.revoked, interceptors.AuthConfig{
+				LegacyValidateSession: cfg.LegacyValidateSession,
+			}),
 		),
 	)
-	pb.RegisterAuthServiceServer(srv, controller.NewAuthServer(s.auth, s.tokenMgr))
+	pb.RegisterAuthServiceServer(srv, controller.NewAuthServer(s.auth, s.tokenMgr, s.revoked))
 	pb.RegisterUserServiceServer(srv, controller.NewUserServer(s.users, s.metrics))
-	pb.RegisterSessionServiceServer(srv, controller.NewSessionServer(s.sessions, s.metrics, s.google))
+	pb.RegisterSessionServiceServer(srv, controller.NewSessionServer(s.sessions, s.metrics, s.oidc))
+	pb.RegisterMfaServiceServer(srv, controller.NewMFAServer(s.mfa))
+	pb.RegisterAdminServiceServer(srv, controller.NewAdminServer(s.admin))
 	reflection.Register(srv)
 
 	s.log.Info("starting grpc server", "port", cfg.Port)
@@ -0,0 +1,557 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/api/rest/requests"
+	"github.com/netbill/auth-svc/internal/api/rest/responses"
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/admin"
+	"github.com/netbill/auth-svc/internal/modules/audit"
+	"github.com/netbill/auth-svc/internal/modules/session"
+	"github.com/netbill/auth-svc/internal/modules/user"
+	"github.com/netbill/restkit/pagi"
+	"github.com/netbill/restkit/problems"
+	"github.com/netbill/restkit/render"
+)
+
+type adminCore interface {
+	ListUsers(
+		ctx context.Context,
+		actor models.UserActor,
+		params user.FilterParams,
+		limit, offset uint,
+	) (pagi.Page[[]models.User], error)
+	GetUser(ctx context.Context, actor models.UserActor, userID uuid.UUID) (models.User, models.UserEmail, error)
+	ListUserSessions(
+		ctx context.Context,
+		actor models.UserActor,
+		userID uuid.UUID,
+		opts ...session.ListSessionsOption,
+	) (pagi.Page[[]models.Session], error)
+
+	UpdateUserRole(ctx context.Context, actor models.UserActor, userID uuid.UUID, role string) (models.User, error)
+	SuspendUser(
+		ctx context.Context,
+		actor models.UserActor,
+		userID uuid.UUID,
+		params admin.SuspendParams,
+	) (models.User, error)
+	UnsuspendUser(ctx context.Context, actor models.UserActor, userID uuid.UUID) (models.User, error)
+	RestoreUser(ctx context.Context, actor models.UserActor, userID uuid.UUID) (models.User, error)
+	RevokeUserSessions(ctx context.Context, actor models.UserActor, userID uuid.UUID) error
+
+	ListAuthEvents(
+		ctx context.Context,
+		actor models.UserActor,
+		params audit.FilterParams,
+		limit, offset uint,
+	) (pagi.Page[[]models.AuthEvent], error)
+}
+
+// AdminController is the user management API for sysadmins. Its routes are
+// behind the sysadmin middleware, and the service checks the stored role
+// again.
+type AdminController struct {
+	admin adminCore
+}
+
+func NewAdminController(admin adminCore) *AdminController {
+	return &AdminController{admin: admin}
+}
+
+const operationAdminListUsers = "admin_list_users"
+
+func (c *AdminController) ListUsers(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationAdminListUsers)
+
+	limit, offset := pagi.GetPagination(r)
+
+	filters := user.FilterParams{}
+
+	q := r.URL.Query()
+	if text := strings.TrimSpace(q.Get("text")); text != "" {
+		filters.Text = &text
+	}
+
+	switch q.Get("filter[deleted]") {
+	case "deleted":
+		filters.Deleted = user.DeletedFilterDeleted
+	case "all":
+		filters.Deleted = user.DeletedFilterAll
+	default:
+		filters.Deleted = user.DeletedFilterActive
+	}
+
+	res, err := c.admin.ListUsers(r.Context(), scope.UserActor(r), filters, limit, offset)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.WithError(err).Warn("user is not an admin")
+		render.ResponseError(w, problems.Forbidden("user does not have enough permissions"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		render.Response(w, http.StatusOK, responses.UserCollection(r, res))
+	}
+}
+
+const operationAdminGetUser = "admin_get_user"
+
+func (c *AdminController) GetUser(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationAdminGetUser)
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("target_user_id", chi.URLParam(r, "user_id")).
+			Warn("invalid user id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid user id: %s", chi.URLParam(r, "user_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("target_user_id", userID)
+
+	u, email, err := c.admin.GetUser(r.Context(), scope.UserActor(r), userID)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.WithError(err).Warn("user is not an admin")
+		render.ResponseError(w, problems.Forbidden("user does not have enough permissions"))
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.WithError(err).Info("user not found")
+		render.ResponseError(w, problems.NotFound("user not found"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		render.Response(w, http.StatusOK, responses.User(r, u, responses.WithUserEmail(email), responses.WithSuspensionDetails()))
+	}
+}
+
+const operationAdminGetUserSessions = "admin_get_user_sessions"
+
+func (c *AdminController) GetUserSessions(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationAdminGetUserSessions)
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("target_user_id", chi.URLParam(r, "user_id")).
+			Warn("invalid user id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid user id: %s", chi.URLParam(r, "user_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("target_user_id", userID)
+
+	limit, offset := pagi.GetPagination(r)
+
+	opts := []session.ListSessionsOption{
+		session.WithLimit(limit),
+		session.WithOffset(offset),
+	}
+
+	switch r.URL.Query().Get("filter[active]") {
+	case "true":
+		opts = append(opts, session.WithDeleted(session.DeletedFilterActive))
+	case "false":
+		opts = append(opts, session.WithDeleted(session.DeletedFilterDeleted))
+	default:
+		opts = append(opts, session.WithDeleted(session.DeletedFilterAll))
+	}
+
+	switch r.URL.Query().Get("sort[last_used]") {
+	case "asc":
+		opts = append(opts, session.WithLastUsedOrder(session.LastUsedAsc))
+	default:
+		opts = append(opts, session.WithLastUsedOrder(session.LastUsedDesc))
+	}
+
+	sessions, err := c.admin.ListUserSessions(r.Context(), scope.UserActor(r), userID, opts...)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.WithError(err).Warn("user is not an admin")
+		render.ResponseError(w, problems.Forbidden("user does not have enough permissions"))
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.WithError(err).Info("user not found")
+		render.ResponseError(w, problems.NotFound("user not found"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		render.Response(w, http.StatusOK, responses.UserSessionsCollection(r, sessions))
+	}
+}
+
+const operationAdminUpdateUserRole = "admin_update_user_role"
+
+func (c *AdminController) UpdateUserRole(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationAdminUpdateUserRole)
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("target_user_id", chi.URLParam(r, "user_id")).
+			Warn("invalid user id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid user id: %s", chi.URLParam(r, "user_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("target_user_id", userID)
+
+	req, err := requests.UpdateUserRole(r)
+	if err != nil {
+		log.WithError(err).Info("invalid update user role request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	u, err := c.admin.UpdateUserRole(r.Context(), scope.UserActor(r), userID, req.Data.Attributes.Role)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.WithError(err).Warn("user is not an admin")
+		render.ResponseError(w, problems.Forbidden("user does not have enough permissions"))
+	case errors.Is(err, errx.ErrorRoleNotSupported):
+		log.WithError(err).Info("role is not supported")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/role": err,
+		})...)
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.WithError(err).Info("user not found")
+		render.ResponseError(w, problems.NotFound("user not found"))
+	case errors.Is(err, errx.ErrorSelfModeration):
+		log.WithError(err).Info("admins cannot moderate themselves")
+		render.ResponseError(w, problems.Conflict("admins cannot moderate themselves"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.WithField("role", u.Role).Info("user role updated")
+		render.Response(w, http.StatusOK, responses.User(r, u, responses.WithSuspensionDetails()))
+	}
+}
+
+const operationAdminSuspendUser = "admin_suspend_user"
+
+func (c *AdminController) SuspendUser(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationAdminSuspendUser)
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("target_user_id", chi.URLParam(r, "user_id")).
+			Warn("invalid user id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid user id: %s", chi.URLParam(r, "user_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("target_user_id", userID)
+
+	req, err := requests.SuspendUser(r)
+	if err != nil {
+		log.WithError(err).Info("invalid suspend user request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	u, err := c.admin.SuspendUser(r.Context(), scope.UserActor(r), userID, admin.SuspendParams{
+		Reason: req.Data.Attributes.Reason,
+		Until:  req.Data.Attributes.EndsAt,
+	})
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.WithError(err).Warn("user is not an admin")
+		render.ResponseError(w, problems.Forbidden("user does not have enough permissions"))
+	case errors.Is(err, errx.ErrorSuspensionEndInvalid):
+		log.WithError(err).Info("suspension end is not in the future")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/ends_at": fmt.Errorf("must be in the future"),
+		})...)
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.WithError(err).Info("user not found")
+		render.ResponseError(w, problems.NotFound("user not found"))
+	case errors.Is(err, errx.ErrorSelfModeration):
+		log.WithError(err).Info("admins cannot moderate themselves")
+		render.ResponseError(w, problems.Conflict("admins cannot moderate themselves"))
+	case errors.Is(err, errx.ErrorUserAlreadySuspended):
+		log.WithError(err).Info("user is already suspended")
+		render.ResponseError(w, problems.Conflict("user is already suspended"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("user suspended")
+		render.Response(w, http.StatusOK, responses.User(r, u, responses.WithSuspensionDetails()))
+	}
+}
+
+const operationAdminUnsuspendUser = "admin_unsuspend_user"
+
+func (c *AdminController) UnsuspendUser(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationAdminUnsuspendUser)
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("target_user_id", chi.URLParam(r, "user_id")).
+			Warn("invalid user id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid user id: %s", chi.URLParam(r, "user_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("target_user_id", userID)
+
+	u, err := c.admin.UnsuspendUser(r.Context(), scope.UserActor(r), userID)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.WithError(err).Warn("user is not an admin")
+		render.ResponseError(w, problems.Forbidden("user does not have enough permissions"))
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.WithError(err).Info("user not found")
+		render.ResponseError(w, problems.NotFound("user not found"))
+	case errors.Is(err, errx.ErrorUserNotSuspended):
+		log.WithError(err).Info("user is not suspended")
+		render.ResponseError(w, problems.Conflict("user is not suspended"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("user suspension lifted")
+		render.Response(w, http.StatusOK, responses.User(r, u, responses.WithSuspensionDetails()))
+	}
+}
+
+const operationAdminRestoreUser = "admin_restore_user"
+
+func (c *AdminController) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationAdminRestoreUser)
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("target_user_id", chi.URLParam(r, "user_id")).
+			Warn("invalid user id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid user id: %s", chi.URLParam(r, "user_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("target_user_id", userID)
+
+	u, err := c.admin.RestoreUser(r.Context(), scope.UserActor(r), userID)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.WithError(err).Warn("user is not an admin")
+		render.ResponseError(w, problems.Forbidden("user does not have enough permissions"))
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.WithError(err).Info("user not found")
+		render.ResponseError(w, problems.NotFound("user not found"))
+	case errors.Is(err, errx.ErrorUserNotDeleted):
+		log.WithError(err).Info("user is not deleted")
+		render.ResponseError(w, problems.Conflict("user is not deleted"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("user restored")
+		render.Response(w, http.StatusOK, responses.User(r, u, responses.WithSuspensionDetails()))
+	}
+}
+
+const operationAdminRevokeUserSessions = "admin_revoke_user_sessions"
+
+func (c *AdminController) RevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationAdminRevokeUserSessions)
+
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("target_user_id", chi.URLParam(r, "user_id")).
+			Warn("invalid user id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid user id: %s", chi.URLParam(r, "user_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("target_user_id", userID)
+
+	err = c.admin.RevokeUserSessions(r.Context(), scope.UserActor(r), userID)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.WithError(err).Warn("user is not an admin")
+		render.ResponseError(w, problems.Forbidden("user does not have enough permissions"))
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.WithError(err).Info("user not found")
+		render.ResponseError(w, problems.NotFound("user not found"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("user sessions revoked")
+		render.Response(w, http.StatusNoContent, nil)
+	}
+}
+
+const operationAdminListAuthEvents = "admin_list_auth_events"
+
+func (c *AdminController) ListAuthEvents(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationAdminListAuthEvents)
+
+	limit, offset := pagi.GetPagination(r)
+
+	filters, err := authEventFilters(r)
+	if err != nil {
+		log.WithError(err).Info("invalid auth events filter")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	res, err := c.admin.ListAuthEvents(r.Context(), scope.UserActor(r), filters, limit, offset)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserForbidden):
+		log.WithError(err).Warn("user is not an admin")
+		render.ResponseError(w, problems.Forbidden("user does not have enough permissions"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		render.Response(w, http.StatusOK, responses.AuthEventsCollection(r, res))
+	}
+}
+
+// authEventFilters reads the filter[...] query parameters of the audit log
+// search. Unknown event kinds and outcomes are not rejected; they match
+// nothing.
+func authEventFilters(r *http.Request) (audit.FilterParams, error) {
+	var filters audit.FilterParams
+	errs := validation.Errors{}
+
+	q := r.URL.Query()
+	if v := q.Get("filter[user_id]"); v != "" {
+		userID, err := uuid.Parse(v)
+		if err != nil {
+			errs["filter[user_id]"] = fmt.Errorf("invalid user id: %s", v)
+		} else {
+			filters.UserID = &userID
+		}
+	}
+	if v := strings.TrimSpace(q.Get("filter[event]")); v != "" {
+		filters.Type = &v
+	}
+	if v := strings.TrimSpace(q.Get("filter[outcome]")); v != "" {
+		filters.Outcome = &v
+	}
+	if v := strings.TrimSpace(q.Get("filter[ip]")); v != "" {
+		filters.IP = &v
+	}
+	if v := q.Get("filter[since]"); v != "" {
+		since, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			errs["filter[since]"] = fmt.Errorf("must be an RFC 3339 time")
+		} else {
+			filters.Since = &since
+		}
+	}
+	if v := q.Get("filter[until]"); v != "" {
+		until, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			errs["filter[until]"] = fmt.Errorf("must be an RFC 3339 time")
+		} else {
+			filters.Until = &until
+		}
+	}
+
+	return filters, errs.Filter()
+}
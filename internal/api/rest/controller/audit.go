@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/netbill/auth-svc/internal/api/rest/responses"
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/restkit/pagi"
+	"github.com/netbill/restkit/problems"
+	"github.com/netbill/restkit/render"
+)
+
+type auditCore interface {
+	ListMyEvents(ctx context.Context, actor models.UserActor, limit, offset uint) (pagi.Page[[]models.AuthEvent], error)
+}
+
+// AuditController shows users their own security audit log; the admin side
+// of the log is on AdminController.
+type AuditController struct {
+	audit auditCore
+}
+
+func NewAuditController(audit auditCore) *AuditController {
+	return &AuditController{audit: audit}
+}
+
+const operationGetMySecurityEvents = "get_my_security_events"
+
+func (c *AuditController) GetMySecurityEvents(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationGetMySecurityEvents)
+
+	limit, offset := pagi.GetPagination(r)
+
+	events, err := c.audit.ListMyEvents(r.Context(), scope.UserActor(r), limit, offset)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("security events retrieved")
+		render.Response(w, http.StatusOK, responses.AuthEventsCollection(r, events))
+	}
+}
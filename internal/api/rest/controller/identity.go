@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/api/rest/responses"
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/restkit/problems"
+	"github.com/netbill/restkit/render"
+)
+
+const operationGetMyIdentities = "get_my_identities"
+
+func (c *UserController) GetMyIdentities(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationGetMyIdentities)
+
+	identities, err := c.users.ListMyIdentities(r.Context(), scope.UserActor(r))
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("identities retrieved")
+		render.Response(w, http.StatusOK, responses.IdentitiesCollection(identities))
+	}
+}
+
+const operationDeleteMyIdentity = "delete_my_identity"
+
+func (c *UserController) DeleteMyIdentity(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationDeleteMyIdentity)
+
+	identityID, err := uuid.Parse(chi.URLParam(r, "identity_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("identity_id", chi.URLParam(r, "identity_id")).
+			Warn("invalid identity id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid identity id: %s", chi.URLParam(r, "identity_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("identity_id", identityID)
+
+	err = c.users.UnlinkMyIdentity(r.Context(), scope.UserActor(r), identityID)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorIdentityNotFound):
+		log.WithError(err).Warn("identity not found")
+		render.ResponseError(w, problems.NotFound("identity not found"))
+	case errors.Is(err, errx.ErrorLastCredential):
+		log.WithError(err).Warn("identity is the last credential")
+		render.ResponseError(w, problems.Conflict("identity is the only way left to sign in, add a password or passkey first"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("identity unlinked")
+		render.Response(w, http.StatusNoContent, nil)
+	}
+}
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/netbill/auth-svc/internal/api/rest/responses"
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/auth-svc/pkg/tokenmanager"
+	"github.com/netbill/restkit/render"
+)
+
+type keySet interface {
+	JWKS() tokenmanager.JWKS
+}
+
+type KeysController struct {
+	keys keySet
+}
+
+func NewKeysController(keys keySet) *KeysController {
+	return &KeysController{keys: keys}
+}
+
+// jwksMaxAge bounds how long verifiers cache the key set. They refetch it on
+// an unknown kid anyway, so it mostly decides how long a removed key lingers.
+const jwksMaxAge = "max-age=300"
+
+const operationGetJWKS = "get_jwks"
+
+func (c *KeysController) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	scope.Log(r).WithOperation(operationGetJWKS).Debug("jwks requested")
+
+	w.Header().Set("Cache-Control", "public, "+jwksMaxAge)
+	render.Response(w, http.StatusOK, responses.JWKS(c.keys.JWKS()))
+}
@@ -5,18 +5,23 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/api/rest/requests"
 	"github.com/netbill/auth-svc/internal/api/rest/responses"
 	"github.com/netbill/auth-svc/internal/api/rest/scope"
 	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
 	"github.com/netbill/auth-svc/internal/modules/session"
 	"github.com/netbill/restkit/problems"
 	"github.com/netbill/restkit/render"
-	"golang.org/x/oauth2"
 )
 
 const operationLoginByEmail = "login_by_email"
@@ -34,100 +39,472 @@ func (c *SessionController) LoginByEmail(w http.ResponseWriter, r *http.Request)
 	log = log.WithField("email", req.Data.Attributes.Email)
 
 	defer c.metrics.RecordEmailLogin(r.Context(), &err)
-	token, err := c.sessions.LoginByEmail(r.Context(), req.Data.Attributes.Email, req.Data.Attributes.Password)
+	result, err := c.sessions.LoginByEmail(
+		r.Context(),
+		req.Data.Attributes.Email,
+		req.Data.Attributes.Password,
+		scope.Client(r),
+	)
 	switch {
 	case errors.Is(err, errx.ErrorPasswordInvalid),
 		errors.Is(err, errx.ErrorUserNotFound),
 		errors.Is(err, errx.ErrorUserDeleted):
 		log.WithError(err).Warn("invalid login or password")
 		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorTooManyLoginAttempts):
+		log.WithError(err).Warn("login locked out")
+		if d, ok := errx.RetryAfter(err); ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(d.Seconds()))))
+		}
+		render.ResponseError(w, problems.TooManyRequests())
 	case err != nil:
 		log.WithError(err).Error("unexpected error")
 		render.ResponseError(w, problems.InternalError())
+	case result.Challenge != nil:
+		log.Info("login by email awaits second factor")
+		render.Response(w, http.StatusAccepted, responses.MFAChallenge(*result.Challenge))
 	default:
 		log.Info("login by email successful")
-		render.Response(w, http.StatusOK, responses.TokensPair(token))
+		render.Response(w, http.StatusOK, responses.TokensPair(result.Tokens))
 	}
 }
 
-func (c *SessionController) LoginByGoogleOAuth(w http.ResponseWriter, r *http.Request) {
-	url := c.google.AuthCodeURL("state-token", oauth2.AccessTypeOffline)
-	http.Redirect(w, r, url, http.StatusTemporaryRedirect)
+const operationLoginByMFA = "login_by_mfa"
+
+func (c *SessionController) LoginByMFA(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationLoginByMFA)
+
+	req, err := requests.LoginByMFA(r)
+	if err != nil {
+		log.WithError(err).Info("invalid mfa login request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	defer c.metrics.RecordMFALogin(r.Context(), &err)
+	tokensPair, err := c.sessions.LoginByMFA(
+		r.Context(),
+		req.Data.Attributes.ChallengeToken,
+		req.Data.Attributes.Code,
+		scope.Client(r),
+	)
+	switch {
+	case errors.Is(err, errx.ErrorMFAChallengeInvalid):
+		log.WithError(err).Warn("invalid mfa challenge")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		log.WithError(err).Warn("invalid mfa code")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorTooManyLoginAttempts):
+		log.WithError(err).Warn("mfa locked out")
+		if d, ok := errx.RetryAfter(err); ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(d.Seconds()))))
+		}
+		render.ResponseError(w, problems.TooManyRequests())
+	case errors.Is(err, errx.ErrorUserNotFound),
+		errors.Is(err, errx.ErrorUserDeleted):
+		log.WithError(err).Warn("user not found")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("login by mfa successful")
+		render.Response(w, http.StatusOK, responses.TokensPair(tokensPair))
+	}
 }
 
-const operationLoginByGoogleOAuthCallback = "login_by_google_oauth"
+const operationBeginPasskeyLogin = "begin_passkey_login"
 
-func (c *SessionController) LoginByGoogleOAuthCallback(w http.ResponseWriter, r *http.Request) {
-	log := scope.Log(r).WithOperation(operationLoginByGoogleOAuthCallback)
+func (c *SessionController) BeginPasskeyLogin(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationBeginPasskeyLogin)
 
-	code := r.URL.Query().Get("code")
-	if code == "" {
+	opts, err := c.sessions.BeginPasskeyLogin(r.Context())
+	if err != nil {
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+		return
+	}
+
+	resp, err := responses.PasskeyRequestOptions(opts)
+	if err != nil {
+		log.WithError(err).Error("failed to encode passkey request options")
+		render.ResponseError(w, problems.InternalError())
+		return
+	}
+
+	log.Info("passkey login started")
+	render.Response(w, http.StatusOK, resp)
+}
+
+const operationLoginByPasskey = "login_by_passkey"
+
+func (c *SessionController) LoginByPasskey(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationLoginByPasskey)
+
+	_, cred, err := requests.LoginByPasskey(r)
+	if err != nil {
+		log.WithError(err).Info("invalid passkey login request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	defer c.metrics.RecordPasskeyLogin(r.Context(), &err)
+	tokensPair, err := c.sessions.LoginByPasskey(r.Context(), cred, scope.Client(r))
+	switch {
+	case errors.Is(err, errx.ErrorPasskeyChallengeInvalid):
+		log.WithError(err).Warn("invalid passkey login challenge")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorPasskeyInvalid),
+		errors.Is(err, errx.ErrorPasskeyNotFound):
+		log.WithError(err).Warn("invalid passkey assertion")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserNotFound),
+		errors.Is(err, errx.ErrorUserDeleted):
+		log.WithError(err).Warn("user not found")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("login by passkey successful")
+		render.Response(w, http.StatusOK, responses.TokensPair(tokensPair))
+	}
+}
+
+// oauthBindingCookie ties a provider login or link to the browser that
+// started it. It is only sent to the callback of the provider.
+const (
+	oauthBindingCookie = "oauth_binding"
+	oauthCallbackPath  = "/auth-svc/v1/login/%s/callback"
+)
+
+func (c *SessionController) setOAuthBindingCookie(w http.ResponseWriter, provider, binding string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauthBindingCookie,
+		Value:    binding,
+		Path:     fmt.Sprintf(oauthCallbackPath, provider),
+		MaxAge:   int(session.OAuthStateTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   c.secureCookies,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (c *SessionController) clearOAuthBindingCookie(w http.ResponseWriter, provider string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauthBindingCookie,
+		Path:     fmt.Sprintf(oauthCallbackPath, provider),
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   c.secureCookies,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+const operationLoginByOAuth = "login_by_oauth_start"
+
+// LoginByOAuth sends the browser to the consent screen of the provider in
+// the path. The state, nonce and PKCE verifier of this attempt stay in
+// Redis until the provider calls back, and the browser gets a cookie only
+// its own callback can present, so a callback the browser did not start
+// here is refused.
+func (c *SessionController) LoginByOAuth(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+	log := scope.Log(r).WithOperation(operationLoginByOAuth).WithField("provider", provider)
+
+	if !c.providers.Has(provider) {
+		log.Warn("unknown oauth provider")
+		render.ResponseError(w, problems.NotFound("oauth provider not found"))
+		return
+	}
+
+	start, err := c.sessions.StartOAuthLogin(r.Context(), provider, r.URL.Query().Get("redirect_uri"))
+	switch {
+	case errors.Is(err, errx.ErrorOAuthPostLoginRedirectNotAllowed):
+		log.WithError(err).Warn("post-login redirect not allowed")
 		render.ResponseError(w, problems.BadRequest(validation.Errors{
-			"query": fmt.Errorf("code is required"),
+			"redirect_uri": fmt.Errorf("redirect uri is not allowed"),
 		})...)
 		return
+	case err != nil:
+		log.WithError(err).Error("failed to start oauth login")
+		render.ResponseError(w, problems.InternalError())
+		return
 	}
 
-	token, err := c.google.Exchange(r.Context(), code)
+	authURL, err := c.providers.AuthCodeURL(r.Context(), provider, start.State, start.Nonce, start.CodeVerifier)
 	if err != nil {
-		log.WithError(err).Error("google oauth exchange failed")
+		log.WithError(err).Error("failed to build oauth authorization url")
+		render.ResponseError(w, problems.InternalError())
+		return
+	}
+
+	c.setOAuthBindingCookie(w, provider, start.Binding)
+	http.Redirect(w, r, authURL, http.StatusTemporaryRedirect)
+}
+
+const operationLinkIdentity = "link_identity_start"
+
+// LinkIdentity starts the flow of the provider in the path for a signed in
+// user who links their account there. The request carries a bearer token,
+// so it cannot be a navigation: the consent screen URL is returned for the
+// client to open, along with the binding cookie the callback requires.
+func (c *SessionController) LinkIdentity(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+	log := scope.Log(r).WithOperation(operationLinkIdentity).WithField("provider", provider)
+
+	if !c.providers.Has(provider) {
+		log.Warn("unknown oauth provider")
+		render.ResponseError(w, problems.NotFound("oauth provider not found"))
+		return
+	}
+
+	start, err := c.sessions.StartOAuthLink(r.Context(), scope.UserActor(r), provider, r.URL.Query().Get("redirect_uri"))
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+		return
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+		return
+	case errors.Is(err, errx.ErrorOAuthPostLoginRedirectNotAllowed):
+		log.WithError(err).Warn("post-login redirect not allowed")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"redirect_uri": fmt.Errorf("redirect uri is not allowed"),
+		})...)
+		return
+	case err != nil:
+		log.WithError(err).Error("failed to start identity link")
 		render.ResponseError(w, problems.InternalError())
 		return
 	}
 
-	client := c.google.Client(r.Context(), token)
-	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	authURL, err := c.providers.AuthCodeURL(r.Context(), provider, start.State, start.Nonce, start.CodeVerifier)
 	if err != nil {
-		log.WithError(err).Error("google userinfo request failed")
+		log.WithError(err).Error("failed to build oauth authorization url")
 		render.ResponseError(w, problems.InternalError())
 		return
 	}
 
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			log.WithError(err).Warn("failed to close google userinfo response body")
-		}
-	}()
+	c.setOAuthBindingCookie(w, provider, start.Binding)
+	log.Info("identity link started")
+	render.Response(w, http.StatusOK, responses.OAuthRedirect(authURL))
+}
 
-	if resp.StatusCode != http.StatusOK {
-		log.WithField("google_status", resp.StatusCode).Error("google userinfo returned non-200")
-		render.ResponseError(w, problems.InternalError())
+const operationLoginByOAuthCallback = "login_by_oauth"
+
+// LoginByOAuthCallback finishes a login started by LoginByOAuth, or a link
+// started by LinkIdentity. Once the state checks out, a login that asked
+// for a post-login redirect gets every outcome as a redirect with the
+// result in the URL fragment; otherwise the result is rendered as JSON.
+func (c *SessionController) LoginByOAuthCallback(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+	log := scope.Log(r).WithOperation(operationLoginByOAuthCallback).WithField("provider", provider)
+	query := r.URL.Query()
+
+	if !c.providers.Has(provider) {
+		log.Warn("unknown oauth provider")
+		render.ResponseError(w, problems.NotFound("oauth provider not found"))
 		return
 	}
 
-	var userInfo struct {
-		Email string `json:"email"`
+	binding, err := r.Cookie(oauthBindingCookie)
+	if err != nil {
+		log.WithError(err).Warn("oauth callback without binding cookie")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"state": fmt.Errorf("state is invalid or expired"),
+		})...)
+		return
 	}
-	if err = json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
-		log.WithError(err).Error("failed to decode google userinfo")
+
+	// The cookie is good for one callback, whatever comes of it.
+	c.clearOAuthBindingCookie(w, provider)
+
+	state, err := c.sessions.ConsumeOAuthLoginState(r.Context(), provider, query.Get("state"), binding.Value)
+	switch {
+	case errors.Is(err, errx.ErrorOAuthStateInvalid):
+		log.WithError(err).Warn("invalid oauth state")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"state": fmt.Errorf("state is invalid or expired"),
+		})...)
+		return
+	case err != nil:
+		log.WithError(err).Error("failed to load oauth state")
 		render.ResponseError(w, problems.InternalError())
 		return
 	}
 
-	log = log.WithField("user_email", userInfo.Email)
+	fail := func(code string, problem ...error) {
+		if state.RedirectURI != "" {
+			redirectWithFragment(w, r, state.RedirectURI, url.Values{"error": {code}})
+			return
+		}
+		render.ResponseError(w, problem...)
+	}
+
+	if e := query.Get("error"); e != "" {
+		log.WithField("provider_error", e).Warn("oauth login declined")
+		fail("access_denied", problems.Unauthorized())
+		return
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		log.Warn("oauth callback without code")
+		fail("invalid_request", problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("code is required"),
+		})...)
+		return
+	}
+
+	idToken, err := c.providers.Exchange(r.Context(), provider, code, state.CodeVerifier)
+	if err != nil {
+		log.WithError(err).Error("oauth code exchange failed")
+		fail("server_error", problems.InternalError())
+		return
+	}
+
+	account, err := c.providers.VerifyNonce(r.Context(), provider, idToken, state.Nonce)
+	if err != nil {
+		log.WithError(err).Warn("invalid id token")
+		fail("access_denied", problems.Unauthorized())
+		return
+	}
+
+	identity := models.ExternalIdentity{
+		Provider:      provider,
+		Subject:       account.Subject,
+		Email:         account.Email,
+		EmailVerified: account.EmailVerified,
+	}
+
+	log = log.WithField("user_email", identity.Email)
 
-	defer c.metrics.RecordGoogleLogin(r.Context(), &err)
-	tokensPair, err := c.sessions.LoginByGoogle(r.Context(), userInfo.Email)
+	if state.LinkUserID != uuid.Nil {
+		c.finishIdentityLink(w, r, state, identity, fail)
+		return
+	}
+
+	defer c.metrics.RecordOAuthLogin(r.Context(), provider, &err)
+	result, err := c.sessions.LoginByOIDC(r.Context(), identity, scope.Client(r))
 	switch {
 	case errors.Is(err, errx.ErrorUserNotFound),
 		errors.Is(err, errx.ErrorUserDeleted):
-		log.WithError(err).Warn("user with this email not found")
-		render.ResponseError(w, problems.NotFound("user with this email not found"))
+		log.WithError(err).Warn("user for this provider account not found")
+		fail("access_denied", problems.NotFound("user for this provider account not found"))
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		fail("access_denied", problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorIdentityNotLinked):
+		log.WithError(err).Warn("provider account not linked to the user with its email")
+		fail("identity_not_linked", problems.Conflict(
+			"user with this email exists, sign in and link the provider account first",
+		))
 	case err != nil:
 		log.WithError(err).Error("unexpected error")
-		render.ResponseError(w, problems.InternalError())
+		fail("server_error", problems.InternalError())
+	case state.RedirectURI != "":
+		log.Info("login by oauth finished, redirecting")
+		redirectWithFragment(w, r, state.RedirectURI, loginResultFragment(result))
+	case result.Challenge != nil:
+		log.Info("login by oauth awaits second factor")
+		render.Response(w, http.StatusAccepted, responses.MFAChallenge(*result.Challenge))
 	default:
-		log.Info("login by google successful")
-		render.Response(w, http.StatusOK, responses.TokensPair(tokensPair))
+		log.Info("login by oauth successful")
+		render.Response(w, http.StatusOK, responses.TokensPair(result.Tokens))
+	}
+}
+
+const operationLinkIdentityCallback = "link_identity"
+
+func (c *SessionController) finishIdentityLink(
+	w http.ResponseWriter,
+	r *http.Request,
+	state models.OAuthLoginState,
+	identity models.ExternalIdentity,
+	fail func(code string, problem ...error),
+) {
+	log := scope.Log(r).WithOperation(operationLinkIdentityCallback).
+		WithField("provider", identity.Provider).
+		WithField("user_id", state.LinkUserID).
+		WithField("user_email", identity.Email)
+
+	linked, err := c.identities.LinkIdentity(r.Context(), state.LinkUserID, identity)
+	switch {
+	case errors.Is(err, errx.ErrorIdentityAlreadyLinked):
+		log.WithError(err).Warn("provider account already linked")
+		fail("identity_already_linked", problems.Conflict("provider account is already linked"))
+	case errors.Is(err, errx.ErrorUserNotFound),
+		errors.Is(err, errx.ErrorUserDeleted):
+		log.WithError(err).Warn("user linking provider account not found")
+		fail("access_denied", problems.NotFound("user not found"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		fail("server_error", problems.InternalError())
+	case state.RedirectURI != "":
+		log.Info("provider account linked, redirecting")
+		redirectWithFragment(w, r, state.RedirectURI, url.Values{"identity_id": {linked.ID.String()}})
+	default:
+		log.Info("provider account linked")
+		render.Response(w, http.StatusCreated, responses.Identity(linked))
+	}
+}
+
+// loginResultFragment encodes a login result for a post-login redirect. It
+// goes into the fragment, which browsers never send to the server.
+func loginResultFragment(result models.LoginResult) url.Values {
+	if result.Challenge != nil {
+		return url.Values{
+			"mfa_challenge":            {result.Challenge.Token},
+			"mfa_challenge_expires_at": {result.Challenge.ExpiresAt.UTC().Format(time.RFC3339)},
+		}
+	}
+
+	return url.Values{
+		"session_id":    {result.Tokens.SessionID.String()},
+		"access_token":  {result.Tokens.Access},
+		"refresh_token": {result.Tokens.Refresh},
+	}
+}
+
+func redirectWithFragment(w http.ResponseWriter, r *http.Request, redirectURI string, values url.Values) {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		render.ResponseError(w, problems.InternalError())
+		return
 	}
+
+	u.Fragment = ""
+	u.RawFragment = ""
+	http.Redirect(w, r, u.String()+"#"+values.Encode(), http.StatusSeeOther)
+}
+
+// qrMessage is what QRConfirm and QRReject publish to the QRConnect stream
+// of the token: either the tokens pair of the new session or a rejection.
+type qrMessage struct {
+	Tokens   json.RawMessage `json:"tokens,omitempty"`
+	Rejected bool            `json:"rejected,omitempty"`
 }
 
 const operationQRConnect = "qr_connect"
 
 // QRConnect streams the QR login flow to the client over Server-Sent
-// Events: it hands out a fresh QR token, then blocks until either the token
-// is confirmed elsewhere (the tokens pair arrives over the bus, possibly
-// published by a different auth-svc replica) or it expires.
+// Events: it hands out a fresh QR token and its verification code, then
+// blocks until the token is confirmed or rejected elsewhere (the outcome
+// arrives over the bus, possibly published by a different auth-svc replica)
+// or it expires.
 //
 // The server-wide http.Server.WriteTimeout is far shorter than this flow
 // needs, so the write deadline is extended per-request via
@@ -146,20 +523,20 @@ func (c *SessionController) QRConnect(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), session.QRTokenTTL)
 	defer cancel()
 
-	token, err := c.sessions.CreateQRToken(ctx)
+	login, err := c.sessions.CreateQRToken(ctx, scope.Client(r))
 	if err != nil {
 		log.WithError(err).Error("failed to create qr token")
 		render.ResponseError(w, problems.InternalError())
 		return
 	}
 
-	msgCh, cleanup := c.bus.SubscribeQRToken(ctx, token)
+	msgCh, cleanup := c.bus.SubscribeQRToken(ctx, login.Token)
 	defer cleanup()
 
 	render.SSEHeaders(w)
 	w.WriteHeader(http.StatusOK)
 
-	if err = render.WriteSSE(w, "qr_token", responses.QRTokenEvent(token)); err != nil {
+	if err = render.WriteSSE(w, "qr_token", responses.QRTokenEvent(login)); err != nil {
 		log.WithError(err).Error("failed to send QR token")
 		return
 	}
@@ -170,8 +547,19 @@ func (c *SessionController) QRConnect(w http.ResponseWriter, r *http.Request) {
 
 	select {
 	case payload := <-msgCh:
-		if err = render.WriteRawSSE(w, "tokens", payload); err != nil {
-			log.WithError(err).Error("failed to write tokens pair")
+		var msg qrMessage
+		switch err = json.Unmarshal(payload, &msg); {
+		case err != nil:
+			log.WithError(err).Error("failed to decode qr message")
+			err = render.WriteErrorSSE(w, "error", problems.InternalError())
+		case msg.Rejected:
+			log.Info("qr token rejected")
+			err = render.WriteErrorSSE(w, "error", problems.Forbidden("QR login rejected"))
+		default:
+			err = render.WriteRawSSE(w, "tokens", msg.Tokens)
+		}
+		if err != nil {
+			log.WithError(err).Error("failed to write qr outcome")
 		}
 	case <-ctx.Done():
 		if err = render.WriteErrorSSE(w, "error", problems.NotFound("QR token expired")); err != nil {
@@ -184,6 +572,33 @@ func (c *SessionController) QRConnect(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+const operationQRLogin = "qr_login"
+
+func (c *SessionController) QRLogin(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationQRLogin)
+
+	qrToken, err := uuid.Parse(chi.URLParam(r, "qr_token"))
+	if err != nil {
+		log.WithError(err).Warn("invalid qr token")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid qr token: %s", chi.URLParam(r, "qr_token")),
+		})...)
+		return
+	}
+
+	login, err := c.sessions.GetQRLogin(r.Context(), qrToken.String())
+	switch {
+	case errors.Is(err, errx.ErrorQRTokenNotFound):
+		log.WithError(err).Warn("qr token not found")
+		render.ResponseError(w, problems.NotFound("qr token not found or expired"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		render.Response(w, http.StatusOK, responses.QRLogin(login))
+	}
+}
+
 const operationQRConfirm = "qr_confirm"
 
 func (c *SessionController) QRConfirm(w http.ResponseWriter, r *http.Request) {
@@ -200,7 +615,7 @@ func (c *SessionController) QRConfirm(w http.ResponseWriter, r *http.Request) {
 
 	defer c.metrics.RecordQRLogin(r.Context(), &err)
 
-	pair, err := c.sessions.ConfirmQRToken(r.Context(), scope.UserActor(r), qrToken)
+	pair, err := c.sessions.ConfirmQRToken(r.Context(), scope.UserActor(r), qrToken, req.Data.Attributes.Code)
 	switch {
 	case errors.Is(err, errx.ErrorQRTokenNotFound):
 		log.WithError(err).Warn("qr token not found")
@@ -210,6 +625,24 @@ func (c *SessionController) QRConfirm(w http.ResponseWriter, r *http.Request) {
 		log.WithError(err).Warn("qr token already confirmed")
 		render.ResponseError(w, problems.Conflict("qr token already confirmed"))
 		return
+	case errors.Is(err, errx.ErrorQRTokenRejected):
+		log.WithError(err).Warn("qr token rejected")
+		// Too many wrong codes reject the login here; end the QRConnect
+		// stream as QRReject would. For a login rejected earlier nobody is
+		// subscribed any more.
+		if perr := c.publishQRRejected(r.Context(), qrToken); perr != nil {
+			log.WithError(perr).Error("failed to publish qr reject")
+		}
+		render.ResponseError(w, problems.Conflict("qr token rejected"))
+		return
+	case errors.Is(err, errx.ErrorQRCodeMismatch):
+		log.WithError(err).Warn("qr code mismatch")
+		render.ResponseError(w, problems.Forbidden("verification code does not match"))
+		return
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+		return
 	case err != nil:
 		log.WithError(err).Error("unexpected error")
 		render.ResponseError(w, problems.InternalError())
@@ -218,13 +651,20 @@ func (c *SessionController) QRConfirm(w http.ResponseWriter, r *http.Request) {
 
 	// Marshal the same JSON:API-shaped body every other login endpoint returns,
 	// so the SSE "tokens" event isn't a special case for clients to parse.
-	payload, err := json.Marshal(responses.TokensPair(pair))
+	tokens, err := json.Marshal(responses.TokensPair(pair))
 	if err != nil {
 		log.WithError(err).Error("failed to marshal tokens pair")
 		render.ResponseError(w, problems.InternalError())
 		return
 	}
 
+	payload, err := json.Marshal(qrMessage{Tokens: tokens})
+	if err != nil {
+		log.WithError(err).Error("failed to marshal qr message")
+		render.ResponseError(w, problems.InternalError())
+		return
+	}
+
 	if err = c.sessions.PublishQRToken(r.Context(), qrToken, payload); err != nil {
 		log.WithError(err).Error("failed to publish qr confirm")
 		render.ResponseError(w, problems.InternalError())
@@ -234,3 +674,56 @@ func (c *SessionController) QRConfirm(w http.ResponseWriter, r *http.Request) {
 	log.Info("qr token confirmed")
 	render.Response(w, http.StatusNoContent, nil)
 }
+
+const operationQRReject = "qr_reject"
+
+func (c *SessionController) QRReject(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationQRReject)
+
+	req, err := requests.QRReject(r)
+	if err != nil {
+		log.WithError(err).Warn("invalid qr reject request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	qrToken := req.Data.Attributes.QrToken.String()
+
+	err = c.sessions.RejectQRToken(r.Context(), scope.UserActor(r), qrToken)
+	switch {
+	case errors.Is(err, errx.ErrorQRTokenNotFound):
+		log.WithError(err).Warn("qr token not found")
+		render.ResponseError(w, problems.NotFound("qr token not found or expired"))
+		return
+	case errors.Is(err, errx.ErrorQRTokenAlreadyConfirmed):
+		log.WithError(err).Warn("qr token already confirmed")
+		render.ResponseError(w, problems.Conflict("qr token already confirmed"))
+		return
+	case errors.Is(err, errx.ErrorQRTokenRejected):
+		log.WithError(err).Warn("qr token already rejected")
+		render.ResponseError(w, problems.Conflict("qr token already rejected"))
+		return
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+		return
+	}
+
+	if err = c.publishQRRejected(r.Context(), qrToken); err != nil {
+		log.WithError(err).Error("failed to publish qr reject")
+		render.ResponseError(w, problems.InternalError())
+		return
+	}
+
+	log.Info("qr token rejected")
+	render.Response(w, http.StatusNoContent, nil)
+}
+
+func (c *SessionController) publishQRRejected(ctx context.Context, qrToken string) error {
+	payload, err := json.Marshal(qrMessage{Rejected: true})
+	if err != nil {
+		return fmt.Errorf("marshal qr message: %w", err)
+	}
+
+	return c.sessions.PublishQRToken(ctx, qrToken, payload)
+}
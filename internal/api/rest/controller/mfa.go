@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/internal/api/rest/requests"
+	"github.com/netbill/auth-svc/internal/api/rest/responses"
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/restkit/problems"
+	"github.com/netbill/restkit/render"
+)
+
+type mfaCore interface {
+	GetMyStatus(ctx context.Context, actor models.UserActor) (models.MFAStatus, error)
+	EnrollTOTP(ctx context.Context, actor models.UserActor) (models.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, actor models.UserActor, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, actor models.UserActor, password, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, actor models.UserActor, password, code string) ([]string, error)
+}
+
+type MFAController struct {
+	mfa mfaCore
+}
+
+func NewMFAController(mfa mfaCore) *MFAController {
+	return &MFAController{mfa: mfa}
+}
+
+const operationGetMyMFA = "get_my_mfa"
+
+func (c *MFAController) GetMyMFA(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationGetMyMFA)
+
+	actor := scope.UserActor(r)
+
+	status, err := c.mfa.GetMyStatus(r.Context(), actor)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("mfa status retrieved")
+		render.Response(w, http.StatusOK, responses.MFAStatus(actor.ID, status))
+	}
+}
+
+const operationEnrollTOTP = "enroll_totp"
+
+func (c *MFAController) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationEnrollTOTP)
+
+	enrollment, err := c.mfa.EnrollTOTP(r.Context(), scope.UserActor(r))
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorMFAAlreadyEnabled):
+		log.WithError(err).Warn("mfa already enabled")
+		render.ResponseError(w, problems.Conflict("mfa is already enabled"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("totp enrollment started")
+		render.Response(w, http.StatusCreated, responses.TOTPEnrollment(enrollment))
+	}
+}
+
+const operationConfirmTOTP = "confirm_totp"
+
+func (c *MFAController) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationConfirmTOTP)
+
+	req, err := requests.ConfirmTOTP(r)
+	if err != nil {
+		log.WithError(err).Info("invalid confirm totp request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	codes, err := c.mfa.ConfirmTOTP(r.Context(), scope.UserActor(r), req.Data.Attributes.Code)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		log.WithError(err).Warn("invalid totp code")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/code": err,
+		})...)
+	case errors.Is(err, errx.ErrorMFANotEnabled):
+		log.WithError(err).Warn("no pending totp enrollment")
+		render.ResponseError(w, problems.NotFound("no pending totp enrollment"))
+	case errors.Is(err, errx.ErrorMFAAlreadyEnabled):
+		log.WithError(err).Warn("mfa already enabled")
+		render.ResponseError(w, problems.Conflict("mfa is already enabled"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("mfa enabled")
+		render.Response(w, http.StatusOK, responses.RecoveryCodes(codes))
+	}
+}
+
+const operationDisableTOTP = "disable_totp"
+
+func (c *MFAController) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationDisableTOTP)
+
+	req, err := requests.DisableTOTP(r)
+	if err != nil {
+		log.WithError(err).Info("invalid disable totp request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	err = c.mfa.DisableTOTP(
+		r.Context(),
+		scope.UserActor(r),
+		req.Data.Attributes.GetPassword(),
+		req.Data.Attributes.GetCode(),
+	)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorPasswordInvalid):
+		log.WithError(err).Warn("invalid password")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		log.WithError(err).Warn("invalid mfa code")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorTooManyLoginAttempts):
+		log.WithError(err).Warn("mfa locked out")
+		if d, ok := errx.RetryAfter(err); ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(d.Seconds()))))
+		}
+		render.ResponseError(w, problems.TooManyRequests())
+	case errors.Is(err, errx.ErrorPasswordNotSet):
+		log.WithError(err).Warn("password not set")
+		render.ResponseError(w, problems.Conflict("password is not set, confirm with an mfa code"))
+	case errors.Is(err, errx.ErrorMFANotEnabled):
+		log.WithError(err).Warn("mfa not enabled")
+		render.ResponseError(w, problems.NotFound("mfa is not enabled"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("mfa disabled")
+		render.Response(w, http.StatusNoContent, nil)
+	}
+}
+
+const operationRegenerateRecoveryCodes = "regenerate_recovery_codes"
+
+func (c *MFAController) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationRegenerateRecoveryCodes)
+
+	req, err := requests.RegenerateRecoveryCodes(r)
+	if err != nil {
+		log.WithError(err).Info("invalid regenerate recovery codes request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	codes, err := c.mfa.RegenerateRecoveryCodes(
+		r.Context(),
+		scope.UserActor(r),
+		req.Data.Attributes.GetPassword(),
+		req.Data.Attributes.GetCode(),
+	)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorPasswordInvalid):
+		log.WithError(err).Warn("invalid password")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		log.WithError(err).Warn("invalid mfa code")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorTooManyLoginAttempts):
+		log.WithError(err).Warn("mfa locked out")
+		if d, ok := errx.RetryAfter(err); ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(d.Seconds()))))
+		}
+		render.ResponseError(w, problems.TooManyRequests())
+	case errors.Is(err, errx.ErrorPasswordNotSet):
+		log.WithError(err).Warn("password not set")
+		render.ResponseError(w, problems.Conflict("password is not set, confirm with an mfa code"))
+	case errors.Is(err, errx.ErrorMFANotEnabled):
+		log.WithError(err).Warn("mfa not enabled")
+		render.ResponseError(w, problems.NotFound("mfa is not enabled"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("recovery codes regenerated")
+		render.Response(w, http.StatusOK, responses.RecoveryCodes(codes))
+	}
+}
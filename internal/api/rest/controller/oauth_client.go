@@ -0,0 +1,203 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/internal/api/rest/requests"
+	"github.com/netbill/auth-svc/internal/api/rest/responses"
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/oauthclient"
+	"github.com/netbill/restkit/problems"
+	"github.com/netbill/restkit/render"
+)
+
+type oauthClientCore interface {
+	Create(ctx context.Context, params oauthclient.CreateParams) (models.OAuthClient, string, error)
+	Get(ctx context.Context, clientID string) (models.OAuthClient, error)
+	List(ctx context.Context) ([]models.OAuthClient, error)
+	Update(ctx context.Context, clientID string, params oauthclient.UpdateParams) (models.OAuthClient, error)
+	RotateSecret(ctx context.Context, clientID string) (models.OAuthClient, string, error)
+	Delete(ctx context.Context, clientID string) error
+}
+
+// OAuthClientController is the admin API of the OAuth client registry. Its
+// routes are behind the sysadmin middleware.
+type OAuthClientController struct {
+	clients oauthClientCore
+}
+
+func NewOAuthClientController(clients oauthClientCore) *OAuthClientController {
+	return &OAuthClientController{clients: clients}
+}
+
+const operationCreateOAuthClient = "create_oauth_client"
+
+func (c *OAuthClientController) CreateOAuthClient(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationCreateOAuthClient)
+
+	req, err := requests.CreateOAuthClient(r)
+	if err != nil {
+		log.WithError(err).Info("invalid create oauth client request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	log = log.WithField("client_id", req.Data.Id)
+
+	client, secret, err := c.clients.Create(r.Context(), oauthclient.CreateParams{
+		ID:           req.Data.Id,
+		Name:         req.Data.Attributes.Name,
+		Public:       req.Data.Attributes.GetPublic(),
+		Scopes:       req.Data.Attributes.Scopes,
+		RedirectURIs: req.Data.Attributes.RedirectUris,
+	})
+	switch {
+	case errors.Is(err, errx.ErrorOAuthClientScopeNotSupported):
+		log.WithError(err).Info("scope is not supported")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/scopes": err,
+		})...)
+	case errors.Is(err, errx.ErrorOAuthClientRedirectURINotValid):
+		log.WithError(err).Info("redirect uri is invalid")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/redirect_uris": err,
+		})...)
+	case errors.Is(err, errx.ErrorOAuthClientAlreadyExists):
+		log.WithError(err).Warn("oauth client already exists")
+		render.ResponseError(w, problems.Conflict("oauth client with this id already exists"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("oauth client created")
+		render.Response(w, http.StatusCreated, responses.OAuthClient(client, secret))
+	}
+}
+
+const operationGetOAuthClients = "get_oauth_clients"
+
+func (c *OAuthClientController) GetOAuthClients(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationGetOAuthClients)
+
+	clients, err := c.clients.List(r.Context())
+	if err != nil {
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+		return
+	}
+
+	render.Response(w, http.StatusOK, responses.OAuthClientsCollection(clients))
+}
+
+const operationGetOAuthClient = "get_oauth_client"
+
+func (c *OAuthClientController) GetOAuthClient(w http.ResponseWriter, r *http.Request) {
+	clientID := chi.URLParam(r, "client_id")
+	log := scope.Log(r).WithOperation(operationGetOAuthClient).WithField("client_id", clientID)
+
+	client, err := c.clients.Get(r.Context(), clientID)
+	switch {
+	case errors.Is(err, errx.ErrorOAuthClientNotFound):
+		log.WithError(err).Info("oauth client not found")
+		render.ResponseError(w, problems.NotFound("oauth client not found"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		render.Response(w, http.StatusOK, responses.OAuthClient(client, ""))
+	}
+}
+
+const operationUpdateOAuthClient = "update_oauth_client"
+
+func (c *OAuthClientController) UpdateOAuthClient(w http.ResponseWriter, r *http.Request) {
+	clientID := chi.URLParam(r, "client_id")
+	log := scope.Log(r).WithOperation(operationUpdateOAuthClient).WithField("client_id", clientID)
+
+	req, err := requests.UpdateOAuthClient(r, clientID)
+	if err != nil {
+		log.WithError(err).Info("invalid update oauth client request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	params := oauthclient.UpdateParams{Name: req.Data.Attributes.Name}
+	if req.Data.Attributes.Scopes != nil {
+		params.Scopes = &req.Data.Attributes.Scopes
+	}
+	if req.Data.Attributes.RedirectUris != nil {
+		params.RedirectURIs = &req.Data.Attributes.RedirectUris
+	}
+
+	client, err := c.clients.Update(r.Context(), clientID, params)
+	switch {
+	case errors.Is(err, errx.ErrorOAuthClientScopeNotSupported):
+		log.WithError(err).Info("scope is not supported")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/scopes": err,
+		})...)
+	case errors.Is(err, errx.ErrorOAuthClientRedirectURINotValid):
+		log.WithError(err).Info("redirect uri is invalid")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/redirect_uris": err,
+		})...)
+	case errors.Is(err, errx.ErrorOAuthClientNotFound):
+		log.WithError(err).Info("oauth client not found")
+		render.ResponseError(w, problems.NotFound("oauth client not found"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("oauth client updated")
+		render.Response(w, http.StatusOK, responses.OAuthClient(client, ""))
+	}
+}
+
+const operationRotateOAuthClientSecret = "rotate_oauth_client_secret"
+
+func (c *OAuthClientController) RotateOAuthClientSecret(w http.ResponseWriter, r *http.Request) {
+	clientID := chi.URLParam(r, "client_id")
+	log := scope.Log(r).WithOperation(operationRotateOAuthClientSecret).WithField("client_id", clientID)
+
+	client, secret, err := c.clients.RotateSecret(r.Context(), clientID)
+	switch {
+	case errors.Is(err, errx.ErrorOAuthClientNotFound):
+		log.WithError(err).Info("oauth client not found")
+		render.ResponseError(w, problems.NotFound("oauth client not found"))
+	case errors.Is(err, errx.ErrorOAuthClientUnauthorized):
+		log.WithError(err).Info("oauth client is public")
+		render.ResponseError(w, problems.Conflict("oauth client is public and has no secret"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("oauth client secret rotated")
+		render.Response(w, http.StatusOK, responses.OAuthClient(client, secret))
+	}
+}
+
+const operationDeleteOAuthClient = "delete_oauth_client"
+
+func (c *OAuthClientController) DeleteOAuthClient(w http.ResponseWriter, r *http.Request) {
+	clientID := chi.URLParam(r, "client_id")
+	log := scope.Log(r).WithOperation(operationDeleteOAuthClient).WithField("client_id", clientID)
+
+	err := c.clients.Delete(r.Context(), clientID)
+	switch {
+	case errors.Is(err, errx.ErrorOAuthClientNotFound):
+		log.WithError(err).Info("oauth client not found")
+		render.ResponseError(w, problems.NotFound("oauth client not found"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("oauth client deleted")
+		render.Response(w, http.StatusNoContent, nil)
+	}
+}
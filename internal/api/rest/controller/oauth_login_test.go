@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/netbill/auth-svc/pkg/oidcprovider"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOAuthSessions implements the OAuth login part of sessionCore; the
+// embedded interface is nil, so anything else panics if it's ever reached.
+type fakeOAuthSessions struct {
+	sessionCore
+
+	start   models.OAuthLoginStart
+	binding string
+
+	consumed []string
+}
+
+func (f *fakeOAuthSessions) StartOAuthLogin(context.Context, string, string) (models.OAuthLoginStart, error) {
+	return f.start, nil
+}
+
+func (f *fakeOAuthSessions) ConsumeOAuthLoginState(
+	_ context.Context, _, _, binding string,
+) (models.OAuthLoginState, error) {
+	f.consumed = append(f.consumed, binding)
+	if binding != f.binding {
+		return models.OAuthLoginState{}, errx.ErrorOAuthStateInvalid.Raise(errors.New("binding mismatch"))
+	}
+	return f.start.OAuthLoginState, nil
+}
+
+type fakeOIDCProviders struct{}
+
+func (fakeOIDCProviders) Has(string) bool { return true }
+
+func (fakeOIDCProviders) AuthCodeURL(context.Context, string, string, string, string) (string, error) {
+	return "https://accounts.example.com/auth", nil
+}
+
+func (fakeOIDCProviders) Exchange(context.Context, string, string, string) (string, error) {
+	panic("not used by this test")
+}
+
+func (fakeOIDCProviders) VerifyNonce(context.Context, string, string, string) (oidcprovider.Identity, error) {
+	panic("not used by this test")
+}
+
+func serveOAuthLogin(c *SessionController, req *http.Request) *httptest.ResponseRecorder {
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(scope.CtxLog(r.Context(), log.New("debug", "text", "test"))))
+		})
+	})
+	r.Post("/auth-svc/v1/login/{provider}/", c.LoginByOAuth)
+	r.Get("/auth-svc/v1/login/{provider}/callback", c.LoginByOAuthCallback)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func oauthBindingCookieOf(t *testing.T, rec *httptest.ResponseRecorder) *http.Cookie {
+	t.Helper()
+
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == oauthBindingCookie {
+			return cookie
+		}
+	}
+	t.Fatalf("no %s cookie in the response", oauthBindingCookie)
+	return nil
+}
+
+func TestLoginByOAuth_SetsBindingCookie(t *testing.T) {
+	sessions := &fakeOAuthSessions{start: models.OAuthLoginStart{State: "state", Binding: "binding"}}
+	c := &SessionController{sessions: sessions, providers: fakeOIDCProviders{}, secureCookies: true}
+
+	rec := serveOAuthLogin(c, httptest.NewRequest(http.MethodPost, "/auth-svc/v1/login/google/", nil))
+
+	require.Equal(t, http.StatusTemporaryRedirect, rec.Code)
+
+	cookie := oauthBindingCookieOf(t, rec)
+	require.Equal(t, "binding", cookie.Value)
+	require.Equal(t, "/auth-svc/v1/login/google/callback", cookie.Path)
+	require.True(t, cookie.HttpOnly)
+	require.True(t, cookie.Secure)
+	require.Equal(t, http.SameSiteLaxMode, cookie.SameSite)
+}
+
+func TestLoginByOAuth_InsecureCookiesForLocalRuns(t *testing.T) {
+	sessions := &fakeOAuthSessions{start: models.OAuthLoginStart{State: "state", Binding: "binding"}}
+	c := &SessionController{sessions: sessions, providers: fakeOIDCProviders{}}
+
+	rec := serveOAuthLogin(c, httptest.NewRequest(http.MethodPost, "/auth-svc/v1/login/google/", nil))
+
+	require.False(t, oauthBindingCookieOf(t, rec).Secure)
+}
+
+func TestLoginByOAuthCallback_WithoutBindingCookie(t *testing.T) {
+	sessions := &fakeOAuthSessions{binding: "binding"}
+	c := &SessionController{sessions: sessions, providers: fakeOIDCProviders{}}
+
+	req := httptest.NewRequest(http.MethodGet, "/auth-svc/v1/login/google/callback?state=state&code=code", nil)
+	rec := serveOAuthLogin(c, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Empty(t, sessions.consumed)
+}
+
+func TestLoginByOAuthCallback_WrongBindingCookie(t *testing.T) {
+	sessions := &fakeOAuthSessions{binding: "binding"}
+	c := &SessionController{sessions: sessions, providers: fakeOIDCProviders{}}
+
+	req := httptest.NewRequest(http.MethodGet, "/auth-svc/v1/login/google/callback?state=state&code=code", nil)
+	req.AddCookie(&http.Cookie{Name: oauthBindingCookie, Value: "attacker-binding"})
+	rec := serveOAuthLogin(c, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, []string{"attacker-binding"}, sessions.consumed)
+	require.Equal(t, -1, oauthBindingCookieOf(t, rec).MaxAge)
+}
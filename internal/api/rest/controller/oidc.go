@@ -0,0 +1,320 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/netbill/auth-svc/internal/api/rest/requests"
+	"github.com/netbill/auth-svc/internal/api/rest/responses"
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/oauthclient"
+	"github.com/netbill/auth-svc/internal/modules/oidc"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/restkit/problems"
+	"github.com/netbill/restkit/render"
+)
+
+type oidcCore interface {
+	Issuer() string
+	CheckAuthorizeRequest(ctx context.Context, req models.OAuthAuthorizeRequest) error
+	Authorize(ctx context.Context, actor models.UserActor, req models.OAuthAuthorizeRequest) (string, error)
+	ExchangeCode(
+		ctx context.Context,
+		params oidc.ExchangeCodeParams,
+		client models.SessionClient,
+	) (models.OAuthTokens, error)
+	Refresh(
+		ctx context.Context,
+		clientID, clientSecret, refreshToken string,
+		client models.SessionClient,
+	) (models.OAuthTokens, error)
+	IssueServiceToken(ctx context.Context, clientID, clientSecret, scope string) (models.OAuthTokens, error)
+	UserInfo(ctx context.Context, actor models.UserActor, scopes []string) (models.OAuthUserInfo, error)
+}
+
+type signingAlgorithms interface {
+	SigningAlgorithms() []string
+}
+
+type OIDCController struct {
+	oidc oidcCore
+	keys signingAlgorithms
+
+	// loginURL is the netbill login page authorization requests are sent
+	// to. It approves them once the user is signed in.
+	loginURL string
+}
+
+func NewOIDCController(oidc oidcCore, keys signingAlgorithms, loginURL string) *OIDCController {
+	return &OIDCController{oidc: oidc, keys: keys, loginURL: loginURL}
+}
+
+const operationGetOpenIDConfiguration = "get_openid_configuration"
+
+func (c *OIDCController) GetOpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
+	scope.Log(r).WithOperation(operationGetOpenIDConfiguration).Debug("openid configuration requested")
+
+	w.Header().Set("Cache-Control", "public, "+jwksMaxAge)
+	renderJSON(w, http.StatusOK, responses.OpenIDConfiguration(
+		c.oidc.Issuer(), oauthclient.SupportedScopes, c.keys.SigningAlgorithms(),
+	))
+}
+
+const operationStartOAuthAuthorization = "start_oauth_authorization"
+
+// StartOAuthAuthorization is the authorization endpoint browsers are sent to
+// by clients. auth-svc has no pages of its own, so a valid request is passed
+// on, query string and all, to the login page.
+func (c *OIDCController) StartOAuthAuthorization(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationStartOAuthAuthorization)
+
+	req := oauthAuthorizeRequest(requests.OAuthAuthorizeQuery(r))
+	log = log.WithField("client_id", req.ClientID)
+
+	err := c.oidc.CheckAuthorizeRequest(r.Context(), req)
+	switch {
+	case errors.Is(err, errx.ErrorOAuthClientInvalid),
+		errors.Is(err, errx.ErrorOAuthRedirectURIInvalid):
+		log.WithError(err).Warn("untrusted authorization request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+	case err != nil:
+		log.WithError(err).Info("authorization request refused")
+		http.Redirect(w, r, oauthErrorRedirect(req, err), http.StatusFound)
+	default:
+		login, parseErr := url.Parse(c.loginURL)
+		if parseErr != nil {
+			log.WithError(parseErr).Error("invalid login page url")
+			render.ResponseError(w, problems.InternalError())
+			return
+		}
+		login.RawQuery = r.URL.RawQuery
+
+		log.Info("authorization request sent to login page")
+		http.Redirect(w, r, login.String(), http.StatusFound)
+	}
+}
+
+const operationApproveOAuthAuthorization = "approve_oauth_authorization"
+
+func (c *OIDCController) ApproveOAuthAuthorization(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationApproveOAuthAuthorization)
+
+	body, err := requests.OAuthAuthorize(r)
+	if err != nil {
+		log.WithError(err).Info("invalid authorization approval request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	req := oauthAuthorizeRequest(body.Data.Attributes)
+	log = log.WithField("client_id", req.ClientID)
+
+	code, err := c.oidc.Authorize(r.Context(), scope.UserActor(r), req)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorOAuthClientInvalid),
+		errors.Is(err, errx.ErrorOAuthRedirectURIInvalid):
+		log.WithError(err).Warn("untrusted authorization request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+	case isOAuthError(err):
+		log.WithError(err).Info("authorization request refused")
+		render.Response(w, http.StatusOK, responses.OAuthRedirect(oauthErrorRedirect(req, err)))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("authorization approved")
+		render.Response(w, http.StatusOK, responses.OAuthRedirect(oauthRedirect(req.RedirectURI, url.Values{
+			"code": {code},
+		}, req.State)))
+	}
+}
+
+const operationOAuthToken = "oauth_token"
+
+// OAuthToken is the token endpoint. It answers in the RFC 6749 format
+// rather than JSON:API, since OAuth client libraries expect exactly that.
+func (c *OIDCController) OAuthToken(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationOAuthToken)
+
+	req, err := requests.OAuthToken(r)
+	if err != nil {
+		log.WithError(err).Info("invalid token request")
+		renderOAuthError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	clientID, clientSecret := req.GetClientId(), req.GetClientSecret()
+	log = log.WithField("client_id", clientID).WithField("grant_type", req.GrantType)
+
+	var tokens models.OAuthTokens
+	switch req.GrantType {
+	case requests.GrantTypeAuthorizationCode:
+		tokens, err = c.oidc.ExchangeCode(r.Context(), oidc.ExchangeCodeParams{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Code:         *req.Code,
+			RedirectURI:  *req.RedirectUri,
+			CodeVerifier: *req.CodeVerifier,
+		}, scope.Client(r))
+	case requests.GrantTypeRefreshToken:
+		tokens, err = c.oidc.Refresh(r.Context(), clientID, clientSecret, *req.RefreshToken, scope.Client(r))
+	case requests.GrantTypeClientCredentials:
+		tokens, err = c.oidc.IssueServiceToken(r.Context(), clientID, clientSecret, req.GetScope())
+	default:
+		err = errx.ErrorOAuthGrantTypeUnsupported.Raise(errors.New("grant type is not supported"))
+	}
+
+	switch {
+	case errors.Is(err, errx.ErrorOAuthClientInvalid):
+		log.WithError(err).Warn("client authentication failed")
+		if _, _, basic := r.BasicAuth(); basic {
+			w.Header().Set("WWW-Authenticate", `Basic realm="auth-svc"`)
+		}
+		renderOAuthError(w, http.StatusUnauthorized, "invalid_client", "")
+	case errors.Is(err, errx.ErrorOAuthClientUnauthorized):
+		log.WithError(err).Warn("grant not allowed for client")
+		renderOAuthError(w, http.StatusBadRequest, "unauthorized_client", "")
+	case errors.Is(err, errx.ErrorOAuthScopeInvalid):
+		log.WithError(err).Info("invalid scope")
+		renderOAuthError(w, http.StatusBadRequest, "invalid_scope", "")
+	case errors.Is(err, errx.ErrorOAuthGrantInvalid):
+		log.WithError(err).Warn("invalid grant")
+		renderOAuthError(w, http.StatusBadRequest, "invalid_grant", "")
+	case errors.Is(err, errx.ErrorOAuthGrantTypeUnsupported):
+		log.WithError(err).Info("unsupported grant type")
+		renderOAuthError(w, http.StatusBadRequest, "unsupported_grant_type", "")
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		renderOAuthError(w, http.StatusInternalServerError, "server_error", "")
+	default:
+		log.Info("oauth tokens issued")
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Pragma", "no-cache")
+		renderJSON(w, http.StatusOK, responses.OAuthTokens(tokens))
+	}
+}
+
+const operationOAuthUserInfo = "oauth_userinfo"
+
+func (c *OIDCController) OAuthUserInfo(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationOAuthUserInfo)
+
+	scopes, ok := scope.ClientScopes(r)
+	if !ok {
+		scopes = oidc.SupportedScopes
+	}
+
+	info, err := c.oidc.UserInfo(r.Context(), scope.UserActor(r), scopes)
+	switch {
+	case errors.Is(err, errx.ErrorUserNotFound),
+		errors.Is(err, errx.ErrorUserDeleted):
+		log.WithError(err).Warn("user not found")
+		render.ResponseError(w, problems.Unauthorized())
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Debug("userinfo retrieved")
+		renderJSON(w, http.StatusOK, responses.OAuthUserInfo(info))
+	}
+}
+
+func oauthAuthorizeRequest(a oapi.OAuthAuthorizeDataAttributes) models.OAuthAuthorizeRequest {
+	req := models.OAuthAuthorizeRequest{
+		ClientID:            a.ClientId,
+		RedirectURI:         a.RedirectUri,
+		ResponseType:        a.ResponseType,
+		Scope:               a.Scope,
+		CodeChallenge:       a.CodeChallenge,
+		CodeChallengeMethod: a.CodeChallengeMethod,
+	}
+	if a.State != nil {
+		req.State = *a.State
+	}
+	if a.Nonce != nil {
+		req.Nonce = *a.Nonce
+	}
+
+	return req
+}
+
+// oauthErrorCodes maps the errors that may be reported back to the client
+// to RFC 6749 error codes.
+var oauthErrorCodes = []struct {
+	err  error
+	code string
+}{
+	{errx.ErrorOAuthRequestInvalid, "invalid_request"},
+	{errx.ErrorOAuthResponseTypeUnsupported, "unsupported_response_type"},
+	{errx.ErrorOAuthScopeInvalid, "invalid_scope"},
+}
+
+func isOAuthError(err error) bool {
+	for _, e := range oauthErrorCodes {
+		if errors.Is(err, e.err) {
+			return true
+		}
+	}
+	return false
+}
+
+func oauthErrorRedirect(req models.OAuthAuthorizeRequest, err error) string {
+	code := "server_error"
+	for _, e := range oauthErrorCodes {
+		if errors.Is(err, e.err) {
+			code = e.code
+			break
+		}
+	}
+
+	return oauthRedirect(req.RedirectURI, url.Values{"error": {code}}, req.State)
+}
+
+// oauthRedirect adds params and state to the client's redirect URI, keeping
+// any query it was registered with.
+func oauthRedirect(redirectURI string, params url.Values, state string) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+
+	q := u.Query()
+	for k, v := range params {
+		q[k] = v
+	}
+	if state != "" {
+		q.Set("state", state)
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+func renderOAuthError(w http.ResponseWriter, status int, code, description string) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	renderJSON(w, status, responses.OAuthError(code, description))
+}
+
+// renderJSON writes a plain JSON body for the OpenID Connect endpoints,
+// whose clients expect application/json rather than JSON:API.
+func renderJSON(w http.ResponseWriter, status int, res any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		panic(fmt.Errorf("failed to render response %w", err))
+	}
+}
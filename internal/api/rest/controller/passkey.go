@@ -0,0 +1,231 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/api/rest/requests"
+	"github.com/netbill/auth-svc/internal/api/rest/responses"
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/webauthn"
+	"github.com/netbill/restkit/problems"
+	"github.com/netbill/restkit/render"
+)
+
+type passkeyCore interface {
+	BeginRegistration(ctx context.Context, actor models.UserActor) (webauthn.CreationOptions, error)
+	FinishRegistration(
+		ctx context.Context,
+		actor models.UserActor,
+		name string,
+		cred webauthn.RegistrationCredential,
+	) (models.Passkey, error)
+
+	ListMyPasskeys(ctx context.Context, actor models.UserActor) ([]models.Passkey, error)
+	RenameMyPasskey(ctx context.Context, actor models.UserActor, passkeyID uuid.UUID, name string) (models.Passkey, error)
+	DeleteMyPasskey(ctx context.Context, actor models.UserActor, passkeyID uuid.UUID) error
+}
+
+type PasskeyController struct {
+	passkeys passkeyCore
+}
+
+func NewPasskeyController(passkeys passkeyCore) *PasskeyController {
+	return &PasskeyController{passkeys: passkeys}
+}
+
+const operationGetMyPasskeys = "get_my_passkeys"
+
+func (c *PasskeyController) GetMyPasskeys(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationGetMyPasskeys)
+
+	passkeys, err := c.passkeys.ListMyPasskeys(r.Context(), scope.UserActor(r))
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("passkeys retrieved")
+		render.Response(w, http.StatusOK, responses.PasskeysCollection(passkeys))
+	}
+}
+
+const operationBeginPasskeyRegistration = "begin_passkey_registration"
+
+func (c *PasskeyController) BeginPasskeyRegistration(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationBeginPasskeyRegistration)
+
+	opts, err := c.passkeys.BeginRegistration(r.Context(), scope.UserActor(r))
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+		return
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+		return
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+		return
+	}
+
+	resp, err := responses.PasskeyCreationOptions(opts)
+	if err != nil {
+		log.WithError(err).Error("failed to encode passkey creation options")
+		render.ResponseError(w, problems.InternalError())
+		return
+	}
+
+	log.Info("passkey registration started")
+	render.Response(w, http.StatusOK, resp)
+}
+
+const operationFinishPasskeyRegistration = "finish_passkey_registration"
+
+func (c *PasskeyController) FinishPasskeyRegistration(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationFinishPasskeyRegistration)
+
+	req, cred, err := requests.FinishPasskeyRegistration(r)
+	if err != nil {
+		log.WithError(err).Info("invalid finish passkey registration request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	var name string
+	if req.Data.Attributes.Name != nil {
+		name = *req.Data.Attributes.Name
+	}
+
+	passkey, err := c.passkeys.FinishRegistration(r.Context(), scope.UserActor(r), name, cred)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorPasskeyChallengeInvalid):
+		log.WithError(err).Warn("invalid passkey registration challenge")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/credential": fmt.Errorf("registration was not started or has expired"),
+		})...)
+	case errors.Is(err, errx.ErrorPasskeyInvalid):
+		log.WithError(err).Warn("invalid passkey credential")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/credential": err,
+		})...)
+	case errors.Is(err, errx.ErrorPasskeyAlreadyExists):
+		log.WithError(err).Warn("passkey already registered")
+		render.ResponseError(w, problems.Conflict("passkey is already registered"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.WithField("passkey_id", passkey.ID).Info("passkey registered")
+		render.Response(w, http.StatusCreated, responses.Passkey(passkey))
+	}
+}
+
+const operationUpdateMyPasskey = "update_my_passkey"
+
+func (c *PasskeyController) UpdateMyPasskey(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationUpdateMyPasskey)
+
+	passkeyID, err := uuid.Parse(chi.URLParam(r, "passkey_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("passkey_id", chi.URLParam(r, "passkey_id")).
+			Warn("invalid passkey id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid passkey id: %s", chi.URLParam(r, "passkey_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("passkey_id", passkeyID)
+
+	req, err := requests.UpdatePasskey(r)
+	if err != nil {
+		log.WithError(err).Info("invalid update passkey request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	passkey, err := c.passkeys.RenameMyPasskey(r.Context(), scope.UserActor(r), passkeyID, req.Data.Attributes.Name)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorPasskeyNotFound):
+		log.WithError(err).Warn("passkey not found")
+		render.ResponseError(w, problems.NotFound("passkey not found"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("passkey renamed")
+		render.Response(w, http.StatusOK, responses.Passkey(passkey))
+	}
+}
+
+const operationDeleteMyPasskey = "delete_my_passkey"
+
+func (c *PasskeyController) DeleteMyPasskey(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationDeleteMyPasskey)
+
+	passkeyID, err := uuid.Parse(chi.URLParam(r, "passkey_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("passkey_id", chi.URLParam(r, "passkey_id")).
+			Warn("invalid passkey id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid passkey id: %s", chi.URLParam(r, "passkey_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("passkey_id", passkeyID)
+
+	err = c.passkeys.DeleteMyPasskey(r.Context(), scope.UserActor(r), passkeyID)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorPasskeyNotFound):
+		log.WithError(err).Warn("passkey not found")
+		render.ResponseError(w, problems.NotFound("passkey not found"))
+	case errors.Is(err, errx.ErrorLastCredential):
+		log.WithError(err).Warn("passkey is the last credential")
+		render.ResponseError(w, problems.Conflict("passkey is the only way left to sign in, add a password or another passkey first"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("passkey deleted")
+		render.Response(w, http.StatusNoContent, nil)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/netbill/auth-svc/internal/models"
 	"github.com/netbill/auth-svc/internal/modules/session"
 	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/netbill/auth-svc/pkg/webauthn"
 	"github.com/netbill/restkit/pagi"
 	"github.com/stretchr/testify/require"
 )
@@ -21,21 +22,55 @@ import (
 // fakeQRSessions implements sessionCore. QRConnect only ever calls
 // CreateQRToken, so every other method panics if it's ever reached.
 type fakeQRSessions struct {
-	token string
+	login models.QRLogin
 	err   error
 }
 
-func (f *fakeQRSessions) CreateQRToken(context.Context) (string, error) { return f.token, f.err }
+func (f *fakeQRSessions) CreateQRToken(context.Context, models.SessionClient) (models.QRLogin, error) {
+	return f.login, f.err
+}
+
+func (f *fakeQRSessions) LoginByEmail(
+	context.Context, string, string, models.SessionClient,
+) (models.LoginResult, error) {
+	panic("not used by this test")
+}
+
+func (f *fakeQRSessions) LoginByOIDC(context.Context, models.ExternalIdentity, models.SessionClient) (models.LoginResult, error) {
+	panic("not used by this test")
+}
+
+func (f *fakeQRSessions) StartOAuthLogin(context.Context, string, string) (models.OAuthLoginStart, error) {
+	panic("not used by this test")
+}
 
-func (f *fakeQRSessions) LoginByEmail(context.Context, string, string) (models.TokensPair, error) {
+func (f *fakeQRSessions) StartOAuthLink(context.Context, models.UserActor, string, string) (models.OAuthLoginStart, error) {
 	panic("not used by this test")
 }
 
-func (f *fakeQRSessions) LoginByGoogle(context.Context, string) (models.TokensPair, error) {
+func (f *fakeQRSessions) ConsumeOAuthLoginState(
+	context.Context, string, string, string,
+) (models.OAuthLoginState, error) {
 	panic("not used by this test")
 }
 
-func (f *fakeQRSessions) Refresh(context.Context, string) (models.TokensPair, error) {
+func (f *fakeQRSessions) LoginByMFA(
+	context.Context, string, string, models.SessionClient,
+) (models.TokensPair, error) {
+	panic("not used by this test")
+}
+
+func (f *fakeQRSessions) BeginPasskeyLogin(context.Context) (webauthn.RequestOptions, error) {
+	panic("not used by this test")
+}
+
+func (f *fakeQRSessions) LoginByPasskey(
+	context.Context, webauthn.AssertionCredential, models.SessionClient,
+) (models.TokensPair, error) {
+	panic("not used by this test")
+}
+
+func (f *fakeQRSessions) Refresh(context.Context, string, models.SessionClient) (models.TokensPair, error) {
 	panic("not used by this test")
 }
 
@@ -49,7 +84,21 @@ func (f *fakeQRSessions) GetMySessions(
 	panic("not used by this test")
 }
 
-func (f *fakeQRSessions) ConfirmQRToken(context.Context, models.UserActor, string) (models.TokensPair, error) {
+func (f *fakeQRSessions) UpdateMySession(
+	context.Context, models.UserActor, uuid.UUID, session.UpdateSessionParams,
+) (models.Session, error) {
+	panic("not used by this test")
+}
+
+func (f *fakeQRSessions) GetQRLogin(context.Context, string) (models.QRLogin, error) {
+	panic("not used by this test")
+}
+
+func (f *fakeQRSessions) ConfirmQRToken(context.Context, models.UserActor, string, string) (models.TokensPair, error) {
+	panic("not used by this test")
+}
+
+func (f *fakeQRSessions) RejectQRToken(context.Context, models.UserActor, string) error {
 	panic("not used by this test")
 }
 
@@ -65,7 +114,7 @@ func (f *fakeQRSessions) DeleteMySession(context.Context, models.UserActor, uuid
 	panic("not used by this test")
 }
 
-func (f *fakeQRSessions) DeleteMySessions(context.Context, models.UserActor) error {
+func (f *fakeQRSessions) DeleteMySessions(context.Context, models.UserActor, session.DeleteSessionsParams) (int, error) {
 	panic("not used by this test")
 }
 
@@ -93,7 +142,8 @@ func newQRTestServer(t *testing.T, c *SessionController) *httptest.Server {
 func TestQRConnect_DeliversTokensOverSSE(t *testing.T) {
 	const qrToken = "550e8400-e29b-41d4-a716-446655440000"
 
-	sessions := &fakeQRSessions{token: qrToken}
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	sessions := &fakeQRSessions{login: models.QRLogin{Token: qrToken, Code: "042917", ExpiresAt: expiresAt}}
 	bus := &fakeQRBus{ch: make(chan []byte, 1)}
 
 	srv := newQRTestServer(t, &SessionController{sessions: sessions, bus: bus})
@@ -111,15 +161,40 @@ func TestQRConnect_DeliversTokensOverSSE(t *testing.T) {
 
 	event, data := readSSEFrame(t, reader)
 	require.Equal(t, "qr_token", event)
-	require.JSONEq(t, `{"data":{"type":"qr_token","attributes":{"qr_token":"`+qrToken+`"}}}`, data)
+	require.JSONEq(t, `{"data":{"type":"qr_token","attributes":{"qr_token":"`+qrToken+
+		`","code":"042917","expires_at":"2030-01-02T03:04:05Z"}}}`, data)
 
-	bus.ch <- []byte(`{"access":"a","refresh":"r"}`)
+	bus.ch <- []byte(`{"tokens":{"access":"a","refresh":"r"}}`)
 
 	event, data = readSSEFrame(t, reader)
 	require.Equal(t, "tokens", event)
 	require.Equal(t, `{"access":"a","refresh":"r"}`, data)
 }
 
+func TestQRConnect_Rejected(t *testing.T) {
+	const qrToken = "550e8400-e29b-41d4-a716-446655440000"
+
+	sessions := &fakeQRSessions{login: models.QRLogin{Token: qrToken, Code: "042917"}}
+	bus := &fakeQRBus{ch: make(chan []byte, 1)}
+
+	srv := newQRTestServer(t, &SessionController{sessions: sessions, bus: bus})
+
+	resp, err := (&http.Client{Timeout: 5 * time.Second}).Get(srv.URL)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	reader := bufio.NewReader(resp.Body)
+
+	event, _ := readSSEFrame(t, reader)
+	require.Equal(t, "qr_token", event)
+
+	bus.ch <- []byte(`{"rejected":true}`)
+
+	event, data := readSSEFrame(t, reader)
+	require.Equal(t, "error", event)
+	require.Contains(t, data, `"status":"403"`)
+}
+
 func TestQRConnect_CreateTokenFails(t *testing.T) {
 	sessions := &fakeQRSessions{err: context.DeadlineExceeded}
 	bus := &fakeQRBus{ch: make(chan []byte, 1)}
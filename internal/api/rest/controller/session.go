@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -15,17 +18,33 @@ import (
 	"github.com/netbill/auth-svc/internal/errx"
 	"github.com/netbill/auth-svc/internal/models"
 	"github.com/netbill/auth-svc/internal/modules/session"
+	"github.com/netbill/auth-svc/pkg/oidcprovider"
+	"github.com/netbill/auth-svc/pkg/webauthn"
 	"github.com/netbill/restkit/pagi"
 	"github.com/netbill/restkit/problems"
 	"github.com/netbill/restkit/render"
-	"golang.org/x/oauth2"
 )
 
 type sessionCore interface {
-	LoginByEmail(ctx context.Context, email, password string) (models.TokensPair, error)
-	LoginByGoogle(ctx context.Context, email string) (models.TokensPair, error)
+	LoginByEmail(ctx context.Context, email, password string, client models.SessionClient) (models.LoginResult, error)
+	LoginByOIDC(ctx context.Context, identity models.ExternalIdentity, client models.SessionClient) (models.LoginResult, error)
+	StartOAuthLogin(ctx context.Context, provider, redirectURI string) (models.OAuthLoginStart, error)
+	StartOAuthLink(
+		ctx context.Context,
+		actor models.UserActor,
+		provider, redirectURI string,
+	) (models.OAuthLoginStart, error)
+	ConsumeOAuthLoginState(ctx context.Context, provider, state, binding string) (models.OAuthLoginState, error)
+	LoginByMFA(ctx context.Context, challenge, code string, client models.SessionClient) (models.TokensPair, error)
+
+	BeginPasskeyLogin(ctx context.Context) (webauthn.RequestOptions, error)
+	LoginByPasskey(
+		ctx context.Context,
+		cred webauthn.AssertionCredential,
+		client models.SessionClient,
+	) (models.TokensPair, error)
 
-	Refresh(ctx context.Context, oldRefreshToken string) (models.TokensPair, error)
+	Refresh(ctx context.Context, oldRefreshToken string, client models.SessionClient) (models.TokensPair, error)
 
 	GetMySession(ctx context.Context, actor models.UserActor, sessionID uuid.UUID) (models.Session, error)
 	GetMySessions(
@@ -33,54 +52,89 @@ type sessionCore interface {
 		actor models.UserActor,
 		opts ...session.ListSessionsOption,
 	) (pagi.Page[[]models.Session], error)
+	UpdateMySession(
+		ctx context.Context,
+		actor models.UserActor,
+		sessionID uuid.UUID,
+		params session.UpdateSessionParams,
+	) (models.Session, error)
 
-	CreateQRToken(ctx context.Context) (string, error)
+	CreateQRToken(ctx context.Context, client models.SessionClient) (models.QRLogin, error)
+	GetQRLogin(ctx context.Context, qrToken string) (models.QRLogin, error)
 	ConfirmQRToken(
 		ctx context.Context,
 		actor models.UserActor,
 		qrToken string,
+		code string,
 	) (models.TokensPair, error)
+	RejectQRToken(ctx context.Context, actor models.UserActor, qrToken string) error
 
 	PublishQRToken(ctx context.Context, key string, payload []byte) error
 
 	Logout(ctx context.Context, actor models.UserActor) error
 	DeleteMySession(ctx context.Context, actor models.UserActor, sessionID uuid.UUID) error
-	DeleteMySessions(ctx context.Context, actor models.UserActor) error
+	DeleteMySessions(ctx context.Context, actor models.UserActor, params session.DeleteSessionsParams) (int, error)
 }
 
 type SessionMetrics interface {
 	RecordEmailLogin(ctx context.Context, err *error)
-	RecordGoogleLogin(ctx context.Context, err *error)
+	RecordOAuthLogin(ctx context.Context, provider string, err *error)
 	RecordTokenRefresh(ctx context.Context, err *error)
 	RecordSessionDeleted(ctx context.Context, scope string, err *error)
 	RecordQRLogin(ctx context.Context, err *error)
+	RecordMFALogin(ctx context.Context, err *error)
+	RecordPasskeyLogin(ctx context.Context, err *error)
 }
 
-// qrBus delivers the tokens pair published by whichever request confirmed
-// the QR token — possibly a different auth-svc replica than the one
+// qrBus delivers the qrMessage published by whichever request confirmed or
+// rejected the QR token — possibly a different auth-svc replica than the one
 // streaming this response.
 type qrBus interface {
 	SubscribeQRToken(ctx context.Context, key string) (<-chan []byte, func())
 }
 
+// oidcProviders runs the authorization code flow against the configured
+// external providers, each addressed by name.
+type oidcProviders interface {
+	Has(provider string) bool
+	AuthCodeURL(ctx context.Context, provider, state, nonce, codeVerifier string) (string, error)
+	Exchange(ctx context.Context, provider, code, codeVerifier string) (string, error)
+	VerifyNonce(ctx context.Context, provider, idToken, nonce string) (oidcprovider.Identity, error)
+}
+
+// identityLinker links a provider account once the callback of a link
+// flow has verified it.
+type identityLinker interface {
+	LinkIdentity(ctx context.Context, userID uuid.UUID, identity models.ExternalIdentity) (models.UserIdentity, error)
+}
+
 type SessionController struct {
-	google   oauth2.Config
-	sessions sessionCore
-	metrics  SessionMetrics
-	bus      qrBus
+	providers  oidcProviders
+	sessions   sessionCore
+	identities identityLinker
+	metrics    SessionMetrics
+	bus        qrBus
+
+	// secureCookies marks the OAuth binding cookie Secure. It is off only
+	// for local runs over plain http, where browsers would drop the cookie.
+	secureCookies bool
 }
 
 func NewSessionController(
 	sessions sessionCore,
-	google oauth2.Config,
+	identities identityLinker,
+	providers oidcProviders,
 	m SessionMetrics,
 	bus qrBus,
+	secureCookies bool,
 ) *SessionController {
 	return &SessionController{
-		google:   google,
-		sessions: sessions,
-		metrics:  m,
-		bus:      bus,
+		providers:     providers,
+		sessions:      sessions,
+		identities:    identities,
+		metrics:       m,
+		bus:           bus,
+		secureCookies: secureCookies,
 	}
 }
 
@@ -156,6 +210,54 @@ func (c *SessionController) GetMySessions(w http.ResponseWriter, r *http.Request
 	}
 }
 
+const operationUpdateMySession = "update_my_session"
+
+func (c *SessionController) UpdateMySession(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationUpdateMySession)
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "session_id"))
+	if err != nil {
+		log.WithError(err).
+			WithField("target_session_id", chi.URLParam(r, "session_id")).
+			Warn("invalid session id")
+
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"path": fmt.Errorf("invalid session id: %s", chi.URLParam(r, "session_id")),
+		})...)
+		return
+	}
+
+	log = log.WithField("target_session_id", sessionID)
+
+	req, err := requests.UpdateSession(r)
+	if err != nil {
+		log.WithError(err).Info("invalid update session request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	s, err := c.sessions.UpdateMySession(r.Context(), scope.UserActor(r), sessionID, session.UpdateSessionParams{
+		DeviceName: req.Data.Attributes.DeviceName,
+	})
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("session not found")
+		render.ResponseError(w, problems.NotFound("session not found"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("session updated")
+		render.Response(w, http.StatusOK, responses.UserSession(s))
+	}
+}
+
 const operationRefreshSession = "refresh_session"
 
 func (c *SessionController) RefreshSession(w http.ResponseWriter, r *http.Request) {
@@ -170,7 +272,7 @@ func (c *SessionController) RefreshSession(w http.ResponseWriter, r *http.Reques
 
 	defer c.metrics.RecordTokenRefresh(r.Context(), &err)
 
-	tokensPair, err := c.sessions.Refresh(r.Context(), req.Data.Attributes.RefreshToken)
+	tokensPair, err := c.sessions.Refresh(r.Context(), req.Data.Attributes.RefreshToken, scope.Client(r))
 	switch {
 	case errors.Is(err, errx.ErrorSessionExpired):
 		log.WithError(err).Warn("refresh token expired")
@@ -184,6 +286,17 @@ func (c *SessionController) RefreshSession(w http.ResponseWriter, r *http.Reques
 	case errors.Is(err, errx.ErrorSessionTokenMismatch):
 		log.WithError(err).Warn("refresh token mismatch")
 		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorSessionTokenReused):
+		log.WithError(err).Warn("refresh token reuse detected, sessions revoked")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorSessionIdleTimeout),
+		errors.Is(err, errx.ErrorSessionLifetimeExceeded),
+		errors.Is(err, errx.ErrorSessionEvicted):
+		log.WithError(err).Info("session ended by session policy")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
 	case err != nil:
 		log.WithError(err).Error("unexpected error")
 		render.ResponseError(w, problems.InternalError())
@@ -216,6 +329,9 @@ func (c *SessionController) DeleteMySession(w http.ResponseWriter, r *http.Reque
 
 	err = c.sessions.DeleteMySession(r.Context(), scope.UserActor(r), sessionID)
 	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
 	case errors.Is(err, errx.ErrorUserInvalidSession):
 		log.WithError(err).Warn("invalid credentials")
 		render.ResponseError(w, problems.Unauthorized())
@@ -236,11 +352,24 @@ const operationDeleteMySessions = "delete_my_sessions"
 func (c *SessionController) DeleteMySessions(w http.ResponseWriter, r *http.Request) {
 	log := scope.Log(r).WithOperation(operationDeleteMySessions)
 
-	var err error
-	defer c.metrics.RecordSessionDeleted(r.Context(), "all", &err)
+	params, err := deleteSessionsParams(r)
+	if err != nil {
+		log.WithError(err).Info("invalid delete sessions filter")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	metricScope := "selected"
+	if params.All() {
+		metricScope = "all"
+	}
+	defer c.metrics.RecordSessionDeleted(r.Context(), metricScope, &err)
 
-	err = c.sessions.DeleteMySessions(r.Context(), scope.UserActor(r))
+	revoked, err := c.sessions.DeleteMySessions(r.Context(), scope.UserActor(r), params)
 	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
 	case errors.Is(err, errx.ErrorUserInvalidSession),
 		errors.Is(err, errx.ErrorSessionNotFound):
 		log.WithError(err).Warn("invalid credentials")
@@ -249,11 +378,55 @@ func (c *SessionController) DeleteMySessions(w http.ResponseWriter, r *http.Requ
 		log.WithError(err).Error("unexpected error")
 		render.ResponseError(w, problems.InternalError())
 	default:
-		log.Info("sessions deleted")
-		render.Response(w, http.StatusNoContent, nil)
+		log.Info("sessions deleted", "count", revoked)
+		render.Response(w, http.StatusOK, responses.SessionsRevoked(revoked))
 	}
 }
 
+// deleteSessionsParams reads the query parameters of DELETE /me/sessions.
+func deleteSessionsParams(r *http.Request) (session.DeleteSessionsParams, error) {
+	var params session.DeleteSessionsParams
+	errs := validation.Errors{}
+
+	q := r.URL.Query()
+	if v := q.Get("keep_current"); v != "" {
+		keep, err := strconv.ParseBool(v)
+		if err != nil {
+			errs["keep_current"] = fmt.Errorf("must be true or false")
+		} else {
+			params.KeepCurrent = keep
+		}
+	}
+	if v := q.Get("filter[last_used_before]"); v != "" {
+		before, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			errs["filter[last_used_before]"] = fmt.Errorf("must be an RFC 3339 time")
+		} else {
+			params.LastUsedBefore = &before
+		}
+	}
+	if v := q.Get("filter[created_before]"); v != "" {
+		before, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			errs["filter[created_before]"] = fmt.Errorf("must be an RFC 3339 time")
+		} else {
+			params.CreatedBefore = &before
+		}
+	}
+	if v := q.Get("filter[id]"); v != "" {
+		for _, raw := range strings.Split(v, ",") {
+			id, err := uuid.Parse(strings.TrimSpace(raw))
+			if err != nil {
+				errs["filter[id]"] = fmt.Errorf("invalid session id: %s", raw)
+				break
+			}
+			params.SessionIDs = append(params.SessionIDs, id)
+		}
+	}
+
+	return params, errs.Filter()
+}
+
 const operationLogout = "logout"
 
 func (c *SessionController) Logout(w http.ResponseWriter, r *http.Request) {
@@ -39,9 +39,19 @@ type userCore interface {
 
 	UpdatePassword(ctx context.Context, actor models.UserActor, oldPassword, newPassword string) error
 
+	RequestEmailVerification(ctx context.Context, actor models.UserActor) error
+	ConfirmEmailVerification(ctx context.Context, token string) (models.UserEmail, error)
+	RequestEmailChange(ctx context.Context, actor models.UserActor, newEmail string) error
+	ConfirmEmailChange(ctx context.Context, token string) (models.UserEmail, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, token, newPassword string, client models.SessionClient) error
+
 	CreateUploadMediaLinks(ctx context.Context, actor models.UserActor) (models.User, models.UploadUserMediaLinks, error)
 	DeleteUploadMedia(ctx context.Context, actor models.UserActor, params user.DeleteUploadMediaParams) error
 
+	ListMyIdentities(ctx context.Context, actor models.UserActor) ([]models.UserIdentity, error)
+	UnlinkMyIdentity(ctx context.Context, actor models.UserActor, identityID uuid.UUID) error
+
 	DeleteMyUser(ctx context.Context, actor models.UserActor) error
 }
 
@@ -364,10 +374,13 @@ func (c *UserController) UpdatePassword(w http.ResponseWriter, r *http.Request)
 	err = c.users.UpdatePassword(
 		r.Context(),
 		scope.UserActor(r),
-		req.Data.Attributes.OldPassword,
+		req.Data.Attributes.GetOldPassword(),
 		req.Data.Attributes.NewPassword,
 	)
 	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
 	case errors.Is(err, errx.ErrorUserInvalidSession),
 		errors.Is(err, errx.ErrorSessionNotFound):
 		log.WithError(err).Warn("invalid credentials")
@@ -389,6 +402,190 @@ func (c *UserController) UpdatePassword(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+const operationRequestEmailVerification = "request_email_verification"
+
+func (c *UserController) RequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationRequestEmailVerification)
+
+	err := c.users.RequestEmailVerification(r.Context(), scope.UserActor(r))
+	switch {
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.WithError(err).Warn("user not found")
+		render.ResponseError(w, problems.NotFound("user not found"))
+	case errors.Is(err, errx.ErrorEmailAlreadyVerified):
+		log.WithError(err).Info("email already verified")
+		render.ResponseError(w, problems.Conflict("email is already verified"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("email verification requested")
+		render.Response(w, http.StatusNoContent, nil)
+	}
+}
+
+const operationConfirmEmailVerification = "confirm_email_verification"
+
+func (c *UserController) ConfirmEmailVerification(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationConfirmEmailVerification)
+
+	req, err := requests.ConfirmEmailVerification(r)
+	if err != nil {
+		log.WithError(err).Info("invalid confirm email verification request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	email, err := c.users.ConfirmEmailVerification(r.Context(), req.Data.Attributes.Token)
+	switch {
+	case errors.Is(err, errx.ErrorEmailVerificationTokenInvalid):
+		log.WithError(err).Warn("invalid email verification token")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/token": fmt.Errorf("token is invalid, expired or already used"),
+		})...)
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.With("user_id", email.UserID).Info("email verified")
+		render.Response(w, http.StatusNoContent, nil)
+	}
+}
+
+const operationUpdateMyEmail = "update_my_email"
+
+func (c *UserController) UpdateEmail(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationUpdateMyEmail)
+
+	req, err := requests.UpdateEmail(r)
+	if err != nil {
+		log.WithError(err).Info("invalid update email request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	err = c.users.RequestEmailChange(r.Context(), scope.UserActor(r), req.Data.Attributes.Email)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
+	case errors.Is(err, errx.ErrorUserInvalidSession),
+		errors.Is(err, errx.ErrorSessionNotFound):
+		log.WithError(err).Warn("invalid credentials")
+		render.ResponseError(w, problems.Unauthorized())
+	case errors.Is(err, errx.ErrorUserNotFound):
+		log.WithError(err).Warn("user not found")
+		render.ResponseError(w, problems.NotFound("user not found"))
+	case errors.Is(err, errx.ErrorEmailUnchanged):
+		log.WithError(err).Info("email unchanged")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/email": fmt.Errorf("email is the current one"),
+		})...)
+	case errors.Is(err, errx.ErrorEmailAlreadyExist):
+		log.WithError(err).Warn("email already exists")
+		render.ResponseError(w, problems.Conflict("user with this email already exists"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("email change requested")
+		render.Response(w, http.StatusAccepted, nil)
+	}
+}
+
+const operationConfirmEmailChange = "confirm_email_change"
+
+func (c *UserController) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationConfirmEmailChange)
+
+	req, err := requests.ConfirmEmailChange(r)
+	if err != nil {
+		log.WithError(err).Info("invalid confirm email change request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	email, err := c.users.ConfirmEmailChange(r.Context(), req.Data.Attributes.Token)
+	switch {
+	case errors.Is(err, errx.ErrorEmailChangeTokenInvalid):
+		log.WithError(err).Warn("invalid email change token")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/token": fmt.Errorf("token is invalid, expired or already used"),
+		})...)
+	case errors.Is(err, errx.ErrorEmailAlreadyExist):
+		log.WithError(err).Warn("email already exists")
+		render.ResponseError(w, problems.Conflict("user with this email already exists"))
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.With("user_id", email.UserID).Info("email changed")
+		render.Response(w, http.StatusNoContent, nil)
+	}
+}
+
+const operationRequestPasswordReset = "request_password_reset"
+
+func (c *UserController) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationRequestPasswordReset)
+
+	req, err := requests.RequestPasswordReset(r)
+	if err != nil {
+		log.WithError(err).Info("invalid request password reset request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	// The response is the same whether the email is known or not.
+	err = c.users.RequestPasswordReset(r.Context(), req.Data.Attributes.Email)
+	switch {
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("password reset requested")
+		render.Response(w, http.StatusNoContent, nil)
+	}
+}
+
+const operationConfirmPasswordReset = "confirm_password_reset"
+
+func (c *UserController) ConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	log := scope.Log(r).WithOperation(operationConfirmPasswordReset)
+
+	req, err := requests.ConfirmPasswordReset(r)
+	if err != nil {
+		log.WithError(err).Info("invalid confirm password reset request")
+		render.ResponseError(w, problems.BadRequest(err)...)
+		return
+	}
+
+	err = c.users.ConfirmPasswordReset(
+		r.Context(),
+		req.Data.Attributes.Token,
+		req.Data.Attributes.NewPassword,
+		scope.Client(r),
+	)
+	switch {
+	case errors.Is(err, errx.ErrorPasswordResetTokenInvalid):
+		log.WithError(err).Warn("invalid password reset token")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/token": fmt.Errorf("token is invalid, expired or already used"),
+		})...)
+	case errors.Is(err, errx.ErrorPasswordIsNotAllowed):
+		log.WithError(err).Warn("new password is not allowed")
+		render.ResponseError(w, problems.BadRequest(validation.Errors{
+			"data/attributes/new_password": err,
+		})...)
+	case err != nil:
+		log.WithError(err).Error("unexpected error")
+		render.ResponseError(w, problems.InternalError())
+	default:
+		log.Info("password reset")
+		render.Response(w, http.StatusNoContent, nil)
+	}
+}
+
 const operationDeleteMyUser = "delete_my_user"
 
 func (c *UserController) DeleteMyUser(w http.ResponseWriter, r *http.Request) {
@@ -396,6 +593,9 @@ func (c *UserController) DeleteMyUser(w http.ResponseWriter, r *http.Request) {
 
 	err := c.users.DeleteMyUser(r.Context(), scope.UserActor(r))
 	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		log.WithError(err).Warn("user is suspended")
+		render.ResponseError(w, problems.Forbidden("user is suspended"))
 	case errors.Is(err, errx.ErrorUserInvalidSession),
 		errors.Is(err, errx.ErrorSessionNotFound):
 		log.WithError(err).Warn("invalid credentials")
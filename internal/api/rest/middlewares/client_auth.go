@@ -0,0 +1,47 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/restkit/headers"
+	"github.com/netbill/restkit/problems"
+	"github.com/netbill/restkit/render"
+)
+
+// UserOrClientAuth accepts an access token issued to an OpenID Connect
+// client, and otherwise behaves as UserAuth. Handlers behind it read the
+// client's scopes with scope.ClientScopes. Only the userinfo endpoint takes
+// client tokens; UserAuth rejects them everywhere else.
+func (p *Provider) UserOrClientAuth() func(next http.Handler) http.Handler {
+	userAuth := p.UserAuth()
+
+	return func(next http.Handler) http.Handler {
+		asUser := userAuth(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, err := headers.GetAuthorizationToken(r)
+			if err != nil {
+				asUser.ServeHTTP(w, r)
+				return
+			}
+
+			claims, err := p.tokenManager.ParseClientAccess(token)
+			if err != nil {
+				asUser.ServeHTTP(w, r)
+				return
+			}
+
+			if p.revocations.IsRevoked(r.Context(), claims.SessionID) {
+				scope.Log(r).WithUserAuthClaims(claims.AccountAuthClaims).
+					WithField("client_id", claims.ClientID).
+					Info("client authentication rejected: session revoked")
+				render.ResponseError(w, problems.Unauthorized())
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(scope.CtxClientAuth(r.Context(), claims)))
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/auth-svc/pkg/clientip"
+)
+
+func (p *Provider) ClientIP(resolver *clientip.Resolver) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			forwardedFor := strings.Join(r.Header.Values("X-Forwarded-For"), ",")
+			ip := resolver.Resolve(r.RemoteAddr, forwardedFor, r.Header.Get("X-Real-IP"))
+			next.ServeHTTP(w, r.WithContext(scope.CtxClientIP(r.Context(), ip)))
+		})
+	}
+}
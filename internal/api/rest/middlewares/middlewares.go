@@ -1,21 +1,34 @@
 package middlewares
 
 import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/pkg/tokenmanager"
 	"github.com/netbill/restkit/tokens"
 )
 
 type tokenManager interface {
 	ParseUserAuthAccess(tokenStr string) (tokens.AccountAuthClaims, error)
+	ParseServiceAccess(tokenStr string) (tokenmanager.ServiceClaims, error)
+	ParseClientAccess(tokenStr string) (tokenmanager.ClientClaims, error)
+}
+
+type revocations interface {
+	IsRevoked(ctx context.Context, sessionID uuid.UUID) bool
 }
 
 type Provider struct {
 	tokenManager tokenManager
+	revocations  revocations
 }
 
 func New(
 	tokenManager tokenManager,
+	revocations revocations,
 ) *Provider {
 	return &Provider{
 		tokenManager: tokenManager,
+		revocations:  revocations,
 	}
 }
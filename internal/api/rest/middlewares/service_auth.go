@@ -0,0 +1,46 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/netbill/auth-svc/internal/api/rest/scope"
+	"github.com/netbill/restkit/headers"
+	"github.com/netbill/restkit/problems"
+	"github.com/netbill/restkit/render"
+)
+
+// UserOrServiceAuth accepts a service token carrying requiredScope, and
+// otherwise behaves as UserAuth with allowedRoles. Handlers behind it must
+// not assume a user actor.
+func (p *Provider) UserOrServiceAuth(requiredScope string, allowedRoles ...string) func(next http.Handler) http.Handler {
+	userAuth := p.UserAuth(allowedRoles...)
+
+	return func(next http.Handler) http.Handler {
+		asUser := userAuth(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, err := headers.GetAuthorizationToken(r)
+			if err != nil {
+				asUser.ServeHTTP(w, r)
+				return
+			}
+
+			claims, err := p.tokenManager.ParseServiceAccess(token)
+			if err != nil {
+				asUser.ServeHTTP(w, r)
+				return
+			}
+
+			if !claims.HasScope(requiredScope) {
+				scope.Log(r).WithServiceAuthClaims(claims).
+					WithField("required_scope", requiredScope).
+					Info("service authentication rejected by scope")
+				render.ResponseError(w, problems.Forbidden("service token lacks the required scope"))
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(scope.CtxServiceAuth(r.Context(), claims)))
+		})
+	}
+}
@@ -33,6 +33,13 @@ func (p *Provider) UserAuth(allowedRoles ...string) func(next http.Handler) http
 				return
 			}
 
+			if p.revocations.IsRevoked(r.Context(), claims.SessionID) {
+				scope.Log(r).WithUserAuthClaims(claims).Info("user authentication rejected: session revoked")
+				render.ResponseError(w, problems.Unauthorized())
+
+				return
+			}
+
 			if len(allowed) > 0 {
 				if _, ok := allowed[claims.Role]; !ok {
 					scope.Log(r).Debug("user authentication rejected by role")
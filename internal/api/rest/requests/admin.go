@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/restkit"
+)
+
+// UpdateUserRole only checks the shape of the body; whether the role exists
+// is up to the service.
+func UpdateUserRole(r *http.Request) (req oapi.UpdateUserRole, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":            validation.Validate(req.Data.Type, validation.Required, validation.In("user_role")),
+		"data/attributes/role": validation.Validate(req.Data.Attributes.Role, validation.Required),
+	}
+	return req, errs.Filter()
+}
+
+func SuspendUser(r *http.Request) (req oapi.SuspendUser, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In("user_suspension")),
+		"data/attributes/reason": validation.Validate(
+			req.Data.Attributes.Reason, validation.NilOrNotEmpty, validation.RuneLength(0, 1024),
+		),
+	}
+	return req, errs.Filter()
+}
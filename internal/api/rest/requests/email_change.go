@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/restkit"
+)
+
+func UpdateEmail(r *http.Request) (req oapi.UpdateEmail, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In("email_change")),
+		"data/attributes/email": validation.Validate(
+			req.Data.Attributes.Email, validation.Required, validation.Length(5, 254), is.Email,
+		),
+	}
+	return req, errs.Filter()
+}
+
+func ConfirmEmailChange(r *http.Request) (req oapi.ConfirmEmailChange, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":             validation.Validate(req.Data.Type, validation.Required, validation.In("email_change")),
+		"data/attributes/token": validation.Validate(req.Data.Attributes.Token, validation.Required),
+	}
+	return req, errs.Filter()
+}
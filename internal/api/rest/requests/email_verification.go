@@ -0,0 +1,23 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/restkit"
+)
+
+func ConfirmEmailVerification(r *http.Request) (req oapi.ConfirmEmailVerification, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":             validation.Validate(req.Data.Type, validation.Required, validation.In("email_verification")),
+		"data/attributes/token": validation.Validate(req.Data.Attributes.Token, validation.Required),
+	}
+	return req, errs.Filter()
+}
@@ -0,0 +1,61 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/restkit"
+)
+
+func LoginByMFA(r *http.Request) (req oapi.LoginByMFA, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":                       validation.Validate(req.Data.Type, validation.Required, validation.In("mfa_login")),
+		"data/attributes/challenge_token": validation.Validate(req.Data.Attributes.ChallengeToken, validation.Required),
+		"data/attributes/code":            validation.Validate(req.Data.Attributes.Code, validation.Required),
+	}
+	return req, errs.Filter()
+}
+
+func ConfirmTOTP(r *http.Request) (req oapi.ConfirmTOTP, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":            validation.Validate(req.Data.Type, validation.Required, validation.In("totp_confirm")),
+		"data/attributes/code": validation.Validate(req.Data.Attributes.Code, validation.Required),
+	}
+	return req, errs.Filter()
+}
+
+func DisableTOTP(r *http.Request) (req oapi.DisableTOTP, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In("totp_disable")),
+	}
+	return req, errs.Filter()
+}
+
+func RegenerateRecoveryCodes(r *http.Request) (req oapi.RegenerateRecoveryCodes, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In("recovery_codes_regenerate")),
+	}
+	return req, errs.Filter()
+}
@@ -0,0 +1,128 @@
+package requests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/restkit"
+)
+
+// OAuthAuthorizeQuery reads an authorization request from the query string.
+// It is not validated here: which problems may be reported to the client's
+// redirect URI is up to the oidc service.
+func OAuthAuthorizeQuery(r *http.Request) oapi.OAuthAuthorizeDataAttributes {
+	q := r.URL.Query()
+	return oapi.OAuthAuthorizeDataAttributes{
+		ClientId:            q.Get("client_id"),
+		RedirectUri:         q.Get("redirect_uri"),
+		ResponseType:        q.Get("response_type"),
+		Scope:               q.Get("scope"),
+		State:               optionalQuery(q.Get("state")),
+		Nonce:               optionalQuery(q.Get("nonce")),
+		CodeChallenge:       q.Get("code_challenge"),
+		CodeChallengeMethod: q.Get("code_challenge_method"),
+	}
+}
+
+func OAuthAuthorize(r *http.Request) (req oapi.OAuthAuthorize, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":                 validation.Validate(req.Data.Type, validation.Required, validation.In("oauth_authorization")),
+		"data/attributes/client_id": validation.Validate(req.Data.Attributes.ClientId, validation.Required),
+		"data/attributes/redirect_uri": validation.Validate(
+			req.Data.Attributes.RedirectUri, validation.Required,
+		),
+	}
+	return req, errs.Filter()
+}
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+)
+
+// OAuthToken reads a token request from the form body (RFC 6749, section
+// 4.1.3, 4.4 and 6). Client credentials may come in the form or as HTTP
+// Basic auth (section 2.3.1), but not both. Parameters the grant type needs
+// are checked here, the grant itself by the oidc service.
+func OAuthToken(r *http.Request) (req oapi.OAuthToken, err error) {
+	if err = r.ParseForm(); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	req = oapi.OAuthToken{
+		GrantType:    r.PostForm.Get("grant_type"),
+		ClientId:     optionalQuery(r.PostForm.Get("client_id")),
+		ClientSecret: optionalQuery(r.PostForm.Get("client_secret")),
+		Code:         optionalQuery(r.PostForm.Get("code")),
+		RedirectUri:  optionalQuery(r.PostForm.Get("redirect_uri")),
+		CodeVerifier: optionalQuery(r.PostForm.Get("code_verifier")),
+		RefreshToken: optionalQuery(r.PostForm.Get("refresh_token")),
+		Scope:        optionalQuery(r.PostForm.Get("scope")),
+	}
+
+	errs := validation.Errors{
+		"grant_type": validation.Validate(req.GrantType, validation.Required),
+	}
+
+	if id, secret, ok := basicAuth(r); ok {
+		if req.ClientSecret != nil {
+			errs["client_secret"] = errors.New("must not be sent with HTTP Basic authentication")
+		}
+		if req.ClientId != nil && *req.ClientId != id {
+			errs["client_id"] = errors.New("does not match HTTP Basic authentication")
+		}
+		req.ClientId, req.ClientSecret = &id, &secret
+	}
+	if errs["client_id"] == nil {
+		errs["client_id"] = validation.Validate(req.ClientId, validation.Required)
+	}
+
+	switch req.GrantType {
+	case GrantTypeAuthorizationCode:
+		errs["code"] = validation.Validate(req.Code, validation.Required)
+		errs["redirect_uri"] = validation.Validate(req.RedirectUri, validation.Required)
+		errs["code_verifier"] = validation.Validate(req.CodeVerifier, validation.Required)
+	case GrantTypeRefreshToken:
+		errs["refresh_token"] = validation.Validate(req.RefreshToken, validation.Required)
+	}
+
+	return req, errs.Filter()
+}
+
+// basicAuth reads client credentials from the Authorization header. RFC
+// 6749 has them form-urlencoded before being base64 encoded.
+func basicAuth(r *http.Request) (id, secret string, ok bool) {
+	rawID, rawSecret, ok := r.BasicAuth()
+	if !ok {
+		return "", "", false
+	}
+
+	id, err := url.QueryUnescape(rawID)
+	if err != nil {
+		return "", "", false
+	}
+	secret, err = url.QueryUnescape(rawSecret)
+	if err != nil {
+		return "", "", false
+	}
+
+	return id, secret, true
+}
+
+func optionalQuery(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,49 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/restkit"
+)
+
+var oauthClientIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{2,63}$`)
+
+func CreateOAuthClient(r *http.Request) (req oapi.CreateOAuthClient, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":   validation.Validate(req.Data.Id, validation.Required, validation.Match(oauthClientIDPattern)),
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In("oauth_client")),
+		"data/attributes/name": validation.Validate(
+			req.Data.Attributes.Name, validation.Required, validation.RuneLength(1, 128),
+		),
+	}
+
+	return req, errs.Filter()
+}
+
+func UpdateOAuthClient(r *http.Request, clientID string) (req oapi.UpdateOAuthClient, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id": validation.Validate(req.Data.Id, validation.Required, validation.In(clientID).
+			Error(fmt.Sprintf("must match the client id in the path: %s", clientID))),
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In("oauth_client")),
+		"data/attributes/name": validation.Validate(
+			req.Data.Attributes.Name, validation.NilOrNotEmpty, validation.RuneLength(1, 128),
+		),
+	}
+
+	return req, errs.Filter()
+}
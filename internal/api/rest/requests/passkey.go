@@ -0,0 +1,88 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/auth-svc/pkg/webauthn"
+	"github.com/netbill/restkit"
+)
+
+func FinishPasskeyRegistration(r *http.Request) (
+	req oapi.FinishPasskeyRegistration,
+	cred webauthn.RegistrationCredential,
+	err error,
+) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In("passkey_registration")),
+		"data/attributes/name": validation.Validate(
+			req.Data.Attributes.Name, validation.NilOrNotEmpty, validation.RuneLength(1, 64),
+		),
+		"data/attributes/credential": validation.Validate(req.Data.Attributes.Credential, validation.Required),
+	}
+	if errs["data/attributes/credential"] == nil {
+		errs["data/attributes/credential"] = decodeCredential(req.Data.Attributes.Credential, &cred)
+	}
+
+	return req, cred, errs.Filter()
+}
+
+func UpdatePasskey(r *http.Request) (req oapi.UpdatePasskey, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In("passkey")),
+		"data/attributes/name": validation.Validate(
+			req.Data.Attributes.Name, validation.Required, validation.RuneLength(1, 64),
+		),
+	}
+
+	return req, errs.Filter()
+}
+
+func LoginByPasskey(r *http.Request) (
+	req oapi.LoginByPasskey,
+	cred webauthn.AssertionCredential,
+	err error,
+) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":                  validation.Validate(req.Data.Type, validation.Required, validation.In("passkey_login")),
+		"data/attributes/credential": validation.Validate(req.Data.Attributes.Credential, validation.Required),
+	}
+	if errs["data/attributes/credential"] == nil {
+		errs["data/attributes/credential"] = decodeCredential(req.Data.Attributes.Credential, &cred)
+	}
+
+	return req, cred, errs.Filter()
+}
+
+// decodeCredential reads the free-form credential object of a request into
+// the webauthn type it describes.
+func decodeCredential(raw map[string]interface{}, dst any) error {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+
+	if err = json.Unmarshal(data, dst); err != nil {
+		return fmt.Errorf("invalid credential: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/restkit"
+)
+
+func RequestPasswordReset(r *http.Request) (req oapi.RequestPasswordReset, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In("password_reset")),
+		"data/attributes/email": validation.Validate(
+			req.Data.Attributes.Email, validation.Required, validation.Length(5, 255), is.Email,
+		),
+	}
+	return req, errs.Filter()
+}
+
+func ConfirmPasswordReset(r *http.Request) (req oapi.ConfirmPasswordReset, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":                    validation.Validate(req.Data.Type, validation.Required, validation.In("password_reset")),
+		"data/attributes/token":        validation.Validate(req.Data.Attributes.Token, validation.Required),
+		"data/attributes/new_password": validation.Validate(req.Data.Attributes.NewPassword, validation.Required),
+	}
+	return req, errs.Filter()
+}
@@ -3,12 +3,15 @@ package requests
 import (
 	"encoding/json"
 	"net/http"
+	"regexp"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/netbill/auth-svc/pkg/oapi"
 	"github.com/netbill/restkit"
 )
 
+var qrCodePattern = regexp.MustCompile(`^[0-9]{6}$`)
+
 func QRConfirm(r *http.Request) (req oapi.QRConfirm, err error) {
 	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
 		err = restkit.NewDecodeError("body", err)
@@ -16,8 +19,22 @@ func QRConfirm(r *http.Request) (req oapi.QRConfirm, err error) {
 	}
 
 	errs := validation.Errors{
-		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In("qr_token")),
-		"data/attributes": validation.Validate(req.Data.Attributes.QrToken, validation.Required),
+		"data/type":                validation.Validate(req.Data.Type, validation.Required, validation.In("qr_token")),
+		"data/attributes/qr_token": validation.Validate(req.Data.Attributes.QrToken, validation.Required),
+		"data/attributes/code":     validation.Validate(req.Data.Attributes.Code, validation.Required, validation.Match(qrCodePattern)),
+	}
+	return req, errs.Filter()
+}
+
+func QRReject(r *http.Request) (req oapi.QRReject, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":                validation.Validate(req.Data.Type, validation.Required, validation.In("qr_token")),
+		"data/attributes/qr_token": validation.Validate(req.Data.Attributes.QrToken, validation.Required),
 	}
 	return req, errs.Filter()
 }
@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/restkit"
+)
+
+func UpdateSession(r *http.Request) (req oapi.UpdateSession, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = restkit.NewDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In("user_session")),
+		"data/attributes/device_name": validation.Validate(
+			req.Data.Attributes.DeviceName, validation.RuneLength(0, 64),
+		),
+	}
+
+	return req, errs.Filter()
+}
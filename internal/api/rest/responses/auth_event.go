@@ -0,0 +1,53 @@
+package responses
+
+import (
+	"net/http"
+
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/restkit/pagi"
+)
+
+func AuthEvent(m models.AuthEvent) oapi.AuthEventData {
+	attrs := oapi.AuthEventAttributes{
+		UserId:    m.UserID,
+		SessionId: m.SessionID,
+		Event:     m.Type,
+		Outcome:   m.Outcome,
+		Reason:    m.Reason,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.IP != "" {
+		attrs.Ip = &m.IP
+	}
+	if m.UserAgent != "" {
+		attrs.UserAgent = &m.UserAgent
+	}
+
+	return oapi.AuthEventData{
+		Id:         m.ID,
+		Type:       "auth_event",
+		Attributes: attrs,
+	}
+}
+
+func AuthEventsCollection(r *http.Request, page pagi.Page[[]models.AuthEvent]) oapi.AuthEventsCollection {
+	data := make([]oapi.AuthEventData, 0, len(page.Data))
+
+	for _, e := range page.Data {
+		data = append(data, AuthEvent(e))
+	}
+
+	links := pagi.BuildPageLinks(r, page.Page, page.Size, page.Total)
+
+	return oapi.AuthEventsCollection{
+		Data: data,
+		Links: oapi.PaginationData{
+			First: links.First,
+			Last:  links.Last,
+			Prev:  links.Prev,
+			Next:  links.Next,
+			Self:  links.Self,
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package responses
+
+import (
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/oapi"
+)
+
+func identityData(m models.UserIdentity) oapi.IdentityData {
+	return oapi.IdentityData{
+		Id:   m.ID,
+		Type: "identity",
+		Attributes: oapi.IdentityDataAttributes{
+			Provider:   m.Provider,
+			Subject:    m.Subject,
+			Email:      m.Email,
+			LastUsedAt: m.LastUsedAt,
+			CreatedAt:  m.CreatedAt,
+		},
+	}
+}
+
+func Identity(m models.UserIdentity) oapi.Identity {
+	return oapi.Identity{Data: identityData(m)}
+}
+
+func IdentitiesCollection(ms []models.UserIdentity) oapi.IdentitiesCollection {
+	data := make([]oapi.IdentityData, 0, len(ms))
+	for _, m := range ms {
+		data = append(data, identityData(m))
+	}
+
+	return oapi.IdentitiesCollection{Data: data}
+}
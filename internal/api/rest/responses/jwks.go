@@ -0,0 +1,31 @@
+package responses
+
+import (
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/auth-svc/pkg/tokenmanager"
+)
+
+func JWKS(set tokenmanager.JWKS) oapi.JWKS {
+	keys := make([]oapi.JWKSKeysInner, 0, len(set.Keys))
+	for _, k := range set.Keys {
+		keys = append(keys, oapi.JWKSKeysInner{
+			Kty: k.Kty,
+			Kid: k.Kid,
+			Use: k.Use,
+			Alg: k.Alg,
+			N:   optionalString(k.N),
+			E:   optionalString(k.E),
+			Crv: optionalString(k.Crv),
+			X:   optionalString(k.X),
+		})
+	}
+
+	return oapi.JWKS{Keys: keys}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,56 @@
+package responses
+
+import (
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/oapi"
+)
+
+func MFAChallenge(m models.MFAChallenge) oapi.MFAChallenge {
+	return oapi.MFAChallenge{
+		Data: oapi.MFAChallengeData{
+			Type: "mfa_challenge",
+			Attributes: oapi.MFAChallengeDataAttributes{
+				ChallengeToken: m.Token,
+				ExpiresAt:      m.ExpiresAt,
+			},
+		},
+	}
+}
+
+func MFAStatus(userID uuid.UUID, m models.MFAStatus) oapi.MFAStatus {
+	return oapi.MFAStatus{
+		Data: oapi.MFAStatusData{
+			Id:   userID,
+			Type: "mfa_status",
+			Attributes: oapi.MFAStatusDataAttributes{
+				Enabled:                m.Enabled,
+				EnabledAt:              m.EnabledAt,
+				RecoveryCodesRemaining: int32(m.RecoveryCodesRemaining),
+			},
+		},
+	}
+}
+
+func TOTPEnrollment(m models.TOTPEnrollment) oapi.TOTPEnrollment {
+	return oapi.TOTPEnrollment{
+		Data: oapi.TOTPEnrollmentData{
+			Type: "totp_enrollment",
+			Attributes: oapi.TOTPEnrollmentDataAttributes{
+				Secret: m.Secret,
+				Uri:    m.URI,
+			},
+		},
+	}
+}
+
+func RecoveryCodes(codes []string) oapi.RecoveryCodes {
+	return oapi.RecoveryCodes{
+		Data: oapi.RecoveryCodesData{
+			Type: "recovery_codes",
+			Attributes: oapi.RecoveryCodesDataAttributes{
+				Codes: codes,
+			},
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package responses
+
+import (
+	"strings"
+
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/oapi"
+)
+
+const (
+	oauthAuthorizePath = "/auth-svc/v1/oauth2/authorize"
+	oauthTokenPath     = "/auth-svc/v1/oauth2/token"
+	oauthUserInfoPath  = "/auth-svc/v1/oauth2/userinfo"
+	jwksPath           = "/.well-known/jwks.json"
+)
+
+func OpenIDConfiguration(issuer string, scopes, algs []string) oapi.OpenIDConfiguration {
+	base := strings.TrimSuffix(issuer, "/")
+
+	return oapi.OpenIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             base + oauthAuthorizePath,
+		TokenEndpoint:                     base + oauthTokenPath,
+		UserinfoEndpoint:                  base + oauthUserInfoPath,
+		JwksUri:                           base + jwksPath,
+		ScopesSupported:                   scopes,
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "refresh_token", "client_credentials"},
+		SubjectTypesSupported:             []string{"public"},
+		IdTokenSigningAlgValuesSupported:  algs,
+		TokenEndpointAuthMethodsSupported: []string{"none", "client_secret_basic", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		ClaimsSupported: []string{
+			"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce",
+			"preferred_username", "pseudonym", "email", "email_verified",
+		},
+	}
+}
+
+func OAuthRedirect(redirectURI string) oapi.OAuthRedirect {
+	return oapi.OAuthRedirect{
+		Data: oapi.OAuthRedirectData{
+			Type: "oauth_redirect",
+			Attributes: oapi.OAuthRedirectDataAttributes{
+				RedirectUri: redirectURI,
+			},
+		},
+	}
+}
+
+func OAuthTokens(t models.OAuthTokens) oapi.OAuthTokens {
+	resp := oapi.OAuthTokens{
+		AccessToken: t.Access,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(t.ExpiresIn.Seconds()),
+	}
+	if t.Refresh != "" {
+		resp.RefreshToken = oapi.PtrString(t.Refresh)
+	}
+	if t.IDToken != "" {
+		resp.IdToken = oapi.PtrString(t.IDToken)
+	}
+	if len(t.Scopes) > 0 {
+		resp.Scope = oapi.PtrString(strings.Join(t.Scopes, " "))
+	}
+
+	return resp
+}
+
+func OAuthUserInfo(info models.OAuthUserInfo) oapi.OAuthUserInfo {
+	resp := oapi.OAuthUserInfo{
+		Sub:               info.User.ID,
+		PreferredUsername: info.User.Username,
+		Pseudonym:         info.User.Pseudonym,
+	}
+	if info.Email.Email != "" {
+		resp.Email = oapi.PtrString(info.Email.Email)
+		resp.EmailVerified = oapi.PtrBool(info.Email.Verified)
+	}
+
+	return resp
+}
+
+func OAuthError(code, description string) oapi.OAuthError {
+	resp := oapi.OAuthError{Error: code}
+	if description != "" {
+		resp.ErrorDescription = oapi.PtrString(description)
+	}
+
+	return resp
+}
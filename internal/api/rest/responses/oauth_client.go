@@ -0,0 +1,50 @@
+package responses
+
+import (
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/oapi"
+)
+
+func oauthClientData(m models.OAuthClient) oapi.OAuthClientData {
+	scopes := m.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	redirectURIs := m.RedirectURIs
+	if redirectURIs == nil {
+		redirectURIs = []string{}
+	}
+
+	return oapi.OAuthClientData{
+		Id:   m.ID,
+		Type: "oauth_client",
+		Attributes: oapi.OAuthClientDataAttributes{
+			Name:         m.Name,
+			Public:       !m.Confidential(),
+			Scopes:       scopes,
+			RedirectUris: redirectURIs,
+			CreatedAt:    m.CreatedAt,
+			UpdatedAt:    m.UpdatedAt,
+		},
+	}
+}
+
+// OAuthClient renders a client. The secret is only passed right after it
+// was generated.
+func OAuthClient(m models.OAuthClient, secret string) oapi.OAuthClient {
+	data := oauthClientData(m)
+	if secret != "" {
+		data.Attributes.ClientSecret = oapi.PtrString(secret)
+	}
+
+	return oapi.OAuthClient{Data: data}
+}
+
+func OAuthClientsCollection(ms []models.OAuthClient) oapi.OAuthClientsCollection {
+	data := make([]oapi.OAuthClientData, 0, len(ms))
+	for _, m := range ms {
+		data = append(data, oauthClientData(m))
+	}
+
+	return oapi.OAuthClientsCollection{Data: data}
+}
@@ -0,0 +1,85 @@
+package responses
+
+import (
+	"encoding/json"
+
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/oapi"
+	"github.com/netbill/auth-svc/pkg/webauthn"
+)
+
+func passkeyData(m models.Passkey) oapi.PasskeyData {
+	transports := m.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+
+	return oapi.PasskeyData{
+		Id:   m.ID,
+		Type: "passkey",
+		Attributes: oapi.PasskeyDataAttributes{
+			Name:       m.Name,
+			Transports: transports,
+			LastUsedAt: m.LastUsedAt,
+			CreatedAt:  m.CreatedAt,
+			UpdatedAt:  m.UpdatedAt,
+		},
+	}
+}
+
+func Passkey(m models.Passkey) oapi.Passkey {
+	return oapi.Passkey{Data: passkeyData(m)}
+}
+
+func PasskeysCollection(ms []models.Passkey) oapi.PasskeysCollection {
+	data := make([]oapi.PasskeyData, 0, len(ms))
+	for _, m := range ms {
+		data = append(data, passkeyData(m))
+	}
+
+	return oapi.PasskeysCollection{Data: data}
+}
+
+func PasskeyCreationOptions(opts webauthn.CreationOptions) (oapi.PasskeyCreationOptions, error) {
+	publicKey, err := asObject(opts)
+	if err != nil {
+		return oapi.PasskeyCreationOptions{}, err
+	}
+
+	return oapi.PasskeyCreationOptions{
+		Data: oapi.PasskeyCreationOptionsData{
+			Type:       "passkey_creation_options",
+			Attributes: oapi.PasskeyCreationOptionsDataAttributes{PublicKey: publicKey},
+		},
+	}, nil
+}
+
+func PasskeyRequestOptions(opts webauthn.RequestOptions) (oapi.PasskeyRequestOptions, error) {
+	publicKey, err := asObject(opts)
+	if err != nil {
+		return oapi.PasskeyRequestOptions{}, err
+	}
+
+	return oapi.PasskeyRequestOptions{
+		Data: oapi.PasskeyRequestOptionsData{
+			Type:       "passkey_request_options",
+			Attributes: oapi.PasskeyRequestOptionsDataAttributes{PublicKey: publicKey},
+		},
+	}, nil
+}
+
+// asObject turns webauthn options into the free-form object the API schema
+// declares for them, keeping their JSON field names.
+func asObject(v any) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj map[string]interface{}
+	if err = json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
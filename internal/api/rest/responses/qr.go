@@ -0,0 +1,27 @@
+package responses
+
+import (
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/oapi"
+)
+
+// QRLogin describes the device behind a QR login to the one confirming it.
+// The verification code is never part of it.
+func QRLogin(m models.QRLogin) oapi.QRLogin {
+	return oapi.QRLogin{
+		Data: oapi.QRLoginData{
+			Id:   uuid.MustParse(m.Token),
+			Type: "qr_login",
+			Attributes: oapi.QRLoginDataAttributes{
+				Status:    m.Status,
+				Ip:        optionalString(m.Client.IP),
+				UserAgent: optionalString(m.Client.UserAgent),
+				Platform:  optionalString(m.Client.Platform),
+				Browser:   optionalString(m.Client.Browser),
+				CreatedAt: m.CreatedAt,
+				ExpiresAt: m.ExpiresAt,
+			},
+		},
+	}
+}
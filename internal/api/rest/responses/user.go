@@ -10,8 +10,9 @@ import (
 )
 
 type userResponse struct {
-	user  models.User
-	email *models.UserEmail
+	user       models.User
+	email      *models.UserEmail
+	suspension bool
 }
 
 type UserOption func(*userResponse)
@@ -22,6 +23,14 @@ func WithUserEmail(email models.UserEmail) UserOption {
 	}
 }
 
+// WithSuspensionDetails adds who suspended the user and why, which only
+// admins get to see.
+func WithSuspensionDetails() UserOption {
+	return func(res *userResponse) {
+		res.suspension = true
+	}
+}
+
 func User(
 	r *http.Request,
 	m models.User,
@@ -39,8 +48,14 @@ func User(
 		included = append(included, UserEmailData(*res.email))
 	}
 
+	data := userData(r, m)
+	if res.suspension {
+		data.Attributes.SuspendedBy = m.SuspendedBy
+		data.Attributes.SuspensionReason = m.SuspensionReason
+	}
+
 	return oapi.User{
-		Data:     userData(r, m),
+		Data:     data,
 		Included: included,
 	}
 }
@@ -57,6 +72,10 @@ func userData(r *http.Request, m models.User) oapi.UserData {
 			Version:     m.Version,
 			CreatedAt:   m.CreatedAt,
 			UpdatedAt:   m.UpdatedAt,
+			SuspendedAt: m.SuspendedAt,
+			DeletedAt:   m.DeletedAt,
+
+			SuspendedUntil: m.SuspendedUntil,
 		},
 	}
 	if m.AvatarKey != nil {
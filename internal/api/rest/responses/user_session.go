@@ -10,12 +10,17 @@ import (
 
 func UserSession(m models.Session) oapi.UserSession {
 	attrs := oapi.UserSessionAttributes{
-		UserId:    m.UserID,
-		Version:   m.Version,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
-		LastUsed:  m.LastUsed,
-		DeletedAt: m.DeletedAt,
+		UserId:     m.UserID,
+		UserAgent:  m.UserAgent,
+		ClientIp:   m.ClientIP,
+		Platform:   m.Platform,
+		Browser:    m.Browser,
+		DeviceName: m.DeviceName,
+		Version:    m.Version,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+		LastUsed:   m.LastUsed,
+		DeletedAt:  m.DeletedAt,
 	}
 
 	return oapi.UserSession{
@@ -47,3 +52,14 @@ func UserSessionsCollection(r *http.Request, page pagi.Page[[]models.Session]) o
 		},
 	}
 }
+
+func SessionsRevoked(count int) oapi.SessionsRevoked {
+	return oapi.SessionsRevoked{
+		Data: oapi.SessionsRevokedData{
+			Type: "sessions_revoked",
+			Attributes: oapi.SessionsRevokedDataAttributes{
+				Revoked: int32(count),
+			},
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package scope
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+// Client describes the caller for session bookkeeping. The address also
+// keys the per-IP login limiter, so it is whatever CtxClientIP stored: the
+// connection's peer, or the forwarded address when that peer is a trusted
+// proxy. Forwarding headers are never read here.
+func Client(r *http.Request) models.SessionClient {
+	return models.SessionClient{
+		UserAgent: r.UserAgent(),
+		IP:        clientIP(r),
+	}
+}
+
+// CtxClientIP stores the resolved client address of the request.
+func CtxClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, ClientIPCtxKey, ip)
+}
+
+func clientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(ClientIPCtxKey).(string); ok {
+		return ip
+	}
+
+	host := r.RemoteAddr
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr.Unmap().String()
+	}
+
+	return ""
+}
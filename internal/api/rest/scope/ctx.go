@@ -7,6 +7,7 @@ import (
 	"github.com/netbill/auth-svc/internal/media"
 	"github.com/netbill/auth-svc/internal/models"
 	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/netbill/auth-svc/pkg/tokenmanager"
 	"github.com/netbill/restkit/tokens"
 )
 
@@ -16,6 +17,9 @@ const (
 	LogCtxKey ctxKey = iota
 	UserDataCtxKey
 	BaseURLCtxKey
+	ServiceDataCtxKey
+	ClientDataCtxKey
+	ClientIPCtxKey
 )
 
 func CtxLog(ctx context.Context, log *log.Logger) context.Context {
@@ -30,6 +34,11 @@ func Log(r *http.Request) *log.Logger {
 		log = log.WithUserAuthClaims(authClaims)
 	}
 
+	serviceClaims, ok := r.Context().Value(ServiceDataCtxKey).(tokenmanager.ServiceClaims)
+	if ok {
+		log = log.WithServiceAuthClaims(serviceClaims)
+	}
+
 	return log
 }
 
@@ -37,12 +46,34 @@ func CtxUserAuth(ctx context.Context, user tokens.AccountAuthClaims) context.Con
 	return context.WithValue(ctx, UserDataCtxKey, user)
 }
 
+func CtxServiceAuth(ctx context.Context, service tokenmanager.ServiceClaims) context.Context {
+	return context.WithValue(ctx, ServiceDataCtxKey, service)
+}
+
+// CtxClientAuth stores the claims of a token issued to an OpenID Connect
+// client. The user they carry is the request's actor, as with CtxUserAuth.
+func CtxClientAuth(ctx context.Context, client tokenmanager.ClientClaims) context.Context {
+	ctx = context.WithValue(ctx, UserDataCtxKey, client.AccountAuthClaims)
+	return context.WithValue(ctx, ClientDataCtxKey, client)
+}
+
+// ClientScopes returns the scopes of a client's token, and false when the
+// request was made with a first-party token.
+func ClientScopes(r *http.Request) ([]string, bool) {
+	claims, ok := r.Context().Value(ClientDataCtxKey).(tokenmanager.ClientClaims)
+	if !ok {
+		return nil, false
+	}
+	return claims.Scopes(), true
+}
+
 func UserActor(r *http.Request) models.UserActor {
 	claims := r.Context().Value(UserDataCtxKey).(tokens.AccountAuthClaims)
 	return models.UserActor{
 		ID:        claims.GetAccountID(),
 		SessionID: claims.GetSessionID(),
 		Role:      claims.GetRole(),
+		Client:    Client(r),
 	}
 }
 
@@ -9,6 +9,8 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/netbill/auth-svc/internal/media"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/clientip"
 	"github.com/netbill/auth-svc/pkg/log"
 	"github.com/netbill/restkit/tokens"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -24,66 +26,163 @@ type UserController interface {
 	UpdatePassword(w http.ResponseWriter, r *http.Request)
 	DeleteMyUser(w http.ResponseWriter, r *http.Request)
 
+	RequestEmailVerification(w http.ResponseWriter, r *http.Request)
+	ConfirmEmailVerification(w http.ResponseWriter, r *http.Request)
+	UpdateEmail(w http.ResponseWriter, r *http.Request)
+	ConfirmEmailChange(w http.ResponseWriter, r *http.Request)
+	RequestPasswordReset(w http.ResponseWriter, r *http.Request)
+	ConfirmPasswordReset(w http.ResponseWriter, r *http.Request)
+
 	GetUserByID(w http.ResponseWriter, r *http.Request)
 	GetUserByUsername(w http.ResponseWriter, r *http.Request)
 	FilterUsers(w http.ResponseWriter, r *http.Request)
 
 	CreateUploadMediaLink(w http.ResponseWriter, r *http.Request)
 	DeleteUploadMedia(w http.ResponseWriter, r *http.Request)
+
+	GetMyIdentities(w http.ResponseWriter, r *http.Request)
+	DeleteMyIdentity(w http.ResponseWriter, r *http.Request)
 }
 
 type SessionController interface {
 	LoginByEmail(w http.ResponseWriter, r *http.Request)
-	LoginByGoogleOAuth(w http.ResponseWriter, r *http.Request)
-	LoginByGoogleOAuthCallback(w http.ResponseWriter, r *http.Request)
+	LoginByOAuth(w http.ResponseWriter, r *http.Request)
+	LoginByOAuthCallback(w http.ResponseWriter, r *http.Request)
+	LinkIdentity(w http.ResponseWriter, r *http.Request)
+	LoginByMFA(w http.ResponseWriter, r *http.Request)
+	BeginPasskeyLogin(w http.ResponseWriter, r *http.Request)
+	LoginByPasskey(w http.ResponseWriter, r *http.Request)
 
 	Logout(w http.ResponseWriter, r *http.Request)
 	RefreshSession(w http.ResponseWriter, r *http.Request)
 
 	GetMySession(w http.ResponseWriter, r *http.Request)
 	GetMySessions(w http.ResponseWriter, r *http.Request)
+	UpdateMySession(w http.ResponseWriter, r *http.Request)
 	DeleteMySession(w http.ResponseWriter, r *http.Request)
 	DeleteMySessions(w http.ResponseWriter, r *http.Request)
 }
 
+type MFAController interface {
+	GetMyMFA(w http.ResponseWriter, r *http.Request)
+	EnrollTOTP(w http.ResponseWriter, r *http.Request)
+	ConfirmTOTP(w http.ResponseWriter, r *http.Request)
+	DisableTOTP(w http.ResponseWriter, r *http.Request)
+	RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request)
+}
+
+type PasskeyController interface {
+	GetMyPasskeys(w http.ResponseWriter, r *http.Request)
+	BeginPasskeyRegistration(w http.ResponseWriter, r *http.Request)
+	FinishPasskeyRegistration(w http.ResponseWriter, r *http.Request)
+	UpdateMyPasskey(w http.ResponseWriter, r *http.Request)
+	DeleteMyPasskey(w http.ResponseWriter, r *http.Request)
+}
+
+type KeysController interface {
+	GetJWKS(w http.ResponseWriter, r *http.Request)
+}
+
+type OIDCController interface {
+	GetOpenIDConfiguration(w http.ResponseWriter, r *http.Request)
+	StartOAuthAuthorization(w http.ResponseWriter, r *http.Request)
+	ApproveOAuthAuthorization(w http.ResponseWriter, r *http.Request)
+	OAuthToken(w http.ResponseWriter, r *http.Request)
+	OAuthUserInfo(w http.ResponseWriter, r *http.Request)
+}
+
+type OAuthClientController interface {
+	CreateOAuthClient(w http.ResponseWriter, r *http.Request)
+	GetOAuthClients(w http.ResponseWriter, r *http.Request)
+	GetOAuthClient(w http.ResponseWriter, r *http.Request)
+	UpdateOAuthClient(w http.ResponseWriter, r *http.Request)
+	RotateOAuthClientSecret(w http.ResponseWriter, r *http.Request)
+	DeleteOAuthClient(w http.ResponseWriter, r *http.Request)
+}
+
+type AdminController interface {
+	ListUsers(w http.ResponseWriter, r *http.Request)
+	GetUser(w http.ResponseWriter, r *http.Request)
+	GetUserSessions(w http.ResponseWriter, r *http.Request)
+	UpdateUserRole(w http.ResponseWriter, r *http.Request)
+	SuspendUser(w http.ResponseWriter, r *http.Request)
+	UnsuspendUser(w http.ResponseWriter, r *http.Request)
+	RestoreUser(w http.ResponseWriter, r *http.Request)
+	RevokeUserSessions(w http.ResponseWriter, r *http.Request)
+	ListAuthEvents(w http.ResponseWriter, r *http.Request)
+}
+
+type AuditController interface {
+	GetMySecurityEvents(w http.ResponseWriter, r *http.Request)
+}
+
 type QRController interface {
 	QRConnect(w http.ResponseWriter, r *http.Request)
+	QRLogin(w http.ResponseWriter, r *http.Request)
 	QRConfirm(w http.ResponseWriter, r *http.Request)
+	QRReject(w http.ResponseWriter, r *http.Request)
 }
 
 type Middlewares interface {
 	UserAuth(allowedRoles ...string) func(next http.Handler) http.Handler
+	UserOrServiceAuth(scope string, allowedRoles ...string) func(next http.Handler) http.Handler
+	UserOrClientAuth() func(next http.Handler) http.Handler
 	Logger(log *log.Logger) func(next http.Handler) http.Handler
 	CorsDocs() func(next http.Handler) http.Handler
 	ResolverUrl(resolver *media.Resolver) func(next http.Handler) http.Handler
+	ClientIP(resolver *clientip.Resolver) func(next http.Handler) http.Handler
 }
 
 type Server struct {
 	users       UserController
 	sessions    SessionController
+	mfa         MFAController
+	passkeys    PasskeyController
+	keys        KeysController
+	oidc        OIDCController
+	clients     OAuthClientController
+	admin       AdminController
+	audit       AuditController
 	qr          QRController
 	middlewares Middlewares
 	log         *log.Logger
 	resolver    *media.Resolver
+	clientIP    *clientip.Resolver
 }
 
 type ServerDeps struct {
 	Users       UserController
 	Sessions    SessionController
+	MFA         MFAController
+	Passkeys    PasskeyController
+	Keys        KeysController
+	OIDC        OIDCController
+	Clients     OAuthClientController
+	Admin       AdminController
+	Audit       AuditController
 	QR          QRController
 	Middlewares Middlewares
 	Log         *log.Logger
 	Resolver    *media.Resolver
+	ClientIP    *clientip.Resolver
 }
 
 func New(deps ServerDeps) *Server {
 	return &Server{
 		users:       deps.Users,
 		sessions:    deps.Sessions,
+		mfa:         deps.MFA,
+		passkeys:    deps.Passkeys,
+		keys:        deps.Keys,
+		oidc:        deps.OIDC,
+		clients:     deps.Clients,
+		admin:       deps.Admin,
+		audit:       deps.Audit,
 		qr:          deps.QR,
 		middlewares: deps.Middlewares,
 		log:         deps.Log,
 		resolver:    deps.Resolver,
+		clientIP:    deps.ClientIP,
 	}
 }
 
@@ -103,32 +202,73 @@ func (s *Server) Run(ctx context.Context, cfg Config) {
 	r.Use(
 		s.middlewares.Logger(s.log),
 		s.middlewares.ResolverUrl(s.resolver),
+		s.middlewares.ClientIP(s.clientIP),
 		s.middlewares.CorsDocs(),
 	)
 
+	r.Get("/.well-known/jwks.json", s.keys.GetJWKS)
+	r.Get("/.well-known/openid-configuration", s.oidc.GetOpenIDConfiguration)
+
 	r.Route("/auth-svc", func(r chi.Router) {
 		r.Route("/v1", func(r chi.Router) {
 			r.Route("/registration", func(r chi.Router) {
 				r.Post("/", s.users.Registration)
-				r.With(sysadmin).Post("/admin", s.users.RegistrationByAdmin)
+				r.With(s.middlewares.UserOrServiceAuth(models.ScopeUsersCreate, tokens.RoleSystemAdmin)).
+					Post("/admin", s.users.RegistrationByAdmin)
 			})
 
 			r.Route("/login", func(r chi.Router) {
 				r.Post("/email", s.sessions.LoginByEmail)
+				r.Post("/mfa", s.sessions.LoginByMFA)
 
-				r.Route("/google", func(r chi.Router) {
-					r.Post("/", s.sessions.LoginByGoogleOAuth)
-					r.Get("/callback", s.sessions.LoginByGoogleOAuthCallback)
+				r.Route("/passkey", func(r chi.Router) {
+					r.Post("/begin", s.sessions.BeginPasskeyLogin)
+					r.Post("/finish", s.sessions.LoginByPasskey)
 				})
 
 				r.Route("/qr", func(r chi.Router) {
 					r.Get("/", s.qr.QRConnect)
 					r.With(auth).Post("/confirm", s.qr.QRConfirm)
+					r.With(auth).Post("/reject", s.qr.QRReject)
+					r.With(auth).Get("/{qr_token}", s.qr.QRLogin)
+				})
+
+				r.Route("/{provider}", func(r chi.Router) {
+					r.Post("/", s.sessions.LoginByOAuth)
+					r.Get("/callback", s.sessions.LoginByOAuthCallback)
+				})
+			})
+
+			r.Route("/oauth2", func(r chi.Router) {
+				r.Get("/authorize", s.oidc.StartOAuthAuthorization)
+				r.With(auth).Post("/authorize", s.oidc.ApproveOAuthAuthorization)
+				r.Post("/token", s.oidc.OAuthToken)
+				r.With(s.middlewares.UserOrClientAuth()).Get("/userinfo", s.oidc.OAuthUserInfo)
+				r.With(s.middlewares.UserOrClientAuth()).Post("/userinfo", s.oidc.OAuthUserInfo)
+
+				r.With(sysadmin).Route("/clients", func(r chi.Router) {
+					r.Get("/", s.clients.GetOAuthClients)
+					r.Post("/", s.clients.CreateOAuthClient)
+
+					r.Route("/{client_id}", func(r chi.Router) {
+						r.Get("/", s.clients.GetOAuthClient)
+						r.Patch("/", s.clients.UpdateOAuthClient)
+						r.Delete("/", s.clients.DeleteOAuthClient)
+						r.Post("/secret", s.clients.RotateOAuthClientSecret)
+					})
 				})
 			})
 
 			r.Post("/refresh", s.sessions.RefreshSession)
 
+			r.Post("/email/verify/confirm", s.users.ConfirmEmailVerification)
+			r.Post("/email/change/confirm", s.users.ConfirmEmailChange)
+
+			r.Route("/password/reset", func(r chi.Router) {
+				r.Post("/request", s.users.RequestPasswordReset)
+				r.Post("/confirm", s.users.ConfirmPasswordReset)
+			})
+
 			r.With(auth).Route("/me", func(r chi.Router) {
 				r.Get("/", s.users.GetMyUser)
 				r.Patch("/", s.users.UpdateMyUser)
@@ -138,17 +278,48 @@ func (s *Server) Run(ctx context.Context, cfg Config) {
 				r.Patch("/password", s.users.UpdatePassword)
 				r.Patch("/username", s.users.UpdateUsername)
 
+				r.Patch("/email", s.users.UpdateEmail)
+				r.Post("/email/verify/request", s.users.RequestEmailVerification)
+
 				r.Route("/media", func(r chi.Router) {
 					r.Post("/", s.users.CreateUploadMediaLink)
 					r.Delete("/", s.users.DeleteUploadMedia)
 				})
 
+				r.Route("/mfa", func(r chi.Router) {
+					r.Get("/", s.mfa.GetMyMFA)
+					r.Post("/totp", s.mfa.EnrollTOTP)
+					r.Post("/totp/confirm", s.mfa.ConfirmTOTP)
+					r.Post("/totp/disable", s.mfa.DisableTOTP)
+					r.Post("/recovery-codes", s.mfa.RegenerateRecoveryCodes)
+				})
+
+				r.Route("/identities", func(r chi.Router) {
+					r.Get("/", s.users.GetMyIdentities)
+					r.Post("/{provider}/link", s.sessions.LinkIdentity)
+					r.Delete("/{identity_id}", s.users.DeleteMyIdentity)
+				})
+
+				r.Route("/passkeys", func(r chi.Router) {
+					r.Get("/", s.passkeys.GetMyPasskeys)
+					r.Post("/begin", s.passkeys.BeginPasskeyRegistration)
+					r.Post("/finish", s.passkeys.FinishPasskeyRegistration)
+
+					r.Route("/{passkey_id}", func(r chi.Router) {
+						r.Patch("/", s.passkeys.UpdateMyPasskey)
+						r.Delete("/", s.passkeys.DeleteMyPasskey)
+					})
+				})
+
+				r.Get("/security-events", s.audit.GetMySecurityEvents)
+
 				r.Route("/sessions", func(r chi.Router) {
 					r.Get("/", s.sessions.GetMySessions)
 					r.Delete("/", s.sessions.DeleteMySessions)
 
 					r.Route("/{session_id}", func(r chi.Router) {
 						r.Get("/", s.sessions.GetMySession)
+						r.Patch("/", s.sessions.UpdateMySession)
 						r.Delete("/", s.sessions.DeleteMySession)
 					})
 				})
@@ -159,6 +330,25 @@ func (s *Server) Run(ctx context.Context, cfg Config) {
 				r.Get("/@{username}", s.users.GetUserByUsername)
 				r.Get("/{user_id:[0-9a-fA-F-]{36}}", s.users.GetUserByID)
 			})
+
+			r.With(sysadmin).Route("/admin/users", func(r chi.Router) {
+				r.Get("/", s.admin.ListUsers)
+
+				r.Route("/{user_id}", func(r chi.Router) {
+					r.Get("/", s.admin.GetUser)
+					r.Patch("/role", s.admin.UpdateUserRole)
+					r.Post("/suspend", s.admin.SuspendUser)
+					r.Post("/unsuspend", s.admin.UnsuspendUser)
+					r.Post("/restore", s.admin.RestoreUser)
+
+					r.Route("/sessions", func(r chi.Router) {
+						r.Get("/", s.admin.GetUserSessions)
+						r.Delete("/", s.admin.RevokeUserSessions)
+					})
+				})
+			})
+
+			r.With(sysadmin).Get("/admin/auth-events", s.admin.ListAuthEvents)
 		})
 	})
 
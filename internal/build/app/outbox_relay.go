@@ -0,0 +1,56 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/netbill/auth-svc/internal/outbox"
+	"github.com/netbill/auth-svc/internal/repo/pg"
+	"github.com/netbill/pgdbx"
+)
+
+// RunOutboxRelay publishes the outbox table to Kafka until ctx is done. It is
+// the alternative to running Debezium and needs only the database and the
+// brokers.
+func (a *App) RunOutboxRelay(ctx context.Context) error {
+	if len(a.config.Kafka.Brokers) == 0 {
+		return errors.New("KAFKA_BROKERS is not set")
+	}
+
+	pool, err := a.config.PoolDB(ctx)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer pool.Close()
+
+	db := pgdbx.NewDB(pool)
+
+	publisher := outbox.NewKafkaPublisher(a.config.Kafka.Brokers)
+	defer func() {
+		if err := publisher.Close(); err != nil {
+			a.log.WithError(err).Error("failed to close kafka writer")
+		}
+	}()
+
+	relay := outbox.NewRelay(outbox.RelayDeps{
+		Config: outbox.Config{
+			BatchSize:     a.config.Kafka.Relay.BatchSize,
+			PollInterval:  a.config.Kafka.Relay.PollInterval,
+			BackoffBase:   a.config.Kafka.Relay.BackoffBase,
+			BackoffMax:    a.config.Kafka.Relay.BackoffMax,
+			MaxAttempts:   a.config.Kafka.Relay.MaxAttempts,
+			ClaimTimeout:  a.config.Kafka.Relay.ClaimTimeout,
+			Retention:     a.config.Kafka.Relay.Retention,
+			PruneInterval: a.config.Kafka.Relay.PruneInterval,
+		},
+		Events:    pg.NewOutboxRepo(db, a.config.Kafka.Identity),
+		Publisher: publisher,
+		Log:       a.log,
+	})
+
+	a.log.Info("starting outbox relay", "brokers", a.config.Kafka.Brokers)
+	relay.Run(ctx)
+
+	return nil
+}
@@ -12,18 +12,30 @@ import (
 	"github.com/netbill/auth-svc/internal/api/rest/controller"
 	"github.com/netbill/auth-svc/internal/api/rest/middlewares"
 	"github.com/netbill/auth-svc/internal/bus"
+	"github.com/netbill/auth-svc/internal/janitor"
+	"github.com/netbill/auth-svc/internal/mail"
 	"github.com/netbill/auth-svc/internal/media"
+	"github.com/netbill/auth-svc/internal/modules/admin"
+	"github.com/netbill/auth-svc/internal/modules/audit"
 	authmodule "github.com/netbill/auth-svc/internal/modules/auth"
+	"github.com/netbill/auth-svc/internal/modules/mfa"
+	"github.com/netbill/auth-svc/internal/modules/oauthclient"
+	"github.com/netbill/auth-svc/internal/modules/oidc"
+	"github.com/netbill/auth-svc/internal/modules/passkey"
 	"github.com/netbill/auth-svc/internal/modules/session"
 	"github.com/netbill/auth-svc/internal/modules/user"
 	"github.com/netbill/auth-svc/internal/observability/metrics"
 	"github.com/netbill/auth-svc/internal/observability/telemetry"
 	"github.com/netbill/auth-svc/internal/repo/chache"
 	"github.com/netbill/auth-svc/internal/repo/pg"
-	"github.com/netbill/auth-svc/pkg/googleid"
+	"github.com/netbill/auth-svc/internal/revocation"
+	"github.com/netbill/auth-svc/pkg/clientip"
+	"github.com/netbill/auth-svc/pkg/cryptobox"
+	"github.com/netbill/auth-svc/pkg/oidcprovider"
 	"github.com/netbill/auth-svc/pkg/passmanager"
 	"github.com/netbill/auth-svc/pkg/tokenmanager"
 	"github.com/netbill/auth-svc/pkg/username"
+	"github.com/netbill/auth-svc/pkg/webauthn"
 	"github.com/netbill/awsx"
 	"github.com/netbill/pgdbx"
 )
@@ -68,7 +80,13 @@ func (a *App) Run(ctx context.Context) error {
 	emailRepo := pg.NewEmailRepo(db)
 	passwordRepo := pg.NewPasswordRepo(db)
 	sessionRepo := pg.NewSessionRepo(db)
+	mfaRepo := pg.NewMFARepo(db)
+	passkeyRepo := pg.NewPasskeyRepo(db)
+	identityRepo := pg.NewIdentityRepo(db)
+	oauthClientRepo := pg.NewOAuthClientRepo(db)
 	outboxRepo := pg.NewOutboxRepo(db, a.config.Kafka.Identity)
+	authEventRepo := pg.NewAuthEventRepo(db)
+	locker := pg.NewLocker(db)
 
 	redisTTL := a.config.Database.Redis.TTL
 
@@ -77,12 +95,46 @@ func (a *App) Run(ctx context.Context) error {
 	passwordCache := chache.NewPasswordCache(redisClient, redisTTL.Password, svcMetrics, a.log)
 	sessionCache := chache.NewSessionCache(redisClient, redisTTL.Session, svcMetrics, a.log)
 	qrCache := chache.NewQRCache(redisClient)
+	loginAttemptCache := chache.NewLoginAttemptCache(redisClient, a.log)
+	mfaChallengeCache := chache.NewMFAChallengeCache(redisClient)
+	passkeyCeremonyCache := chache.NewPasskeyCeremonyCache(redisClient)
+	oauthCodeCache := chache.NewOAuthCodeCache(redisClient)
+	oauthStateCache := chache.NewOAuthStateCache(redisClient)
+	emailVerifyCache := chache.NewEmailVerificationCache(redisClient, a.config.Auth.EmailVerify.TTL)
+	emailChangeCache := chache.NewEmailChangeCache(redisClient, a.config.Auth.EmailChange.TTL)
+	passwordResetCache := chache.NewPasswordResetCache(redisClient, a.config.Auth.PasswordReset.TTL)
+
+	revokedSessions := revocation.New(
+		chache.NewRevokedSessionCache(redisClient, a.log),
+		revocation.Config{
+			TokenTTL: a.config.Auth.Tokens.UserAccess.TTL,
+			LocalTTL: a.config.Auth.Sessions.RevocationLocalTTL,
+			LocalMax: a.config.Auth.Sessions.RevocationLocalMax,
+		},
+		svcMetrics,
+		a.log,
+	)
 
 	qrPublisher := bus.NewPublisher(redisClient)
 	qrSubscriber := bus.NewSubscriber(redisClient)
 
 	passMgr := passmanager.New(a.config.Auth.PassBcryptCost)
 
+	relyingParty, err := webauthn.New(webauthn.Config{
+		RPID:    a.config.Auth.WebAuthn.RPID,
+		RPName:  a.config.Auth.WebAuthn.RPName,
+		Origins: a.config.Auth.WebAuthn.Origins,
+		Timeout: passkey.CeremonyTTL,
+	})
+	if err != nil {
+		return fmt.Errorf("init webauthn: %w", err)
+	}
+
+	oidcProviders, err := oidcprovider.NewRegistry(a.config.OIDCProviders()...)
+	if err != nil {
+		return fmt.Errorf("init oidc providers: %w", err)
+	}
+
 	awsCfg, err := awscfg.LoadDefaultConfig(
 		ctx,
 		awscfg.WithRegion(a.config.S3.Aws.Region),
@@ -105,10 +157,34 @@ func (a *App) Run(ctx context.Context) error {
 	mediaResolver := media.NewResolver(a.config.S3.Aws.BaseURL)
 	usernameValidator := username.NewValidator()
 
+	var mailTransport mail.Transport
+	switch a.config.Mail.Transport {
+	case "file":
+		mailTransport = mail.NewFileTransport(a.config.Mail.FilePath)
+	case "log":
+		mailTransport = mail.NewLogTransport(a.log)
+	default:
+		return fmt.Errorf("unknown mail transport %q", a.config.Mail.Transport)
+	}
+
+	mailer := mail.New(mailTransport, mail.Config{
+		From:             a.config.Mail.From,
+		EmailVerifyURL:   a.config.Mail.Links.EmailVerify,
+		PasswordResetURL: a.config.Mail.Links.PasswordReset,
+		EmailChangeURL:   a.config.Mail.Links.EmailChange,
+	})
+
+	accessKeys, err := a.config.AccessSigningKeys()
+	if err != nil {
+		return fmt.Errorf("load access token signing keys: %w", err)
+	}
+
 	tokenMgr := tokenmanager.New(tokenmanager.Config{
 		Issuer:           a.config.Auth.Tokens.Issuer,
+		AccessKeys:       accessKeys,
 		AccessSecretKey:  a.config.Auth.Tokens.UserAccess.SecretKey,
 		AccessTTL:        a.config.Auth.Tokens.UserAccess.TTL,
+		ServiceTTL:       a.config.Auth.Tokens.ServiceAccess.TTL,
 		RefreshSecretKey: a.config.Auth.Tokens.UserRefresh.SecretKey,
 		RefreshTTL:       a.config.Auth.Tokens.UserRefresh.TTL,
 		RefreshHashKey:   a.config.Auth.Tokens.UserRefresh.HashKey,
@@ -119,52 +195,192 @@ func (a *App) Run(ctx context.Context) error {
 		SessionRepo: sessionRepo,
 	})
 
+	auditSvc := audit.New(audit.ServiceDeps{
+		Config: audit.Config{
+			Retention:     a.config.Auth.Audit.Retention,
+			PruneInterval: a.config.Auth.Audit.PruneInterval,
+		},
+		Auth:      authSvc,
+		EventRepo: authEventRepo,
+		Locks:     locker,
+		Tx:        db,
+		Log:       a.log,
+	})
+
 	userSvc := user.New(user.ServiceDeps{
+		Auth:               authSvc,
+		UserRepo:           userRepo,
+		EmailRepo:          emailRepo,
+		PasswordRepo:       passwordRepo,
+		SessionRepo:        sessionRepo,
+		IdentityRepo:       identityRepo,
+		Tx:                 db,
+		UserCache:          userCache,
+		EmailCache:         emailCache,
+		PasswordCache:      passwordCache,
+		SessionsCache:      sessionCache,
+		RevokedSessions:    revokedSessions,
+		EmailVerifications: emailVerifyCache,
+		EmailChanges:       emailChangeCache,
+		PasswordResets:     passwordResetCache,
+		PassManager:        passMgr,
+		Messenger:          outboxRepo,
+		Mailer:             mailer,
+		Bucket:             mediaStorage,
+		Username:           usernameValidator,
+		AuditLog:           auditSvc,
+		Log:                a.log,
+	})
+
+	loginLimits := session.LoginLimits{
+		Window:                a.config.Auth.LoginLimits.Window,
+		MaxFailuresPerEmail:   a.config.Auth.LoginLimits.MaxFailuresPerEmail,
+		MaxFailuresPerIP:      a.config.Auth.LoginLimits.MaxFailuresPerIP,
+		MaxMFAFailuresPerUser: a.config.Auth.LoginLimits.MaxMFAFailuresPerUser,
+		LockoutBase:           a.config.Auth.LoginLimits.LockoutBase,
+		LockoutMax:            a.config.Auth.LoginLimits.LockoutMax,
+	}
+
+	mfaDeps := mfa.ServiceDeps{
+		Config: mfa.Config{
+			Issuer:        a.config.Auth.MFA.Issuer,
+			RecoveryCodes: a.config.Auth.MFA.RecoveryCodes,
+		},
 		Auth:          authSvc,
-		UserRepo:      userRepo,
-		EmailRepo:     emailRepo,
+		MFARepo:       mfaRepo,
 		PasswordRepo:  passwordRepo,
-		SessionRepo:   sessionRepo,
 		Tx:            db,
-		UserCache:     userCache,
-		EmailCache:    emailCache,
 		PasswordCache: passwordCache,
-		SessionsCache: sessionCache,
 		PassManager:   passMgr,
-		Messenger:     outboxRepo,
-		Bucket:        mediaStorage,
-		Username:      usernameValidator,
+		Attempts:      session.NewMFALimiter(loginAttemptCache, loginLimits, svcMetrics),
+	}
+	if key := a.config.Auth.MFA.EncryptionKey; key != "" {
+		mfaBox, err := cryptobox.New(key)
+		if err != nil {
+			return fmt.Errorf("init mfa encryption: %w", err)
+		}
+		mfaDeps.Box = mfaBox
+	} else {
+		a.log.Warn("AUTH_MFA_ENCRYPTION_KEY is not set, TOTP enrollment and verification are disabled")
+	}
+	mfaSvc := mfa.New(mfaDeps)
+
+	passkeySvc := passkey.New(passkey.ServiceDeps{
+		Auth:         authSvc,
+		PasskeyRepo:  passkeyRepo,
+		Credentials:  identityRepo,
+		Ceremonies:   passkeyCeremonyCache,
+		Tx:           db,
+		RelyingParty: relyingParty,
 	})
 
 	broker := bus.NewBroker(qrPublisher, qrSubscriber)
 
 	sessionSvc := session.New(session.ServiceDeps{
-		Auth:          authSvc,
-		UserRepo:      userRepo,
-		EmailRepo:     emailRepo,
-		PasswordRepo:  passwordRepo,
-		SessionRepo:   sessionRepo,
-		Tx:            db,
-		PasswordCache: passwordCache,
-		UserCache:     userCache,
-		SessionsCache: sessionCache,
-		PassManager:   passMgr,
-		TokenManager:  tokenMgr,
-		QRStore:       qrCache,
-		Bus:           broker,
+		Config: session.Config{
+			RevokeAllOnTokenReuse: a.config.Auth.Sessions.RevokeAllOnTokenReuse,
+			LoginLimits:           loginLimits,
+			Policy: session.Policy{
+				IdleTimeout:     a.config.Auth.Sessions.IdleTimeout,
+				MaxAge:          a.config.Auth.Sessions.MaxAge,
+				MaxActive:       a.config.Auth.Sessions.MaxActive,
+				MaxActiveByRole: a.config.Auth.Sessions.MaxActiveByRole,
+			},
+			PostLoginRedirects:   a.config.Auth.OAuth.PostLoginRedirects,
+			OAuthSignUpProviders: a.config.OAuthSignUpProviders(),
+		},
+		Auth:            authSvc,
+		Users:           userSvc,
+		UserRepo:        userRepo,
+		EmailRepo:       emailRepo,
+		PasswordRepo:    passwordRepo,
+		SessionRepo:     sessionRepo,
+		IdentityRepo:    identityRepo,
+		Tx:              db,
+		PasswordCache:   passwordCache,
+		UserCache:       userCache,
+		SessionsCache:   sessionCache,
+		RevokedSessions: revokedSessions,
+		PassManager:     passMgr,
+		TokenManager:    tokenMgr,
+		QRStore:         qrCache,
+		Bus:             broker,
+		LoginAttempts:   loginAttemptCache,
+		MFA:             mfaSvc,
+		MFAChallenges:   mfaChallengeCache,
+		Passkeys:        passkeySvc,
+		OAuthStates:     oauthStateCache,
+		Messenger:       outboxRepo,
+		Metrics:         svcMetrics,
+		AuditLog:        auditSvc,
+	})
+
+	oauthClientSvc := oauthclient.New(oauthclient.ServiceDeps{
+		ClientRepo: oauthClientRepo,
+	})
+
+	adminSvc := admin.New(admin.ServiceDeps{
+		Auth:            authSvc,
+		UserRepo:        userRepo,
+		EmailRepo:       emailRepo,
+		SessionRepo:     sessionRepo,
+		AuthEventRepo:   authEventRepo,
+		Tx:              db,
+		UserCache:       userCache,
+		EmailCache:      emailCache,
+		SessionsCache:   sessionCache,
+		RevokedSessions: revokedSessions,
+		Messenger:       outboxRepo,
+	})
+
+	oidcSvc := oidc.New(oidc.ServiceDeps{
+		Config: oidc.Config{
+			Issuer:     a.config.Auth.OIDC.Issuer,
+			IDTokenTTL: a.config.Auth.OIDC.IDTokenTTL,
+			AccessTTL:  a.config.Auth.Tokens.UserAccess.TTL,
+			ServiceTTL: a.config.Auth.Tokens.ServiceAccess.TTL,
+		},
+		Auth:         authSvc,
+		Sessions:     sessionSvc,
+		Users:        userSvc,
+		Clients:      oauthClientSvc,
+		Codes:        oauthCodeCache,
+		TokenManager: tokenMgr,
 	})
 
 	userCtrl := controller.NewUserController(userSvc, svcMetrics)
-	sessionCtrl := controller.NewSessionController(sessionSvc, a.config.GoogleOAuth(), svcMetrics, broker)
+	sessionCtrl := controller.NewSessionController(
+		sessionSvc,
+		userSvc,
+		oidcProviders,
+		svcMetrics,
+		broker,
+		a.config.Rest.SecureCookies,
+	)
+	mfaCtrl := controller.NewMFAController(mfaSvc)
+	passkeyCtrl := controller.NewPasskeyController(passkeySvc)
+	keysCtrl := controller.NewKeysController(tokenMgr)
+	oidcCtrl := controller.NewOIDCController(oidcSvc, tokenMgr, a.config.Auth.OIDC.LoginURL)
+	oauthClientCtrl := controller.NewOAuthClientController(oauthClientSvc)
+	adminCtrl := controller.NewAdminController(adminSvc)
+	auditCtrl := controller.NewAuditController(auditSvc)
 
-	mdll := middlewares.New(tokenMgr)
+	mdll := middlewares.New(tokenMgr, revokedSessions)
 	router := rest.New(rest.ServerDeps{
 		Users:       userCtrl,
 		Sessions:    sessionCtrl,
+		MFA:         mfaCtrl,
+		Passkeys:    passkeyCtrl,
+		Keys:        keysCtrl,
+		OIDC:        oidcCtrl,
+		Clients:     oauthClientCtrl,
+		Admin:       adminCtrl,
+		Audit:       auditCtrl,
 		QR:          sessionCtrl,
 		Middlewares: mdll,
 		Log:         a.log,
 		Resolver:    mediaResolver,
+		ClientIP:    clientip.New(a.config.Rest.TrustedProxies),
 	})
 
 	run(func() {
@@ -177,24 +393,56 @@ func (a *App) Run(ctx context.Context) error {
 		})
 	})
 
-	googleVerifier := googleid.New(a.config.Auth.OAuth.Google.ClientID)
-
 	grpcServer := grpcapi.New(grpcapi.ServerDeps{
 		Auth:     authSvc,
 		Users:    userSvc,
 		Sessions: sessionSvc,
-		Google:   googleVerifier,
+		MFA:      mfaSvc,
+		Admin:    adminSvc,
+		OIDC:     oidcProviders,
 		Metrics:  svcMetrics,
 		TokenMgr: tokenMgr,
+		Revoked:  revokedSessions,
+		ClientIP: clientip.New(a.config.GRPC.TrustedProxies),
 		Log:      a.log,
 	})
 
 	run(func() {
 		grpcServer.Run(ctx, grpcapi.Config{
-			Port: a.config.GRPC.Port,
+			Port:                  a.config.GRPC.Port,
+			LegacyValidateSession: a.config.GRPC.LegacyValidateSession,
 		})
 	})
 
+	run(func() {
+		auditSvc.RunRetention(ctx)
+	})
+
+	// A session unused for longer than the refresh token lives can't be
+	// refreshed any more, so it is only purged once both windows are over.
+	sessionJanitor := janitor.New(janitor.Deps{
+		Config: janitor.Config{
+			Retention: a.config.Auth.Sessions.Janitor.Retention,
+			IdleRetention: max(
+				a.config.Auth.Sessions.Janitor.Retention,
+				a.config.Auth.Tokens.UserRefresh.TTL,
+			),
+			Interval:  a.config.Auth.Sessions.Janitor.Interval,
+			BatchSize: a.config.Auth.Sessions.Janitor.BatchSize,
+		},
+		Sessions:    sessionRepo,
+		Locks:       locker,
+		Tx:          db,
+		Messenger:   outboxRepo,
+		Revocations: revokedSessions,
+		Metrics:     svcMetrics,
+		Log:         a.log,
+	})
+
+	run(func() {
+		sessionJanitor.Run(ctx)
+	})
+
 	a.log.Info("starting application")
 	wg.Wait()
 	return nil
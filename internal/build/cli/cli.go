@@ -19,6 +19,7 @@ func Run(args []string) {
 		service    = kingpin.New(cfg.Service.Name, "")
 		runCmd     = service.Command("run", "run command flags: service")
 		serviceCmd = runCmd.Command("service", "starting all service processes")
+		relayCmd   = runCmd.Command("outbox-relay", "publish the outbox table to Kafka")
 
 		migrateCmd     = service.Command("migrate", "migrate command")
 		migrateUpCmd   = migrateCmd.Command("up", "migrate db up")
@@ -38,6 +39,8 @@ func Run(args []string) {
 	switch command {
 	case serviceCmd.FullCommand():
 		err = application.Run(ctx)
+	case relayCmd.FullCommand():
+		err = application.RunOutboxRelay(ctx)
 	case migrateUpCmd.FullCommand():
 		err = application.MigrateUp(ctx)
 	case migrateDownCmd.FullCommand():
@@ -3,7 +3,9 @@ package config
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -11,10 +13,10 @@ import (
 	"github.com/exaring/otelpgx"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/netbill/auth-svc/pkg/oidcprovider"
+	"github.com/netbill/auth-svc/pkg/tokenmanager"
 	"github.com/netbill/awsx"
 	"github.com/redis/go-redis/v9"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
 )
 
 type ServiceCfg struct {
@@ -59,10 +61,25 @@ type RestTimeouts struct {
 type RestConfig struct {
 	Port     int
 	Timeouts RestTimeouts
+
+	// TrustedProxies are the networks whose X-Forwarded-For and X-Real-IP
+	// headers are believed. Empty means clients connect directly and their
+	// socket address is used.
+	TrustedProxies []netip.Prefix
+
+	// SecureCookies sets the Secure flag on cookies the API hands out. Turn it
+	// off only for local runs over plain http.
+	SecureCookies bool
 }
 
-type TokenConfig struct {
+// AccessTokenConfig configures access token signing. Keys lists the
+// asymmetric signing keys as "<kid>:<state>" pairs, each read from
+// KeysDir/<kid>.pem; see AccessSigningKeys. SecretKey is the HS256 secret
+// used without keys, or alongside them to keep verifying older tokens.
+type AccessTokenConfig struct {
 	SecretKey string
+	KeysDir   string
+	Keys      []string
 	TTL       time.Duration
 }
 
@@ -72,35 +89,188 @@ type RefreshTokenConfig struct {
 	TTL       time.Duration
 }
 
-type AuthTokensConfig struct {
-	Issuer      string
-	UserAccess  TokenConfig
-	UserRefresh RefreshTokenConfig
+// ServiceTokenConfig configures the tokens OAuth clients get with the
+// client_credentials grant. They are signed like user access tokens.
+type ServiceTokenConfig struct {
+	TTL time.Duration
 }
 
-type GoogleOAuthConfig struct {
+type AuthTokensConfig struct {
+	Issuer        string
+	UserAccess    AccessTokenConfig
+	UserRefresh   RefreshTokenConfig
+	ServiceAccess ServiceTokenConfig
+}
+
+// OAuthProviderConfig configures login through one external OpenID Connect
+// provider. Name is what /login/{provider} and linked identities refer to
+// it by.
+type OAuthProviderConfig struct {
+	Name         string
+	Issuer       string
+	DiscoveryURL string
 	ClientID     string
 	ClientSecret string
 	RedirectURL  string
+	Scopes       []string
+
+	// SignUp creates an account on the first login of a verified email
+	// nobody registered yet, instead of refusing the login.
+	SignUp bool
 }
 
+// AuthOAuthConfig lists the external login providers. PostLoginRedirects
+// whitelists the pages the browser may be sent back to once a login is
+// done; a login started without one answers the callback with JSON.
 type AuthOAuthConfig struct {
-	Google GoogleOAuthConfig
+	Providers          []OAuthProviderConfig
+	PostLoginRedirects []string
+}
+
+type EmailVerifyConfig struct {
+	TTL time.Duration
+}
+
+type EmailChangeConfig struct {
+	TTL time.Duration
+}
+
+type PasswordResetConfig struct {
+	TTL time.Duration
+}
+
+type AuthSessionsConfig struct {
+	// RevokeAllOnTokenReuse makes a replayed refresh token end every session
+	// of the user instead of only the one the token belongs to.
+	RevokeAllOnTokenReuse bool
+
+	// RevocationLocalTTL is how long a replica trusts its cached answer that
+	// a session is not revoked, i.e. how late a revocation made through
+	// another replica may take effect.
+	RevocationLocalTTL time.Duration
+
+	// RevocationLocalMax caps the sessions a replica keeps in that cache;
+	// zero means no cap.
+	RevocationLocalMax int
+
+	// Session policies, see session.Policy. Zero disables a limit.
+	IdleTimeout     time.Duration
+	MaxAge          time.Duration
+	MaxActive       int
+	MaxActiveByRole map[string]int
+
+	Janitor AuthSessionsJanitorConfig
+}
+
+// AuthSessionsJanitorConfig configures hard deletion of old sessions, see
+// janitor.Config.
+type AuthSessionsJanitorConfig struct {
+	Retention time.Duration
+	Interval  time.Duration
+	BatchSize uint
+}
+
+// AuthLoginLimitsConfig bounds failed logins, see session.LoginLimits.
+type AuthLoginLimitsConfig struct {
+	Window                time.Duration
+	MaxFailuresPerEmail   int
+	MaxFailuresPerIP      int
+	MaxMFAFailuresPerUser int
+	LockoutBase           time.Duration
+	LockoutMax            time.Duration
+}
+
+// AuthAuditConfig keeps the security audit log, see audit.Config.
+type AuthAuditConfig struct {
+	Retention     time.Duration
+	PruneInterval time.Duration
+}
+
+// AuthMFAConfig configures TOTP two-factor authentication. EncryptionKey
+// seals the TOTP secrets at rest; changing it makes every enrolled
+// authenticator unusable. Without it TOTP is unavailable.
+type AuthMFAConfig struct {
+	Issuer        string
+	EncryptionKey string
+	RecoveryCodes int
+}
+
+// AuthWebAuthnConfig identifies the relying party passkeys are bound to.
+// RPID is the registrable domain of the web app; Origins lists every origin
+// its pages are served from.
+type AuthWebAuthnConfig struct {
+	RPID    string
+	RPName  string
+	Origins []string
+}
+
+// AuthOIDCConfig configures auth-svc as an OpenID Provider. Issuer is the
+// public base URL of the service; LoginURL is the login page authorization
+// requests are forwarded to. Clients are registered through the admin API.
+type AuthOIDCConfig struct {
+	Issuer     string
+	LoginURL   string
+	IDTokenTTL time.Duration
 }
 
 type AuthConfig struct {
 	Tokens         AuthTokensConfig
 	OAuth          AuthOAuthConfig
+	EmailVerify    EmailVerifyConfig
+	EmailChange    EmailChangeConfig
+	PasswordReset  PasswordResetConfig
+	Sessions       AuthSessionsConfig
+	LoginLimits    AuthLoginLimitsConfig
+	Audit          AuthAuditConfig
+	MFA            AuthMFAConfig
+	WebAuthn       AuthWebAuthnConfig
+	OIDC           AuthOIDCConfig
 	PassBcryptCost int
 }
 
+type MailLinksConfig struct {
+	EmailVerify   string
+	EmailChange   string
+	PasswordReset string
+}
+
+type MailConfig struct {
+	// Transport is either "log" (write messages to the service log) or
+	// "file" (append them to FilePath).
+	Transport string
+	FilePath  string
+	From      string
+	Links     MailLinksConfig
+}
+
 type KafkaConfig struct {
 	Brokers  []string
 	Identity string
+	Relay    KafkaRelayConfig
+}
+
+// KafkaRelayConfig tunes the built-in outbox relay (run outbox-relay).
+type KafkaRelayConfig struct {
+	BatchSize     uint
+	PollInterval  time.Duration
+	BackoffBase   time.Duration
+	BackoffMax    time.Duration
+	MaxAttempts   int32
+	ClaimTimeout  time.Duration
+	Retention     time.Duration
+	PruneInterval time.Duration
 }
 
 type GRPCConfig struct {
 	Port int
+
+	// LegacyValidateSession keeps AuthService.ValidateSession open to
+	// callers without a service token.
+	LegacyValidateSession bool
+
+	// TrustedProxies is RestConfig.TrustedProxies for x-forwarded-for
+	// metadata.
+	TrustedProxies []netip.Prefix
 }
 
 type OTELConfig struct {
@@ -147,6 +317,7 @@ type Config struct {
 	GRPC     GRPCConfig
 	Auth     AuthConfig
 	S3       S3Config
+	Mail     MailConfig
 	Kafka    KafkaConfig
 	OTEL     OTELConfig
 }
@@ -173,15 +344,21 @@ func LoadConfig() *Config {
 				Write:      envDurationOr("REST_WRITE_TIMEOUT", 15*time.Second),
 				Idle:       envDurationOr("REST_IDLE_TIMEOUT", 60*time.Second),
 			},
+			TrustedProxies: envPrefixList("REST_TRUSTED_PROXIES"),
+			SecureCookies:  envBoolOr("REST_SECURE_COOKIES", true),
 		},
 		GRPC: GRPCConfig{
-			Port: envIntOr("GRPC_PORT", 9001),
+			Port:                  envIntOr("GRPC_PORT", 9001),
+			LegacyValidateSession: envBoolOr("GRPC_LEGACY_VALIDATE_SESSION", false),
+			TrustedProxies:        envPrefixList("GRPC_TRUSTED_PROXIES"),
 		},
 		Auth: AuthConfig{
 			Tokens: AuthTokensConfig{
 				Issuer: envOr("AUTH_TOKENS_ISSUER", "auth-svc"),
-				UserAccess: TokenConfig{
-					SecretKey: mustEnv("AUTH_TOKENS_USER_ACCESS_SECRET_KEY"),
+				UserAccess: AccessTokenConfig{
+					SecretKey: envOr("AUTH_TOKENS_USER_ACCESS_SECRET_KEY", ""),
+					KeysDir:   envOr("AUTH_TOKENS_USER_ACCESS_KEYS_DIR", "keys"),
+					Keys:      envList("AUTH_TOKENS_USER_ACCESS_KEYS"),
 					TTL:       envDurationOr("AUTH_TOKENS_USER_ACCESS_TTL", 720*time.Hour),
 				},
 				UserRefresh: RefreshTokenConfig{
@@ -189,18 +366,78 @@ func LoadConfig() *Config {
 					HashKey:   mustEnv("AUTH_TOKENS_USER_REFRESH_HASH_KEY"),
 					TTL:       envDurationOr("AUTH_TOKENS_USER_REFRESH_TTL", 720*time.Hour),
 				},
+				ServiceAccess: ServiceTokenConfig{
+					TTL: envDurationOr("AUTH_TOKENS_SERVICE_ACCESS_TTL", time.Hour),
+				},
 			},
 			OAuth: AuthOAuthConfig{
-				// Optional: the service runs fine without Google login configured,
-				// it just won't be able to complete that specific flow.
-				Google: GoogleOAuthConfig{
-					ClientID:     envOr("AUTH_OAUTH_GOOGLE_CLIENT_ID", ""),
-					ClientSecret: envOr("AUTH_OAUTH_GOOGLE_CLIENT_SECRET", ""),
-					RedirectURL:  envOr("AUTH_OAUTH_GOOGLE_REDIRECT_URL", ""),
+				// Optional: the service runs fine without external providers,
+				// it just won't offer logins through them.
+				Providers:          oauthProviders(),
+				PostLoginRedirects: envList("AUTH_OAUTH_POST_LOGIN_REDIRECTS"),
+			},
+			EmailVerify: EmailVerifyConfig{
+				TTL: envDurationOr("AUTH_EMAIL_VERIFY_TTL", 24*time.Hour),
+			},
+			EmailChange: EmailChangeConfig{
+				TTL: envDurationOr("AUTH_EMAIL_CHANGE_TTL", time.Hour),
+			},
+			PasswordReset: PasswordResetConfig{
+				TTL: envDurationOr("AUTH_PASSWORD_RESET_TTL", 30*time.Minute),
+			},
+			Sessions: AuthSessionsConfig{
+				RevokeAllOnTokenReuse: envBoolOr("AUTH_SESSIONS_REVOKE_ALL_ON_TOKEN_REUSE", false),
+				RevocationLocalTTL:    envDurationOr("AUTH_SESSIONS_REVOCATION_LOCAL_TTL", 5*time.Second),
+				RevocationLocalMax:    envIntOr("AUTH_SESSIONS_REVOCATION_LOCAL_MAX", 100000),
+				IdleTimeout:           envDurationOr("AUTH_SESSIONS_IDLE_TIMEOUT", 0),
+				MaxAge:                envDurationOr("AUTH_SESSIONS_MAX_AGE", 0),
+				MaxActive:             envIntOr("AUTH_SESSIONS_MAX_ACTIVE", 0),
+				MaxActiveByRole:       envIntMap("AUTH_SESSIONS_MAX_ACTIVE_BY_ROLE"),
+				Janitor: AuthSessionsJanitorConfig{
+					Retention: envDurationOr("AUTH_SESSIONS_JANITOR_RETENTION", 30*24*time.Hour),
+					Interval:  envPositiveDurationOr("AUTH_SESSIONS_JANITOR_INTERVAL", time.Hour),
+					BatchSize: uint(envPositiveIntOr("AUTH_SESSIONS_JANITOR_BATCH_SIZE", 1000)),
 				},
 			},
+			LoginLimits: AuthLoginLimitsConfig{
+				Window:                envDurationOr("AUTH_LOGIN_LIMITS_WINDOW", 15*time.Minute),
+				MaxFailuresPerEmail:   envIntOr("AUTH_LOGIN_LIMITS_MAX_FAILURES_PER_EMAIL", 5),
+				MaxFailuresPerIP:      envIntOr("AUTH_LOGIN_LIMITS_MAX_FAILURES_PER_IP", 20),
+				MaxMFAFailuresPerUser: envIntOr("AUTH_LOGIN_LIMITS_MAX_MFA_FAILURES_PER_USER", 10),
+				LockoutBase:           envDurationOr("AUTH_LOGIN_LIMITS_LOCKOUT_BASE", time.Minute),
+				LockoutMax:            envDurationOr("AUTH_LOGIN_LIMITS_LOCKOUT_MAX", time.Hour),
+			},
+			Audit: AuthAuditConfig{
+				Retention:     envDurationOr("AUTH_AUDIT_RETENTION", 90*24*time.Hour),
+				PruneInterval: envPositiveDurationOr("AUTH_AUDIT_PRUNE_INTERVAL", time.Hour),
+			},
+			MFA: AuthMFAConfig{
+				Issuer:        envOr("AUTH_MFA_ISSUER", "netbill"),
+				EncryptionKey: envOr("AUTH_MFA_ENCRYPTION_KEY", ""),
+				RecoveryCodes: envIntOr("AUTH_MFA_RECOVERY_CODES", 10),
+			},
+			WebAuthn: AuthWebAuthnConfig{
+				RPID:    envOr("AUTH_WEBAUTHN_RP_ID", "localhost"),
+				RPName:  envOr("AUTH_WEBAUTHN_RP_NAME", "netbill"),
+				Origins: envListOr("AUTH_WEBAUTHN_ORIGINS", []string{"http://localhost:3000"}),
+			},
+			OIDC: AuthOIDCConfig{
+				Issuer:     envOr("AUTH_OIDC_ISSUER", "http://localhost:8001"),
+				LoginURL:   envOr("AUTH_OIDC_LOGIN_URL", "http://localhost:3000/oauth2/authorize"),
+				IDTokenTTL: envDurationOr("AUTH_OIDC_ID_TOKEN_TTL", time.Hour),
+			},
 			PassBcryptCost: envIntOr("AUTH_PASS_BCRYPT_COST", 11),
 		},
+		Mail: MailConfig{
+			Transport: envOr("MAIL_TRANSPORT", "log"),
+			FilePath:  envOr("MAIL_FILE_PATH", "mail.log"),
+			From:      envOr("MAIL_FROM", "no-reply@netbill.local"),
+			Links: MailLinksConfig{
+				EmailVerify:   envOr("MAIL_LINK_EMAIL_VERIFY", "http://localhost:3000/email/verify"),
+				EmailChange:   envOr("MAIL_LINK_EMAIL_CHANGE", "http://localhost:3000/email/change"),
+				PasswordReset: envOr("MAIL_LINK_PASSWORD_RESET", "http://localhost:3000/password/reset"),
+			},
+		},
 		Database: DatabaseConfig{
 			SQL: SQLConfig{
 				URL: mustEnv("DATABASE_SQL_URL"),
@@ -247,11 +484,21 @@ func LoadConfig() *Config {
 			},
 		},
 		Kafka: KafkaConfig{
-			// Not read by this service yet — Debezium publishes the outbox
-			// table to Kafka independently. Identity is only used as the
+			// Brokers are only dialed by the built-in outbox relay; with
+			// Debezium the service never talks to Kafka. Identity is the
 			// outbox rows' producer label.
 			Brokers:  envList("KAFKA_BROKERS"),
 			Identity: envOr("KAFKA_IDENTITY", "auth-svc"),
+			Relay: KafkaRelayConfig{
+				BatchSize:     uint(envPositiveIntOr("KAFKA_RELAY_BATCH_SIZE", 100)),
+				PollInterval:  envDurationOr("KAFKA_RELAY_POLL_INTERVAL", time.Second),
+				BackoffBase:   envDurationOr("KAFKA_RELAY_BACKOFF_BASE", time.Second),
+				BackoffMax:    envDurationOr("KAFKA_RELAY_BACKOFF_MAX", 5*time.Minute),
+				MaxAttempts:   int32(envPositiveIntOr("KAFKA_RELAY_MAX_ATTEMPTS", 20)),
+				ClaimTimeout:  envPositiveDurationOr("KAFKA_RELAY_CLAIM_TIMEOUT", time.Minute),
+				Retention:     envDurationOr("KAFKA_RELAY_RETENTION", 24*time.Hour),
+				PruneInterval: envPositiveDurationOr("KAFKA_RELAY_PRUNE_INTERVAL", time.Hour),
+			},
 		},
 		OTEL: OTELConfig{
 			CollectorEndpoint: envOr("OTEL_COLLECTOR_ENDPOINT", ""),
@@ -260,6 +507,49 @@ func LoadConfig() *Config {
 	}
 }
 
+// reservedOAuthProviderNames are the /login routes a provider name would
+// collide with.
+var reservedOAuthProviderNames = []string{"email", "mfa", "passkey", "qr"}
+
+// oauthProviders reads the providers listed in AUTH_OAUTH_PROVIDERS, each
+// from its own AUTH_OAUTH_<NAME>_* vars. Google only needs its client: the
+// issuer defaults to Google's, and it is enabled by setting
+// AUTH_OAUTH_GOOGLE_CLIENT_ID even when the list is not set.
+func oauthProviders() []OAuthProviderConfig {
+	def := []string(nil)
+	if envOr("AUTH_OAUTH_GOOGLE_CLIENT_ID", "") != "" {
+		def = []string{"google"}
+	}
+
+	names := envListOr("AUTH_OAUTH_PROVIDERS", def)
+	providers := make([]OAuthProviderConfig, 0, len(names))
+	for _, name := range names {
+		if slices.Contains(reservedOAuthProviderNames, name) {
+			panic(fmt.Errorf("oauth provider name %q is reserved", name))
+		}
+
+		prefix := "AUTH_OAUTH_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+
+		issuer := ""
+		if name == "google" {
+			issuer = oidcprovider.GoogleIssuer
+		}
+
+		providers = append(providers, OAuthProviderConfig{
+			Name:         name,
+			Issuer:       envOr(prefix+"ISSUER", issuer),
+			DiscoveryURL: envOr(prefix+"DISCOVERY_URL", ""),
+			ClientID:     mustEnv(prefix + "CLIENT_ID"),
+			ClientSecret: envOr(prefix+"CLIENT_SECRET", ""),
+			RedirectURL:  mustEnv(prefix + "REDIRECT_URL"),
+			Scopes:       envListOr(prefix+"SCOPES", oidcprovider.DefaultScopes),
+			SignUp:       envBoolOr(prefix+"SIGN_UP", true),
+		})
+	}
+
+	return providers
+}
+
 func mustEnv(key string) string {
 	v, ok := os.LookupEnv(key)
 	if !ok || v == "" {
@@ -292,6 +582,25 @@ func envListOr(key string, def []string) []string {
 	return splitList(v)
 }
 
+// envPrefixList parses a comma-separated list of CIDRs. A bare address is
+// taken as a single-host network.
+func envPrefixList(key string) []netip.Prefix {
+	var out []netip.Prefix
+	for _, v := range envList(key) {
+		if addr, err := netip.ParseAddr(v); err == nil {
+			out = append(out, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+
+		p, err := netip.ParsePrefix(v)
+		if err != nil {
+			panic(fmt.Errorf("invalid network %q for %s: %w", v, key, err))
+		}
+		out = append(out, p.Masked())
+	}
+	return out
+}
+
 func splitList(v string) []string {
 	parts := strings.Split(v, ",")
 	out := make([]string, 0, len(parts))
@@ -316,6 +625,38 @@ func envIntOr(key string, def int) int {
 	return n
 }
 
+// envPositiveIntOr is envIntOr for values that must be above zero.
+func envPositiveIntOr(key string, def int) int {
+	n := envIntOr(key, def)
+	if n <= 0 {
+		panic(fmt.Errorf("%s must be positive, got %d", key, n))
+	}
+	return n
+}
+
+// envIntMap parses a comma-separated list of key=int pairs.
+func envIntMap(key string) map[string]int {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return nil
+	}
+
+	out := make(map[string]int)
+	for _, pair := range splitList(v) {
+		k, n, found := strings.Cut(pair, "=")
+		if !found {
+			panic(fmt.Errorf("invalid key=value pair %q for %s", pair, key))
+		}
+
+		i, err := strconv.Atoi(strings.TrimSpace(n))
+		if err != nil {
+			panic(fmt.Errorf("invalid int value for %s: %w", key, err))
+		}
+		out[strings.TrimSpace(k)] = i
+	}
+	return out
+}
+
 func envInt64Or(key string, def int64) int64 {
 	v, ok := os.LookupEnv(key)
 	if !ok || v == "" {
@@ -342,6 +683,19 @@ func envFloatOr(key string, def float64) float64 {
 	return f
 }
 
+func envBoolOr(key string, def bool) bool {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return def
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		panic(fmt.Errorf("invalid bool value for %s: %w", key, err))
+	}
+	return b
+}
+
 func envDurationOr(key string, def time.Duration) time.Duration {
 	v, ok := os.LookupEnv(key)
 	if !ok || v == "" {
@@ -355,6 +709,15 @@ func envDurationOr(key string, def time.Duration) time.Duration {
 	return d
 }
 
+// envPositiveDurationOr is envDurationOr for values that must be above zero.
+func envPositiveDurationOr(key string, def time.Duration) time.Duration {
+	d := envDurationOr(key, def)
+	if d <= 0 {
+		panic(fmt.Errorf("%s must be positive, got %s", key, d))
+	}
+	return d
+}
+
 func (cfg *Config) Logger() *log.Logger {
 	return log.New(cfg.Log.Level, cfg.Log.Format, cfg.Service.Name)
 }
@@ -388,12 +751,58 @@ func (cfg *Config) RedisClient() *redis.Client {
 	})
 }
 
-func (cfg *Config) GoogleOAuth() oauth2.Config {
-	return oauth2.Config{
-		ClientID:     cfg.Auth.OAuth.Google.ClientID,
-		ClientSecret: cfg.Auth.OAuth.Google.ClientSecret,
-		RedirectURL:  cfg.Auth.OAuth.Google.RedirectURL,
-		Scopes:       []string{"https://www.googleapis.com/auth/userinfo.email", "https://www.googleapis.com/auth/userinfo.profile"},
-		Endpoint:     google.Endpoint,
+// AccessSigningKeys loads the access token signing keys listed in
+// AUTH_TOKENS_USER_ACCESS_KEYS. With none listed, access tokens fall back to
+// HS256 and AUTH_TOKENS_USER_ACCESS_SECRET_KEY becomes required.
+func (cfg *Config) AccessSigningKeys() ([]tokenmanager.Key, error) {
+	access := cfg.Auth.Tokens.UserAccess
+
+	if len(access.Keys) == 0 {
+		if access.SecretKey == "" {
+			return nil, fmt.Errorf(
+				"either AUTH_TOKENS_USER_ACCESS_KEYS or AUTH_TOKENS_USER_ACCESS_SECRET_KEY must be set",
+			)
+		}
+		return nil, nil
+	}
+
+	refs := make([]tokenmanager.KeyRef, 0, len(access.Keys))
+	for _, entry := range access.Keys {
+		id, state, ok := strings.Cut(entry, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid signing key entry %q, want <kid>:<state>", entry)
+		}
+		refs = append(refs, tokenmanager.KeyRef{ID: id, State: tokenmanager.KeyState(state)})
+	}
+
+	return tokenmanager.LoadKeys(access.KeysDir, refs)
+}
+
+// OIDCProviders is the configuration of the external login providers.
+func (cfg *Config) OIDCProviders() []oidcprovider.Config {
+	out := make([]oidcprovider.Config, 0, len(cfg.Auth.OAuth.Providers))
+	for _, p := range cfg.Auth.OAuth.Providers {
+		out = append(out, oidcprovider.Config{
+			Name:         p.Name,
+			Issuer:       p.Issuer,
+			DiscoveryURL: p.DiscoveryURL,
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       p.Scopes,
+		})
+	}
+	return out
+}
+
+// OAuthSignUpProviders lists the providers whose first login may create an
+// account.
+func (cfg *Config) OAuthSignUpProviders() []string {
+	var out []string
+	for _, p := range cfg.Auth.OAuth.Providers {
+		if p.SignUp {
+			out = append(out, p.Name)
+		}
 	}
+	return out
 }
@@ -0,0 +1,21 @@
+package errx
+
+import (
+	"github.com/netbill/ape"
+)
+
+var (
+	ErrorIdentityNotFound = ape.DeclareError("IDENTITY_NOT_FOUND")
+
+	// ErrorIdentityAlreadyLinked means the provider account is linked to a
+	// user already, this one or another.
+	ErrorIdentityAlreadyLinked = ape.DeclareError("IDENTITY_ALREADY_LINKED")
+
+	// ErrorIdentityNotLinked means a provider login carries the email of an
+	// existing account the provider account was never linked to. The user
+	// has to sign in another way and link it first.
+	ErrorIdentityNotLinked = ape.DeclareError("IDENTITY_NOT_LINKED")
+
+	// ErrorLastCredential refuses to remove the only way left to sign in.
+	ErrorLastCredential = ape.DeclareError("LAST_CREDENTIAL")
+)
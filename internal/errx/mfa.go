@@ -0,0 +1,13 @@
+package errx
+
+import (
+	"github.com/netbill/ape"
+)
+
+var (
+	ErrorMFAAlreadyEnabled = ape.DeclareError("MFA_ALREADY_ENABLED")
+	ErrorMFANotEnabled     = ape.DeclareError("MFA_NOT_ENABLED")
+
+	ErrorMFACodeInvalid      = ape.DeclareError("MFA_CODE_INVALID")
+	ErrorMFAChallengeInvalid = ape.DeclareError("MFA_CHALLENGE_INVALID")
+)
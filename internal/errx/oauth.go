@@ -0,0 +1,46 @@
+package errx
+
+import (
+	"github.com/netbill/ape"
+)
+
+var (
+	// ErrorOAuthClientInvalid and ErrorOAuthRedirectURIInvalid mean the
+	// authorization request cannot be trusted to redirect anywhere: the
+	// error is shown to the user instead of being sent to the client.
+	ErrorOAuthClientInvalid      = ape.DeclareError("OAUTH_CLIENT_INVALID")
+	ErrorOAuthRedirectURIInvalid = ape.DeclareError("OAUTH_REDIRECT_URI_INVALID")
+
+	ErrorOAuthRequestInvalid          = ape.DeclareError("OAUTH_REQUEST_INVALID")
+	ErrorOAuthResponseTypeUnsupported = ape.DeclareError("OAUTH_RESPONSE_TYPE_UNSUPPORTED")
+	ErrorOAuthScopeInvalid            = ape.DeclareError("OAUTH_SCOPE_INVALID")
+
+	// ErrorOAuthGrantInvalid covers an authorization code or refresh token
+	// that is unknown, expired, already used or issued to someone else.
+	ErrorOAuthGrantInvalid         = ape.DeclareError("OAUTH_GRANT_INVALID")
+	ErrorOAuthGrantTypeUnsupported = ape.DeclareError("OAUTH_GRANT_TYPE_UNSUPPORTED")
+)
+
+var (
+	ErrorOAuthClientNotFound      = ape.DeclareError("OAUTH_CLIENT_NOT_FOUND")
+	ErrorOAuthClientAlreadyExists = ape.DeclareError("OAUTH_CLIENT_ALREADY_EXISTS")
+
+	// ErrorOAuthClientUnauthorized means the client authenticated but may
+	// not use the grant it asked for, e.g. a public client asking for
+	// client credentials.
+	ErrorOAuthClientUnauthorized = ape.DeclareError("OAUTH_CLIENT_UNAUTHORIZED")
+
+	ErrorOAuthClientScopeNotSupported   = ape.DeclareError("OAUTH_CLIENT_SCOPE_NOT_SUPPORTED")
+	ErrorOAuthClientRedirectURINotValid = ape.DeclareError("OAUTH_CLIENT_REDIRECT_URI_NOT_VALID")
+)
+
+var (
+	// ErrorOAuthStateInvalid means a provider callback carries a state we
+	// did not issue, or one that expired or was already used: the callback
+	// may be a forged login and is refused.
+	ErrorOAuthStateInvalid = ape.DeclareError("OAUTH_STATE_INVALID")
+
+	// ErrorOAuthPostLoginRedirectNotAllowed means the page to return to
+	// after an external login is not in the configured whitelist.
+	ErrorOAuthPostLoginRedirectNotAllowed = ape.DeclareError("OAUTH_POST_LOGIN_REDIRECT_NOT_ALLOWED")
+)
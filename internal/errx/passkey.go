@@ -0,0 +1,15 @@
+package errx
+
+import (
+	"github.com/netbill/ape"
+)
+
+var (
+	ErrorPasskeyNotFound      = ape.DeclareError("PASSKEY_NOT_FOUND")
+	ErrorPasskeyAlreadyExists = ape.DeclareError("PASSKEY_ALREADY_EXISTS")
+
+	// ErrorPasskeyInvalid covers every WebAuthn response that fails
+	// verification: wrong origin, bad signature, replayed counter and so on.
+	ErrorPasskeyInvalid          = ape.DeclareError("PASSKEY_INVALID")
+	ErrorPasskeyChallengeInvalid = ape.DeclareError("PASSKEY_CHALLENGE_INVALID")
+)
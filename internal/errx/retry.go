@@ -0,0 +1,31 @@
+package errx
+
+import (
+	"errors"
+	"time"
+)
+
+// retryAfterError attaches a wait hint to a domain error. ape errors don't
+// unwrap to their cause, so the hint rides on top and unwraps to the error
+// it decorates; errors.Is keeps matching the declared error.
+type retryAfterError struct {
+	err   error
+	after time.Duration
+}
+
+func (e *retryAfterError) Error() string { return e.err.Error() }
+func (e *retryAfterError) Unwrap() error { return e.err }
+
+// WithRetryAfter tells the caller to wait d before repeating the request.
+func WithRetryAfter(err error, d time.Duration) error {
+	return &retryAfterError{err: err, after: d}
+}
+
+// RetryAfter returns the hint attached by WithRetryAfter, if any.
+func RetryAfter(err error) (time.Duration, bool) {
+	var re *retryAfterError
+	if errors.As(err, &re) {
+		return re.after, true
+	}
+	return 0, false
+}
@@ -9,8 +9,17 @@ var (
 	ErrorSessionDeleted  = ape.DeclareError("SESSION_DELETED")
 
 	ErrorSessionTokenMismatch = ape.DeclareError("SESSION_TOKEN_MISMATCH")
+	ErrorSessionTokenReused   = ape.DeclareError("SESSION_TOKEN_REUSED")
 	ErrorSessionExpired       = ape.DeclareError("SESSION_EXPIRED")
 
+	ErrorSessionIdleTimeout      = ape.DeclareError("SESSION_IDLE_TIMEOUT")
+	ErrorSessionLifetimeExceeded = ape.DeclareError("SESSION_LIFETIME_EXCEEDED")
+	ErrorSessionEvicted          = ape.DeclareError("SESSION_EVICTED")
+
+	ErrorTooManyLoginAttempts = ape.DeclareError("TOO_MANY_LOGIN_ATTEMPTS")
+
 	ErrorQRTokenNotFound         = ape.DeclareError("QR_TOKEN_NOT_FOUND")
 	ErrorQRTokenAlreadyConfirmed = ape.DeclareError("QR_TOKEN_ALREADY_CONFIRMED")
+	ErrorQRTokenRejected         = ape.DeclareError("QR_TOKEN_REJECTED")
+	ErrorQRCodeMismatch          = ape.DeclareError("QR_CODE_MISMATCH")
 )
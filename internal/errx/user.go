@@ -10,12 +10,41 @@ var (
 
 	ErrorUserInvalidSession = ape.DeclareError("USER_INVALID_SESSION")
 
+	// ErrorUserSuspended means an admin suspended the user: they cannot log
+	// in, refresh or use a session until the suspension ends.
+	ErrorUserSuspended = ape.DeclareError("USER_SUSPENDED")
+
+	// ErrorUserForbidden means the actor's current role does not allow the
+	// action, whatever role their access token still carries.
+	ErrorUserForbidden = ape.DeclareError("USER_FORBIDDEN")
+
+	// ErrorSelfModeration means an admin tried to change their own role or
+	// suspend themselves.
+	ErrorSelfModeration = ape.DeclareError("SELF_MODERATION")
+
+	ErrorUserAlreadySuspended = ape.DeclareError("USER_ALREADY_SUSPENDED")
+	ErrorUserNotSuspended     = ape.DeclareError("USER_NOT_SUSPENDED")
+	ErrorUserNotDeleted       = ape.DeclareError("USER_NOT_DELETED")
+	ErrorSuspensionEndInvalid = ape.DeclareError("SUSPENSION_END_INVALID")
+
 	ErrorEmailAlreadyExist = ape.DeclareError("EMAIL_ALREADY_EXIST")
 
+	ErrorEmailAlreadyVerified          = ape.DeclareError("EMAIL_ALREADY_VERIFIED")
+	ErrorEmailVerificationTokenInvalid = ape.DeclareError("EMAIL_VERIFICATION_TOKEN_INVALID")
+
+	ErrorEmailUnchanged          = ape.DeclareError("EMAIL_UNCHANGED")
+	ErrorEmailChangeTokenInvalid = ape.DeclareError("EMAIL_CHANGE_TOKEN_INVALID")
+
+	// ErrorPasswordNotSet means the user has no password: the account was
+	// created through an external provider and never set one.
+	ErrorPasswordNotSet = ape.DeclareError("PASSWORD_NOT_SET")
+
 	ErrorPasswordInvalid         = ape.DeclareError("PASSWORD_INVALID")
 	ErrorPasswordIsNotAllowed    = ape.DeclareError("PASSWORD_IS_NOT_ALLOWED")
 	ErrorCannotChangePasswordYet = ape.DeclareError("CANNOT_CHANGE_PASSWORD_YET")
 
+	ErrorPasswordResetTokenInvalid = ape.DeclareError("PASSWORD_RESET_TOKEN_INVALID")
+
 	ErrorRoleNotSupported = ape.DeclareError("USER_ROLE_NOT_SUPPORTED")
 
 	ErrorUserUploadedAvatarInvalid = ape.DeclareError("USER_UPLOADED_AVATAR_INVALID")
@@ -0,0 +1,212 @@
+// Package janitor hard-deletes sessions that no longer matter: soft-deleted
+// ones past their retention and ones nobody has refreshed for so long that
+// their refresh token can't have survived.
+package janitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
+)
+
+// lockKey is the advisory lock that keeps replicas from purging at the same
+// time.
+const lockKey int64 = 0x73657373_6a616e69 // "sessjani"
+
+//go:generate mockery --name=metrics --inpackage
+type metrics interface {
+	RecordSessionsPurged(ctx context.Context, kind string, count int64)
+}
+
+type Config struct {
+	// Retention is how long soft-deleted sessions are kept. Zero disables the
+	// janitor.
+	Retention time.Duration
+
+	// IdleRetention is how long a session that was never deleted may go
+	// unused before it is purged. Shorter than the refresh token TTL, it
+	// would purge sessions whose refresh token still works.
+	IdleRetention time.Duration
+
+	// Interval is how often the janitor runs. It must be positive.
+	Interval time.Duration
+
+	// BatchSize caps the rows one DELETE removes, so catching up on a large
+	// backlog does not hold long locks on the table. It must be positive.
+	BatchSize uint
+}
+
+type Janitor struct {
+	config Config
+
+	sessions    sessionRepo
+	locks       locker
+	tx          transaction
+	messenger   messenger
+	revocations revocations
+
+	metrics metrics
+	log     *log.Logger
+}
+
+type Deps struct {
+	Config Config
+
+	Sessions    sessionRepo
+	Locks       locker
+	Tx          transaction
+	Messenger   messenger
+	Revocations revocations
+
+	Metrics metrics
+	Log     *log.Logger
+}
+
+func New(deps Deps) *Janitor {
+	return &Janitor{
+		config:      deps.Config,
+		sessions:    deps.Sessions,
+		locks:       deps.Locks,
+		tx:          deps.Tx,
+		messenger:   deps.Messenger,
+		revocations: deps.Revocations,
+		metrics:     deps.Metrics,
+		log:         deps.Log,
+	}
+}
+
+// Purge removes eligible sessions batch by batch until none are left. Every
+// batch is a transaction of its own holding the advisory lock; when another
+// replica holds it, Purge leaves the work to that replica and returns.
+// Idle sessions were never revoked, so their revocation is written to the
+// outbox in the batch's transaction and they go on the revocation list
+// once it commits, as on any other deletion.
+func (j *Janitor) Purge(ctx context.Context) (Purged, error) {
+	now := time.Now()
+	deletedBefore := now.Add(-j.config.Retention)
+	idleBefore := now.Add(-j.config.IdleRetention)
+
+	var total Purged
+	for {
+		var (
+			batch  Purged
+			idle   []models.EndedSession
+			locked bool
+		)
+		err := j.tx.Transaction(ctx, func(ctx context.Context) error {
+			var err error
+			locked, err = j.locks.TryXactLock(ctx, lockKey)
+			if err != nil || !locked {
+				return err
+			}
+
+			purged, err := j.sessions.PurgeSessions(ctx, deletedBefore, idleBefore, j.config.BatchSize)
+			if err != nil {
+				return err
+			}
+
+			batch, idle = count(purged)
+			return j.writeIdleRevoked(ctx, purged)
+		})
+		if err != nil {
+			return total, err
+		}
+		if !locked {
+			j.log.Debug("another replica is purging sessions")
+			return total, nil
+		}
+
+		if len(idle) > 0 {
+			// The list logs its own failures.
+			_ = j.revocations.Revoke(ctx, idle)
+		}
+
+		j.record(ctx, batch)
+		total.Deleted += batch.Deleted
+		total.Idle += batch.Idle
+
+		if uint(batch.Deleted+batch.Idle) < j.config.BatchSize {
+			return total, nil
+		}
+	}
+}
+
+// count tells apart the deleted and the idle sessions of a batch and
+// returns the idle ones.
+func count(purged []PurgedSession) (Purged, []models.EndedSession) {
+	var (
+		batch Purged
+		idle  []models.EndedSession
+	)
+	for _, p := range purged {
+		if !p.Idle {
+			batch.Deleted++
+			continue
+		}
+		batch.Idle++
+		idle = append(idle, models.EndedSession{ID: p.ID, LastUsed: p.LastUsed})
+	}
+	return batch, idle
+}
+
+// writeIdleRevoked writes a sessions_revoked event for every user with idle
+// sessions in the batch.
+func (j *Janitor) writeIdleRevoked(ctx context.Context, purged []PurgedSession) error {
+	var users []uuid.UUID
+	byUser := make(map[uuid.UUID][]uuid.UUID)
+	for _, p := range purged {
+		if !p.Idle {
+			continue
+		}
+		if _, ok := byUser[p.UserID]; !ok {
+			users = append(users, p.UserID)
+		}
+		byUser[p.UserID] = append(byUser[p.UserID], p.ID)
+	}
+
+	for _, userID := range users {
+		err := j.messenger.WriteSessionsRevoked(ctx, userID, byUser[userID], models.SessionRevokedIdlePurged)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *Janitor) record(ctx context.Context, batch Purged) {
+	if batch.Deleted > 0 {
+		j.metrics.RecordSessionsPurged(ctx, "deleted", batch.Deleted)
+	}
+	if batch.Idle > 0 {
+		j.metrics.RecordSessionsPurged(ctx, "idle", batch.Idle)
+	}
+}
+
+// Run purges right away and then every Config.Interval until ctx is done.
+func (j *Janitor) Run(ctx context.Context) {
+	if j.config.Retention <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(j.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := j.Purge(ctx)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			j.log.WithError(err).Error("failed to purge sessions")
+		case n.Deleted+n.Idle > 0:
+			j.log.Info("purged sessions", "deleted", n.Deleted, "idle", n.Idle)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
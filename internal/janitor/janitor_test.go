@@ -0,0 +1,171 @@
+package janitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeTx struct{}
+
+func (f *fakeTx) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type JanitorSuite struct {
+	suite.Suite
+
+	sessions    *mockSessionRepo
+	locks       *mockLocker
+	messenger   *mockMessenger
+	revocations *mockRevocations
+	metrics     *mockMetrics
+
+	janitor *Janitor
+}
+
+func (s *JanitorSuite) SetupTest() {
+	s.sessions = newMockSessionRepo(s.T())
+	s.locks = newMockLocker(s.T())
+	s.messenger = newMockMessenger(s.T())
+	s.revocations = newMockRevocations(s.T())
+	s.metrics = newMockMetrics(s.T())
+
+	s.janitor = New(Deps{
+		Config: Config{
+			Retention:     24 * time.Hour,
+			IdleRetention: 30 * 24 * time.Hour,
+			Interval:      time.Hour,
+			BatchSize:     10,
+		},
+		Sessions:    s.sessions,
+		Locks:       s.locks,
+		Tx:          &fakeTx{},
+		Messenger:   s.messenger,
+		Revocations: s.revocations,
+		Metrics:     s.metrics,
+		Log:         log.New("error", "text", "test"),
+	})
+}
+
+func TestJanitor(t *testing.T) {
+	suite.Run(t, new(JanitorSuite))
+}
+
+func purgedSessions(n int, idle bool) []PurgedSession {
+	purged := make([]PurgedSession, n)
+	for i := range purged {
+		purged[i] = PurgedSession{ID: uuid.New(), UserID: uuid.New(), Idle: idle}
+	}
+	return purged
+}
+
+func (s *JanitorSuite) TestPurge_InBatches() {
+	deletedBefore := mock.MatchedBy(func(t time.Time) bool {
+		return time.Until(t) < -23*time.Hour && time.Until(t) > -25*time.Hour
+	})
+	idleBefore := mock.MatchedBy(func(t time.Time) bool {
+		return time.Until(t) < -29*24*time.Hour
+	})
+
+	s.locks.On("TryXactLock", mock.Anything, lockKey).Return(true, nil)
+	s.sessions.On("PurgeSessions", mock.Anything, deletedBefore, idleBefore, uint(10)).
+		Return(append(purgedSessions(7, false), purgedSessions(3, true)...), nil).Once()
+	s.sessions.On("PurgeSessions", mock.Anything, deletedBefore, idleBefore, uint(10)).
+		Return(purgedSessions(2, false), nil).Once()
+	s.messenger.On("WriteSessionsRevoked", mock.Anything, mock.Anything, mock.Anything, models.SessionRevokedIdlePurged).
+		Return(nil).Times(3)
+	s.revocations.On("Revoke", mock.Anything, mock.Anything).Return(nil).Once()
+	s.metrics.On("RecordSessionsPurged", mock.Anything, "deleted", int64(7)).Return().Once()
+	s.metrics.On("RecordSessionsPurged", mock.Anything, "idle", int64(3)).Return().Once()
+	s.metrics.On("RecordSessionsPurged", mock.Anything, "deleted", int64(2)).Return().Once()
+
+	n, err := s.janitor.Purge(context.Background())
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), Purged{Deleted: 9, Idle: 3}, n)
+}
+
+func (s *JanitorSuite) TestPurge_LockHeldElsewhere() {
+	s.locks.On("TryXactLock", mock.Anything, lockKey).Return(false, nil)
+
+	n, err := s.janitor.Purge(context.Background())
+
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), n)
+	s.sessions.AssertNotCalled(s.T(), "PurgeSessions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *JanitorSuite) TestPurge_RepoError() {
+	repoErr := errors.New("db error")
+
+	s.locks.On("TryXactLock", mock.Anything, lockKey).Return(true, nil)
+	s.sessions.On("PurgeSessions", mock.Anything, mock.Anything, mock.Anything, uint(10)).
+		Return([]PurgedSession(nil), repoErr)
+
+	_, err := s.janitor.Purge(context.Background())
+
+	assert.ErrorIs(s.T(), err, repoErr)
+}
+
+func (s *JanitorSuite) TestPurge_RevokesIdleSessions() {
+	userA, userB := uuid.New(), uuid.New()
+	a1, a2, b1 := uuid.New(), uuid.New(), uuid.New()
+	lastUsed := time.Now().Add(-31 * 24 * time.Hour)
+
+	s.locks.On("TryXactLock", mock.Anything, lockKey).Return(true, nil)
+	s.sessions.On("PurgeSessions", mock.Anything, mock.Anything, mock.Anything, uint(10)).Return([]PurgedSession{
+		{ID: a1, UserID: userA, LastUsed: lastUsed, Idle: true},
+		{ID: uuid.New(), UserID: userA},
+		{ID: b1, UserID: userB, LastUsed: lastUsed, Idle: true},
+		{ID: a2, UserID: userA, LastUsed: lastUsed, Idle: true},
+	}, nil).Once()
+	s.messenger.On("WriteSessionsRevoked", mock.Anything, userA, []uuid.UUID{a1, a2}, models.SessionRevokedIdlePurged).
+		Return(nil).Once()
+	s.messenger.On("WriteSessionsRevoked", mock.Anything, userB, []uuid.UUID{b1}, models.SessionRevokedIdlePurged).
+		Return(nil).Once()
+	s.revocations.On("Revoke", mock.Anything, []models.EndedSession{
+		{ID: a1, LastUsed: lastUsed},
+		{ID: b1, LastUsed: lastUsed},
+		{ID: a2, LastUsed: lastUsed},
+	}).Return(nil).Once()
+	s.metrics.On("RecordSessionsPurged", mock.Anything, "deleted", int64(1)).Return().Once()
+	s.metrics.On("RecordSessionsPurged", mock.Anything, "idle", int64(3)).Return().Once()
+
+	n, err := s.janitor.Purge(context.Background())
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), Purged{Deleted: 1, Idle: 3}, n)
+}
+
+func (s *JanitorSuite) TestPurge_OutboxErrorRevokesNothing() {
+	outboxErr := errors.New("outbox down")
+
+	s.locks.On("TryXactLock", mock.Anything, lockKey).Return(true, nil)
+	s.sessions.On("PurgeSessions", mock.Anything, mock.Anything, mock.Anything, uint(10)).
+		Return(purgedSessions(1, true), nil).Once()
+	s.messenger.On("WriteSessionsRevoked", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(outboxErr)
+
+	_, err := s.janitor.Purge(context.Background())
+
+	assert.ErrorIs(s.T(), err, outboxErr)
+	s.revocations.AssertNotCalled(s.T(), "Revoke", mock.Anything, mock.Anything)
+}
+
+func (s *JanitorSuite) TestRun_ZeroRetentionDoesNothing() {
+	s.janitor.config.Retention = 0
+
+	s.janitor.Run(context.Background())
+
+	s.locks.AssertNotCalled(s.T(), "TryXactLock", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,56 @@
+package janitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+//go:generate mockery --name=transaction --inpackage
+type transaction interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+//go:generate mockery --name=locker --inpackage
+type locker interface {
+	// TryXactLock takes the advisory lock for the rest of the current
+	// transaction, or reports false at once when someone else holds it.
+	TryXactLock(ctx context.Context, key int64) (bool, error)
+}
+
+// Purged counts the sessions one purge removed, by why they were eligible.
+type Purged struct {
+	// Deleted are sessions soft-deleted before the retention window.
+	Deleted int64
+	// Idle are sessions never deleted but unused for longer than the idle
+	// retention window.
+	Idle int64
+}
+
+// PurgedSession is a session PurgeSessions removed. Idle is set for one
+// that was never deleted, so nothing revoked it before.
+type PurgedSession struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	LastUsed time.Time
+	Idle     bool
+}
+
+//go:generate mockery --name=sessionRepo --inpackage
+type sessionRepo interface {
+	// PurgeSessions hard-deletes up to limit sessions soft-deleted before
+	// deletedBefore or last used before idleBefore.
+	PurgeSessions(ctx context.Context, deletedBefore, idleBefore time.Time, limit uint) ([]PurgedSession, error)
+}
+
+//go:generate mockery --name=messenger --inpackage
+type messenger interface {
+	WriteSessionsRevoked(ctx context.Context, userID uuid.UUID, revoked []uuid.UUID, reason string) error
+}
+
+//go:generate mockery --name=revocations --inpackage
+type revocations interface {
+	Revoke(ctx context.Context, sessions []models.EndedSession) error
+}
@@ -0,0 +1,133 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Body    string
+}
+
+// Transport delivers an already composed message. The service ships with
+// log- and file-backed transports for local runs; a real provider only needs
+// to implement this interface.
+type Transport interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type Config struct {
+	From string
+
+	// EmailVerifyURL is the frontend page that redeems a verification
+	// token; the token is appended as the "token" query parameter.
+	EmailVerifyURL string
+
+	// PasswordResetURL is the frontend page where a user picks a new
+	// password; the token is passed the same way as for EmailVerifyURL.
+	PasswordResetURL string
+
+	// EmailChangeURL is the frontend page that confirms a new email
+	// address, again with the token in the "token" query parameter.
+	EmailChangeURL string
+}
+
+type Mailer struct {
+	transport Transport
+	config    Config
+}
+
+func New(transport Transport, config Config) *Mailer {
+	return &Mailer{
+		transport: transport,
+		config:    config,
+	}
+}
+
+func (m *Mailer) SendEmailVerification(ctx context.Context, to string, token string) error {
+	link, err := withToken(m.config.EmailVerifyURL, token)
+	if err != nil {
+		return err
+	}
+
+	return m.transport.Send(ctx, Message{
+		From:    m.config.From,
+		To:      to,
+		Subject: "Confirm your email",
+		Body: fmt.Sprintf(
+			"Follow the link to confirm your email address:\n\n%s\n\n"+
+				"If you didn't create an account, just ignore this message.",
+			link,
+		),
+	})
+}
+
+func (m *Mailer) SendPasswordReset(ctx context.Context, to string, token string) error {
+	link, err := withToken(m.config.PasswordResetURL, token)
+	if err != nil {
+		return err
+	}
+
+	return m.transport.Send(ctx, Message{
+		From:    m.config.From,
+		To:      to,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Follow the link to set a new password:\n\n%s\n\n"+
+				"If you didn't ask for a password reset, just ignore this message. "+
+				"Your current password stays unchanged.",
+			link,
+		),
+	})
+}
+
+func (m *Mailer) SendEmailChange(ctx context.Context, to string, token string) error {
+	link, err := withToken(m.config.EmailChangeURL, token)
+	if err != nil {
+		return err
+	}
+
+	return m.transport.Send(ctx, Message{
+		From:    m.config.From,
+		To:      to,
+		Subject: "Confirm your new email",
+		Body: fmt.Sprintf(
+			"Follow the link to make this your account's email address:\n\n%s\n\n"+
+				"If you didn't ask for this, just ignore this message.",
+			link,
+		),
+	})
+}
+
+// SendEmailChangeNotice tells the current address that a switch to newEmail
+// was requested, so the owner learns about it even if they did not ask.
+func (m *Mailer) SendEmailChangeNotice(ctx context.Context, to string, newEmail string) error {
+	return m.transport.Send(ctx, Message{
+		From:    m.config.From,
+		To:      to,
+		Subject: "Your email is about to change",
+		Body: fmt.Sprintf(
+			"Someone asked to change your account's email address to %s. "+
+				"The change takes effect once the new address is confirmed.\n\n"+
+				"If it wasn't you, change your password and end your other sessions.",
+			newEmail,
+		),
+	})
+}
+
+func withToken(base, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("parse link %q: %w", base, err)
+	}
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
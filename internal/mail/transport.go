@@ -0,0 +1,67 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/netbill/auth-svc/pkg/log"
+)
+
+// LogTransport writes every message to the service log instead of sending
+// it anywhere.
+type LogTransport struct {
+	log *log.Logger
+}
+
+func NewLogTransport(log *log.Logger) *LogTransport {
+	return &LogTransport{log: log}
+}
+
+func (t *LogTransport) Send(_ context.Context, msg Message) error {
+	t.log.WithComponent("mail").WithFields(map[string]any{
+		"mail_from":    msg.From,
+		"mail_to":      msg.To,
+		"mail_subject": msg.Subject,
+	}).Info("mail message", "mail_body", msg.Body)
+
+	return nil
+}
+
+// FileTransport appends every message to a plain text file, one block per
+// message, so local runs can pick links out of it.
+type FileTransport struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileTransport(path string) *FileTransport {
+	return &FileTransport{path: path}
+}
+
+func (t *FileTransport) Send(_ context.Context, msg Message) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	f, err := os.OpenFile(t.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open mail file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err = fmt.Fprintf(
+		f,
+		"Date: %s\nFrom: %s\nTo: %s\nSubject: %s\n\n%s\n\n",
+		time.Now().UTC().Format(time.RFC1123Z),
+		msg.From,
+		msg.To,
+		msg.Subject,
+		msg.Body,
+	); err != nil {
+		return fmt.Errorf("write mail file: %w", err)
+	}
+
+	return nil
+}
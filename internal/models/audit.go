@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of AuthEvent. A login is recorded under the way the user proved who
+// they are.
+const (
+	AuthEventLoginEmail      = "login_email"
+	AuthEventLoginOIDC       = "login_oidc"
+	AuthEventLoginMFA        = "login_mfa"
+	AuthEventLoginPasskey    = "login_passkey"
+	AuthEventLoginOAuthCode  = "login_oauth_code"
+	AuthEventRefresh         = "refresh"
+	AuthEventLogout          = "logout"
+	AuthEventSessionDeleted  = "session_deleted"
+	AuthEventSessionsDeleted = "sessions_deleted"
+	AuthEventQRConfirmed     = "qr_confirmed"
+	AuthEventQRRejected      = "qr_rejected"
+	AuthEventPasswordChanged = "password_changed"
+	AuthEventPasswordReset   = "password_reset"
+)
+
+// Outcomes of AuthEvent. A login that passed the first factor and now waits
+// for the second one is challenged.
+const (
+	AuthEventSuccess    = "success"
+	AuthEventFailure    = "failure"
+	AuthEventChallenged = "challenged"
+)
+
+// AuthEvent is an entry of the security audit log. UserID is the account
+// the event is about and is unset for a login that named no existing user;
+// SessionID is the session the event opened, refreshed or ended, or the one
+// the user acted from. Reason is set for failures only.
+type AuthEvent struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    *uuid.UUID `json:"user_id,omitempty"`
+	SessionID *uuid.UUID `json:"session_id,omitempty"`
+	Type      string     `json:"type"`
+	Outcome   string     `json:"outcome"`
+	Reason    *string    `json:"reason,omitempty"`
+	IP        string     `json:"ip,omitempty"`
+	UserAgent string     `json:"user_agent,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
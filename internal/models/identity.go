@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IdentityProviderGoogle names Google in UserIdentity.Provider. Other
+// providers go by the name they are configured under.
+const IdentityProviderGoogle = "google"
+
+// ExternalIdentity is an account at an external login provider as the
+// provider vouched for it in a login: Subject is the provider's stable ID
+// of the account, Email the address it reported and EmailVerified whether
+// the provider vouches for that address too.
+type ExternalIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+// UserIdentity links a user to an account at an external login provider.
+// Email is the address the provider reported when the link was made and is
+// informational only: logins match on Provider and Subject.
+type UserIdentity struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	Provider   string     `json:"provider"`
+	Subject    string     `json:"subject"`
+	Email      string     `json:"email"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
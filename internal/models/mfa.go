@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserTOTP is a TOTP authenticator of a user. Secret is sealed at rest and
+// only opened by the mfa service. An authenticator counts as enabled once
+// ConfirmedAt is set.
+type UserTOTP struct {
+	UserID       uuid.UUID  `json:"user_id"`
+	Secret       []byte     `json:"-"`
+	ConfirmedAt  *time.Time `json:"confirmed_at,omitempty"`
+	LastUsedStep *int64     `json:"-"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+func (t UserTOTP) Enabled() bool {
+	return t.ConfirmedAt != nil
+}
+
+type MFAStatus struct {
+	Enabled                bool       `json:"enabled"`
+	EnabledAt              *time.Time `json:"enabled_at,omitempty"`
+	RecoveryCodesRemaining int        `json:"recovery_codes_remaining"`
+}
+
+// TOTPEnrollment is handed to the user once to set up an authenticator app.
+type TOTPEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// MFAChallenge stands in for a tokens pair when the password (or Google)
+// step of a login succeeded but the user still has to present a second
+// factor.
+type MFAChallenge struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// LoginResult is what a primary login step returns: either the tokens of the
+// new session or, for users with MFA enabled, a challenge to finish the login
+// with.
+type LoginResult struct {
+	Tokens    TokensPair    `json:"tokens"`
+	Challenge *MFAChallenge `json:"challenge,omitempty"`
+}
@@ -0,0 +1,113 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OAuthAuthorizeRequest is an OpenID Connect authorization request as the
+// client sent it to the authorization endpoint.
+type OAuthAuthorizeRequest struct {
+	ClientID            string
+	RedirectURI         string
+	ResponseType        string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+// OAuthAuthorization is what an authorization code resolves to: the user who
+// approved the request and everything the token request is checked against.
+type OAuthAuthorization struct {
+	ClientID      string    `json:"client_id"`
+	RedirectURI   string    `json:"redirect_uri"`
+	UserID        uuid.UUID `json:"user_id"`
+	Scopes        []string  `json:"scopes"`
+	Nonce         string    `json:"nonce,omitempty"`
+	CodeChallenge string    `json:"code_challenge"`
+	AuthTime      time.Time `json:"auth_time"`
+}
+
+// OAuthGrant binds a session to the OpenID Connect client it was opened for
+// and the scopes the user granted that client. Only the client may refresh
+// the session, and its access tokens are issued to the client alone.
+type OAuthGrant struct {
+	ClientID string
+	Scopes   []string
+}
+
+// OAuthLoginState is what a login through an external provider remembers
+// between redirecting the browser to the provider and the provider's
+// callback: the nonce the ID token must carry, the PKCE verifier for the
+// code exchange and where to send the browser once the login is done.
+// Provider is the provider the flow was started with; a callback of another
+// provider does not get to use the state. LinkUserID is set when a signed in
+// user started the flow to link the provider account rather than to log in.
+// BindingHash is the hash of the cookie set in the browser that started the
+// flow; a callback arriving in any other browser does not get the state.
+type OAuthLoginState struct {
+	Provider     string    `json:"provider"`
+	Nonce        string    `json:"nonce"`
+	CodeVerifier string    `json:"code_verifier"`
+	RedirectURI  string    `json:"redirect_uri,omitempty"`
+	LinkUserID   uuid.UUID `json:"link_user_id,omitempty"`
+	BindingHash  string    `json:"binding_hash"`
+}
+
+// OAuthLoginStart is a started provider login: State goes into the
+// authorization request and comes back on the callback, Binding goes into a
+// cookie of the browser and must come back with it.
+type OAuthLoginStart struct {
+	State   string
+	Binding string
+	OAuthLoginState
+}
+
+// OAuthTokens is a token endpoint response. IDToken is set for the
+// authorization code grant only.
+type OAuthTokens struct {
+	SessionID uuid.UUID
+	Access    string
+	Refresh   string
+	IDToken   string
+	ExpiresIn time.Duration
+	Scopes    []string
+}
+
+// OAuthUserInfo holds the claims about a user an OpenID Connect client may
+// see. Which of them it gets depends on the granted scopes.
+type OAuthUserInfo struct {
+	User  User
+	Email UserEmail
+}
+
+// Scopes of service tokens issued by the client_credentials grant. Each
+// names what a netbill service may call on auth-svc.
+const (
+	ScopeSessionsValidate = "sessions:validate"
+	ScopeUsersCreate      = "users:create"
+)
+
+// ServiceScopes are the scopes a client_credentials token may carry.
+var ServiceScopes = []string{ScopeSessionsValidate, ScopeUsersCreate}
+
+// OAuthClient is a registered OAuth client: a web app signing users in
+// through the OpenID Provider, a netbill service using client credentials,
+// or both. Public clients have no secret.
+type OAuthClient struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	SecretHash   *string   `json:"-"`
+	Scopes       []string  `json:"scopes"`
+	RedirectURIs []string  `json:"redirect_uris"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// Confidential reports whether the client authenticates with a secret.
+func (c OAuthClient) Confidential() bool {
+	return c.SecretHash != nil
+}
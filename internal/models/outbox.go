@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEvent is a row of the transactional outbox as the relay publishes
+// it. Attempts counts the failed deliveries so far.
+type OutboxEvent struct {
+	ID        uuid.UUID
+	Topic     string
+	Key       string
+	Type      string
+	Version   int32
+	Producer  string
+	Payload   []byte
+	Attempts  int32
+	CreatedAt time.Time
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Passkey is a WebAuthn credential registered by a user. PublicKey is the
+// COSE encoded key logins are verified against.
+type Passkey struct {
+	ID           uuid.UUID  `json:"id"`
+	UserID       uuid.UUID  `json:"user_id"`
+	CredentialID []byte     `json:"-"`
+	PublicKey    []byte     `json:"-"`
+	SignCount    uint32     `json:"-"`
+	AAGUID       []byte     `json:"-"`
+	Transports   []string   `json:"transports"`
+	Name         string     `json:"name"`
+	LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// PasskeyCeremony is the server side state of a WebAuthn registration or
+// login between its begin and finish calls. UserID is set for registrations
+// only: a login learns the user from the passkey.
+type PasskeyCeremony struct {
+	Kind   string    `json:"kind"`
+	UserID uuid.UUID `json:"user_id,omitempty"`
+}
+
+const (
+	PasskeyCeremonyRegistration = "registration"
+	PasskeyCeremonyLogin        = "login"
+)
@@ -7,17 +7,109 @@ import (
 )
 
 type Session struct {
-	ID        uuid.UUID  `json:"id"`
-	UserID    uuid.UUID  `json:"user_id"`
-	Version   int32      `json:"version"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
-	LastUsed  time.Time  `json:"last_used"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	UserAgent  *string    `json:"user_agent,omitempty"`
+	ClientIP   *string    `json:"client_ip,omitempty"`
+	Platform   *string    `json:"platform,omitempty"`
+	Browser    *string    `json:"browser,omitempty"`
+	DeviceName *string    `json:"device_name,omitempty"`
+	Version    int32      `json:"version"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	LastUsed   time.Time  `json:"last_used"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
 }
 
+// SessionClient describes the client a session is opened or refreshed from.
+// Transports fill UserAgent and IP; Platform and Browser are derived from the
+// user agent by the session service.
+type SessionClient struct {
+	UserAgent string
+	IP        string
+	Platform  string
+	Browser   string
+}
+
+// SessionToken holds the refresh token hashes stored for a session. The hash
+// rotated out by the last refresh is kept so a replay of it can be told apart
+// from a token that never belonged to the session.
+//
+// EndReason is set when a session policy ended the session; such a session
+// is still returned so the refresh can tell why it no longer works. Grant is
+// set for sessions opened for an OpenID Connect client.
+type SessionToken struct {
+	UserID       uuid.UUID
+	Hash         string
+	PreviousHash *string
+	CreatedAt    time.Time
+	LastUsed     time.Time
+	EndReason    *string
+	Grant        *OAuthGrant
+}
+
+// EndedSession is a session that was just deleted or ended. LastUsed is
+// when its latest access token was issued, at login or the last refresh,
+// so the revocation list knows when the session can be forgotten.
+type EndedSession struct {
+	ID       uuid.UUID
+	LastUsed time.Time
+}
+
+// EndedSessionIDs returns the ids of the sessions, in order, and nil for
+// none.
+func EndedSessionIDs(sessions []EndedSession) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, s := range sessions {
+		ids = append(ids, s.ID)
+	}
+	return ids
+}
+
+// Reasons a session was revoked, as published in session revocation events.
+const (
+	SessionRevokedLogout    = "logout"
+	SessionRevokedByUser    = "deleted_by_user"
+	SessionRevokedAllByUser = "all_deleted_by_user"
+
+	// SessionRevokedOthersByUser is "sign out everywhere else";
+	// SessionRevokedSelectedByUser a bulk deletion narrowed by filters.
+	SessionRevokedOthersByUser   = "others_deleted_by_user"
+	SessionRevokedSelectedByUser = "selected_deleted_by_user"
+
+	// Sessions ended by a session policy rather than by anyone's request.
+	SessionRevokedIdleTimeout = "idle_timeout"
+	SessionRevokedMaxAge      = "max_age"
+	SessionRevokedEvicted     = "session_limit"
+
+	// SessionRevokedIdlePurged is a session the janitor removed for going
+	// unused longer than its refresh token lives.
+	SessionRevokedIdlePurged = "idle_purged"
+)
+
 type TokensPair struct {
 	SessionID uuid.UUID `json:"session_id"`
 	Refresh   string    `json:"refresh"`
 	Access    string    `json:"access"`
 }
+
+// QR login statuses. A QR login starts pending and is resolved exactly once.
+const (
+	QRLoginPending   = "pending"
+	QRLoginConfirmed = "confirmed"
+	QRLoginRejected  = "rejected"
+)
+
+// QRLogin is a login requested by scanning a QR code and waiting for a
+// signed-in device to confirm it. Client is the device that opened the QR
+// flow, shown to the confirming user so they can tell whose login it is.
+// Code is displayed next to the QR code and has to be typed in on the
+// confirming device, so a QR code relayed to someone else is not enough.
+type QRLogin struct {
+	Token     string
+	Status    string
+	Code      string
+	Client    SessionClient
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
@@ -10,6 +10,9 @@ type UserActor struct {
 	ID        uuid.UUID `json:"id"`
 	SessionID uuid.UUID `json:"session_id"`
 	Role      string    `json:"role"`
+
+	// Client is where the request came from; it only goes into the audit log.
+	Client SessionClient `json:"-"`
 }
 
 type User struct {
@@ -21,9 +24,21 @@ type User struct {
 	AvatarKey   *string   `json:"avatar_key,omitempty"`
 	Version     int32     `json:"version"`
 
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
+
+	// SuspendedUntil ends the suspension by itself; nil keeps it until an
+	// admin lifts it.
+	SuspendedUntil   *time.Time `json:"suspended_until,omitempty"`
+	SuspendedBy      *uuid.UUID `json:"suspended_by,omitempty"`
+	SuspensionReason *string    `json:"suspension_reason,omitempty"`
+}
+
+// Suspended reports whether a suspension is in force at now.
+func (u User) Suspended(now time.Time) bool {
+	return u.SuspendedAt != nil && (u.SuspendedUntil == nil || now.Before(*u.SuspendedUntil))
 }
 
 type UploadUserMediaLinks struct {
@@ -46,6 +61,26 @@ type UserEmail struct {
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
+// EmailVerification is what a pending email verification token resolves to.
+type EmailVerification struct {
+	UserID uuid.UUID `json:"user_id"`
+	Email  string    `json:"email"`
+}
+
+// EmailChange is what a pending email change token resolves to. OldEmail
+// pins the address the change was requested from, so a token outlives no
+// other change made in the meantime.
+type EmailChange struct {
+	UserID   uuid.UUID `json:"user_id"`
+	OldEmail string    `json:"old_email"`
+	NewEmail string    `json:"new_email"`
+}
+
+// PasswordReset is what a pending password reset token resolves to.
+type PasswordReset struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
 type UserPassword struct {
 	UserID    uuid.UUID  `json:"user_id"`
 	Hash      string     `json:"hash"`
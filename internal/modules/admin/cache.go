@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+//go:generate mockery --name=userCache --inpackage
+type userCache interface {
+	Set(ctx context.Context, user models.User) error
+}
+
+//go:generate mockery --name=emailCache --inpackage
+type emailCache interface {
+	Set(ctx context.Context, email models.UserEmail) error
+}
+
+//go:generate mockery --name=sessionsCache --inpackage
+type sessionsCache interface {
+	Delete(ctx context.Context, sessionID uuid.UUID) error
+}
+
+// revokedSessions takes the sessions ended by a suspension or a forced
+// sign-out, so the suspended user's access tokens are refused at once rather
+// than when they expire.
+//
+//go:generate mockery --name=revokedSessions --inpackage
+type revokedSessions interface {
+	Revoke(ctx context.Context, sessions []models.EndedSession) error
+}
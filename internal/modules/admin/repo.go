@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/audit"
+	"github.com/netbill/auth-svc/internal/modules/session"
+	"github.com/netbill/auth-svc/internal/modules/user"
+	"github.com/netbill/restkit/pagi"
+)
+
+//go:generate mockery --name=transaction --inpackage
+type transaction interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+//go:generate mockery --name=userRepo --inpackage
+type userRepo interface {
+	GetByID(ctx context.Context, userID uuid.UUID, opts ...user.GetUserOption) (models.User, error)
+	Filter(ctx context.Context, params user.FilterParams, limit, offset uint) (pagi.Page[[]models.User], error)
+	UpdateRole(ctx context.Context, userID uuid.UUID, role string) (models.User, error)
+	Suspend(ctx context.Context, userID, by uuid.UUID, params SuspendParams) (models.User, error)
+	Unsuspend(ctx context.Context, userID uuid.UUID) (models.User, error)
+	Restore(ctx context.Context, userID uuid.UUID) (models.User, error)
+}
+
+//go:generate mockery --name=emailRepo --inpackage
+type emailRepo interface {
+	GetByID(ctx context.Context, userID uuid.UUID, opts ...user.GetUserOption) (models.UserEmail, error)
+}
+
+//go:generate mockery --name=sessionRepo --inpackage
+type sessionRepo interface {
+	GetListForUser(
+		ctx context.Context,
+		userID uuid.UUID,
+		opts ...session.ListSessionsOption,
+	) (pagi.Page[[]models.Session], error)
+	DeleteManyForUser(ctx context.Context, userID uuid.UUID) ([]models.EndedSession, error)
+}
+
+//go:generate mockery --name=authEventRepo --inpackage
+type authEventRepo interface {
+	Filter(ctx context.Context, params audit.FilterParams, limit, offset uint) (pagi.Page[[]models.AuthEvent], error)
+}
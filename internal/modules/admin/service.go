@@ -0,0 +1,412 @@
+// Package admin is the user management sysadmins do on other people's
+// accounts: search, inspection, role changes, suspension, restoring deleted
+// users, ending their sessions and reading the security audit log.
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/audit"
+	"github.com/netbill/auth-svc/internal/modules/session"
+	"github.com/netbill/auth-svc/internal/modules/user"
+	"github.com/netbill/restkit/pagi"
+	"github.com/netbill/restkit/tokens"
+)
+
+//go:generate mockery --name=auth --inpackage
+type auth interface {
+	ValidateSession(ctx context.Context, actor models.UserActor) (models.User, models.Session, error)
+}
+
+//go:generate mockery --name=messenger --inpackage
+type messenger interface {
+	WriteUserRoleUpdated(ctx context.Context, user models.User, by uuid.UUID) error
+	WriteUserSuspended(ctx context.Context, user models.User, by uuid.UUID, revoked []uuid.UUID) error
+	WriteUserUnsuspended(ctx context.Context, user models.User, by uuid.UUID) error
+	WriteUserRestored(ctx context.Context, user models.User, email models.UserEmail, by uuid.UUID) error
+	WriteUserSessionsRevoked(ctx context.Context, userID, by uuid.UUID, revoked []uuid.UUID) error
+}
+
+type Service struct {
+	auth auth
+
+	userRepo      userRepo
+	emailRepo     emailRepo
+	sessionRepo   sessionRepo
+	authEventRepo authEventRepo
+
+	tx transaction
+
+	userCache     userCache
+	emailCache    emailCache
+	sessionsCache sessionsCache
+
+	revokedSessions revokedSessions
+
+	messenger messenger
+}
+
+type ServiceDeps struct {
+	Auth auth
+
+	UserRepo      userRepo
+	EmailRepo     emailRepo
+	SessionRepo   sessionRepo
+	AuthEventRepo authEventRepo
+
+	Tx transaction
+
+	UserCache     userCache
+	EmailCache    emailCache
+	SessionsCache sessionsCache
+
+	RevokedSessions revokedSessions
+
+	Messenger messenger
+}
+
+func New(deps ServiceDeps) *Service {
+	return &Service{
+		auth:            deps.Auth,
+		userRepo:        deps.UserRepo,
+		emailRepo:       deps.EmailRepo,
+		sessionRepo:     deps.SessionRepo,
+		authEventRepo:   deps.AuthEventRepo,
+		tx:              deps.Tx,
+		userCache:       deps.UserCache,
+		emailCache:      deps.EmailCache,
+		sessionsCache:   deps.SessionsCache,
+		revokedSessions: deps.RevokedSessions,
+		messenger:       deps.Messenger,
+	}
+}
+
+// authorize checks the actor against their stored role rather than the one
+// in the access token, so an admin who was demoted loses the admin API
+// right away instead of when the token expires.
+func (s *Service) authorize(ctx context.Context, actor models.UserActor) error {
+	u, _, err := s.auth.ValidateSession(ctx, actor)
+	if err != nil {
+		return err
+	}
+
+	if u.Role != tokens.RoleSystemAdmin {
+		return errx.ErrorUserForbidden.Raise(
+			fmt.Errorf("user %s has role %s, admin required", actor.ID, u.Role),
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) ListUsers(
+	ctx context.Context,
+	actor models.UserActor,
+	params user.FilterParams,
+	limit, offset uint,
+) (pagi.Page[[]models.User], error) {
+	if err := s.authorize(ctx, actor); err != nil {
+		return pagi.Page[[]models.User]{}, err
+	}
+
+	return s.userRepo.Filter(ctx, params, limit, offset)
+}
+
+// GetUser returns the user and their email, deleted or not.
+func (s *Service) GetUser(
+	ctx context.Context,
+	actor models.UserActor,
+	userID uuid.UUID,
+) (models.User, models.UserEmail, error) {
+	if err := s.authorize(ctx, actor); err != nil {
+		return models.User{}, models.UserEmail{}, err
+	}
+
+	u, err := s.userRepo.GetByID(ctx, userID, user.WithDeleted(user.DeletedFilterAll))
+	if err != nil {
+		return models.User{}, models.UserEmail{}, err
+	}
+
+	email, err := s.emailRepo.GetByID(ctx, userID, user.WithDeleted(user.DeletedFilterAll))
+	if err != nil {
+		return models.User{}, models.UserEmail{}, err
+	}
+
+	return u, email, nil
+}
+
+func (s *Service) ListUserSessions(
+	ctx context.Context,
+	actor models.UserActor,
+	userID uuid.UUID,
+	opts ...session.ListSessionsOption,
+) (pagi.Page[[]models.Session], error) {
+	if err := s.authorize(ctx, actor); err != nil {
+		return pagi.Page[[]models.Session]{}, err
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, userID, user.WithDeleted(user.DeletedFilterAll)); err != nil {
+		return pagi.Page[[]models.Session]{}, err
+	}
+
+	return s.sessionRepo.GetListForUser(ctx, userID, opts...)
+}
+
+// ListAuthEvents searches the security audit log of every user, newest
+// events first.
+func (s *Service) ListAuthEvents(
+	ctx context.Context,
+	actor models.UserActor,
+	params audit.FilterParams,
+	limit, offset uint,
+) (pagi.Page[[]models.AuthEvent], error) {
+	if err := s.authorize(ctx, actor); err != nil {
+		return pagi.Page[[]models.AuthEvent]{}, err
+	}
+
+	return s.authEventRepo.Filter(ctx, params, limit, offset)
+}
+
+// UpdateUserRole takes effect on the user's next refresh; access tokens
+// already issued keep the old role until they expire.
+func (s *Service) UpdateUserRole(
+	ctx context.Context,
+	actor models.UserActor,
+	userID uuid.UUID,
+	role string,
+) (models.User, error) {
+	if err := s.authorize(ctx, actor); err != nil {
+		return models.User{}, err
+	}
+
+	if err := tokens.ValidateUserSystemRole(role); err != nil {
+		return models.User{}, errx.ErrorRoleNotSupported.Raise(err)
+	}
+
+	if userID == actor.ID {
+		return models.User{}, errx.ErrorSelfModeration.Raise(
+			fmt.Errorf("user %s tried to change their own role", actor.ID),
+		)
+	}
+
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if u.Role == role {
+		return u, nil
+	}
+
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		u, err = s.userRepo.UpdateRole(ctx, userID, role)
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteUserRoleUpdated(ctx, u, actor.ID)
+	}); err != nil {
+		return models.User{}, err
+	}
+
+	go s.userCache.Set(context.WithoutCancel(ctx), u)
+
+	return u, nil
+}
+
+type SuspendParams struct {
+	Reason *string
+
+	// Until ends the suspension by itself; nil keeps it until lifted.
+	Until *time.Time
+}
+
+// SuspendUser blocks the user without deleting anything and ends all of
+// their sessions. Logins, refreshes and ValidateSession refuse the user
+// while the suspension is in force.
+func (s *Service) SuspendUser(
+	ctx context.Context,
+	actor models.UserActor,
+	userID uuid.UUID,
+	params SuspendParams,
+) (models.User, error) {
+	if err := s.authorize(ctx, actor); err != nil {
+		return models.User{}, err
+	}
+
+	now := time.Now()
+	if params.Until != nil && !params.Until.After(now) {
+		return models.User{}, errx.ErrorSuspensionEndInvalid.Raise(
+			fmt.Errorf("suspension end %s is not in the future", params.Until),
+		)
+	}
+
+	if userID == actor.ID {
+		return models.User{}, errx.ErrorSelfModeration.Raise(
+			fmt.Errorf("user %s tried to suspend themselves", actor.ID),
+		)
+	}
+
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if u.Suspended(now) {
+		return models.User{}, errx.ErrorUserAlreadySuspended.Raise(
+			fmt.Errorf("user %s is already suspended", userID),
+		)
+	}
+
+	var ended []models.EndedSession
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		u, err = s.userRepo.Suspend(ctx, userID, actor.ID, params)
+		if err != nil {
+			return err
+		}
+
+		ended, err = s.sessionRepo.DeleteManyForUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteUserSuspended(ctx, u, actor.ID, models.EndedSessionIDs(ended))
+	}); err != nil {
+		return models.User{}, err
+	}
+
+	detached := context.WithoutCancel(ctx)
+
+	go s.userCache.Set(detached, u)
+	go s.revokedSessions.Revoke(detached, ended)
+	for _, e := range ended {
+		go s.sessionsCache.Delete(detached, e.ID)
+	}
+
+	return u, nil
+}
+
+func (s *Service) UnsuspendUser(
+	ctx context.Context,
+	actor models.UserActor,
+	userID uuid.UUID,
+) (models.User, error) {
+	if err := s.authorize(ctx, actor); err != nil {
+		return models.User{}, err
+	}
+
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if !u.Suspended(time.Now()) {
+		return models.User{}, errx.ErrorUserNotSuspended.Raise(
+			fmt.Errorf("user %s is not suspended", userID),
+		)
+	}
+
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		u, err = s.userRepo.Unsuspend(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteUserUnsuspended(ctx, u, actor.ID)
+	}); err != nil {
+		return models.User{}, err
+	}
+
+	go s.userCache.Set(context.WithoutCancel(ctx), u)
+
+	return u, nil
+}
+
+// RestoreUser brings back a soft-deleted user with their email and
+// password. Sessions stay deleted, and the username stays the anonymized
+// one it got on deletion.
+func (s *Service) RestoreUser(
+	ctx context.Context,
+	actor models.UserActor,
+	userID uuid.UUID,
+) (models.User, error) {
+	if err := s.authorize(ctx, actor); err != nil {
+		return models.User{}, err
+	}
+
+	u, err := s.userRepo.GetByID(ctx, userID, user.WithDeleted(user.DeletedFilterAll))
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if u.DeletedAt == nil {
+		return models.User{}, errx.ErrorUserNotDeleted.Raise(
+			fmt.Errorf("user %s is not deleted", userID),
+		)
+	}
+
+	var email models.UserEmail
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		u, err = s.userRepo.Restore(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		email, err = s.emailRepo.GetByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteUserRestored(ctx, u, email, actor.ID)
+	}); err != nil {
+		return models.User{}, err
+	}
+
+	detached := context.WithoutCancel(ctx)
+
+	go s.userCache.Set(detached, u)
+	go s.emailCache.Set(detached, email)
+
+	return u, nil
+}
+
+// RevokeUserSessions ends every active session of the user.
+func (s *Service) RevokeUserSessions(
+	ctx context.Context,
+	actor models.UserActor,
+	userID uuid.UUID,
+) error {
+	if err := s.authorize(ctx, actor); err != nil {
+		return err
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return err
+	}
+
+	var ended []models.EndedSession
+	if err := s.tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		ended, err = s.sessionRepo.DeleteManyForUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteUserSessionsRevoked(ctx, userID, actor.ID, models.EndedSessionIDs(ended))
+	}); err != nil {
+		return err
+	}
+
+	detached := context.WithoutCancel(ctx)
+	go s.revokedSessions.Revoke(detached, ended)
+	for _, e := range ended {
+		go s.sessionsCache.Delete(detached, e.ID)
+	}
+
+	return nil
+}
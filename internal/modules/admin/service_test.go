@@ -0,0 +1,388 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/audit"
+	"github.com/netbill/auth-svc/internal/modules/user"
+	"github.com/netbill/restkit/pagi"
+	"github.com/netbill/restkit/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeTx struct{}
+
+func (f *fakeTx) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type AdminServiceSuite struct {
+	suite.Suite
+
+	auth            *mockAuth
+	userRepo        *mockUserRepo
+	emailRepo       *mockEmailRepo
+	sessionRepo     *mockSessionRepo
+	authEventRepo   *mockAuthEventRepo
+	userCache       *mockUserCache
+	emailCache      *mockEmailCache
+	sessionsCache   *mockSessionsCache
+	revokedSessions *mockRevokedSessions
+	messenger       *mockMessenger
+
+	svc *Service
+
+	actor models.UserActor
+
+	background sync.WaitGroup
+}
+
+func (s *AdminServiceSuite) SetupTest() {
+	s.auth = newMockAuth(s.T())
+	s.userRepo = newMockUserRepo(s.T())
+	s.emailRepo = newMockEmailRepo(s.T())
+	s.sessionRepo = newMockSessionRepo(s.T())
+	s.authEventRepo = newMockAuthEventRepo(s.T())
+	s.userCache = newMockUserCache(s.T())
+	s.emailCache = newMockEmailCache(s.T())
+	s.sessionsCache = newMockSessionsCache(s.T())
+	s.revokedSessions = newMockRevokedSessions(s.T())
+	s.messenger = newMockMessenger(s.T())
+
+	s.svc = New(ServiceDeps{
+		Auth:            s.auth,
+		UserRepo:        s.userRepo,
+		EmailRepo:       s.emailRepo,
+		SessionRepo:     s.sessionRepo,
+		AuthEventRepo:   s.authEventRepo,
+		Tx:              &fakeTx{},
+		UserCache:       s.userCache,
+		EmailCache:      s.emailCache,
+		SessionsCache:   s.sessionsCache,
+		RevokedSessions: s.revokedSessions,
+		Messenger:       s.messenger,
+	})
+
+	s.actor = models.UserActor{ID: uuid.New(), SessionID: uuid.New(), Role: tokens.RoleSystemAdmin}
+}
+
+func TestAdminService(t *testing.T) {
+	suite.Run(t, new(AdminServiceSuite))
+}
+
+// TearDownTest waits for the cache and denylist writes the service leaves
+// running after it returns, so they don't outlive the test that started them.
+func (s *AdminServiceSuite) TearDownTest() {
+	done := make(chan struct{})
+	go func() {
+		s.background.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		s.T().Fatal("background writes did not finish")
+	}
+}
+
+// inBackground expects call once, off the request path, and makes
+// TearDownTest wait for it.
+func (s *AdminServiceSuite) inBackground(call *mock.Call) {
+	s.background.Add(1)
+	call.Run(func(mock.Arguments) { s.background.Done() }).Once()
+}
+
+func (s *AdminServiceSuite) expectAdmin() {
+	s.auth.On("ValidateSession", mock.Anything, s.actor).
+		Return(models.User{ID: s.actor.ID, Role: tokens.RoleSystemAdmin}, models.Session{}, nil)
+}
+
+// ─── Authorization ──────────────────────────────────────────────────────────
+
+func (s *AdminServiceSuite) TestAuthorize_DemotedAdminIsForbidden() {
+	s.auth.On("ValidateSession", mock.Anything, s.actor).
+		Return(models.User{ID: s.actor.ID, Role: tokens.RoleSystemUser}, models.Session{}, nil)
+
+	_, err := s.svc.ListUsers(context.Background(), s.actor, user.FilterParams{}, 10, 0)
+	assert.True(s.T(), errors.Is(err, errx.ErrorUserForbidden))
+}
+
+func (s *AdminServiceSuite) TestAuthorize_InvalidSession() {
+	s.auth.On("ValidateSession", mock.Anything, s.actor).
+		Return(models.User{}, models.Session{}, errx.ErrorUserInvalidSession.Raise(errors.New("gone")))
+
+	err := s.svc.RevokeUserSessions(context.Background(), s.actor, uuid.New())
+	assert.True(s.T(), errors.Is(err, errx.ErrorUserInvalidSession))
+}
+
+// ─── ListUsers / GetUser ────────────────────────────────────────────────────
+
+func (s *AdminServiceSuite) TestListUsers_PassesFilter() {
+	params := user.FilterParams{Deleted: user.DeletedFilterAll}
+	page := pagi.Page[[]models.User]{Data: []models.User{{ID: uuid.New()}}, Total: 1}
+
+	s.expectAdmin()
+	s.userRepo.On("Filter", mock.Anything, params, uint(10), uint(20)).Return(page, nil)
+
+	res, err := s.svc.ListUsers(context.Background(), s.actor, params, 10, 20)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), page, res)
+}
+
+func (s *AdminServiceSuite) TestGetUser_ReturnsEmail() {
+	userID := uuid.New()
+	u := models.User{ID: userID}
+	email := models.UserEmail{UserID: userID, Email: "user@example.com"}
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID, mock.Anything).Return(u, nil)
+	s.emailRepo.On("GetByID", mock.Anything, userID, mock.Anything).Return(email, nil)
+
+	gotUser, gotEmail, err := s.svc.GetUser(context.Background(), s.actor, userID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), u, gotUser)
+	assert.Equal(s.T(), email, gotEmail)
+}
+
+func (s *AdminServiceSuite) TestGetUser_NotFound() {
+	userID := uuid.New()
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID, mock.Anything).
+		Return(models.User{}, errx.ErrorUserNotFound.Raise(errors.New("no rows")))
+
+	_, _, err := s.svc.GetUser(context.Background(), s.actor, userID)
+	assert.True(s.T(), errors.Is(err, errx.ErrorUserNotFound))
+}
+
+// ─── ListAuthEvents ─────────────────────────────────────────────────────────
+
+func (s *AdminServiceSuite) TestListAuthEvents_PassesFilter() {
+	userID := uuid.New()
+	outcome := models.AuthEventFailure
+	params := audit.FilterParams{UserID: &userID, Outcome: &outcome}
+	page := pagi.Page[[]models.AuthEvent]{Data: []models.AuthEvent{{ID: uuid.New(), UserID: &userID}}, Total: 1}
+
+	s.expectAdmin()
+	s.authEventRepo.On("Filter", mock.Anything, params, uint(50), uint(0)).Return(page, nil)
+
+	res, err := s.svc.ListAuthEvents(context.Background(), s.actor, params, 50, 0)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), page, res)
+}
+
+func (s *AdminServiceSuite) TestListAuthEvents_NonAdminIsForbidden() {
+	s.auth.On("ValidateSession", mock.Anything, s.actor).
+		Return(models.User{ID: s.actor.ID, Role: tokens.RoleSystemUser}, models.Session{}, nil)
+
+	_, err := s.svc.ListAuthEvents(context.Background(), s.actor, audit.FilterParams{}, 10, 0)
+	assert.ErrorIs(s.T(), err, errx.ErrorUserForbidden)
+	s.authEventRepo.AssertNotCalled(s.T(), "Filter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ─── UpdateUserRole ─────────────────────────────────────────────────────────
+
+func (s *AdminServiceSuite) TestUpdateUserRole_HappyPath() {
+	userID := uuid.New()
+	before := models.User{ID: userID, Role: tokens.RoleSystemUser}
+	after := models.User{ID: userID, Role: tokens.RoleSystemModer}
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(before, nil)
+	s.userRepo.On("UpdateRole", mock.Anything, userID, tokens.RoleSystemModer).Return(after, nil)
+	s.messenger.On("WriteUserRoleUpdated", mock.Anything, after, s.actor.ID).Return(nil)
+	s.inBackground(s.userCache.On("Set", mock.Anything, after).Return(nil))
+
+	res, err := s.svc.UpdateUserRole(context.Background(), s.actor, userID, tokens.RoleSystemModer)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), after, res)
+}
+
+func (s *AdminServiceSuite) TestUpdateUserRole_SameRoleIsNoop() {
+	userID := uuid.New()
+	u := models.User{ID: userID, Role: tokens.RoleSystemUser}
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(u, nil)
+
+	res, err := s.svc.UpdateUserRole(context.Background(), s.actor, userID, tokens.RoleSystemUser)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), u, res)
+	s.userRepo.AssertNotCalled(s.T(), "UpdateRole", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *AdminServiceSuite) TestUpdateUserRole_UnsupportedRole() {
+	s.expectAdmin()
+
+	_, err := s.svc.UpdateUserRole(context.Background(), s.actor, uuid.New(), "root")
+	assert.True(s.T(), errors.Is(err, errx.ErrorRoleNotSupported))
+}
+
+func (s *AdminServiceSuite) TestUpdateUserRole_Self() {
+	s.expectAdmin()
+
+	_, err := s.svc.UpdateUserRole(context.Background(), s.actor, s.actor.ID, tokens.RoleSystemUser)
+	assert.True(s.T(), errors.Is(err, errx.ErrorSelfModeration))
+}
+
+// ─── SuspendUser / UnsuspendUser ────────────────────────────────────────────
+
+func (s *AdminServiceSuite) TestSuspendUser_RevokesSessions() {
+	userID := uuid.New()
+	now := time.Now()
+	reason := "spam"
+	params := SuspendParams{Reason: &reason}
+	suspended := models.User{ID: userID, SuspendedAt: &now, SuspendedBy: &s.actor.ID, SuspensionReason: &reason}
+	sessions := []models.EndedSession{{ID: uuid.New(), LastUsed: now}, {ID: uuid.New(), LastUsed: now}}
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID}, nil)
+	s.userRepo.On("Suspend", mock.Anything, userID, s.actor.ID, params).Return(suspended, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, userID).Return(sessions, nil)
+	s.messenger.On("WriteUserSuspended", mock.Anything, suspended, s.actor.ID, models.EndedSessionIDs(sessions)).
+		Return(nil)
+	s.inBackground(s.userCache.On("Set", mock.Anything, suspended).Return(nil))
+	s.inBackground(s.revokedSessions.On("Revoke", mock.Anything, sessions).Return(nil))
+	s.inBackground(s.sessionsCache.On("Delete", mock.Anything, sessions[0].ID).Return(nil))
+	s.inBackground(s.sessionsCache.On("Delete", mock.Anything, sessions[1].ID).Return(nil))
+
+	res, err := s.svc.SuspendUser(context.Background(), s.actor, userID, params)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), suspended, res)
+}
+
+func (s *AdminServiceSuite) TestSuspendUser_ReplacesExpiredSuspension() {
+	userID := uuid.New()
+	past := time.Now().Add(-2 * time.Hour)
+	ended := time.Now().Add(-time.Hour)
+	until := time.Now().Add(24 * time.Hour)
+	params := SuspendParams{Until: &until}
+	now := time.Now()
+	suspended := models.User{ID: userID, SuspendedAt: &now, SuspendedUntil: &until}
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID).
+		Return(models.User{ID: userID, SuspendedAt: &past, SuspendedUntil: &ended}, nil)
+	s.userRepo.On("Suspend", mock.Anything, userID, s.actor.ID, params).Return(suspended, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, userID).Return([]models.EndedSession(nil), nil)
+	s.messenger.On("WriteUserSuspended", mock.Anything, suspended, s.actor.ID, []uuid.UUID(nil)).Return(nil)
+	s.inBackground(s.userCache.On("Set", mock.Anything, suspended).Return(nil))
+	s.inBackground(s.revokedSessions.On("Revoke", mock.Anything, []models.EndedSession(nil)).Return(nil))
+
+	res, err := s.svc.SuspendUser(context.Background(), s.actor, userID, params)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), suspended, res)
+}
+
+func (s *AdminServiceSuite) TestSuspendUser_EndNotInFuture() {
+	until := time.Now().Add(-time.Minute)
+
+	s.expectAdmin()
+
+	_, err := s.svc.SuspendUser(context.Background(), s.actor, uuid.New(), SuspendParams{Until: &until})
+	assert.True(s.T(), errors.Is(err, errx.ErrorSuspensionEndInvalid))
+	s.userRepo.AssertNotCalled(s.T(), "Suspend", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *AdminServiceSuite) TestSuspendUser_AlreadySuspended() {
+	userID := uuid.New()
+	now := time.Now()
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID, SuspendedAt: &now}, nil)
+
+	_, err := s.svc.SuspendUser(context.Background(), s.actor, userID, SuspendParams{})
+	assert.True(s.T(), errors.Is(err, errx.ErrorUserAlreadySuspended))
+}
+
+func (s *AdminServiceSuite) TestSuspendUser_Self() {
+	s.expectAdmin()
+
+	_, err := s.svc.SuspendUser(context.Background(), s.actor, s.actor.ID, SuspendParams{})
+	assert.True(s.T(), errors.Is(err, errx.ErrorSelfModeration))
+}
+
+func (s *AdminServiceSuite) TestUnsuspendUser_NotSuspended() {
+	userID := uuid.New()
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID}, nil)
+
+	_, err := s.svc.UnsuspendUser(context.Background(), s.actor, userID)
+	assert.True(s.T(), errors.Is(err, errx.ErrorUserNotSuspended))
+}
+
+func (s *AdminServiceSuite) TestUnsuspendUser_HappyPath() {
+	userID := uuid.New()
+	now := time.Now()
+	lifted := models.User{ID: userID}
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID, SuspendedAt: &now}, nil)
+	s.userRepo.On("Unsuspend", mock.Anything, userID).Return(lifted, nil)
+	s.messenger.On("WriteUserUnsuspended", mock.Anything, lifted, s.actor.ID).Return(nil)
+	s.inBackground(s.userCache.On("Set", mock.Anything, lifted).Return(nil))
+
+	res, err := s.svc.UnsuspendUser(context.Background(), s.actor, userID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), lifted, res)
+}
+
+// ─── RestoreUser ────────────────────────────────────────────────────────────
+
+func (s *AdminServiceSuite) TestRestoreUser_HappyPath() {
+	userID := uuid.New()
+	now := time.Now()
+	restored := models.User{ID: userID}
+	email := models.UserEmail{UserID: userID, Email: "user@example.com"}
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID, mock.Anything).Return(models.User{ID: userID, DeletedAt: &now}, nil)
+	s.userRepo.On("Restore", mock.Anything, userID).Return(restored, nil)
+	s.emailRepo.On("GetByID", mock.Anything, userID).Return(email, nil)
+	s.messenger.On("WriteUserRestored", mock.Anything, restored, email, s.actor.ID).Return(nil)
+	s.inBackground(s.userCache.On("Set", mock.Anything, restored).Return(nil))
+	s.inBackground(s.emailCache.On("Set", mock.Anything, email).Return(nil))
+
+	res, err := s.svc.RestoreUser(context.Background(), s.actor, userID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), restored, res)
+}
+
+func (s *AdminServiceSuite) TestRestoreUser_NotDeleted() {
+	userID := uuid.New()
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID, mock.Anything).Return(models.User{ID: userID}, nil)
+
+	_, err := s.svc.RestoreUser(context.Background(), s.actor, userID)
+	assert.True(s.T(), errors.Is(err, errx.ErrorUserNotDeleted))
+}
+
+// ─── RevokeUserSessions ─────────────────────────────────────────────────────
+
+func (s *AdminServiceSuite) TestRevokeUserSessions_HappyPath() {
+	userID := uuid.New()
+	sessions := []models.EndedSession{{ID: uuid.New(), LastUsed: time.Now()}}
+	sessionIDs := models.EndedSessionIDs(sessions)
+
+	s.expectAdmin()
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID}, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, userID).Return(sessions, nil)
+	s.messenger.On("WriteUserSessionsRevoked", mock.Anything, userID, s.actor.ID, sessionIDs).Return(nil)
+	s.inBackground(s.revokedSessions.On("Revoke", mock.Anything, sessions).Return(nil))
+	s.inBackground(s.sessionsCache.On("Delete", mock.Anything, sessionIDs[0]).Return(nil))
+
+	require.NoError(s.T(), s.svc.RevokeUserSessions(context.Background(), s.actor, userID))
+}
@@ -0,0 +1,31 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/restkit/pagi"
+)
+
+//go:generate mockery --name=transaction --inpackage
+type transaction interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+//go:generate mockery --name=locker --inpackage
+type locker interface {
+	// TryXactLock takes the advisory lock for the rest of the current
+	// transaction, or reports false at once when someone else holds it.
+	TryXactLock(ctx context.Context, key int64) (bool, error)
+}
+
+//go:generate mockery --name=eventRepo --inpackage
+type eventRepo interface {
+	Create(ctx context.Context, event models.AuthEvent) error
+	Filter(ctx context.Context, params FilterParams, limit, offset uint) (pagi.Page[[]models.AuthEvent], error)
+
+	// DeleteBefore removes up to limit events created before the given time
+	// and reports how many it removed.
+	DeleteBefore(ctx context.Context, before time.Time, limit uint) (int64, error)
+}
@@ -0,0 +1,241 @@
+// Package audit is the security audit log: an append-only record of logins,
+// refreshes, logouts and password changes, kept for Config.Retention.
+package audit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/netbill/restkit/pagi"
+)
+
+//go:generate mockery --name=auth --inpackage
+type auth interface {
+	ValidateSession(ctx context.Context, actor models.UserActor) (models.User, models.Session, error)
+}
+
+// pruneBatch caps the rows one DELETE removes, so catching up on a large
+// backlog does not hold a long lock on the table.
+const pruneBatch = 1000
+
+// pruneLockKey is the advisory lock that keeps replicas from pruning at the
+// same time.
+const pruneLockKey int64 = 0x61756469_7470726e // "auditprn"
+
+type Config struct {
+	// Retention is how long events are kept. Zero keeps them forever.
+	Retention time.Duration
+
+	// PruneInterval is how often RunRetention looks for expired events. It
+	// must be positive.
+	PruneInterval time.Duration
+}
+
+type Service struct {
+	config Config
+
+	auth      auth
+	eventRepo eventRepo
+	locks     locker
+	tx        transaction
+
+	log *log.Logger
+}
+
+type ServiceDeps struct {
+	Config Config
+
+	Auth      auth
+	EventRepo eventRepo
+	Locks     locker
+	Tx        transaction
+
+	Log *log.Logger
+}
+
+func New(deps ServiceDeps) *Service {
+	return &Service{
+		config:    deps.Config,
+		auth:      deps.Auth,
+		eventRepo: deps.EventRepo,
+		locks:     deps.Locks,
+		tx:        deps.Tx,
+		log:       deps.Log,
+	}
+}
+
+type FilterParams struct {
+	UserID  *uuid.UUID
+	Type    *string
+	Outcome *string
+	IP      *string
+	Since   *time.Time
+	Until   *time.Time
+}
+
+// NewEvent describes an operation of eventType on behalf of userID that
+// ended with err. userID is uuid.Nil when the operation named no existing
+// user.
+func NewEvent(eventType string, userID uuid.UUID, client models.SessionClient, err error) models.AuthEvent {
+	event := models.AuthEvent{
+		Type:      eventType,
+		Outcome:   models.AuthEventSuccess,
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
+	}
+	if userID != uuid.Nil {
+		event.UserID = &userID
+	}
+	if err != nil {
+		reason := Reason(err)
+		event.Outcome = models.AuthEventFailure
+		event.Reason = &reason
+	}
+
+	return event
+}
+
+// Reason is the short code a failure is stored under. It names the rule the
+// attempt broke without the details that went into the error message.
+func Reason(err error) string {
+	switch {
+	case errors.Is(err, errx.ErrorPasswordInvalid):
+		return "password_invalid"
+	case errors.Is(err, errx.ErrorUserNotFound), errors.Is(err, errx.ErrorUserDeleted):
+		return "user_not_found"
+	case errors.Is(err, errx.ErrorTooManyLoginAttempts):
+		return "too_many_attempts"
+	case errors.Is(err, errx.ErrorUserSuspended):
+		return "user_suspended"
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		return "mfa_code_invalid"
+	case errors.Is(err, errx.ErrorMFAChallengeInvalid):
+		return "mfa_challenge_invalid"
+	case errors.Is(err, errx.ErrorPasskeyInvalid), errors.Is(err, errx.ErrorPasskeyChallengeInvalid),
+		errors.Is(err, errx.ErrorPasskeyNotFound):
+		return "passkey_invalid"
+	case errors.Is(err, errx.ErrorIdentityNotLinked):
+		return "identity_not_linked"
+	case errors.Is(err, errx.ErrorSessionExpired):
+		return "session_expired"
+	case errors.Is(err, errx.ErrorSessionNotFound), errors.Is(err, errx.ErrorSessionDeleted),
+		errors.Is(err, errx.ErrorUserInvalidSession):
+		return "session_not_found"
+	case errors.Is(err, errx.ErrorSessionTokenMismatch):
+		return "token_mismatch"
+	case errors.Is(err, errx.ErrorSessionTokenReused):
+		return "token_reused"
+	case errors.Is(err, errx.ErrorSessionIdleTimeout):
+		return "session_idle_timeout"
+	case errors.Is(err, errx.ErrorSessionLifetimeExceeded):
+		return "session_lifetime_exceeded"
+	case errors.Is(err, errx.ErrorSessionEvicted):
+		return "session_evicted"
+	case errors.Is(err, errx.ErrorQRTokenNotFound), errors.Is(err, errx.ErrorQRTokenAlreadyConfirmed),
+		errors.Is(err, errx.ErrorQRTokenRejected):
+		return "qr_token_invalid"
+	case errors.Is(err, errx.ErrorQRCodeMismatch):
+		return "qr_code_mismatch"
+	case errors.Is(err, errx.ErrorPasswordIsNotAllowed), errors.Is(err, errx.ErrorCannotChangePasswordYet):
+		return "password_not_allowed"
+	case errors.Is(err, errx.ErrorPasswordResetTokenInvalid):
+		return "reset_token_invalid"
+	default:
+		return "internal_error"
+	}
+}
+
+// Record writes event to the log. The operation it describes has already
+// happened by then, so a failed write is logged rather than returned.
+func (s *Service) Record(ctx context.Context, event models.AuthEvent) {
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		s.log.WithError(err).Error("failed to record auth event", "type", event.Type, "outcome", event.Outcome)
+	}
+}
+
+// ListMyEvents returns the actor's own events, newest first.
+func (s *Service) ListMyEvents(
+	ctx context.Context,
+	actor models.UserActor,
+	limit, offset uint,
+) (pagi.Page[[]models.AuthEvent], error) {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return pagi.Page[[]models.AuthEvent]{}, err
+	}
+
+	return s.eventRepo.Filter(ctx, FilterParams{UserID: &actor.ID}, limit, offset)
+}
+
+// Prune deletes the events older than Config.Retention and reports how many
+// it deleted. Every batch is a transaction of its own holding the advisory
+// lock; when another replica holds it, Prune leaves the work to that replica
+// and returns.
+func (s *Service) Prune(ctx context.Context) (int64, error) {
+	if s.config.Retention <= 0 {
+		return 0, nil
+	}
+
+	before := time.Now().Add(-s.config.Retention)
+
+	var total int64
+	for {
+		var (
+			n      int64
+			locked bool
+		)
+		err := s.tx.Transaction(ctx, func(ctx context.Context) error {
+			var err error
+			locked, err = s.locks.TryXactLock(ctx, pruneLockKey)
+			if err != nil || !locked {
+				return err
+			}
+
+			n, err = s.eventRepo.DeleteBefore(ctx, before, pruneBatch)
+			return err
+		})
+		if err != nil {
+			return total, err
+		}
+		if !locked {
+			s.log.Debug("another replica is pruning auth events")
+			return total, nil
+		}
+
+		total += n
+		if n < pruneBatch {
+			return total, nil
+		}
+	}
+}
+
+// RunRetention prunes the log right away and then every
+// Config.PruneInterval until ctx is done.
+func (s *Service) RunRetention(ctx context.Context) {
+	if s.config.Retention <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.config.PruneInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.Prune(ctx)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			s.log.WithError(err).Error("failed to prune auth events")
+		case n > 0:
+			s.log.Info("pruned auth events", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
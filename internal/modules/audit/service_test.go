@@ -0,0 +1,155 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/netbill/restkit/pagi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeTx struct{}
+
+func (f *fakeTx) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type AuditServiceSuite struct {
+	suite.Suite
+
+	auth      *mockAuth
+	eventRepo *mockEventRepo
+	locks     *mockLocker
+
+	svc *Service
+}
+
+func (s *AuditServiceSuite) SetupTest() {
+	s.auth = newMockAuth(s.T())
+	s.eventRepo = newMockEventRepo(s.T())
+	s.locks = newMockLocker(s.T())
+
+	s.svc = New(ServiceDeps{
+		Config: Config{
+			Retention:     24 * time.Hour,
+			PruneInterval: time.Hour,
+		},
+		Auth:      s.auth,
+		EventRepo: s.eventRepo,
+		Locks:     s.locks,
+		Tx:        &fakeTx{},
+		Log:       log.New("error", "text", "test"),
+	})
+}
+
+func TestAuditService(t *testing.T) {
+	suite.Run(t, new(AuditServiceSuite))
+}
+
+// ─── NewEvent ───────────────────────────────────────────────────────────────
+
+func (s *AuditServiceSuite) TestNewEvent_Success() {
+	userID := uuid.New()
+	client := models.SessionClient{IP: "203.0.113.7", UserAgent: "curl/8.0"}
+
+	ev := NewEvent(models.AuthEventLogout, userID, client, nil)
+
+	assert.Equal(s.T(), models.AuthEventLogout, ev.Type)
+	assert.Equal(s.T(), models.AuthEventSuccess, ev.Outcome)
+	require.NotNil(s.T(), ev.UserID)
+	assert.Equal(s.T(), userID, *ev.UserID)
+	assert.Nil(s.T(), ev.Reason)
+	assert.Equal(s.T(), client.IP, ev.IP)
+	assert.Equal(s.T(), client.UserAgent, ev.UserAgent)
+}
+
+func (s *AuditServiceSuite) TestNewEvent_FailureWithoutUser() {
+	err := errx.ErrorTooManyLoginAttempts.Raise(errors.New("locked"))
+
+	ev := NewEvent(models.AuthEventLoginEmail, uuid.Nil, models.SessionClient{}, err)
+
+	assert.Equal(s.T(), models.AuthEventFailure, ev.Outcome)
+	assert.Nil(s.T(), ev.UserID)
+	require.NotNil(s.T(), ev.Reason)
+	assert.Equal(s.T(), "too_many_attempts", *ev.Reason)
+}
+
+func (s *AuditServiceSuite) TestReason_UnknownErrorIsInternal() {
+	assert.Equal(s.T(), "internal_error", Reason(errors.New("connection refused")))
+}
+
+// ─── Record ─────────────────────────────────────────────────────────────────
+
+func (s *AuditServiceSuite) TestRecord_RepoErrorIsSwallowed() {
+	ev := NewEvent(models.AuthEventRefresh, uuid.New(), models.SessionClient{}, nil)
+	s.eventRepo.On("Create", mock.Anything, ev).Return(errors.New("db down"))
+
+	s.svc.Record(context.Background(), ev)
+}
+
+// ─── ListMyEvents ───────────────────────────────────────────────────────────
+
+func (s *AuditServiceSuite) TestListMyEvents_OnlyActorEvents() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	page := pagi.Page[[]models.AuthEvent]{Data: []models.AuthEvent{{ID: uuid.New(), UserID: &actor.ID}}, Total: 1}
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{ID: actor.ID}, models.Session{}, nil)
+	s.eventRepo.On("Filter", mock.Anything, FilterParams{UserID: &actor.ID}, uint(20), uint(40)).Return(page, nil)
+
+	res, err := s.svc.ListMyEvents(context.Background(), actor, 20, 40)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), page, res)
+}
+
+func (s *AuditServiceSuite) TestListMyEvents_InvalidSession() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+
+	s.auth.On("ValidateSession", mock.Anything, actor).
+		Return(models.User{}, models.Session{}, errx.ErrorUserInvalidSession.Raise(errors.New("revoked")))
+
+	_, err := s.svc.ListMyEvents(context.Background(), actor, 20, 0)
+	assert.ErrorIs(s.T(), err, errx.ErrorUserInvalidSession)
+	s.eventRepo.AssertNotCalled(s.T(), "Filter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ─── Prune ──────────────────────────────────────────────────────────────────
+
+func (s *AuditServiceSuite) TestPrune_DeletesInBatches() {
+	cutoff := mock.MatchedBy(func(t time.Time) bool {
+		return time.Until(t) < -23*time.Hour
+	})
+	s.locks.On("TryXactLock", mock.Anything, pruneLockKey).Return(true, nil).Twice()
+	s.eventRepo.On("DeleteBefore", mock.Anything, cutoff, uint(pruneBatch)).Return(int64(pruneBatch), nil).Once()
+	s.eventRepo.On("DeleteBefore", mock.Anything, cutoff, uint(pruneBatch)).Return(int64(7), nil).Once()
+
+	n, err := s.svc.Prune(context.Background())
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(pruneBatch+7), n)
+}
+
+func (s *AuditServiceSuite) TestPrune_AnotherReplicaHoldsLock() {
+	s.locks.On("TryXactLock", mock.Anything, pruneLockKey).Return(false, nil).Once()
+
+	n, err := s.svc.Prune(context.Background())
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), n)
+	s.eventRepo.AssertNotCalled(s.T(), "DeleteBefore", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *AuditServiceSuite) TestPrune_ZeroRetentionKeepsEverything() {
+	s.svc.config.Retention = 0
+
+	n, err := s.svc.Prune(context.Background())
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), n)
+	s.eventRepo.AssertNotCalled(s.T(), "DeleteBefore", mock.Anything, mock.Anything, mock.Anything)
+}
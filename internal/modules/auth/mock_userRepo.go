@@ -8,6 +8,8 @@ import (
 	models "github.com/netbill/auth-svc/internal/models"
 	mock "github.com/stretchr/testify/mock"
 
+	user "github.com/netbill/auth-svc/internal/modules/user"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -16,9 +18,16 @@ type mockUserRepo struct {
 	mock.Mock
 }
 
-// GetByID provides a mock function with given fields: ctx, userID
-func (_m *mockUserRepo) GetByID(ctx context.Context, userID uuid.UUID) (models.User, error) {
-	ret := _m.Called(ctx, userID)
+// GetByID provides a mock function with given fields: ctx, userID, opts
+func (_m *mockUserRepo) GetByID(ctx context.Context, userID uuid.UUID, opts ...user.GetUserOption) (models.User, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, userID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -26,17 +35,17 @@ func (_m *mockUserRepo) GetByID(ctx context.Context, userID uuid.UUID) (models.U
 
 	var r0 models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.User, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...user.GetUserOption) (models.User, error)); ok {
+		return rf(ctx, userID, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.User); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...user.GetUserOption) models.User); ok {
+		r0 = rf(ctx, userID, opts...)
 	} else {
 		r0 = ret.Get(0).(models.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...user.GetUserOption) error); ok {
+		r1 = rf(ctx, userID, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
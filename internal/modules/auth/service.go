@@ -4,15 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/errx"
 	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/user"
 )
 
 //go:generate mockery --name=userRepo --inpackage
 type userRepo interface {
-	GetByID(ctx context.Context, userID uuid.UUID) (models.User, error)
+	GetByID(ctx context.Context, userID uuid.UUID, opts ...user.GetUserOption) (models.User, error)
 }
 
 //go:generate mockery --name=sessionRepo --inpackage
@@ -51,6 +53,12 @@ func (s *Service) ValidateSession(
 		return models.User{}, models.Session{}, err
 	}
 
+	if user.Suspended(time.Now()) {
+		return models.User{}, models.Session{}, errx.ErrorUserSuspended.Raise(
+			fmt.Errorf("user %s is suspended", actor.ID),
+		)
+	}
+
 	session, err = s.sessionRepo.GetByID(ctx, actor.SessionID)
 	switch {
 	case errors.Is(err, errx.ErrorSessionNotFound):
@@ -61,5 +69,11 @@ func (s *Service) ValidateSession(
 		return models.User{}, models.Session{}, err
 	}
 
+	if session.DeletedAt != nil {
+		return models.User{}, models.Session{}, errx.ErrorUserInvalidSession.Raise(
+			fmt.Errorf("session %s is deleted", actor.SessionID),
+		)
+	}
+
 	return user, session, nil
 }
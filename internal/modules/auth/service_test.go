@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/errx"
@@ -83,6 +84,35 @@ func (s *AuthServiceSuite) TestValidateSession_UserRepoError() {
 	assert.ErrorIs(s.T(), err, repoErr)
 }
 
+func (s *AuthServiceSuite) TestValidateSession_UserSuspended() {
+	userID := uuid.New()
+	suspendedAt := time.Now().Add(-time.Hour)
+	actor := models.UserActor{ID: userID, SessionID: uuid.New()}
+
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID, SuspendedAt: &suspendedAt}, nil)
+
+	_, _, err := s.svc.ValidateSession(context.Background(), actor)
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorUserSuspended)
+}
+
+func (s *AuthServiceSuite) TestValidateSession_SuspensionEnded() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	suspendedAt := time.Now().Add(-2 * time.Hour)
+	suspendedUntil := time.Now().Add(-time.Hour)
+	user := models.User{ID: userID, SuspendedAt: &suspendedAt, SuspendedUntil: &suspendedUntil}
+	actor := models.UserActor{ID: userID, SessionID: sessionID}
+
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.sessionRepo.On("GetByID", mock.Anything, sessionID).Return(models.Session{ID: sessionID}, nil)
+
+	_, _, err := s.svc.ValidateSession(context.Background(), actor)
+
+	require.NoError(s.T(), err)
+}
+
 func (s *AuthServiceSuite) TestValidateSession_SessionNotFound() {
 	userID := uuid.New()
 	sessionID := uuid.New()
@@ -98,6 +128,22 @@ func (s *AuthServiceSuite) TestValidateSession_SessionNotFound() {
 	assert.ErrorIs(s.T(), err, errx.ErrorUserInvalidSession)
 }
 
+func (s *AuthServiceSuite) TestValidateSession_SessionDeleted() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	deletedAt := time.Now().Add(-time.Minute)
+	user := models.User{ID: userID}
+	actor := models.UserActor{ID: userID, SessionID: sessionID}
+
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.sessionRepo.On("GetByID", mock.Anything, sessionID).Return(models.Session{ID: sessionID, DeletedAt: &deletedAt}, nil)
+
+	_, _, err := s.svc.ValidateSession(context.Background(), actor)
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorUserInvalidSession)
+}
+
 func (s *AuthServiceSuite) TestValidateSession_SessionRepoError() {
 	userID := uuid.New()
 	sessionID := uuid.New()
@@ -0,0 +1,76 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/netbill/auth-svc/pkg/totp"
+)
+
+const (
+	defaultRecoveryCodes = 10
+
+	// recoveryCodeSize is 80 bits, rendered as four groups of four base32
+	// characters.
+	recoveryCodeSize = 10
+)
+
+var recoveryEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// newRecoveryCodes returns codes to show to the user and the hashes to store.
+func (s *Service) newRecoveryCodes() (codes, hashes []string, err error) {
+	n := s.config.RecoveryCodes
+	if n <= 0 {
+		n = defaultRecoveryCodes
+	}
+
+	codes = make([]string, n)
+	hashes = make([]string, n)
+	for i := range codes {
+		b := make([]byte, recoveryCodeSize)
+		if _, err = rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+
+		raw := recoveryEncoding.EncodeToString(b)
+		codes[i] = raw[0:4] + "-" + raw[4:8] + "-" + raw[8:12] + "-" + raw[12:16]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores case, dashes and spaces, so the user may type the
+// code however it was written down.
+func hashRecoveryCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// looksLikeTOTP tells authenticator codes apart from recovery codes, which
+// are longer and contain letters.
+func looksLikeTOTP(code string) bool {
+	code = strings.TrimSpace(code)
+	if len(code) != totp.Digits {
+		return false
+	}
+
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,37 @@
+package mfa
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+//go:generate mockery --name=transaction --inpackage
+type transaction interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+//go:generate mockery --name=mfaRepo --inpackage
+type mfaRepo interface {
+	UpsertTOTP(ctx context.Context, userID uuid.UUID, secret []byte) (models.UserTOTP, error)
+	GetTOTP(ctx context.Context, userID uuid.UUID) (models.UserTOTP, error)
+	ConfirmTOTP(ctx context.Context, userID uuid.UUID, step int64) (models.UserTOTP, error)
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error
+	DeleteTOTP(ctx context.Context, userID uuid.UUID) error
+
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, hashes []string) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) error
+	CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error)
+	DeleteRecoveryCodes(ctx context.Context, userID uuid.UUID) error
+}
+
+//go:generate mockery --name=passwordRepo --inpackage
+type passwordRepo interface {
+	GetByID(ctx context.Context, userID uuid.UUID) (models.UserPassword, error)
+}
+
+//go:generate mockery --name=passwordCache --inpackage
+type passwordCache interface {
+	Get(ctx context.Context, userID uuid.UUID) (models.UserPassword, error)
+}
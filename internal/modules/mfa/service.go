@@ -0,0 +1,397 @@
+package mfa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/totp"
+)
+
+//go:generate mockery --name=auth --inpackage
+type auth interface {
+	ValidateSession(ctx context.Context, actor models.UserActor) (models.User, models.Session, error)
+}
+
+//go:generate mockery --name=passwordManager --inpackage
+type passwordManager interface {
+	CheckMatch(password, hash string) error
+}
+
+// secretBox seals TOTP secrets before they reach the database.
+//
+//go:generate mockery --name=secretBox --inpackage
+type secretBox interface {
+	Seal(plaintext []byte) ([]byte, error)
+	Open(sealed []byte) ([]byte, error)
+}
+
+// attemptLimiter bounds wrong re-authentication attempts per user, under the
+// same budget as the second factor codes given at login.
+//
+//go:generate mockery --name=attemptLimiter --inpackage
+type attemptLimiter interface {
+	CheckLocked(ctx context.Context, userID uuid.UUID) error
+	RecordFailure(ctx context.Context, userID uuid.UUID)
+	Reset(ctx context.Context, userID uuid.UUID)
+}
+
+var errNoEncryptionKey = errors.New("no encryption key configured for totp secrets")
+
+// noBox stands in for the secret box when no encryption key is configured,
+// so a deployment that doesn't use TOTP starts without one. Enrolling or
+// checking a TOTP code fails instead.
+type noBox struct{}
+
+func (noBox) Seal([]byte) ([]byte, error) { return nil, errNoEncryptionKey }
+func (noBox) Open([]byte) ([]byte, error) { return nil, errNoEncryptionKey }
+
+// noLimits stands in for the limiter when none is configured.
+type noLimits struct{}
+
+func (noLimits) CheckLocked(context.Context, uuid.UUID) error { return nil }
+func (noLimits) RecordFailure(context.Context, uuid.UUID)     {}
+func (noLimits) Reset(context.Context, uuid.UUID)             {}
+
+type Config struct {
+	// Issuer is the name authenticator apps show next to the account.
+	Issuer string
+
+	// RecoveryCodes is how many one-time recovery codes a user gets.
+	RecoveryCodes int
+}
+
+type Service struct {
+	config Config
+
+	auth auth
+
+	mfaRepo      mfaRepo
+	passwordRepo passwordRepo
+	tx           transaction
+
+	passwordCache passwordCache
+
+	passManager passwordManager
+	box         secretBox
+	attempts    attemptLimiter
+}
+
+type ServiceDeps struct {
+	Config Config
+
+	Auth auth
+
+	MFARepo      mfaRepo
+	PasswordRepo passwordRepo
+	Tx           transaction
+
+	PasswordCache passwordCache
+
+	PassManager passwordManager
+
+	// Box seals TOTP secrets. Without one TOTP can't be enrolled or verified;
+	// recovery codes keep working.
+	Box secretBox
+
+	// Attempts counts wrong codes given to re-authenticate. Without it they
+	// are not limited.
+	Attempts attemptLimiter
+}
+
+func New(deps ServiceDeps) *Service {
+	if deps.Box == nil {
+		deps.Box = noBox{}
+	}
+	if deps.Attempts == nil {
+		deps.Attempts = noLimits{}
+	}
+
+	return &Service{
+		config:        deps.Config,
+		auth:          deps.Auth,
+		mfaRepo:       deps.MFARepo,
+		passwordRepo:  deps.PasswordRepo,
+		tx:            deps.Tx,
+		passwordCache: deps.PasswordCache,
+		passManager:   deps.PassManager,
+		box:           deps.Box,
+		attempts:      deps.Attempts,
+	}
+}
+
+func (s *Service) GetMyStatus(
+	ctx context.Context,
+	actor models.UserActor,
+) (models.MFAStatus, error) {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return models.MFAStatus{}, err
+	}
+
+	t, err := s.mfaRepo.GetTOTP(ctx, actor.ID)
+	switch {
+	case errors.Is(err, errx.ErrorMFANotEnabled):
+		return models.MFAStatus{}, nil
+	case err != nil:
+		return models.MFAStatus{}, err
+	case !t.Enabled():
+		return models.MFAStatus{}, nil
+	}
+
+	remaining, err := s.mfaRepo.CountRecoveryCodes(ctx, actor.ID)
+	if err != nil {
+		return models.MFAStatus{}, err
+	}
+
+	return models.MFAStatus{
+		Enabled:                true,
+		EnabledAt:              t.ConfirmedAt,
+		RecoveryCodesRemaining: remaining,
+	}, nil
+}
+
+// EnrollTOTP starts setting up an authenticator. MFA stays off until the
+// enrollment is confirmed with a code; enrolling again before that replaces
+// the secret.
+func (s *Service) EnrollTOTP(
+	ctx context.Context,
+	actor models.UserActor,
+) (models.TOTPEnrollment, error) {
+	user, _, err := s.auth.ValidateSession(ctx, actor)
+	if err != nil {
+		return models.TOTPEnrollment{}, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return models.TOTPEnrollment{}, err
+	}
+
+	sealed, err := s.box.Seal([]byte(secret))
+	if err != nil {
+		return models.TOTPEnrollment{}, fmt.Errorf("seal totp secret: %w", err)
+	}
+
+	if _, err = s.mfaRepo.UpsertTOTP(ctx, actor.ID, sealed); err != nil {
+		return models.TOTPEnrollment{}, err
+	}
+
+	return models.TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.URI(s.config.Issuer, user.Username, secret),
+	}, nil
+}
+
+// ConfirmTOTP enables MFA once the user proves their app produces valid
+// codes, and returns the recovery codes. They are shown this one time only.
+func (s *Service) ConfirmTOTP(
+	ctx context.Context,
+	actor models.UserActor,
+	code string,
+) ([]string, error) {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return nil, err
+	}
+
+	t, err := s.mfaRepo.GetTOTP(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Enabled() {
+		return nil, errx.ErrorMFAAlreadyEnabled.Raise(
+			fmt.Errorf("totp of user %s is already confirmed", actor.ID),
+		)
+	}
+
+	step, err := s.validateTOTP(t, code)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := s.newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		if _, err = s.mfaRepo.ConfirmTOTP(ctx, actor.ID, step); err != nil {
+			return err
+		}
+
+		return s.mfaRepo.ReplaceRecoveryCodes(ctx, actor.ID, hashes)
+	}); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// DisableTOTP turns MFA off and drops the recovery codes. The password is
+// asked again so a stolen session alone can't strip the second factor; an
+// account without a password gives a current TOTP or recovery code instead.
+func (s *Service) DisableTOTP(
+	ctx context.Context,
+	actor models.UserActor,
+	password, code string,
+) error {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return err
+	}
+
+	if err := s.reauthenticate(ctx, actor.ID, password, code); err != nil {
+		return err
+	}
+
+	return s.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.mfaRepo.DeleteTOTP(ctx, actor.ID); err != nil {
+			return err
+		}
+
+		return s.mfaRepo.DeleteRecoveryCodes(ctx, actor.ID)
+	})
+}
+
+// RegenerateRecoveryCodes replaces every recovery code of the user, used or
+// not, with a fresh set. It re-authenticates the same way DisableTOTP does.
+func (s *Service) RegenerateRecoveryCodes(
+	ctx context.Context,
+	actor models.UserActor,
+	password, code string,
+) ([]string, error) {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return nil, err
+	}
+
+	if err := s.reauthenticate(ctx, actor.ID, password, code); err != nil {
+		return nil, err
+	}
+
+	t, err := s.mfaRepo.GetTOTP(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !t.Enabled() {
+		return nil, errx.ErrorMFANotEnabled.Raise(
+			fmt.Errorf("totp of user %s is not confirmed", actor.ID),
+		)
+	}
+
+	codes, hashes, err := s.newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		return s.mfaRepo.ReplaceRecoveryCodes(ctx, actor.ID, hashes)
+	}); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// IsEnabled reports whether logins of the user need a second factor.
+func (s *Service) IsEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	t, err := s.mfaRepo.GetTOTP(ctx, userID)
+	switch {
+	case errors.Is(err, errx.ErrorMFANotEnabled):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	return t.Enabled(), nil
+}
+
+// Verify checks a second factor presented at login: either a code from the
+// authenticator app or one of the recovery codes. Either is spent on success.
+func (s *Service) Verify(ctx context.Context, userID uuid.UUID, code string) error {
+	t, err := s.mfaRepo.GetTOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !t.Enabled() {
+		return errx.ErrorMFANotEnabled.Raise(
+			fmt.Errorf("totp of user %s is not confirmed", userID),
+		)
+	}
+
+	if !looksLikeTOTP(code) {
+		return s.mfaRepo.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+	}
+
+	step, err := s.validateTOTP(t, code)
+	if err != nil {
+		return err
+	}
+
+	return s.mfaRepo.UseTOTPStep(ctx, userID, step)
+}
+
+func (s *Service) validateTOTP(t models.UserTOTP, code string) (int64, error) {
+	secret, err := s.box.Open(t.Secret)
+	if err != nil {
+		return 0, fmt.Errorf("open totp secret of user %s: %w", t.UserID, err)
+	}
+
+	step, ok := totp.Validate(string(secret), code, time.Now())
+	if !ok {
+		return 0, errx.ErrorMFACodeInvalid.Raise(
+			fmt.Errorf("totp code of user %s does not match", t.UserID),
+		)
+	}
+
+	return step, nil
+}
+
+// reauthenticate checks the password of the user. Accounts created through an
+// identity provider have none, so for them a second factor code is checked
+// and spent instead; the code is ignored while the user has a password.
+// Wrong passwords and codes count against the same per-user limit as codes at
+// login, otherwise a stolen access token would get unlimited guesses.
+func (s *Service) reauthenticate(ctx context.Context, userID uuid.UUID, password, code string) error {
+	if err := s.attempts.CheckLocked(ctx, userID); err != nil {
+		return err
+	}
+
+	err := s.checkPassword(ctx, userID, password)
+	switch {
+	case errors.Is(err, errx.ErrorPasswordInvalid):
+		s.attempts.RecordFailure(ctx, userID)
+		return err
+	case !errors.Is(err, errx.ErrorPasswordNotSet) || code == "":
+		return err
+	}
+
+	err = s.Verify(ctx, userID, code)
+	switch {
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		s.attempts.RecordFailure(ctx, userID)
+		return err
+	case err != nil:
+		return err
+	}
+
+	s.attempts.Reset(ctx, userID)
+
+	return nil
+}
+
+func (s *Service) checkPassword(ctx context.Context, userID uuid.UUID, password string) error {
+	pwd, err := s.passwordCache.Get(ctx, userID)
+	if err != nil {
+		pwd, err = s.passwordRepo.GetByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.passManager.CheckMatch(password, pwd.Hash)
+}
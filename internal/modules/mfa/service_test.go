@@ -0,0 +1,483 @@
+package mfa
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeTx struct{}
+
+func (f *fakeTx) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+const testSecret = "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+
+type MFAServiceSuite struct {
+	suite.Suite
+
+	auth          *mockAuth
+	mfaRepo       *mockMfaRepo
+	passwordRepo  *mockPasswordRepo
+	passwordCache *mockPasswordCache
+	passManager   *mockPasswordManager
+	box           *mockSecretBox
+	attempts      *mockAttemptLimiter
+
+	svc *Service
+}
+
+func (s *MFAServiceSuite) SetupTest() {
+	s.auth = newMockAuth(s.T())
+	s.mfaRepo = newMockMfaRepo(s.T())
+	s.passwordRepo = newMockPasswordRepo(s.T())
+	s.passwordCache = newMockPasswordCache(s.T())
+	s.passManager = newMockPasswordManager(s.T())
+	s.box = newMockSecretBox(s.T())
+	s.attempts = newMockAttemptLimiter(s.T())
+
+	s.svc = New(ServiceDeps{
+		Config:        Config{Issuer: "netbill", RecoveryCodes: 4},
+		Auth:          s.auth,
+		MFARepo:       s.mfaRepo,
+		PasswordRepo:  s.passwordRepo,
+		Tx:            &fakeTx{},
+		PasswordCache: s.passwordCache,
+		PassManager:   s.passManager,
+		Box:           s.box,
+		Attempts:      s.attempts,
+	})
+}
+
+func TestMFAService(t *testing.T) {
+	suite.Run(t, new(MFAServiceSuite))
+}
+
+func (s *MFAServiceSuite) validSession(actor models.UserActor) {
+	s.auth.On("ValidateSession", mock.Anything, actor).
+		Return(models.User{ID: actor.ID, Username: "alice"}, models.Session{}, nil)
+}
+
+func confirmedTOTP(userID uuid.UUID) models.UserTOTP {
+	now := time.Now()
+	return models.UserTOTP{UserID: userID, Secret: []byte("sealed"), ConfirmedAt: &now}
+}
+
+func currentCode(s *MFAServiceSuite) string {
+	code, err := totp.Code(testSecret, time.Now())
+	require.NoError(s.T(), err)
+	return code
+}
+
+// ─── GetMyStatus ─────────────────────────────────────────────────────────────
+
+func (s *MFAServiceSuite) TestGetMyStatus_NotEnrolled() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.validSession(actor)
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).
+		Return(models.UserTOTP{}, errx.ErrorMFANotEnabled.Raise(errors.New("no rows")))
+
+	status, err := s.svc.GetMyStatus(context.Background(), actor)
+
+	require.NoError(s.T(), err)
+	assert.False(s.T(), status.Enabled)
+}
+
+func (s *MFAServiceSuite) TestGetMyStatus_Pending() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.validSession(actor)
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).Return(models.UserTOTP{UserID: actor.ID}, nil)
+
+	status, err := s.svc.GetMyStatus(context.Background(), actor)
+
+	require.NoError(s.T(), err)
+	assert.False(s.T(), status.Enabled)
+}
+
+func (s *MFAServiceSuite) TestGetMyStatus_Enabled() {
+	actor := models.UserActor{ID: uuid.New()}
+	t := confirmedTOTP(actor.ID)
+	s.validSession(actor)
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).Return(t, nil)
+	s.mfaRepo.On("CountRecoveryCodes", mock.Anything, actor.ID).Return(7, nil)
+
+	status, err := s.svc.GetMyStatus(context.Background(), actor)
+
+	require.NoError(s.T(), err)
+	assert.True(s.T(), status.Enabled)
+	assert.Equal(s.T(), t.ConfirmedAt, status.EnabledAt)
+	assert.Equal(s.T(), 7, status.RecoveryCodesRemaining)
+}
+
+// ─── EnrollTOTP ──────────────────────────────────────────────────────────────
+
+func (s *MFAServiceSuite) TestEnrollTOTP_ValidateSessionError() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.auth.On("ValidateSession", mock.Anything, actor).
+		Return(models.User{}, models.Session{}, errx.ErrorUserInvalidSession.Raise(errors.New("bad")))
+
+	_, err := s.svc.EnrollTOTP(context.Background(), actor)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorUserInvalidSession)
+}
+
+func (s *MFAServiceSuite) TestEnrollTOTP_AlreadyEnabled() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.validSession(actor)
+	s.box.On("Seal", mock.Anything).Return([]byte("sealed"), nil)
+	s.mfaRepo.On("UpsertTOTP", mock.Anything, actor.ID, []byte("sealed")).
+		Return(models.UserTOTP{}, errx.ErrorMFAAlreadyEnabled.Raise(errors.New("confirmed")))
+
+	_, err := s.svc.EnrollTOTP(context.Background(), actor)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorMFAAlreadyEnabled)
+}
+
+func (s *MFAServiceSuite) TestEnrollTOTP_HappyPath() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.validSession(actor)
+
+	var sealedPlain []byte
+	s.box.On("Seal", mock.Anything).Run(func(args mock.Arguments) {
+		sealedPlain = args.Get(0).([]byte)
+	}).Return([]byte("sealed"), nil)
+	s.mfaRepo.On("UpsertTOTP", mock.Anything, actor.ID, []byte("sealed")).Return(models.UserTOTP{}, nil)
+
+	got, err := s.svc.EnrollTOTP(context.Background(), actor)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), got.Secret, string(sealedPlain))
+	assert.Contains(s.T(), got.URI, "otpauth://totp/netbill:alice")
+}
+
+func (s *MFAServiceSuite) TestEnrollTOTP_NoEncryptionKey() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.validSession(actor)
+
+	svc := New(ServiceDeps{Config: Config{Issuer: "netbill"}, Auth: s.auth, MFARepo: s.mfaRepo})
+
+	_, err := svc.EnrollTOTP(context.Background(), actor)
+
+	assert.ErrorIs(s.T(), err, errNoEncryptionKey)
+	s.mfaRepo.AssertNotCalled(s.T(), "UpsertTOTP", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ─── ConfirmTOTP ─────────────────────────────────────────────────────────────
+
+func (s *MFAServiceSuite) TestConfirmTOTP_AlreadyEnabled() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.validSession(actor)
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).Return(confirmedTOTP(actor.ID), nil)
+
+	_, err := s.svc.ConfirmTOTP(context.Background(), actor, "123456")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorMFAAlreadyEnabled)
+}
+
+func (s *MFAServiceSuite) TestConfirmTOTP_WrongCode() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.validSession(actor)
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).Return(models.UserTOTP{UserID: actor.ID, Secret: []byte("sealed")}, nil)
+	s.box.On("Open", []byte("sealed")).Return([]byte(testSecret), nil)
+
+	_, err := s.svc.ConfirmTOTP(context.Background(), actor, "abcdef")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorMFACodeInvalid)
+	s.mfaRepo.AssertNotCalled(s.T(), "ConfirmTOTP", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *MFAServiceSuite) TestConfirmTOTP_HappyPath() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.validSession(actor)
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).Return(models.UserTOTP{UserID: actor.ID, Secret: []byte("sealed")}, nil)
+	s.box.On("Open", []byte("sealed")).Return([]byte(testSecret), nil)
+	s.mfaRepo.On("ConfirmTOTP", mock.Anything, actor.ID, mock.AnythingOfType("int64")).Return(confirmedTOTP(actor.ID), nil)
+
+	var stored []string
+	s.mfaRepo.On("ReplaceRecoveryCodes", mock.Anything, actor.ID, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(2).([]string)
+	}).Return(nil)
+
+	codes, err := s.svc.ConfirmTOTP(context.Background(), actor, currentCode(s))
+
+	require.NoError(s.T(), err)
+	require.Len(s.T(), codes, 4)
+	require.Len(s.T(), stored, 4)
+	for i, code := range codes {
+		assert.Regexp(s.T(), `^[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}$`, code)
+		assert.Equal(s.T(), hashRecoveryCode(code), stored[i])
+	}
+}
+
+// ─── DisableTOTP ─────────────────────────────────────────────────────────────
+
+func (s *MFAServiceSuite) TestDisableTOTP_WrongPassword() {
+	actor := models.UserActor{ID: uuid.New()}
+	pwd := models.UserPassword{UserID: actor.ID, Hash: "hash"}
+	checkErr := errx.ErrorPasswordInvalid.Raise(errors.New("password mismatch"))
+
+	s.validSession(actor)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(nil)
+	s.attempts.On("RecordFailure", mock.Anything, actor.ID).Return().Once()
+	s.passwordCache.On("Get", mock.Anything, actor.ID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "wrong", pwd.Hash).Return(checkErr)
+
+	err := s.svc.DisableTOTP(context.Background(), actor, "wrong", "")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasswordInvalid)
+	s.mfaRepo.AssertNotCalled(s.T(), "DeleteTOTP", mock.Anything, mock.Anything)
+}
+
+func (s *MFAServiceSuite) TestDisableTOTP_LockedOut() {
+	actor := models.UserActor{ID: uuid.New()}
+	lockErr := errx.ErrorTooManyLoginAttempts.Raise(errors.New("locked"))
+
+	s.validSession(actor)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(lockErr)
+
+	err := s.svc.DisableTOTP(context.Background(), actor, "Password1!", "")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorTooManyLoginAttempts)
+	s.passManager.AssertNotCalled(s.T(), "CheckMatch", mock.Anything, mock.Anything)
+	s.mfaRepo.AssertNotCalled(s.T(), "DeleteTOTP", mock.Anything, mock.Anything)
+}
+
+func (s *MFAServiceSuite) TestDisableTOTP_HappyPath() {
+	actor := models.UserActor{ID: uuid.New()}
+	pwd := models.UserPassword{UserID: actor.ID, Hash: "hash"}
+
+	s.validSession(actor)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(nil)
+	s.passwordCache.On("Get", mock.Anything, actor.ID).Return(models.UserPassword{}, errors.New("miss"))
+	s.passwordRepo.On("GetByID", mock.Anything, actor.ID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.mfaRepo.On("DeleteTOTP", mock.Anything, actor.ID).Return(nil)
+	s.mfaRepo.On("DeleteRecoveryCodes", mock.Anything, actor.ID).Return(nil)
+
+	err := s.svc.DisableTOTP(context.Background(), actor, "Password1!", "")
+
+	require.NoError(s.T(), err)
+}
+
+func (s *MFAServiceSuite) passwordNotSet(userID uuid.UUID) {
+	s.passwordCache.On("Get", mock.Anything, userID).Return(models.UserPassword{}, errors.New("miss"))
+	s.passwordRepo.On("GetByID", mock.Anything, userID).
+		Return(models.UserPassword{}, errx.ErrorPasswordNotSet.Raise(errors.New("no rows")))
+}
+
+func (s *MFAServiceSuite) TestDisableTOTP_NoPassword_TOTPCode() {
+	actor := models.UserActor{ID: uuid.New()}
+
+	s.validSession(actor)
+	s.passwordNotSet(actor.ID)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(nil)
+	s.attempts.On("Reset", mock.Anything, actor.ID).Return()
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).Return(confirmedTOTP(actor.ID), nil)
+	s.box.On("Open", []byte("sealed")).Return([]byte(testSecret), nil)
+	s.mfaRepo.On("UseTOTPStep", mock.Anything, actor.ID, mock.AnythingOfType("int64")).Return(nil)
+	s.mfaRepo.On("DeleteTOTP", mock.Anything, actor.ID).Return(nil)
+	s.mfaRepo.On("DeleteRecoveryCodes", mock.Anything, actor.ID).Return(nil)
+
+	err := s.svc.DisableTOTP(context.Background(), actor, "", currentCode(s))
+
+	require.NoError(s.T(), err)
+}
+
+func (s *MFAServiceSuite) TestDisableTOTP_NoPassword_WrongCode() {
+	actor := models.UserActor{ID: uuid.New()}
+
+	s.validSession(actor)
+	s.passwordNotSet(actor.ID)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(nil)
+	s.attempts.On("RecordFailure", mock.Anything, actor.ID).Return().Once()
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).Return(confirmedTOTP(actor.ID), nil)
+	s.mfaRepo.On("UseRecoveryCode", mock.Anything, actor.ID, hashRecoveryCode("aaaa-bbbb-cccc-dddd")).
+		Return(errx.ErrorMFACodeInvalid.Raise(errors.New("no rows")))
+
+	err := s.svc.DisableTOTP(context.Background(), actor, "", "aaaa-bbbb-cccc-dddd")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorMFACodeInvalid)
+	s.mfaRepo.AssertNotCalled(s.T(), "DeleteTOTP", mock.Anything, mock.Anything)
+}
+
+func (s *MFAServiceSuite) TestDisableTOTP_NoPassword_LockedOut() {
+	actor := models.UserActor{ID: uuid.New()}
+	lockErr := errx.ErrorTooManyLoginAttempts.Raise(errors.New("locked"))
+
+	s.validSession(actor)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(lockErr)
+
+	err := s.svc.DisableTOTP(context.Background(), actor, "", currentCode(s))
+
+	assert.ErrorIs(s.T(), err, errx.ErrorTooManyLoginAttempts)
+	s.mfaRepo.AssertNotCalled(s.T(), "GetTOTP", mock.Anything, mock.Anything)
+	s.mfaRepo.AssertNotCalled(s.T(), "DeleteTOTP", mock.Anything, mock.Anything)
+}
+
+func (s *MFAServiceSuite) TestDisableTOTP_NoPassword_NoCode() {
+	actor := models.UserActor{ID: uuid.New()}
+
+	s.validSession(actor)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(nil)
+	s.passwordNotSet(actor.ID)
+
+	err := s.svc.DisableTOTP(context.Background(), actor, "", "")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasswordNotSet)
+	s.mfaRepo.AssertNotCalled(s.T(), "DeleteTOTP", mock.Anything, mock.Anything)
+}
+
+func (s *MFAServiceSuite) TestDisableTOTP_CodeDoesNotReplacePassword() {
+	actor := models.UserActor{ID: uuid.New()}
+	pwd := models.UserPassword{UserID: actor.ID, Hash: "hash"}
+	checkErr := errx.ErrorPasswordInvalid.Raise(errors.New("password mismatch"))
+
+	s.validSession(actor)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(nil)
+	s.attempts.On("RecordFailure", mock.Anything, actor.ID).Return().Once()
+	s.passwordCache.On("Get", mock.Anything, actor.ID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "", pwd.Hash).Return(checkErr)
+
+	err := s.svc.DisableTOTP(context.Background(), actor, "", currentCode(s))
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasswordInvalid)
+	s.mfaRepo.AssertNotCalled(s.T(), "GetTOTP", mock.Anything, mock.Anything)
+	s.mfaRepo.AssertNotCalled(s.T(), "DeleteTOTP", mock.Anything, mock.Anything)
+}
+
+// ─── RegenerateRecoveryCodes ─────────────────────────────────────────────────
+
+func (s *MFAServiceSuite) TestRegenerateRecoveryCodes_NotEnabled() {
+	actor := models.UserActor{ID: uuid.New()}
+	pwd := models.UserPassword{UserID: actor.ID, Hash: "hash"}
+
+	s.validSession(actor)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(nil)
+	s.passwordCache.On("Get", mock.Anything, actor.ID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).Return(models.UserTOTP{UserID: actor.ID}, nil)
+
+	_, err := s.svc.RegenerateRecoveryCodes(context.Background(), actor, "Password1!", "")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorMFANotEnabled)
+}
+
+func (s *MFAServiceSuite) TestRegenerateRecoveryCodes_HappyPath() {
+	actor := models.UserActor{ID: uuid.New()}
+	pwd := models.UserPassword{UserID: actor.ID, Hash: "hash"}
+
+	s.validSession(actor)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(nil)
+	s.passwordCache.On("Get", mock.Anything, actor.ID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).Return(confirmedTOTP(actor.ID), nil)
+	s.mfaRepo.On("ReplaceRecoveryCodes", mock.Anything, actor.ID, mock.Anything).Return(nil)
+
+	codes, err := s.svc.RegenerateRecoveryCodes(context.Background(), actor, "Password1!", "")
+
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), codes, 4)
+}
+
+func (s *MFAServiceSuite) TestRegenerateRecoveryCodes_NoPassword_RecoveryCode() {
+	actor := models.UserActor{ID: uuid.New()}
+
+	s.validSession(actor)
+	s.passwordNotSet(actor.ID)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(nil)
+	s.attempts.On("Reset", mock.Anything, actor.ID).Return()
+	s.mfaRepo.On("GetTOTP", mock.Anything, actor.ID).Return(confirmedTOTP(actor.ID), nil)
+	s.mfaRepo.On("UseRecoveryCode", mock.Anything, actor.ID, hashRecoveryCode("aaaa-bbbb-cccc-dddd")).Return(nil)
+	s.mfaRepo.On("ReplaceRecoveryCodes", mock.Anything, actor.ID, mock.Anything).Return(nil)
+
+	codes, err := s.svc.RegenerateRecoveryCodes(context.Background(), actor, "", "aaaa-bbbb-cccc-dddd")
+
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), codes, 4)
+}
+
+func (s *MFAServiceSuite) TestRegenerateRecoveryCodes_NoPassword_LockedOut() {
+	actor := models.UserActor{ID: uuid.New()}
+	lockErr := errx.ErrorTooManyLoginAttempts.Raise(errors.New("locked"))
+
+	s.validSession(actor)
+	s.attempts.On("CheckLocked", mock.Anything, actor.ID).Return(lockErr)
+
+	_, err := s.svc.RegenerateRecoveryCodes(context.Background(), actor, "", "aaaa-bbbb-cccc-dddd")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorTooManyLoginAttempts)
+	s.mfaRepo.AssertNotCalled(s.T(), "UseRecoveryCode", mock.Anything, mock.Anything, mock.Anything)
+	s.mfaRepo.AssertNotCalled(s.T(), "ReplaceRecoveryCodes", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ─── Verify ──────────────────────────────────────────────────────────────────
+
+func (s *MFAServiceSuite) TestVerify_NotConfirmed() {
+	userID := uuid.New()
+	s.mfaRepo.On("GetTOTP", mock.Anything, userID).Return(models.UserTOTP{UserID: userID}, nil)
+
+	err := s.svc.Verify(context.Background(), userID, "123456")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorMFANotEnabled)
+}
+
+func (s *MFAServiceSuite) TestVerify_TOTP() {
+	userID := uuid.New()
+	s.mfaRepo.On("GetTOTP", mock.Anything, userID).Return(confirmedTOTP(userID), nil)
+	s.box.On("Open", []byte("sealed")).Return([]byte(testSecret), nil)
+	s.mfaRepo.On("UseTOTPStep", mock.Anything, userID, mock.AnythingOfType("int64")).Return(nil)
+
+	err := s.svc.Verify(context.Background(), userID, currentCode(s))
+
+	require.NoError(s.T(), err)
+}
+
+func (s *MFAServiceSuite) TestVerify_TOTP_Replayed() {
+	userID := uuid.New()
+	s.mfaRepo.On("GetTOTP", mock.Anything, userID).Return(confirmedTOTP(userID), nil)
+	s.box.On("Open", []byte("sealed")).Return([]byte(testSecret), nil)
+	s.mfaRepo.On("UseTOTPStep", mock.Anything, userID, mock.Anything).
+		Return(errx.ErrorMFACodeInvalid.Raise(errors.New("used")))
+
+	err := s.svc.Verify(context.Background(), userID, currentCode(s))
+
+	assert.ErrorIs(s.T(), err, errx.ErrorMFACodeInvalid)
+}
+
+func (s *MFAServiceSuite) TestVerify_RecoveryCode() {
+	userID := uuid.New()
+	s.mfaRepo.On("GetTOTP", mock.Anything, userID).Return(confirmedTOTP(userID), nil)
+	s.mfaRepo.On("UseRecoveryCode", mock.Anything, userID, hashRecoveryCode("abcd-efgh-ijkl-mnop")).Return(nil)
+
+	err := s.svc.Verify(context.Background(), userID, "ABCD EFGH-IJKL MNOP")
+
+	require.NoError(s.T(), err)
+	s.box.AssertNotCalled(s.T(), "Open", mock.Anything)
+}
+
+// ─── IsEnabled ───────────────────────────────────────────────────────────────
+
+func (s *MFAServiceSuite) TestIsEnabled() {
+	enrolled, pending, none := uuid.New(), uuid.New(), uuid.New()
+	s.mfaRepo.On("GetTOTP", mock.Anything, enrolled).Return(confirmedTOTP(enrolled), nil)
+	s.mfaRepo.On("GetTOTP", mock.Anything, pending).Return(models.UserTOTP{UserID: pending}, nil)
+	s.mfaRepo.On("GetTOTP", mock.Anything, none).
+		Return(models.UserTOTP{}, errx.ErrorMFANotEnabled.Raise(errors.New("no rows")))
+
+	for userID, want := range map[uuid.UUID]bool{enrolled: true, pending: false, none: false} {
+		got, err := s.svc.IsEnabled(context.Background(), userID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), want, got)
+	}
+}
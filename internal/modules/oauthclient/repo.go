@@ -0,0 +1,17 @@
+package oauthclient
+
+import (
+	"context"
+
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+//go:generate mockery --name=clientRepo --inpackage
+type clientRepo interface {
+	Create(ctx context.Context, client models.OAuthClient) (models.OAuthClient, error)
+	GetByID(ctx context.Context, clientID string) (models.OAuthClient, error)
+	List(ctx context.Context) ([]models.OAuthClient, error)
+	Update(ctx context.Context, clientID string, params UpdateParams) (models.OAuthClient, error)
+	UpdateSecretHash(ctx context.Context, clientID string, secretHash string) (models.OAuthClient, error)
+	Delete(ctx context.Context, clientID string) error
+}
@@ -0,0 +1,217 @@
+package oauthclient
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/oidc"
+)
+
+// SupportedScopes are the scopes a client may be allowed: the OpenID
+// Connect ones for signing users in and the service scopes for client
+// credentials.
+var SupportedScopes = append(slices.Clone(oidc.SupportedScopes), models.ServiceScopes...)
+
+type Service struct {
+	clientRepo clientRepo
+}
+
+type ServiceDeps struct {
+	ClientRepo clientRepo
+}
+
+func New(deps ServiceDeps) *Service {
+	return &Service{
+		clientRepo: deps.ClientRepo,
+	}
+}
+
+type CreateParams struct {
+	ID           string
+	Name         string
+	Public       bool
+	Scopes       []string
+	RedirectURIs []string
+}
+
+// Create registers a client. The secret of a confidential client is
+// returned once and only its hash is kept; public clients get none.
+func (s *Service) Create(ctx context.Context, params CreateParams) (models.OAuthClient, string, error) {
+	if err := validateScopes(params.Scopes); err != nil {
+		return models.OAuthClient{}, "", err
+	}
+	if err := validateRedirectURIs(params.RedirectURIs); err != nil {
+		return models.OAuthClient{}, "", err
+	}
+
+	client := models.OAuthClient{
+		ID:           params.ID,
+		Name:         params.Name,
+		Scopes:       params.Scopes,
+		RedirectURIs: params.RedirectURIs,
+	}
+
+	var secret string
+	if !params.Public {
+		var (
+			hash string
+			err  error
+		)
+		secret, hash, err = newSecret()
+		if err != nil {
+			return models.OAuthClient{}, "", err
+		}
+		client.SecretHash = &hash
+	}
+
+	client, err := s.clientRepo.Create(ctx, client)
+	if err != nil {
+		return models.OAuthClient{}, "", err
+	}
+
+	return client, secret, nil
+}
+
+func (s *Service) Get(ctx context.Context, clientID string) (models.OAuthClient, error) {
+	return s.clientRepo.GetByID(ctx, clientID)
+}
+
+func (s *Service) List(ctx context.Context) ([]models.OAuthClient, error) {
+	return s.clientRepo.List(ctx)
+}
+
+// UpdateParams changes the fields that are set. Whether a client is public
+// cannot be changed: register a new one instead.
+type UpdateParams struct {
+	Name         *string
+	Scopes       *[]string
+	RedirectURIs *[]string
+}
+
+func (s *Service) Update(ctx context.Context, clientID string, params UpdateParams) (models.OAuthClient, error) {
+	if params.Scopes != nil {
+		if err := validateScopes(*params.Scopes); err != nil {
+			return models.OAuthClient{}, err
+		}
+	}
+	if params.RedirectURIs != nil {
+		if err := validateRedirectURIs(*params.RedirectURIs); err != nil {
+			return models.OAuthClient{}, err
+		}
+	}
+
+	return s.clientRepo.Update(ctx, clientID, params)
+}
+
+// RotateSecret replaces the secret of a confidential client. The old secret
+// stops working at once; service tokens issued with it stay valid until
+// they expire.
+func (s *Service) RotateSecret(ctx context.Context, clientID string) (models.OAuthClient, string, error) {
+	client, err := s.clientRepo.GetByID(ctx, clientID)
+	if err != nil {
+		return models.OAuthClient{}, "", err
+	}
+
+	if !client.Confidential() {
+		return models.OAuthClient{}, "", errx.ErrorOAuthClientUnauthorized.Raise(
+			fmt.Errorf("client %q is public and has no secret", clientID),
+		)
+	}
+
+	secret, hash, err := newSecret()
+	if err != nil {
+		return models.OAuthClient{}, "", err
+	}
+
+	client, err = s.clientRepo.UpdateSecretHash(ctx, clientID, hash)
+	if err != nil {
+		return models.OAuthClient{}, "", err
+	}
+
+	return client, secret, nil
+}
+
+func (s *Service) Delete(ctx context.Context, clientID string) error {
+	return s.clientRepo.Delete(ctx, clientID)
+}
+
+// Authenticate identifies the client of a token request. A confidential
+// client must present its secret, a public one must present none. Every
+// failure is ErrorOAuthClientInvalid, so callers cannot tell an unknown
+// client from a wrong secret.
+func (s *Service) Authenticate(ctx context.Context, clientID, secret string) (models.OAuthClient, error) {
+	client, err := s.clientRepo.GetByID(ctx, clientID)
+	switch {
+	case errors.Is(err, errx.ErrorOAuthClientNotFound):
+		return models.OAuthClient{}, errx.ErrorOAuthClientInvalid.Raise(err)
+	case err != nil:
+		return models.OAuthClient{}, err
+	}
+
+	if !client.Confidential() {
+		if secret != "" {
+			return models.OAuthClient{}, errx.ErrorOAuthClientInvalid.Raise(
+				fmt.Errorf("public client %q presented a secret", clientID),
+			)
+		}
+		return client, nil
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(*client.SecretHash)) != 1 {
+		return models.OAuthClient{}, errx.ErrorOAuthClientInvalid.Raise(
+			fmt.Errorf("wrong secret for client %q", clientID),
+		)
+	}
+
+	return client, nil
+}
+
+func validateScopes(scopes []string) error {
+	for _, sc := range scopes {
+		if !slices.Contains(SupportedScopes, sc) {
+			return errx.ErrorOAuthClientScopeNotSupported.Raise(fmt.Errorf("scope %q is not supported", sc))
+		}
+	}
+	return nil
+}
+
+// validateRedirectURIs requires absolute URIs without a fragment, as RFC
+// 6749 section 3.1.2 does. They are later matched exactly.
+func validateRedirectURIs(uris []string) error {
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		if err != nil || !u.IsAbs() || u.Fragment != "" {
+			return errx.ErrorOAuthClientRedirectURINotValid.Raise(
+				fmt.Errorf("redirect uri %q must be absolute and without fragment", uri),
+			)
+		}
+	}
+	return nil
+}
+
+// newSecret returns a random client secret and its hash. Secrets carry 256
+// bits of entropy, so a plain sha256 is enough to store them.
+func newSecret() (secret, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generate client secret: %w", err)
+	}
+
+	secret = base64.RawURLEncoding.EncodeToString(b)
+	return secret, hashSecret(secret), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
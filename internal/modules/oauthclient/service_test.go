@@ -0,0 +1,163 @@
+package oauthclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type OAuthClientServiceSuite struct {
+	suite.Suite
+
+	clientRepo *mockClientRepo
+
+	svc *Service
+}
+
+func (s *OAuthClientServiceSuite) SetupTest() {
+	s.clientRepo = newMockClientRepo(s.T())
+	s.svc = New(ServiceDeps{ClientRepo: s.clientRepo})
+}
+
+func TestOAuthClientService(t *testing.T) {
+	suite.Run(t, new(OAuthClientServiceSuite))
+}
+
+// storeCreated makes the repository return the client it is given.
+func (s *OAuthClientServiceSuite) storeCreated() {
+	s.clientRepo.On("Create", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, c models.OAuthClient) (models.OAuthClient, error) {
+			return c, nil
+		}).Once()
+}
+
+// ─── Create ──────────────────────────────────────────────────────────────────
+
+func (s *OAuthClientServiceSuite) TestCreate_Confidential() {
+	s.storeCreated()
+
+	client, secret, err := s.svc.Create(context.Background(), CreateParams{
+		ID:     "billing-svc",
+		Name:   "Billing",
+		Scopes: []string{models.ScopeSessionsValidate},
+	})
+
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), secret)
+	require.True(s.T(), client.Confidential())
+	assert.NotEqual(s.T(), secret, *client.SecretHash)
+	assert.Equal(s.T(), hashSecret(secret), *client.SecretHash)
+}
+
+func (s *OAuthClientServiceSuite) TestCreate_Public() {
+	s.storeCreated()
+
+	client, secret, err := s.svc.Create(context.Background(), CreateParams{
+		ID:           "dashboard",
+		Public:       true,
+		Scopes:       []string{oidc.ScopeOpenID, oidc.ScopeEmail},
+		RedirectURIs: []string{"https://dashboard.netbill.local/callback"},
+	})
+
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), secret)
+	assert.False(s.T(), client.Confidential())
+}
+
+func (s *OAuthClientServiceSuite) TestCreate_Invalid() {
+	cases := []struct {
+		name   string
+		params CreateParams
+		err    error
+	}{
+		{"unknown scope", CreateParams{ID: "x", Scopes: []string{"admin"}}, errx.ErrorOAuthClientScopeNotSupported},
+		{"relative redirect", CreateParams{ID: "x", RedirectURIs: []string{"/callback"}}, errx.ErrorOAuthClientRedirectURINotValid},
+		{"redirect with fragment", CreateParams{
+			ID:           "x",
+			RedirectURIs: []string{"https://app.netbill.local/cb#token"},
+		}, errx.ErrorOAuthClientRedirectURINotValid},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			_, _, err := s.svc.Create(context.Background(), tc.params)
+			assert.ErrorIs(s.T(), err, tc.err)
+		})
+	}
+
+	s.clientRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+// ─── RotateSecret ────────────────────────────────────────────────────────────
+
+func (s *OAuthClientServiceSuite) TestRotateSecret() {
+	old := hashSecret("old")
+	s.clientRepo.On("GetByID", mock.Anything, "billing-svc").
+		Return(models.OAuthClient{ID: "billing-svc", SecretHash: &old}, nil)
+
+	var stored string
+	s.clientRepo.On("UpdateSecretHash", mock.Anything, "billing-svc", mock.Anything).
+		Run(func(args mock.Arguments) { stored = args.String(2) }).
+		Return(models.OAuthClient{ID: "billing-svc"}, nil)
+
+	_, secret, err := s.svc.RotateSecret(context.Background(), "billing-svc")
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), hashSecret(secret), stored)
+	assert.NotEqual(s.T(), old, stored)
+}
+
+func (s *OAuthClientServiceSuite) TestRotateSecret_PublicClient() {
+	s.clientRepo.On("GetByID", mock.Anything, "dashboard").
+		Return(models.OAuthClient{ID: "dashboard"}, nil)
+
+	_, _, err := s.svc.RotateSecret(context.Background(), "dashboard")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorOAuthClientUnauthorized)
+}
+
+// ─── Authenticate ────────────────────────────────────────────────────────────
+
+func (s *OAuthClientServiceSuite) TestAuthenticate() {
+	hash := hashSecret("s3cret")
+	s.clientRepo.On("GetByID", mock.Anything, "billing-svc").
+		Return(models.OAuthClient{ID: "billing-svc", SecretHash: &hash}, nil).Maybe()
+	s.clientRepo.On("GetByID", mock.Anything, "dashboard").
+		Return(models.OAuthClient{ID: "dashboard"}, nil).Maybe()
+	s.clientRepo.On("GetByID", mock.Anything, "ghost").
+		Return(models.OAuthClient{}, errx.ErrorOAuthClientNotFound.Raise(errors.New("no rows"))).Maybe()
+
+	cases := []struct {
+		name     string
+		clientID string
+		secret   string
+		err      error
+	}{
+		{"confidential", "billing-svc", "s3cret", nil},
+		{"wrong secret", "billing-svc", "guess", errx.ErrorOAuthClientInvalid},
+		{"missing secret", "billing-svc", "", errx.ErrorOAuthClientInvalid},
+		{"public", "dashboard", "", nil},
+		{"public with secret", "dashboard", "s3cret", errx.ErrorOAuthClientInvalid},
+		{"unknown", "ghost", "s3cret", errx.ErrorOAuthClientInvalid},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			client, err := s.svc.Authenticate(context.Background(), tc.clientID, tc.secret)
+			if tc.err == nil {
+				require.NoError(s.T(), err)
+				assert.Equal(s.T(), tc.clientID, client.ID)
+			} else {
+				assert.ErrorIs(s.T(), err, tc.err)
+			}
+		})
+	}
+}
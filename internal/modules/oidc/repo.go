@@ -0,0 +1,14 @@
+package oidc
+
+import (
+	"context"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+//go:generate mockery --name=codes --inpackage
+type codes interface {
+	Set(ctx context.Context, codeHash string, v models.OAuthAuthorization, ttl time.Duration) error
+	Consume(ctx context.Context, codeHash string) (models.OAuthAuthorization, error)
+}
@@ -0,0 +1,472 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/tokenmanager"
+)
+
+//go:generate mockery --name=auth --inpackage
+type auth interface {
+	ValidateSession(ctx context.Context, actor models.UserActor) (models.User, models.Session, error)
+}
+
+//go:generate mockery --name=sessions --inpackage
+type sessions interface {
+	LoginByOAuthCode(
+		ctx context.Context,
+		userID uuid.UUID,
+		grant models.OAuthGrant,
+		client models.SessionClient,
+	) (models.TokensPair, error)
+	RefreshForClient(
+		ctx context.Context,
+		clientID, refreshToken string,
+		client models.SessionClient,
+	) (models.TokensPair, error)
+}
+
+//go:generate mockery --name=users --inpackage
+type users interface {
+	GetMyUserByID(ctx context.Context, actor models.UserActor) (models.User, error)
+	GetMyEmailByID(ctx context.Context, actor models.UserActor) (models.UserEmail, error)
+}
+
+//go:generate mockery --name=clients --inpackage
+type clients interface {
+	Get(ctx context.Context, clientID string) (models.OAuthClient, error)
+	Authenticate(ctx context.Context, clientID, secret string) (models.OAuthClient, error)
+}
+
+//go:generate mockery --name=tokenManager --inpackage
+type tokenManager interface {
+	GenerateIDToken(claims tokenmanager.IDTokenClaims) (string, error)
+	GenerateServiceAccess(clientID string, scopes []string) (string, error)
+}
+
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+)
+
+// SupportedScopes are the scopes the provider grants to sign users in.
+// Others a client asks for are ignored, as OpenID Connect Core prescribes.
+var SupportedScopes = []string{ScopeOpenID, ScopeProfile, ScopeEmail}
+
+const (
+	ResponseTypeCode        = "code"
+	CodeChallengeMethodS256 = "S256"
+)
+
+// CodeTTL is how long a client has to exchange an authorization code.
+const CodeTTL = time.Minute
+
+type Config struct {
+	// Issuer is the provider's issuer URL, the iss of every ID token.
+	Issuer     string
+	IDTokenTTL time.Duration
+
+	// AccessTTL and ServiceTTL are only reported to clients as expires_in.
+	AccessTTL  time.Duration
+	ServiceTTL time.Duration
+}
+
+type Service struct {
+	config Config
+
+	auth     auth
+	sessions sessions
+	users    users
+	clients  clients
+	codes    codes
+
+	tokenManager tokenManager
+}
+
+type ServiceDeps struct {
+	Config Config
+
+	Auth     auth
+	Sessions sessions
+	Users    users
+	Clients  clients
+	Codes    codes
+
+	TokenManager tokenManager
+}
+
+func New(deps ServiceDeps) *Service {
+	return &Service{
+		config:       deps.Config,
+		auth:         deps.Auth,
+		sessions:     deps.Sessions,
+		users:        deps.Users,
+		clients:      deps.Clients,
+		codes:        deps.Codes,
+		tokenManager: deps.TokenManager,
+	}
+}
+
+func (s *Service) Issuer() string {
+	return s.config.Issuer
+}
+
+// CheckAuthorizeRequest validates an authorization request before the user
+// is asked to sign in. ErrorOAuthClientInvalid and
+// ErrorOAuthRedirectURIInvalid mean the redirect URI must not be used to
+// report the error.
+func (s *Service) CheckAuthorizeRequest(ctx context.Context, req models.OAuthAuthorizeRequest) error {
+	_, err := s.checkAuthorizeRequest(ctx, req)
+	return err
+}
+
+func (s *Service) checkAuthorizeRequest(ctx context.Context, req models.OAuthAuthorizeRequest) ([]string, error) {
+	client, err := s.clients.Get(ctx, req.ClientID)
+	switch {
+	case errors.Is(err, errx.ErrorOAuthClientNotFound):
+		return nil, errx.ErrorOAuthClientInvalid.Raise(err)
+	case err != nil:
+		return nil, err
+	}
+
+	if !slices.Contains(client.RedirectURIs, req.RedirectURI) {
+		return nil, errx.ErrorOAuthRedirectURIInvalid.Raise(
+			fmt.Errorf("redirect uri %q is not registered for client %q", req.RedirectURI, req.ClientID),
+		)
+	}
+
+	if req.ResponseType != ResponseTypeCode {
+		return nil, errx.ErrorOAuthResponseTypeUnsupported.Raise(
+			fmt.Errorf("response type %q is not supported", req.ResponseType),
+		)
+	}
+
+	scopes := grantedScopes(req.Scope, client.Scopes)
+	if !slices.Contains(scopes, ScopeOpenID) {
+		return nil, errx.ErrorOAuthScopeInvalid.Raise(fmt.Errorf("scope %q lacks openid", req.Scope))
+	}
+
+	if req.CodeChallengeMethod != CodeChallengeMethodS256 {
+		return nil, errx.ErrorOAuthRequestInvalid.Raise(
+			fmt.Errorf("code challenge method %q is not supported", req.CodeChallengeMethod),
+		)
+	}
+	if n := len(req.CodeChallenge); n < 43 || n > 128 {
+		return nil, errx.ErrorOAuthRequestInvalid.Raise(fmt.Errorf("code challenge of %d chars", n))
+	}
+
+	return scopes, nil
+}
+
+// Authorize approves an authorization request on behalf of the signed-in
+// user and returns the authorization code for the client.
+func (s *Service) Authorize(
+	ctx context.Context,
+	actor models.UserActor,
+	req models.OAuthAuthorizeRequest,
+) (string, error) {
+	scopes, err := s.checkAuthorizeRequest(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	user, session, err := s.auth.ValidateSession(ctx, actor)
+	if err != nil {
+		return "", err
+	}
+
+	code, codeHash, err := newCode()
+	if err != nil {
+		return "", err
+	}
+
+	if err = s.codes.Set(ctx, codeHash, models.OAuthAuthorization{
+		ClientID:      req.ClientID,
+		RedirectURI:   req.RedirectURI,
+		UserID:        user.ID,
+		Scopes:        scopes,
+		Nonce:         req.Nonce,
+		CodeChallenge: req.CodeChallenge,
+		AuthTime:      session.CreatedAt,
+	}, CodeTTL); err != nil {
+		return "", fmt.Errorf("store authorization code: %w", err)
+	}
+
+	return code, nil
+}
+
+type ExchangeCodeParams struct {
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+}
+
+// ExchangeCode redeems an authorization code for a new session of the user
+// who approved it and an ID token for the client. The session belongs to
+// the client: its access tokens are issued to the client with the granted
+// scopes and do not pass as first-party tokens.
+func (s *Service) ExchangeCode(
+	ctx context.Context,
+	params ExchangeCodeParams,
+	client models.SessionClient,
+) (models.OAuthTokens, error) {
+	if _, err := s.clients.Authenticate(ctx, params.ClientID, params.ClientSecret); err != nil {
+		return models.OAuthTokens{}, err
+	}
+
+	authz, err := s.codes.Consume(ctx, hashCode(params.Code))
+	if err != nil {
+		return models.OAuthTokens{}, err
+	}
+
+	switch {
+	case authz.ClientID != params.ClientID:
+		return models.OAuthTokens{}, errx.ErrorOAuthGrantInvalid.Raise(
+			fmt.Errorf("code was issued to client %q, not %q", authz.ClientID, params.ClientID),
+		)
+	case authz.RedirectURI != params.RedirectURI:
+		return models.OAuthTokens{}, errx.ErrorOAuthGrantInvalid.Raise(
+			fmt.Errorf("redirect uri %q does not match the authorization request", params.RedirectURI),
+		)
+	case !verifyCodeChallenge(authz.CodeChallenge, params.CodeVerifier):
+		return models.OAuthTokens{}, errx.ErrorOAuthGrantInvalid.Raise(
+			fmt.Errorf("code verifier does not match the code challenge"),
+		)
+	}
+
+	info, err := s.userInfo(ctx, models.UserActor{ID: authz.UserID}, authz.Scopes)
+	if err != nil {
+		return models.OAuthTokens{}, err
+	}
+
+	// A user suspended since approving the request must not get the
+	// session; to the client that is just a grant that no longer works.
+	pair, err := s.sessions.LoginByOAuthCode(ctx, authz.UserID, models.OAuthGrant{
+		ClientID: authz.ClientID,
+		Scopes:   authz.Scopes,
+	}, client)
+	switch {
+	case errors.Is(err, errx.ErrorUserSuspended):
+		return models.OAuthTokens{}, errx.ErrorOAuthGrantInvalid.Raise(err)
+	case err != nil:
+		return models.OAuthTokens{}, err
+	}
+
+	idToken, err := s.tokenManager.GenerateIDToken(s.idTokenClaims(authz, info))
+	if err != nil {
+		return models.OAuthTokens{}, fmt.Errorf("generate id token: %w", err)
+	}
+
+	return models.OAuthTokens{
+		SessionID: pair.SessionID,
+		Access:    pair.Access,
+		Refresh:   pair.Refresh,
+		IDToken:   idToken,
+		ExpiresIn: s.config.AccessTTL,
+		Scopes:    authz.Scopes,
+	}, nil
+}
+
+// Refresh rotates the tokens of a session ExchangeCode opened for the same
+// client. A refresh token of another client's session or of a first-party
+// one is an invalid grant.
+func (s *Service) Refresh(
+	ctx context.Context,
+	clientID, clientSecret, refreshToken string,
+	client models.SessionClient,
+) (models.OAuthTokens, error) {
+	if _, err := s.clients.Authenticate(ctx, clientID, clientSecret); err != nil {
+		return models.OAuthTokens{}, err
+	}
+
+	pair, err := s.sessions.RefreshForClient(ctx, clientID, refreshToken, client)
+	switch {
+	case errors.Is(err, errx.ErrorSessionExpired),
+		errors.Is(err, errx.ErrorSessionNotFound),
+		errors.Is(err, errx.ErrorSessionTokenMismatch),
+		errors.Is(err, errx.ErrorSessionTokenReused),
+		errors.Is(err, errx.ErrorSessionIdleTimeout),
+		errors.Is(err, errx.ErrorSessionLifetimeExceeded),
+		errors.Is(err, errx.ErrorSessionEvicted),
+		errors.Is(err, errx.ErrorUserSuspended):
+		return models.OAuthTokens{}, errx.ErrorOAuthGrantInvalid.Raise(err)
+	case err != nil:
+		return models.OAuthTokens{}, err
+	}
+
+	return models.OAuthTokens{
+		SessionID: pair.SessionID,
+		Access:    pair.Access,
+		Refresh:   pair.Refresh,
+		ExpiresIn: s.config.AccessTTL,
+	}, nil
+}
+
+// IssueServiceToken is the client_credentials grant: a confidential client
+// trades its secret for a service token. Without a scope parameter the
+// token carries every service scope the client is allowed.
+func (s *Service) IssueServiceToken(
+	ctx context.Context,
+	clientID, clientSecret, scope string,
+) (models.OAuthTokens, error) {
+	oauthClient, err := s.clients.Authenticate(ctx, clientID, clientSecret)
+	if err != nil {
+		return models.OAuthTokens{}, err
+	}
+
+	if !oauthClient.Confidential() {
+		return models.OAuthTokens{}, errx.ErrorOAuthClientUnauthorized.Raise(
+			fmt.Errorf("public client %q cannot use client credentials", clientID),
+		)
+	}
+
+	allowed := make([]string, 0, len(oauthClient.Scopes))
+	for _, sc := range oauthClient.Scopes {
+		if slices.Contains(models.ServiceScopes, sc) {
+			allowed = append(allowed, sc)
+		}
+	}
+
+	scopes := allowed
+	if requested := strings.Fields(scope); len(requested) > 0 {
+		scopes = make([]string, 0, len(requested))
+		for _, sc := range requested {
+			if !slices.Contains(allowed, sc) {
+				return models.OAuthTokens{}, errx.ErrorOAuthScopeInvalid.Raise(
+					fmt.Errorf("scope %q is not allowed for client %q", sc, clientID),
+				)
+			}
+			if !slices.Contains(scopes, sc) {
+				scopes = append(scopes, sc)
+			}
+		}
+	}
+
+	token, err := s.tokenManager.GenerateServiceAccess(clientID, scopes)
+	if err != nil {
+		return models.OAuthTokens{}, fmt.Errorf("generate service token: %w", err)
+	}
+
+	return models.OAuthTokens{
+		Access:    token,
+		ExpiresIn: s.config.ServiceTTL,
+		Scopes:    scopes,
+	}, nil
+}
+
+// UserInfo returns the claims the userinfo endpoint serves for the holder of
+// an access token, limited to scopes. A client's token carries the scopes
+// the user granted; a first-party token is served SupportedScopes.
+func (s *Service) UserInfo(
+	ctx context.Context,
+	actor models.UserActor,
+	scopes []string,
+) (models.OAuthUserInfo, error) {
+	return s.userInfo(ctx, actor, scopes)
+}
+
+func (s *Service) userInfo(
+	ctx context.Context,
+	actor models.UserActor,
+	scopes []string,
+) (models.OAuthUserInfo, error) {
+	user, err := s.users.GetMyUserByID(ctx, actor)
+	if err != nil {
+		return models.OAuthUserInfo{}, err
+	}
+
+	info := models.OAuthUserInfo{User: user}
+	if slices.Contains(scopes, ScopeEmail) {
+		if info.Email, err = s.users.GetMyEmailByID(ctx, actor); err != nil {
+			return models.OAuthUserInfo{}, err
+		}
+	}
+
+	return info, nil
+}
+
+func (s *Service) idTokenClaims(authz models.OAuthAuthorization, info models.OAuthUserInfo) tokenmanager.IDTokenClaims {
+	now := time.Now()
+	claims := tokenmanager.IDTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.config.Issuer,
+			Subject:   authz.UserID.String(),
+			Audience:  jwt.ClaimStrings{authz.ClientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.config.IDTokenTTL)),
+		},
+		Nonce:    authz.Nonce,
+		AuthTime: jwt.NewNumericDate(authz.AuthTime),
+	}
+
+	if slices.Contains(authz.Scopes, ScopeProfile) {
+		claims.PreferredUsername = info.User.Username
+		if info.User.Pseudonym != nil {
+			claims.Pseudonym = *info.User.Pseudonym
+		}
+	}
+
+	if slices.Contains(authz.Scopes, ScopeEmail) {
+		verified := info.Email.Verified
+		claims.Email = info.Email.Email
+		claims.EmailVerified = &verified
+	}
+
+	return claims
+}
+
+// grantedScopes keeps the supported scopes of a space separated scope
+// parameter that the client is allowed, in the order the client listed them.
+func grantedScopes(scope string, allowed []string) []string {
+	var scopes []string
+	for _, sc := range strings.Fields(scope) {
+		if slices.Contains(SupportedScopes, sc) && slices.Contains(allowed, sc) && !slices.Contains(scopes, sc) {
+			scopes = append(scopes, sc)
+		}
+	}
+	return scopes
+}
+
+// verifyCodeChallenge checks a PKCE verifier against an S256 challenge
+// (RFC 7636).
+func verifyCodeChallenge(challenge, verifier string) bool {
+	if n := len(verifier); n < 43 || n > 128 {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+func newCode() (code, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generate authorization code: %w", err)
+	}
+
+	code = base64.RawURLEncoding.EncodeToString(b)
+	return code, hashCode(code), nil
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
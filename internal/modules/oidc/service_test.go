@@ -0,0 +1,412 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/tokenmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testClientID    = "dashboard"
+	testRedirectURI = "https://dashboard.netbill.local/callback"
+	testVerifier    = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+
+	testServiceID     = "billing-svc"
+	testServiceSecret = "billing-secret"
+)
+
+var secretHash = "stored-hash"
+
+// testClients is the registry the clients mock serves: the public web app
+// the authorization tests sign in to, one not allowed to sign users in and
+// a confidential service.
+var testClients = map[string]models.OAuthClient{
+	testClientID: {
+		ID:           testClientID,
+		Scopes:       []string{ScopeOpenID, ScopeProfile, ScopeEmail},
+		RedirectURIs: []string{testRedirectURI},
+	},
+	"widget": {
+		ID:           "widget",
+		Scopes:       []string{ScopeProfile},
+		RedirectURIs: []string{testRedirectURI},
+	},
+	testServiceID: {
+		ID:         testServiceID,
+		SecretHash: &secretHash,
+		Scopes:     []string{ScopeOpenID, models.ScopeSessionsValidate, models.ScopeUsersCreate},
+	},
+}
+
+func testChallenge() string {
+	sum := sha256.Sum256([]byte(testVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func authorizeRequest() models.OAuthAuthorizeRequest {
+	return models.OAuthAuthorizeRequest{
+		ClientID:            testClientID,
+		RedirectURI:         testRedirectURI,
+		ResponseType:        ResponseTypeCode,
+		Scope:               "openid profile email",
+		State:               "af0ifjsldkj",
+		Nonce:               "n-0S6_WzA2Mj",
+		CodeChallenge:       testChallenge(),
+		CodeChallengeMethod: CodeChallengeMethodS256,
+	}
+}
+
+type OIDCServiceSuite struct {
+	suite.Suite
+
+	auth         *mockAuth
+	sessions     *mockSessions
+	users        *mockUsers
+	clients      *mockClients
+	codes        *mockCodes
+	tokenManager *mockTokenManager
+
+	svc *Service
+}
+
+func (s *OIDCServiceSuite) SetupTest() {
+	s.auth = newMockAuth(s.T())
+	s.sessions = newMockSessions(s.T())
+	s.users = newMockUsers(s.T())
+	s.clients = newMockClients(s.T())
+	s.codes = newMockCodes(s.T())
+	s.tokenManager = newMockTokenManager(s.T())
+
+	s.clients.On("Get", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, id string) (models.OAuthClient, error) {
+			c, ok := testClients[id]
+			if !ok {
+				return models.OAuthClient{}, errx.ErrorOAuthClientNotFound.Raise(errors.New("no such client"))
+			}
+			return c, nil
+		}).Maybe()
+	s.clients.On("Authenticate", mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, id, secret string) (models.OAuthClient, error) {
+			c, ok := testClients[id]
+			if !ok || (c.Confidential() && secret != testServiceSecret) || (!c.Confidential() && secret != "") {
+				return models.OAuthClient{}, errx.ErrorOAuthClientInvalid.Raise(errors.New("bad credentials"))
+			}
+			return c, nil
+		}).Maybe()
+
+	s.svc = New(ServiceDeps{
+		Config: Config{
+			Issuer:     "https://auth.netbill.local",
+			IDTokenTTL: time.Hour,
+			AccessTTL:  15 * time.Minute,
+			ServiceTTL: time.Hour,
+		},
+		Auth:         s.auth,
+		Sessions:     s.sessions,
+		Users:        s.users,
+		Clients:      s.clients,
+		Codes:        s.codes,
+		TokenManager: s.tokenManager,
+	})
+}
+
+func TestOIDCService(t *testing.T) {
+	suite.Run(t, new(OIDCServiceSuite))
+}
+
+// ─── CheckAuthorizeRequest ───────────────────────────────────────────────────
+
+func (s *OIDCServiceSuite) TestCheckAuthorizeRequest() {
+	cases := []struct {
+		name   string
+		modify func(*models.OAuthAuthorizeRequest)
+		err    error
+	}{
+		{"valid", func(*models.OAuthAuthorizeRequest) {}, nil},
+		{"unknown client", func(r *models.OAuthAuthorizeRequest) { r.ClientID = "other" }, errx.ErrorOAuthClientInvalid},
+		{"unregistered redirect", func(r *models.OAuthAuthorizeRequest) {
+			r.RedirectURI = "https://evil.example/callback"
+		}, errx.ErrorOAuthRedirectURIInvalid},
+		{"implicit flow", func(r *models.OAuthAuthorizeRequest) { r.ResponseType = "id_token" }, errx.ErrorOAuthResponseTypeUnsupported},
+		{"no openid scope", func(r *models.OAuthAuthorizeRequest) { r.Scope = "profile" }, errx.ErrorOAuthScopeInvalid},
+		{"client without redirect uris", func(r *models.OAuthAuthorizeRequest) {
+			r.ClientID = testServiceID
+		}, errx.ErrorOAuthRedirectURIInvalid},
+		{"openid not allowed", func(r *models.OAuthAuthorizeRequest) {
+			r.ClientID = "widget"
+		}, errx.ErrorOAuthScopeInvalid},
+		{"plain pkce", func(r *models.OAuthAuthorizeRequest) { r.CodeChallengeMethod = "plain" }, errx.ErrorOAuthRequestInvalid},
+		{"no pkce", func(r *models.OAuthAuthorizeRequest) {
+			r.CodeChallenge, r.CodeChallengeMethod = "", ""
+		}, errx.ErrorOAuthRequestInvalid},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			req := authorizeRequest()
+			tc.modify(&req)
+
+			err := s.svc.CheckAuthorizeRequest(context.Background(), req)
+			if tc.err == nil {
+				assert.NoError(s.T(), err)
+			} else {
+				assert.ErrorIs(s.T(), err, tc.err)
+			}
+		})
+	}
+}
+
+// ─── Authorize ───────────────────────────────────────────────────────────────
+
+func (s *OIDCServiceSuite) TestAuthorize_InvalidSession() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	s.auth.On("ValidateSession", mock.Anything, actor).
+		Return(models.User{}, models.Session{}, errx.ErrorUserInvalidSession.Raise(errors.New("gone")))
+
+	_, err := s.svc.Authorize(context.Background(), actor, authorizeRequest())
+
+	assert.ErrorIs(s.T(), err, errx.ErrorUserInvalidSession)
+}
+
+func (s *OIDCServiceSuite) TestAuthorize_StoresAuthorization() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	signedIn := time.Now().Add(-time.Hour)
+	s.auth.On("ValidateSession", mock.Anything, actor).
+		Return(models.User{ID: actor.ID}, models.Session{ID: actor.SessionID, CreatedAt: signedIn}, nil)
+
+	var stored models.OAuthAuthorization
+	var storedHash string
+	s.codes.On("Set", mock.Anything, mock.Anything, mock.Anything, CodeTTL).
+		Run(func(args mock.Arguments) {
+			storedHash = args.String(1)
+			stored = args.Get(2).(models.OAuthAuthorization)
+		}).Return(nil)
+
+	req := authorizeRequest()
+	req.Scope = "openid offline_access email openid"
+
+	code, err := s.svc.Authorize(context.Background(), actor, req)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), hashCode(code), storedHash)
+	assert.Equal(s.T(), actor.ID, stored.UserID)
+	assert.Equal(s.T(), []string{"openid", "email"}, stored.Scopes)
+	assert.Equal(s.T(), req.Nonce, stored.Nonce)
+	assert.Equal(s.T(), signedIn, stored.AuthTime)
+}
+
+// ─── ExchangeCode ────────────────────────────────────────────────────────────
+
+func (s *OIDCServiceSuite) storedAuthorization(userID uuid.UUID, scopes ...string) models.OAuthAuthorization {
+	authz := models.OAuthAuthorization{
+		ClientID:      testClientID,
+		RedirectURI:   testRedirectURI,
+		UserID:        userID,
+		Scopes:        scopes,
+		Nonce:         "n-0S6_WzA2Mj",
+		CodeChallenge: testChallenge(),
+		AuthTime:      time.Now().Add(-time.Minute),
+	}
+	s.codes.On("Consume", mock.Anything, hashCode("code")).Return(authz, nil)
+	return authz
+}
+
+func exchangeParams() ExchangeCodeParams {
+	return ExchangeCodeParams{
+		ClientID:     testClientID,
+		Code:         "code",
+		RedirectURI:  testRedirectURI,
+		CodeVerifier: testVerifier,
+	}
+}
+
+func (s *OIDCServiceSuite) TestExchangeCode_UnknownClient() {
+	params := exchangeParams()
+	params.ClientID = "other"
+
+	_, err := s.svc.ExchangeCode(context.Background(), params, models.SessionClient{})
+
+	assert.ErrorIs(s.T(), err, errx.ErrorOAuthClientInvalid)
+	s.codes.AssertNotCalled(s.T(), "Consume", mock.Anything, mock.Anything)
+}
+
+func (s *OIDCServiceSuite) TestExchangeCode_Rejected() {
+	cases := []struct {
+		name   string
+		modify func(*ExchangeCodeParams)
+	}{
+		{"wrong verifier", func(p *ExchangeCodeParams) {
+			p.CodeVerifier = "wrong-verifier-wrong-verifier-wrong-verifier"
+		}},
+		{"other redirect uri", func(p *ExchangeCodeParams) { p.RedirectURI = testRedirectURI + "/other" }},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.storedAuthorization(uuid.New(), ScopeOpenID)
+
+			params := exchangeParams()
+			tc.modify(&params)
+
+			_, err := s.svc.ExchangeCode(context.Background(), params, models.SessionClient{})
+
+			assert.ErrorIs(s.T(), err, errx.ErrorOAuthGrantInvalid)
+			s.sessions.AssertNotCalled(s.T(), "LoginByOAuthCode", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (s *OIDCServiceSuite) TestExchangeCode_IssuesIDToken() {
+	userID := uuid.New()
+	pseudonym := "Alice"
+	authz := s.storedAuthorization(userID, ScopeOpenID, ScopeProfile, ScopeEmail)
+
+	actor := models.UserActor{ID: userID}
+	s.users.On("GetMyUserByID", mock.Anything, actor).
+		Return(models.User{ID: userID, Username: "alice", Pseudonym: &pseudonym}, nil)
+	s.users.On("GetMyEmailByID", mock.Anything, actor).
+		Return(models.UserEmail{UserID: userID, Email: "alice@netbill.local", Verified: true}, nil)
+
+	client := models.SessionClient{IP: "10.0.0.7"}
+	pair := models.TokensPair{SessionID: uuid.New(), Access: "access", Refresh: "refresh"}
+	grant := models.OAuthGrant{ClientID: testClientID, Scopes: authz.Scopes}
+	s.sessions.On("LoginByOAuthCode", mock.Anything, userID, grant, client).Return(pair, nil)
+
+	var claims tokenmanager.IDTokenClaims
+	s.tokenManager.On("GenerateIDToken", mock.Anything).
+		Run(func(args mock.Arguments) { claims = args.Get(0).(tokenmanager.IDTokenClaims) }).
+		Return("id-token", nil)
+
+	tokens, err := s.svc.ExchangeCode(context.Background(), exchangeParams(), client)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "id-token", tokens.IDToken)
+	assert.Equal(s.T(), "access", tokens.Access)
+	assert.Equal(s.T(), 15*time.Minute, tokens.ExpiresIn)
+
+	assert.Equal(s.T(), "https://auth.netbill.local", claims.Issuer)
+	assert.Equal(s.T(), userID.String(), claims.Subject)
+	assert.Equal(s.T(), []string{testClientID}, []string(claims.Audience))
+	assert.Equal(s.T(), authz.Nonce, claims.Nonce)
+	assert.Equal(s.T(), "alice", claims.PreferredUsername)
+	assert.Equal(s.T(), "Alice", claims.Pseudonym)
+	assert.Equal(s.T(), "alice@netbill.local", claims.Email)
+	require.NotNil(s.T(), claims.EmailVerified)
+	assert.True(s.T(), *claims.EmailVerified)
+}
+
+func (s *OIDCServiceSuite) TestExchangeCode_OpenIDScopeOnly() {
+	userID := uuid.New()
+	s.storedAuthorization(userID, ScopeOpenID)
+
+	s.users.On("GetMyUserByID", mock.Anything, models.UserActor{ID: userID}).
+		Return(models.User{ID: userID, Username: "alice"}, nil)
+	s.sessions.On("LoginByOAuthCode", mock.Anything, userID, mock.Anything, mock.Anything).
+		Return(models.TokensPair{SessionID: uuid.New()}, nil)
+
+	var claims tokenmanager.IDTokenClaims
+	s.tokenManager.On("GenerateIDToken", mock.Anything).
+		Run(func(args mock.Arguments) { claims = args.Get(0).(tokenmanager.IDTokenClaims) }).
+		Return("id-token", nil)
+
+	_, err := s.svc.ExchangeCode(context.Background(), exchangeParams(), models.SessionClient{})
+
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), claims.PreferredUsername)
+	assert.Empty(s.T(), claims.Email)
+	assert.Nil(s.T(), claims.EmailVerified)
+	s.users.AssertNotCalled(s.T(), "GetMyEmailByID", mock.Anything, mock.Anything)
+}
+
+// ─── Refresh ─────────────────────────────────────────────────────────────────
+
+func (s *OIDCServiceSuite) TestRefresh_ReusedToken() {
+	s.sessions.On("RefreshForClient", mock.Anything, testClientID, "refresh", mock.Anything).
+		Return(models.TokensPair{}, errx.ErrorSessionTokenReused.Raise(errors.New("replay")))
+
+	_, err := s.svc.Refresh(context.Background(), testClientID, "", "refresh", models.SessionClient{})
+
+	assert.ErrorIs(s.T(), err, errx.ErrorOAuthGrantInvalid)
+}
+
+func (s *OIDCServiceSuite) TestRefresh_OtherClientsSession() {
+	s.sessions.On("RefreshForClient", mock.Anything, testClientID, "refresh", mock.Anything).
+		Return(models.TokensPair{}, errx.ErrorSessionTokenMismatch.Raise(errors.New("session of another client")))
+
+	_, err := s.svc.Refresh(context.Background(), testClientID, "", "refresh", models.SessionClient{})
+
+	assert.ErrorIs(s.T(), err, errx.ErrorOAuthGrantInvalid)
+}
+
+func (s *OIDCServiceSuite) TestRefresh_HappyPath() {
+	pair := models.TokensPair{SessionID: uuid.New(), Access: "access", Refresh: "refresh-2"}
+	s.sessions.On("RefreshForClient", mock.Anything, testClientID, "refresh", mock.Anything).Return(pair, nil)
+
+	tokens, err := s.svc.Refresh(context.Background(), testClientID, "", "refresh", models.SessionClient{})
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "refresh-2", tokens.Refresh)
+	assert.Empty(s.T(), tokens.IDToken)
+}
+
+// ─── IssueServiceToken ───────────────────────────────────────────────────────
+
+func (s *OIDCServiceSuite) TestIssueServiceToken_AllAllowedScopes() {
+	s.tokenManager.On("GenerateServiceAccess", testServiceID,
+		[]string{models.ScopeSessionsValidate, models.ScopeUsersCreate}).Return("service-token", nil)
+
+	tokens, err := s.svc.IssueServiceToken(context.Background(), testServiceID, testServiceSecret, "")
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "service-token", tokens.Access)
+	assert.Empty(s.T(), tokens.Refresh)
+	assert.Equal(s.T(), time.Hour, tokens.ExpiresIn)
+}
+
+func (s *OIDCServiceSuite) TestIssueServiceToken_RequestedScope() {
+	s.tokenManager.On("GenerateServiceAccess", testServiceID,
+		[]string{models.ScopeSessionsValidate}).Return("service-token", nil)
+
+	tokens, err := s.svc.IssueServiceToken(context.Background(),
+		testServiceID, testServiceSecret, models.ScopeSessionsValidate)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []string{models.ScopeSessionsValidate}, tokens.Scopes)
+}
+
+func (s *OIDCServiceSuite) TestIssueServiceToken_Rejected() {
+	cases := []struct {
+		name     string
+		clientID string
+		secret   string
+		scope    string
+		err      error
+	}{
+		{"wrong secret", testServiceID, "guess", "", errx.ErrorOAuthClientInvalid},
+		{"public client", testClientID, "", "", errx.ErrorOAuthClientUnauthorized},
+		{"scope not allowed", testServiceID, testServiceSecret, "sessions:revoke", errx.ErrorOAuthScopeInvalid},
+		{"user scope", testServiceID, testServiceSecret, ScopeOpenID, errx.ErrorOAuthScopeInvalid},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			_, err := s.svc.IssueServiceToken(context.Background(), tc.clientID, tc.secret, tc.scope)
+
+			assert.ErrorIs(s.T(), err, tc.err)
+			s.tokenManager.AssertNotCalled(s.T(), "GenerateServiceAccess", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package passkey
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+//go:generate mockery --name=transaction --inpackage
+type transaction interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+//go:generate mockery --name=passkeyRepo --inpackage
+type passkeyRepo interface {
+	Create(ctx context.Context, p models.Passkey) (models.Passkey, error)
+	GetByCredentialID(ctx context.Context, credentialID []byte) (models.Passkey, error)
+	ListForUser(ctx context.Context, userID uuid.UUID) ([]models.Passkey, error)
+	Rename(ctx context.Context, userID, id uuid.UUID, name string) (models.Passkey, error)
+	RecordUse(ctx context.Context, id uuid.UUID, signCount uint32) error
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+}
+
+//go:generate mockery --name=ceremonies --inpackage
+type ceremonies interface {
+	Set(ctx context.Context, challengeHash string, v models.PasskeyCeremony, ttl time.Duration) error
+	Consume(ctx context.Context, challengeHash string) (models.PasskeyCeremony, error)
+}
+
+// credentials counts the ways a user can sign in, locking the user's row for
+// the rest of the transaction.
+//
+//go:generate mockery --name=credentials --inpackage
+type credentials interface {
+	CountCredentials(ctx context.Context, userID uuid.UUID) (int, error)
+}
@@ -0,0 +1,303 @@
+package passkey
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/webauthn"
+)
+
+//go:generate mockery --name=auth --inpackage
+type auth interface {
+	ValidateSession(ctx context.Context, actor models.UserActor) (models.User, models.Session, error)
+}
+
+//go:generate mockery --name=relyingParty --inpackage
+type relyingParty interface {
+	BeginRegistration(user webauthn.UserEntity, exclude [][]byte) (webauthn.CreationOptions, error)
+	FinishRegistration(challenge []byte, cred webauthn.RegistrationCredential) (webauthn.Credential, error)
+	BeginLogin() (webauthn.RequestOptions, error)
+	FinishLogin(challenge []byte, stored webauthn.Credential, cred webauthn.AssertionCredential) (uint32, error)
+}
+
+// CeremonyTTL is how long a user has between starting a registration or a
+// login and answering it. The relying party gives browsers the same value
+// as their timeout.
+const CeremonyTTL = 5 * time.Minute
+
+// DefaultName labels passkeys registered without a name.
+const DefaultName = "Passkey"
+
+type Service struct {
+	auth auth
+
+	passkeyRepo passkeyRepo
+	credentials credentials
+	ceremonies  ceremonies
+	tx          transaction
+
+	rp relyingParty
+}
+
+type ServiceDeps struct {
+	Auth auth
+
+	PasskeyRepo passkeyRepo
+	Credentials credentials
+	Ceremonies  ceremonies
+	Tx          transaction
+
+	RelyingParty relyingParty
+}
+
+func New(deps ServiceDeps) *Service {
+	return &Service{
+		auth:        deps.Auth,
+		passkeyRepo: deps.PasskeyRepo,
+		credentials: deps.Credentials,
+		ceremonies:  deps.Ceremonies,
+		tx:          deps.Tx,
+		rp:          deps.RelyingParty,
+	}
+}
+
+// BeginRegistration returns the options for navigator.credentials.create().
+// The user handle is the user ID, which is how a login later learns whose
+// passkey it is without asking for a username.
+func (s *Service) BeginRegistration(
+	ctx context.Context,
+	actor models.UserActor,
+) (webauthn.CreationOptions, error) {
+	user, _, err := s.auth.ValidateSession(ctx, actor)
+	if err != nil {
+		return webauthn.CreationOptions{}, err
+	}
+
+	existing, err := s.passkeyRepo.ListForUser(ctx, user.ID)
+	if err != nil {
+		return webauthn.CreationOptions{}, err
+	}
+
+	exclude := make([][]byte, 0, len(existing))
+	for _, p := range existing {
+		exclude = append(exclude, p.CredentialID)
+	}
+
+	opts, err := s.rp.BeginRegistration(webauthn.UserEntity{
+		ID:          user.ID[:],
+		Name:        user.Username,
+		DisplayName: user.Username,
+	}, exclude)
+	if err != nil {
+		return webauthn.CreationOptions{}, err
+	}
+
+	if err = s.ceremonies.Set(ctx, hashChallenge(opts.Challenge), models.PasskeyCeremony{
+		Kind:   models.PasskeyCeremonyRegistration,
+		UserID: user.ID,
+	}, CeremonyTTL); err != nil {
+		return webauthn.CreationOptions{}, fmt.Errorf("store passkey ceremony: %w", err)
+	}
+
+	return opts, nil
+}
+
+// FinishRegistration verifies the browser's answer to BeginRegistration and
+// stores the new passkey.
+func (s *Service) FinishRegistration(
+	ctx context.Context,
+	actor models.UserActor,
+	name string,
+	cred webauthn.RegistrationCredential,
+) (models.Passkey, error) {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return models.Passkey{}, err
+	}
+
+	challenge, err := s.consumeCeremony(ctx, cred.Response.ClientDataJSON, models.PasskeyCeremonyRegistration, actor.ID)
+	if err != nil {
+		return models.Passkey{}, err
+	}
+
+	credential, err := s.rp.FinishRegistration(challenge, cred)
+	if err != nil {
+		return models.Passkey{}, verificationError(err)
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = DefaultName
+	}
+
+	return s.passkeyRepo.Create(ctx, models.Passkey{
+		UserID:       actor.ID,
+		CredentialID: credential.ID,
+		PublicKey:    credential.PublicKey,
+		SignCount:    credential.SignCount,
+		AAGUID:       credential.AAGUID,
+		Transports:   cred.Response.Transports,
+		Name:         name,
+	})
+}
+
+func (s *Service) ListMyPasskeys(
+	ctx context.Context,
+	actor models.UserActor,
+) ([]models.Passkey, error) {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return nil, err
+	}
+
+	return s.passkeyRepo.ListForUser(ctx, actor.ID)
+}
+
+func (s *Service) RenameMyPasskey(
+	ctx context.Context,
+	actor models.UserActor,
+	passkeyID uuid.UUID,
+	name string,
+) (models.Passkey, error) {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return models.Passkey{}, err
+	}
+
+	return s.passkeyRepo.Rename(ctx, actor.ID, passkeyID, strings.TrimSpace(name))
+}
+
+// DeleteMyPasskey removes a passkey of the user, unless it is the last way
+// left to sign in: without a password or a linked identity that would lock
+// the user out.
+func (s *Service) DeleteMyPasskey(
+	ctx context.Context,
+	actor models.UserActor,
+	passkeyID uuid.UUID,
+) error {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return err
+	}
+
+	return s.tx.Transaction(ctx, func(ctx context.Context) error {
+		credentials, err := s.credentials.CountCredentials(ctx, actor.ID)
+		if err != nil {
+			return err
+		}
+
+		if err = s.passkeyRepo.Delete(ctx, actor.ID, passkeyID); err != nil {
+			return err
+		}
+
+		if credentials <= 1 {
+			return errx.ErrorLastCredential.Raise(
+				fmt.Errorf("passkey %s is the last credential of user %s", passkeyID, actor.ID),
+			)
+		}
+
+		return nil
+	})
+}
+
+// BeginLogin returns the options for navigator.credentials.get(). No user is
+// named: the authenticator offers whichever passkeys it holds for the
+// relying party.
+func (s *Service) BeginLogin(ctx context.Context) (webauthn.RequestOptions, error) {
+	opts, err := s.rp.BeginLogin()
+	if err != nil {
+		return webauthn.RequestOptions{}, err
+	}
+
+	if err = s.ceremonies.Set(ctx, hashChallenge(opts.Challenge), models.PasskeyCeremony{
+		Kind: models.PasskeyCeremonyLogin,
+	}, CeremonyTTL); err != nil {
+		return webauthn.RequestOptions{}, fmt.Errorf("store passkey ceremony: %w", err)
+	}
+
+	return opts, nil
+}
+
+// FinishLogin verifies the browser's answer to BeginLogin and returns the
+// user the passkey belongs to. Opening the session is up to the caller.
+func (s *Service) FinishLogin(
+	ctx context.Context,
+	cred webauthn.AssertionCredential,
+) (uuid.UUID, error) {
+	challenge, err := s.consumeCeremony(ctx, cred.Response.ClientDataJSON, models.PasskeyCeremonyLogin, uuid.Nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	passkey, err := s.passkeyRepo.GetByCredentialID(ctx, cred.RawID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if len(cred.Response.UserHandle) > 0 && string(cred.Response.UserHandle) != string(passkey.UserID[:]) {
+		return uuid.Nil, errx.ErrorPasskeyInvalid.Raise(
+			fmt.Errorf("user handle does not match owner of passkey %s", passkey.ID),
+		)
+	}
+
+	signCount, err := s.rp.FinishLogin(challenge, webauthn.Credential{
+		ID:        passkey.CredentialID,
+		PublicKey: passkey.PublicKey,
+		SignCount: passkey.SignCount,
+		AAGUID:    passkey.AAGUID,
+	}, cred)
+	if err != nil {
+		return uuid.Nil, verificationError(err)
+	}
+
+	if err = s.passkeyRepo.RecordUse(ctx, passkey.ID, signCount); err != nil {
+		return uuid.Nil, err
+	}
+
+	return passkey.UserID, nil
+}
+
+// consumeCeremony redeems the ceremony the response was made for and
+// returns its challenge. userID is checked for registrations, where the
+// ceremony is bound to the user who started it.
+func (s *Service) consumeCeremony(
+	ctx context.Context,
+	clientDataJSON []byte,
+	kind string,
+	userID uuid.UUID,
+) ([]byte, error) {
+	challenge, err := webauthn.ClientChallenge(clientDataJSON)
+	if err != nil {
+		return nil, errx.ErrorPasskeyInvalid.Raise(err)
+	}
+
+	ceremony, err := s.ceremonies.Consume(ctx, hashChallenge(challenge))
+	if err != nil {
+		return nil, err
+	}
+
+	if ceremony.Kind != kind || ceremony.UserID != userID {
+		return nil, errx.ErrorPasskeyChallengeInvalid.Raise(
+			fmt.Errorf("passkey ceremony is a %s of user %s, not a %s of user %s",
+				ceremony.Kind, ceremony.UserID, kind, userID),
+		)
+	}
+
+	return challenge, nil
+}
+
+func verificationError(err error) error {
+	if errors.Is(err, webauthn.ErrVerification) {
+		return errx.ErrorPasskeyInvalid.Raise(err)
+	}
+	return err
+}
+
+func hashChallenge(challenge []byte) string {
+	sum := sha256.Sum256(challenge)
+	return hex.EncodeToString(sum[:])
+}
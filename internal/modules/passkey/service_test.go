@@ -0,0 +1,346 @@
+package passkey
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/webauthn"
+	"github.com/netbill/auth-svc/pkg/webauthn/webauthntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const testOrigin = "http://localhost:3000"
+
+type fakeTx struct{}
+
+func (f *fakeTx) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type PasskeyServiceSuite struct {
+	suite.Suite
+
+	auth        *mockAuth
+	passkeyRepo *mockPasskeyRepo
+	credentials *mockCredentials
+	ceremonies  *mockCeremonies
+
+	rp    *webauthn.RelyingParty
+	authn *webauthntest.Authenticator
+
+	svc *Service
+}
+
+func (s *PasskeyServiceSuite) SetupTest() {
+	s.auth = newMockAuth(s.T())
+	s.passkeyRepo = newMockPasskeyRepo(s.T())
+	s.credentials = newMockCredentials(s.T())
+	s.ceremonies = newMockCeremonies(s.T())
+
+	rp, err := webauthn.New(webauthn.Config{
+		RPID:    "localhost",
+		RPName:  "netbill",
+		Origins: []string{testOrigin},
+		Timeout: CeremonyTTL,
+	})
+	require.NoError(s.T(), err)
+	s.rp = rp
+	s.authn = webauthntest.New(testOrigin)
+
+	s.svc = New(ServiceDeps{
+		Auth:         s.auth,
+		PasskeyRepo:  s.passkeyRepo,
+		Credentials:  s.credentials,
+		Ceremonies:   s.ceremonies,
+		Tx:           &fakeTx{},
+		RelyingParty: s.rp,
+	})
+}
+
+func TestPasskeyService(t *testing.T) {
+	suite.Run(t, new(PasskeyServiceSuite))
+}
+
+func (s *PasskeyServiceSuite) validSession(actor models.UserActor) {
+	s.auth.On("ValidateSession", mock.Anything, actor).
+		Return(models.User{ID: actor.ID, Username: "alice"}, models.Session{}, nil)
+}
+
+// expectCeremony accepts the ceremony the service stores and serves it back
+// under the same challenge hash.
+func (s *PasskeyServiceSuite) expectCeremony(ceremony models.PasskeyCeremony) {
+	s.ceremonies.On("Set", mock.Anything, mock.AnythingOfType("string"), ceremony, CeremonyTTL).
+		Run(func(args mock.Arguments) {
+			s.ceremonies.On("Consume", mock.Anything, args.String(1)).Return(ceremony, nil).Maybe()
+		}).
+		Return(nil).Once()
+}
+
+// registered creates a passkey on the software authenticator for the user
+// and returns it as the repository would store it.
+func (s *PasskeyServiceSuite) registered(userID uuid.UUID) models.Passkey {
+	opts, err := s.rp.BeginRegistration(webauthn.UserEntity{ID: userID[:], Name: "alice"}, nil)
+	require.NoError(s.T(), err)
+
+	cred, err := s.authn.Register(opts)
+	require.NoError(s.T(), err)
+
+	c, err := s.rp.FinishRegistration(opts.Challenge, cred)
+	require.NoError(s.T(), err)
+
+	return models.Passkey{
+		ID:           uuid.New(),
+		UserID:       userID,
+		CredentialID: c.ID,
+		PublicKey:    c.PublicKey,
+		SignCount:    c.SignCount,
+		AAGUID:       c.AAGUID,
+		Name:         DefaultName,
+	}
+}
+
+// ─── BeginRegistration ───────────────────────────────────────────────────────
+
+func (s *PasskeyServiceSuite) TestBeginRegistration_ValidateSessionError() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.auth.On("ValidateSession", mock.Anything, actor).
+		Return(models.User{}, models.Session{}, errx.ErrorUserInvalidSession.Raise(errors.New("gone")))
+
+	_, err := s.svc.BeginRegistration(context.Background(), actor)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorUserInvalidSession)
+}
+
+func (s *PasskeyServiceSuite) TestBeginRegistration_ExcludesExistingPasskeys() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.validSession(actor)
+	existing := models.Passkey{CredentialID: []byte("existing-credential")}
+	s.passkeyRepo.On("ListForUser", mock.Anything, actor.ID).Return([]models.Passkey{existing}, nil)
+	s.expectCeremony(models.PasskeyCeremony{Kind: models.PasskeyCeremonyRegistration, UserID: actor.ID})
+
+	opts, err := s.svc.BeginRegistration(context.Background(), actor)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []byte(actor.ID[:]), []byte(opts.User.ID))
+	assert.Equal(s.T(), "alice", opts.User.Name)
+	require.Len(s.T(), opts.ExcludeCredentials, 1)
+	assert.Equal(s.T(), existing.CredentialID, []byte(opts.ExcludeCredentials[0].ID))
+	s.ceremonies.AssertCalled(s.T(), "Set", mock.Anything, hashChallenge(opts.Challenge), mock.Anything, CeremonyTTL)
+}
+
+// ─── FinishRegistration ──────────────────────────────────────────────────────
+
+func (s *PasskeyServiceSuite) beginRegistration(actor models.UserActor) webauthn.CreationOptions {
+	s.validSession(actor)
+	s.passkeyRepo.On("ListForUser", mock.Anything, actor.ID).Return([]models.Passkey{}, nil).Once()
+	s.expectCeremony(models.PasskeyCeremony{Kind: models.PasskeyCeremonyRegistration, UserID: actor.ID})
+
+	opts, err := s.svc.BeginRegistration(context.Background(), actor)
+	require.NoError(s.T(), err)
+	return opts
+}
+
+func (s *PasskeyServiceSuite) TestFinishRegistration_ChallengeInvalid() {
+	actor := models.UserActor{ID: uuid.New()}
+	s.validSession(actor)
+	opts, err := s.rp.BeginRegistration(webauthn.UserEntity{ID: actor.ID[:]}, nil)
+	require.NoError(s.T(), err)
+	cred, err := s.authn.Register(opts)
+	require.NoError(s.T(), err)
+	s.ceremonies.On("Consume", mock.Anything, hashChallenge(opts.Challenge)).
+		Return(models.PasskeyCeremony{}, errx.ErrorPasskeyChallengeInvalid.Raise(errors.New("expired")))
+
+	_, err = s.svc.FinishRegistration(context.Background(), actor, "", cred)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasskeyChallengeInvalid)
+}
+
+func (s *PasskeyServiceSuite) TestFinishRegistration_CeremonyOfAnotherUser() {
+	owner := models.UserActor{ID: uuid.New()}
+	opts := s.beginRegistration(owner)
+	cred, err := s.authn.Register(opts)
+	require.NoError(s.T(), err)
+
+	other := models.UserActor{ID: uuid.New()}
+	s.validSession(other)
+
+	_, err = s.svc.FinishRegistration(context.Background(), other, "", cred)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasskeyChallengeInvalid)
+	s.passkeyRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *PasskeyServiceSuite) TestFinishRegistration_WrongOrigin() {
+	actor := models.UserActor{ID: uuid.New()}
+	opts := s.beginRegistration(actor)
+	s.authn.Origin = "https://evil.example"
+	cred, err := s.authn.Register(opts)
+	require.NoError(s.T(), err)
+
+	_, err = s.svc.FinishRegistration(context.Background(), actor, "", cred)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasskeyInvalid)
+	s.passkeyRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *PasskeyServiceSuite) TestFinishRegistration_HappyPath() {
+	actor := models.UserActor{ID: uuid.New()}
+	opts := s.beginRegistration(actor)
+	cred, err := s.authn.Register(opts)
+	require.NoError(s.T(), err)
+
+	s.passkeyRepo.On("Create", mock.Anything, mock.MatchedBy(func(p models.Passkey) bool {
+		return p.UserID == actor.ID &&
+			string(p.CredentialID) == string(cred.RawID) &&
+			len(p.PublicKey) > 0 &&
+			p.Name == DefaultName
+	})).Return(models.Passkey{ID: uuid.New(), UserID: actor.ID, Name: DefaultName}, nil)
+
+	passkey, err := s.svc.FinishRegistration(context.Background(), actor, "   ", cred)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), DefaultName, passkey.Name)
+}
+
+// ─── Manage ──────────────────────────────────────────────────────────────────
+
+func (s *PasskeyServiceSuite) TestRenameMyPasskey_TrimsName() {
+	actor := models.UserActor{ID: uuid.New()}
+	passkeyID := uuid.New()
+	s.validSession(actor)
+	s.passkeyRepo.On("Rename", mock.Anything, actor.ID, passkeyID, "Laptop").
+		Return(models.Passkey{ID: passkeyID, Name: "Laptop"}, nil)
+
+	passkey, err := s.svc.RenameMyPasskey(context.Background(), actor, passkeyID, "  Laptop ")
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "Laptop", passkey.Name)
+}
+
+func (s *PasskeyServiceSuite) TestDeleteMyPasskey_NotFound() {
+	actor := models.UserActor{ID: uuid.New()}
+	passkeyID := uuid.New()
+	s.validSession(actor)
+	s.credentials.On("CountCredentials", mock.Anything, actor.ID).Return(2, nil)
+	s.passkeyRepo.On("Delete", mock.Anything, actor.ID, passkeyID).
+		Return(errx.ErrorPasskeyNotFound.Raise(errors.New("no rows")))
+
+	err := s.svc.DeleteMyPasskey(context.Background(), actor, passkeyID)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasskeyNotFound)
+}
+
+func (s *PasskeyServiceSuite) TestDeleteMyPasskey_LastCredential() {
+	actor := models.UserActor{ID: uuid.New()}
+	passkeyID := uuid.New()
+	s.validSession(actor)
+	s.credentials.On("CountCredentials", mock.Anything, actor.ID).Return(1, nil)
+	s.passkeyRepo.On("Delete", mock.Anything, actor.ID, passkeyID).Return(nil)
+
+	err := s.svc.DeleteMyPasskey(context.Background(), actor, passkeyID)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorLastCredential)
+}
+
+func (s *PasskeyServiceSuite) TestDeleteMyPasskey_HappyPath() {
+	actor := models.UserActor{ID: uuid.New()}
+	passkeyID := uuid.New()
+	s.validSession(actor)
+	s.credentials.On("CountCredentials", mock.Anything, actor.ID).Return(2, nil)
+	s.passkeyRepo.On("Delete", mock.Anything, actor.ID, passkeyID).Return(nil)
+
+	err := s.svc.DeleteMyPasskey(context.Background(), actor, passkeyID)
+
+	require.NoError(s.T(), err)
+}
+
+// ─── Login ───────────────────────────────────────────────────────────────────
+
+func (s *PasskeyServiceSuite) beginLogin() webauthn.RequestOptions {
+	s.expectCeremony(models.PasskeyCeremony{Kind: models.PasskeyCeremonyLogin})
+
+	opts, err := s.svc.BeginLogin(context.Background())
+	require.NoError(s.T(), err)
+	return opts
+}
+
+func (s *PasskeyServiceSuite) TestFinishLogin_RegistrationCeremony() {
+	actor := models.UserActor{ID: uuid.New()}
+	opts := s.beginRegistration(actor)
+	cred, err := s.authn.Register(opts)
+	require.NoError(s.T(), err)
+
+	// An attestation answers a registration ceremony; replaying its client
+	// data against the login endpoint must not open a session.
+	_, err = s.svc.FinishLogin(context.Background(), webauthn.AssertionCredential{
+		RawID:    cred.RawID,
+		Response: webauthn.AssertionResponse{ClientDataJSON: cred.Response.ClientDataJSON},
+	})
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasskeyChallengeInvalid)
+}
+
+func (s *PasskeyServiceSuite) TestFinishLogin_UnknownPasskey() {
+	s.registered(uuid.New())
+	opts := s.beginLogin()
+	cred, err := s.authn.Login(opts)
+	require.NoError(s.T(), err)
+	s.passkeyRepo.On("GetByCredentialID", mock.Anything, []byte(cred.RawID)).
+		Return(models.Passkey{}, errx.ErrorPasskeyNotFound.Raise(errors.New("no rows")))
+
+	_, err = s.svc.FinishLogin(context.Background(), cred)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasskeyNotFound)
+}
+
+func (s *PasskeyServiceSuite) TestFinishLogin_UserHandleMismatch() {
+	stored := s.registered(uuid.New())
+	opts := s.beginLogin()
+	cred, err := s.authn.Login(opts)
+	require.NoError(s.T(), err)
+
+	stored.UserID = uuid.New()
+	s.passkeyRepo.On("GetByCredentialID", mock.Anything, []byte(cred.RawID)).Return(stored, nil)
+
+	_, err = s.svc.FinishLogin(context.Background(), cred)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasskeyInvalid)
+	s.passkeyRepo.AssertNotCalled(s.T(), "RecordUse", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *PasskeyServiceSuite) TestFinishLogin_CounterWentBack() {
+	stored := s.registered(uuid.New())
+	opts := s.beginLogin()
+	cred, err := s.authn.Login(opts)
+	require.NoError(s.T(), err)
+
+	stored.SignCount = 10
+	s.passkeyRepo.On("GetByCredentialID", mock.Anything, []byte(cred.RawID)).Return(stored, nil)
+
+	_, err = s.svc.FinishLogin(context.Background(), cred)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasskeyInvalid)
+}
+
+func (s *PasskeyServiceSuite) TestFinishLogin_HappyPath() {
+	userID := uuid.New()
+	stored := s.registered(userID)
+	opts := s.beginLogin()
+	cred, err := s.authn.Login(opts)
+	require.NoError(s.T(), err)
+
+	s.passkeyRepo.On("GetByCredentialID", mock.Anything, []byte(cred.RawID)).Return(stored, nil)
+	s.passkeyRepo.On("RecordUse", mock.Anything, stored.ID, uint32(1)).Return(nil)
+
+	got, err := s.svc.FinishLogin(context.Background(), cred)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), userID, got)
+}
@@ -0,0 +1,57 @@
+package session
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/audit"
+)
+
+//go:generate mockery --name=auditLog --inpackage
+type auditLog interface {
+	Record(ctx context.Context, event models.AuthEvent)
+}
+
+// recordEvent adds an entry to the audit log in the background, so writing
+// it neither slows down nor fails the operation it describes.
+func (s *Service) recordEvent(ctx context.Context, event models.AuthEvent) {
+	go s.auditLog.Record(context.WithoutCancel(ctx), event)
+}
+
+// recordLogin audits a login attempt that ended with result or err.
+func (s *Service) recordLogin(
+	ctx context.Context,
+	eventType string,
+	userID uuid.UUID,
+	client models.SessionClient,
+	result models.LoginResult,
+	err error,
+) {
+	event := audit.NewEvent(eventType, userID, client, err)
+	switch {
+	case err != nil:
+	case result.Challenge != nil:
+		event.Outcome = models.AuthEventChallenged
+	default:
+		event.SessionID = &result.Tokens.SessionID
+	}
+
+	s.recordEvent(ctx, event)
+}
+
+// recordSessionEvent audits an operation on a session of userID.
+func (s *Service) recordSessionEvent(
+	ctx context.Context,
+	eventType string,
+	userID, sessionID uuid.UUID,
+	client models.SessionClient,
+	err error,
+) {
+	event := audit.NewEvent(eventType, userID, client, err)
+	if sessionID != uuid.Nil {
+		event.SessionID = &sessionID
+	}
+
+	s.recordEvent(ctx, event)
+}
@@ -25,3 +25,13 @@ type sessionsCache interface {
 	Get(ctx context.Context, sessionID uuid.UUID) (models.Session, error)
 	Delete(ctx context.Context, sessionID uuid.UUID) error
 }
+
+// revokedSessions gets every session this package ends, so the access tokens
+// already issued for it are refused. Logout and device deletion fail when the
+// write fails; policy ends, evictions and refresh token reuse write it in the
+// background.
+//
+//go:generate mockery --name=revokedSessions --inpackage
+type revokedSessions interface {
+	Revoke(ctx context.Context, sessions []models.EndedSession) error
+}
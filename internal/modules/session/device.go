@@ -0,0 +1,54 @@
+package session
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/useragent"
+)
+
+// maxUserAgentLen caps what gets stored from a client-controlled header.
+const maxUserAgentLen = 512
+
+// describeClient fills in the platform and browser parsed from the user
+// agent and trims the user agent to a sane length.
+func describeClient(client models.SessionClient) models.SessionClient {
+	client.UserAgent = strings.TrimSpace(client.UserAgent)
+	if len(client.UserAgent) > maxUserAgentLen {
+		client.UserAgent = strings.ToValidUTF8(client.UserAgent[:maxUserAgentLen], "")
+	}
+
+	client.Platform, client.Browser = useragent.Parse(client.UserAgent)
+
+	return client
+}
+
+type UpdateSessionParams struct {
+	// DeviceName replaces the user-supplied device name; an empty string
+	// clears it.
+	DeviceName string
+}
+
+func (s *Service) UpdateMySession(
+	ctx context.Context,
+	actor models.UserActor,
+	sessionID uuid.UUID,
+	params UpdateSessionParams,
+) (models.Session, error) {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return models.Session{}, err
+	}
+
+	session, err := s.sessionRepo.UpdateDeviceName(
+		ctx, actor.ID, sessionID, strings.TrimSpace(params.DeviceName),
+	)
+	if err != nil {
+		return models.Session{}, err
+	}
+
+	go s.sessionsCache.Set(context.WithoutCancel(ctx), session)
+
+	return session, nil
+}
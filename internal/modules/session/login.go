@@ -2,8 +2,12 @@ package session
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
 	"errors"
 	"fmt"
+	"math/big"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,28 +18,72 @@ import (
 func (s *Service) LoginByEmail(
 	ctx context.Context,
 	email, password string,
-) (models.TokensPair, error) {
+	client models.SessionClient,
+) (result models.LoginResult, err error) {
+	var userID uuid.UUID
+	defer func() { s.recordLogin(ctx, models.AuthEventLoginEmail, userID, client, result, err) }()
+
+	subjects := s.loginSubjects(email, client.IP)
+	if err = s.checkLoginLocked(ctx, subjects); err != nil {
+		return models.LoginResult{}, err
+	}
+
+	user, err := s.authenticateByEmail(ctx, email, password)
+	userID = user.ID
+	if err != nil {
+		if isLoginFailure(err) {
+			s.recordLoginFailure(ctx, subjects)
+		}
+		return models.LoginResult{}, err
+	}
+
+	// Only the email is forgiven: clearing the IP counter would let anyone
+	// reset it by logging into an account of their own. Behind a second
+	// factor that waits until the code is right too, see LoginByMFA.
+	var forgive string
+	for _, sub := range subjects {
+		if sub.kind == "email" {
+			forgive = sub.key
+		}
+	}
+
+	result, err = s.completeLogin(ctx, user, client, forgive)
+	if err == nil && result.Challenge == nil && forgive != "" {
+		_ = s.loginAttempts.Reset(ctx, forgive)
+	}
+
+	return result, err
+}
+
+// authenticateByEmail returns the user along with a wrong-password error, so
+// the audit log can tell whose account was tried.
+func (s *Service) authenticateByEmail(ctx context.Context, email, password string) (models.User, error) {
 	emailRecord, err := s.emailRepo.GetByEmail(ctx, email)
 	if err != nil {
-		return models.TokensPair{}, err
+		return models.User{}, err
 	}
 
 	user, err := s.userRepo.GetByID(ctx, emailRecord.UserID)
 	if err != nil {
-		return models.TokensPair{}, err
+		return models.User{}, err
 	}
 
 	if err = s.checkPassword(ctx, user.ID, password); err != nil {
-		return models.TokensPair{}, err
+		return user, err
 	}
 
-	return s.createSession(ctx, user)
+	return user, nil
 }
 
 func (s *Service) checkPassword(ctx context.Context, userID uuid.UUID, password string) error {
 	pwd, err := s.passwordCache.Get(ctx, userID)
 	if err != nil {
 		pwd, err = s.passwordRepo.GetByID(ctx, userID)
+		// An account without a password fails like a wrong one, so the
+		// login endpoint doesn't tell which accounts were made through Google.
+		if errors.Is(err, errx.ErrorPasswordNotSet) {
+			return errx.ErrorPasswordInvalid.Raise(err)
+		}
 		if err != nil {
 			return err
 		}
@@ -46,27 +94,113 @@ func (s *Service) checkPassword(ctx context.Context, userID uuid.UUID, password
 	return s.passManager.CheckMatch(password, pwd.Hash)
 }
 
-func (s *Service) LoginByGoogle(
+// LoginByOIDC logs in the user an external provider account is linked to.
+// An unlinked account with a verified email nobody has registered gets a
+// new account when Config.OAuthSignUpProviders lists its provider; one with
+// the email of an existing account is refused, since email addresses can
+// change hands.
+func (s *Service) LoginByOIDC(
 	ctx context.Context,
-	email string,
-) (models.TokensPair, error) {
-	emailRecord, err := s.emailRepo.GetByEmail(ctx, email)
+	identity models.ExternalIdentity,
+	client models.SessionClient,
+) (result models.LoginResult, err error) {
+	var userID uuid.UUID
+	defer func() { s.recordLogin(ctx, models.AuthEventLoginOIDC, userID, client, result, err) }()
+
+	user, err := s.userByIdentity(ctx, identity)
+	switch {
+	case errors.Is(err, errx.ErrorIdentityNotFound):
+		user, err = s.signUpByOAuth(ctx, identity)
+		if err != nil {
+			return models.LoginResult{}, err
+		}
+	case err != nil:
+		return models.LoginResult{}, err
+	}
+
+	userID = user.ID
+	return s.completeLogin(ctx, user, client, "")
+}
+
+func (s *Service) userByIdentity(ctx context.Context, identity models.ExternalIdentity) (models.User, error) {
+	linked, err := s.identityRepo.GetByProviderSubject(ctx, identity.Provider, identity.Subject)
 	if err != nil {
-		return models.TokensPair{}, err
+		return models.User{}, err
 	}
 
-	user, err := s.userRepo.GetByID(ctx, emailRecord.UserID)
+	user, err := s.userRepo.GetByID(ctx, linked.UserID)
 	if err != nil {
-		return models.TokensPair{}, err
+		return models.User{}, err
+	}
+
+	if err = s.identityRepo.RecordUse(ctx, linked.ID, identity.Email); err != nil {
+		return models.User{}, err
 	}
 
-	return s.createSession(ctx, user)
+	return user, nil
+}
+
+func (s *Service) signUpByOAuth(ctx context.Context, identity models.ExternalIdentity) (models.User, error) {
+	// Neither matching nor creating accounts by an address the provider
+	// does not vouch for.
+	if identity.Email == "" || !identity.EmailVerified {
+		return models.User{}, errx.ErrorUserNotFound.Raise(
+			fmt.Errorf("no user for %s account %s and it has no verified email", identity.Provider, identity.Subject),
+		)
+	}
+
+	if _, err := s.emailRepo.GetByEmail(ctx, identity.Email); err == nil {
+		return models.User{}, errx.ErrorIdentityNotLinked.Raise(
+			fmt.Errorf("%s account %s is not linked to the user with email %s",
+				identity.Provider, identity.Subject, identity.Email),
+		)
+	} else if !errors.Is(err, errx.ErrorUserNotFound) {
+		return models.User{}, err
+	}
+
+	if !slices.Contains(s.config.OAuthSignUpProviders, identity.Provider) {
+		return models.User{}, errx.ErrorUserNotFound.Raise(
+			fmt.Errorf("no user for %s account %s and sign-up is disabled", identity.Provider, identity.Subject),
+		)
+	}
+
+	user, err := s.users.RegistrationByOAuth(ctx, identity)
+	if !errors.Is(err, errx.ErrorEmailAlreadyExist) && !errors.Is(err, errx.ErrorIdentityAlreadyLinked) {
+		return user, err
+	}
+
+	// Either a concurrent first login created the account a moment ago, or
+	// the email still belongs to a deleted user.
+	user, err = s.userByIdentity(ctx, identity)
+	if errors.Is(err, errx.ErrorIdentityNotFound) {
+		return models.User{}, errx.ErrorUserDeleted.Raise(
+			fmt.Errorf("email %s belongs to a deleted user", identity.Email),
+		)
+	}
+
+	return user, err
+}
+
+// checkSuspension refuses a user an admin suspended. It runs only once the
+// user proved who they are, so a login attempt does not tell strangers
+// which accounts are suspended.
+func checkSuspension(user models.User) error {
+	if user.Suspended(time.Now()) {
+		return errx.ErrorUserSuspended.Raise(fmt.Errorf("user %s is suspended", user.ID))
+	}
+	return nil
 }
 
 func (s *Service) createSession(
 	ctx context.Context,
 	user models.User,
+	client models.SessionClient,
+	grant *models.OAuthGrant,
 ) (models.TokensPair, error) {
+	if err := checkSuspension(user); err != nil {
+		return models.TokensPair{}, err
+	}
+
 	sessionID := uuid.New()
 
 	refreshToken, err := s.tokenManager.GenerateRefresh(user, sessionID)
@@ -79,15 +213,27 @@ func (s *Service) createSession(
 		return models.TokensPair{}, err
 	}
 
-	var session models.Session
+	var (
+		session models.Session
+		evicted []models.EndedSession
+	)
 	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
-		session, err = s.sessionRepo.Create(ctx, sessionID, user.ID, hashToken)
+		session, err = s.sessionRepo.Create(ctx, sessionID, user.ID, hashToken, describeClient(client), grant)
+		if err != nil {
+			return err
+		}
+
+		if err = s.messenger.WriteSessionCreated(ctx, session); err != nil {
+			return err
+		}
+
+		evicted, err = s.evictSessions(ctx, user)
 		return err
 	}); err != nil {
 		return models.TokensPair{}, err
 	}
 
-	accessToken, err := s.tokenManager.GenerateAccess(user, session.ID)
+	accessToken, err := s.generateAccess(user, session.ID, grant)
 	if err != nil {
 		return models.TokensPair{}, err
 	}
@@ -96,6 +242,15 @@ func (s *Service) createSession(
 	go s.userCache.Set(detached, user)
 	go s.sessionsCache.Set(detached, session)
 
+	if len(evicted) > 0 {
+		s.metrics.RecordSessionsEndedByPolicy(ctx, models.SessionRevokedEvicted, len(evicted))
+
+		go s.revokedSessions.Revoke(detached, evicted)
+		for _, e := range evicted {
+			go s.sessionsCache.Delete(detached, e.ID)
+		}
+	}
+
 	return models.TokensPair{
 		SessionID: session.ID,
 		Refresh:   refreshToken,
@@ -103,45 +258,171 @@ func (s *Service) createSession(
 	}, nil
 }
 
+// generateAccess issues a first-party access token, or for a session opened
+// for an OpenID Connect client one only that client can use.
+func (s *Service) generateAccess(user models.User, sessionID uuid.UUID, grant *models.OAuthGrant) (string, error) {
+	if grant == nil {
+		return s.tokenManager.GenerateAccess(user, sessionID)
+	}
+	return s.tokenManager.GenerateClientAccess(user, sessionID, grant.ClientID, grant.Scopes)
+}
+
 // QRTokenTTL is how long a freshly created QR token stays pending.
 // The HTTP handler streaming the QR flow to the client uses the same value
 // as its write deadline, so both sides expire in lockstep.
 const QRTokenTTL = 5 * time.Minute
 
-const qrConfirmedTTL = 30 * time.Second
+// qrResolvedTTL is how long a confirmed or rejected QR login is kept, so a
+// late confirm or reject is told what happened instead of "not found".
+const qrResolvedTTL = 30 * time.Second
+
+// qrCodeDigits is the length of the verification code shown next to the QR
+// code.
+const qrCodeDigits = 6
+
+// qrMaxFailures is how many wrong verification codes a QR login takes before
+// it is rejected, so the code can't be guessed within QRTokenTTL.
+const qrMaxFailures = 5
 
 //go:generate mockery --name=qrRepo --inpackage
 type qrRepo interface {
-	Set(ctx context.Context, token string, status string, ttl time.Duration) error
-	Get(ctx context.Context, token string) (string, error)
+	Create(ctx context.Context, login models.QRLogin, ttl time.Duration) error
+	Get(ctx context.Context, token string) (models.QRLogin, error)
+
+	// Resolve moves a pending QR login to status and reports whether it was
+	// still pending.
+	Resolve(ctx context.Context, token string, status string, ttl time.Duration) (bool, error)
+
+	// AddFailure counts a wrong verification code and returns the failures
+	// so far.
+	AddFailure(ctx context.Context, token string) (int, error)
+}
+
+func newQRCode() (string, error) {
+	n, err := rand.Int(rand.Reader, new(big.Int).Exp(big.NewInt(10), big.NewInt(qrCodeDigits), nil))
+	if err != nil {
+		return "", fmt.Errorf("generate qr code: %w", err)
+	}
+
+	return fmt.Sprintf("%0*d", qrCodeDigits, n.Int64()), nil
+}
+
+// CreateQRToken starts a QR login for client, the device about to display
+// the QR code. The returned login carries the verification code to show
+// alongside it.
+func (s *Service) CreateQRToken(ctx context.Context, client models.SessionClient) (models.QRLogin, error) {
+	code, err := newQRCode()
+	if err != nil {
+		return models.QRLogin{}, err
+	}
+
+	now := time.Now().UTC()
+	login := models.QRLogin{
+		Token:     uuid.New().String(),
+		Status:    models.QRLoginPending,
+		Code:      code,
+		Client:    describeClient(client),
+		CreatedAt: now,
+		ExpiresAt: now.Add(QRTokenTTL),
+	}
+
+	if err = s.qrRepo.Create(ctx, login, QRTokenTTL); err != nil {
+		return models.QRLogin{}, err
+	}
+
+	return login, nil
+}
+
+// GetQRLogin returns the QR login behind qrToken so the confirming device can
+// show who is asking to sign in. The code is blanked: the user has to read it
+// off the screen showing the QR code.
+func (s *Service) GetQRLogin(ctx context.Context, qrToken string) (models.QRLogin, error) {
+	login, err := s.qrRepo.Get(ctx, qrToken)
+	if err != nil {
+		return models.QRLogin{}, err
+	}
+
+	login.Code = ""
+	return login, nil
 }
 
-func (s *Service) CreateQRToken(ctx context.Context) (string, error) {
-	token := uuid.New().String()
-	if err := s.qrRepo.Set(ctx, token, "pending", QRTokenTTL); err != nil {
-		return "", err
+// qrLoginResolved tells why a QR login that is no longer pending can't be
+// confirmed or rejected.
+func qrLoginResolved(login models.QRLogin) error {
+	switch login.Status {
+	case models.QRLoginPending:
+		return nil
+	case models.QRLoginRejected:
+		return errx.ErrorQRTokenRejected.Raise(fmt.Errorf("qr token %s was rejected", login.Token))
+	default:
+		return errx.ErrorQRTokenAlreadyConfirmed.Raise(fmt.Errorf("qr token %s already confirmed", login.Token))
+	}
+}
+
+// resolveQRLogin moves the pending QR login to status, failing if a
+// concurrent confirm or reject got there first.
+func (s *Service) resolveQRLogin(ctx context.Context, qrToken string, status string) error {
+	resolved, err := s.qrRepo.Resolve(ctx, qrToken, status, qrResolvedTTL)
+	if err != nil || resolved {
+		return err
+	}
+
+	login, err := s.qrRepo.Get(ctx, qrToken)
+	if err != nil {
+		return err
 	}
 
-	return token, nil
+	return qrLoginResolved(login)
 }
 
+// qrCodeMismatch counts a wrong verification code. The failure that reaches
+// qrMaxFailures rejects the QR login and is reported as a rejection, so the
+// device waiting on the QR code can be told.
+func (s *Service) qrCodeMismatch(ctx context.Context, qrToken string) error {
+	mismatch := errx.ErrorQRCodeMismatch.Raise(
+		fmt.Errorf("qr token %s: verification code mismatch", qrToken),
+	)
+
+	failures, err := s.qrRepo.AddFailure(ctx, qrToken)
+	if err != nil || failures < qrMaxFailures {
+		return mismatch
+	}
+
+	resolved, err := s.qrRepo.Resolve(ctx, qrToken, models.QRLoginRejected, qrResolvedTTL)
+	if err != nil || !resolved {
+		return mismatch
+	}
+
+	return errx.ErrorQRTokenRejected.Raise(
+		fmt.Errorf("qr token %s: rejected after %d wrong verification codes", qrToken, failures),
+	)
+}
+
+// ConfirmQRToken signs the requesting device of the QR login in as the actor.
+// code must be the one displayed next to the QR code.
 func (s *Service) ConfirmQRToken(
 	ctx context.Context,
 	actor models.UserActor,
 	qrToken string,
-) (models.TokensPair, error) {
-	status, err := s.qrRepo.Get(ctx, qrToken)
-	switch {
-	case errors.Is(err, errx.ErrorQRTokenNotFound):
+	code string,
+) (pair models.TokensPair, err error) {
+	defer func() {
+		// The event is about the new session, but the client that asked for
+		// it is the confirming device.
+		s.recordLogin(ctx, models.AuthEventQRConfirmed, actor.ID, actor.Client, models.LoginResult{Tokens: pair}, err)
+	}()
+
+	login, err := s.qrRepo.Get(ctx, qrToken)
+	if err != nil {
 		return models.TokensPair{}, err
-	case err != nil:
+	}
+
+	if err = qrLoginResolved(login); err != nil {
 		return models.TokensPair{}, err
 	}
 
-	if status != "pending" {
-		return models.TokensPair{}, errx.ErrorQRTokenAlreadyConfirmed.Raise(
-			fmt.Errorf("qr token %s already confirmed", qrToken),
-		)
+	if subtle.ConstantTimeCompare([]byte(code), []byte(login.Code)) != 1 {
+		return models.TokensPair{}, s.qrCodeMismatch(ctx, qrToken)
 	}
 
 	user, err := s.userRepo.GetByID(ctx, actor.ID)
@@ -149,18 +430,36 @@ func (s *Service) ConfirmQRToken(
 		return models.TokensPair{}, err
 	}
 
-	pair, err := s.createSession(ctx, user)
-	if err != nil {
+	// Checked before the login is resolved, so a suspended user leaves it
+	// pending rather than used up with no session to show for it.
+	if err = checkSuspension(user); err != nil {
 		return models.TokensPair{}, err
 	}
 
-	if err = s.qrRepo.Set(ctx, qrToken, "confirmed", qrConfirmedTTL); err != nil {
+	if err = s.resolveQRLogin(ctx, qrToken, models.QRLoginConfirmed); err != nil {
+		return models.TokensPair{}, err
+	}
+
+	// The session is for the device that opened the QR flow, not for the
+	// one confirming it.
+	pair, err = s.createSession(ctx, user, login.Client, nil)
+	if err != nil {
 		return models.TokensPair{}, err
 	}
 
 	return pair, nil
 }
 
+// RejectQRToken turns the QR login down, e.g. because the confirming user
+// does not recognise the requesting device.
+func (s *Service) RejectQRToken(ctx context.Context, actor models.UserActor, qrToken string) (err error) {
+	defer func() {
+		s.recordSessionEvent(ctx, models.AuthEventQRRejected, actor.ID, actor.SessionID, actor.Client, err)
+	}()
+
+	return s.resolveQRLogin(ctx, qrToken, models.QRLoginRejected)
+}
+
 func (s *Service) PublishQRToken(ctx context.Context, key string, payload []byte) error {
 	return s.bus.PublishQRToken(ctx, key, payload)
 }
@@ -0,0 +1,209 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+)
+
+//go:generate mockery --name=loginAttempts --inpackage
+type loginAttempts interface {
+	LockedFor(ctx context.Context, subject string) (time.Duration, error)
+	AddFailure(ctx context.Context, subject string, window time.Duration) (int, error)
+	Lock(ctx context.Context, subject string, d time.Duration) error
+	Reset(ctx context.Context, subject string) error
+}
+
+// LoginLimits bound failed logins. Failures are counted separately per email
+// and per client IP over a sliding Window, and wrong second factor codes per
+// user; reaching the threshold locks that key for LockoutBase, and every
+// further failure in the window doubles the lock up to LockoutMax, or without
+// a cap when LockoutMax is zero. A zero threshold disables that key.
+type LoginLimits struct {
+	Window                time.Duration
+	MaxFailuresPerEmail   int
+	MaxFailuresPerIP      int
+	MaxMFAFailuresPerUser int
+	LockoutBase           time.Duration
+	LockoutMax            time.Duration
+}
+
+type loginSubject struct {
+	kind  string
+	key   string
+	limit int
+}
+
+func (s *Service) loginSubjects(email, ip string) []loginSubject {
+	var subjects []loginSubject
+
+	if limit := s.config.LoginLimits.MaxFailuresPerEmail; limit > 0 {
+		// Emails are hashed so the limiter keys don't leak addresses.
+		sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+		subjects = append(subjects, loginSubject{
+			kind:  "email",
+			key:   "email:" + hex.EncodeToString(sum[:]),
+			limit: limit,
+		})
+	}
+
+	if limit := s.config.LoginLimits.MaxFailuresPerIP; limit > 0 && ip != "" {
+		subjects = append(subjects, loginSubject{
+			kind:  "ip",
+			key:   "ip:" + ip,
+			limit: limit,
+		})
+	}
+
+	return subjects
+}
+
+// mfaSubjects is the limiter key of the second factor of userID. It is per
+// user rather than per challenge: each correct password hands out a new
+// challenge.
+func (s *Service) mfaSubjects(userID uuid.UUID) []loginSubject {
+	return mfaSubjects(s.config.LoginLimits, userID)
+}
+
+func mfaSubjects(limits LoginLimits, userID uuid.UUID) []loginSubject {
+	limit := limits.MaxMFAFailuresPerUser
+	if limit <= 0 {
+		return nil
+	}
+
+	return []loginSubject{{
+		kind:  "mfa",
+		key:   "mfa:" + userID.String(),
+		limit: limit,
+	}}
+}
+
+// checkLoginLocked fails with ErrorTooManyLoginAttempts while any of the
+// subjects is locked out. The limiter fails open: if the store can't be read
+// the attempt goes through, the cache has already logged the error.
+func (s *Service) checkLoginLocked(ctx context.Context, subjects []loginSubject) error {
+	return checkLocked(ctx, s.loginAttempts, subjects)
+}
+
+func checkLocked(ctx context.Context, attempts loginAttempts, subjects []loginSubject) error {
+	var wait time.Duration
+	for _, sub := range subjects {
+		d, err := attempts.LockedFor(ctx, sub.key)
+		if err == nil && d > wait {
+			wait = d
+		}
+	}
+
+	if wait > 0 {
+		return errx.WithRetryAfter(
+			errx.ErrorTooManyLoginAttempts.Raise(
+				fmt.Errorf("login locked out for another %s", wait),
+			),
+			wait,
+		)
+	}
+
+	return nil
+}
+
+// recordLoginFailure counts a failed attempt against every subject and locks
+// those that went over their threshold.
+func (s *Service) recordLoginFailure(ctx context.Context, subjects []loginSubject) {
+	recordFailure(ctx, s.loginAttempts, s.config.LoginLimits, s.metrics, subjects)
+}
+
+func recordFailure(
+	ctx context.Context,
+	attempts loginAttempts,
+	limits LoginLimits,
+	metrics lockoutMetrics,
+	subjects []loginSubject,
+) {
+	for _, sub := range subjects {
+		failures, err := attempts.AddFailure(ctx, sub.key, limits.Window)
+		if err != nil || failures < sub.limit {
+			continue
+		}
+
+		d := lockoutFor(failures-sub.limit, limits)
+		if d <= 0 {
+			continue
+		}
+
+		if err = attempts.Lock(ctx, sub.key, d); err != nil {
+			continue
+		}
+
+		metrics.RecordLoginLockout(ctx, sub.kind)
+	}
+}
+
+// MFALimiter applies LoginLimits.MaxMFAFailuresPerUser outside of the login,
+// to second factor codes a signed-in user gives to re-authenticate. It counts
+// under the same key as LoginByMFA, so both draw on one budget per user.
+type MFALimiter struct {
+	attempts loginAttempts
+	limits   LoginLimits
+	metrics  lockoutMetrics
+}
+
+func NewMFALimiter(attempts loginAttempts, limits LoginLimits, metrics lockoutMetrics) *MFALimiter {
+	return &MFALimiter{
+		attempts: attempts,
+		limits:   limits,
+		metrics:  metrics,
+	}
+}
+
+// CheckLocked fails with ErrorTooManyLoginAttempts while the second factor of
+// the user is locked out.
+func (l *MFALimiter) CheckLocked(ctx context.Context, userID uuid.UUID) error {
+	return checkLocked(ctx, l.attempts, mfaSubjects(l.limits, userID))
+}
+
+// RecordFailure counts a wrong code of the user.
+func (l *MFALimiter) RecordFailure(ctx context.Context, userID uuid.UUID) {
+	recordFailure(ctx, l.attempts, l.limits, l.metrics, mfaSubjects(l.limits, userID))
+}
+
+// Reset forgets the wrong codes of the user.
+func (l *MFALimiter) Reset(ctx context.Context, userID uuid.UUID) {
+	for _, sub := range mfaSubjects(l.limits, userID) {
+		_ = l.attempts.Reset(ctx, sub.key)
+	}
+}
+
+// lockoutFor doubles LockoutBase for every failure past the threshold. A zero
+// LockoutMax leaves the doubling uncapped, short of overflowing.
+func lockoutFor(over int, limits LoginLimits) time.Duration {
+	uncapped := limits.LockoutMax == 0
+
+	d := limits.LockoutBase
+	for i := 0; i < over && (uncapped || d < limits.LockoutMax); i++ {
+		if d > math.MaxInt64/2 {
+			break
+		}
+		d *= 2
+	}
+	if !uncapped && d > limits.LockoutMax {
+		d = limits.LockoutMax
+	}
+	return d
+}
+
+// isLoginFailure tells credential failures, which count towards the limits,
+// apart from errors the client can't be blamed for. Unknown emails count too,
+// otherwise the limiter would reveal which addresses exist.
+func isLoginFailure(err error) bool {
+	return errors.Is(err, errx.ErrorPasswordInvalid) ||
+		errors.Is(err, errx.ErrorUserNotFound) ||
+		errors.Is(err, errx.ErrorUserDeleted)
+}
@@ -0,0 +1,166 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+//go:generate mockery --name=mfa --inpackage
+type mfa interface {
+	IsEnabled(ctx context.Context, userID uuid.UUID) (bool, error)
+	Verify(ctx context.Context, userID uuid.UUID, code string) error
+}
+
+//go:generate mockery --name=mfaChallenges --inpackage
+type mfaChallenges interface {
+	Create(ctx context.Context, tokenHash string, userID uuid.UUID, loginSubject string, ttl time.Duration) error
+	Get(ctx context.Context, tokenHash string) (userID uuid.UUID, loginSubject string, err error)
+	AddFailure(ctx context.Context, tokenHash string) (int, error)
+	Delete(ctx context.Context, tokenHash string) (bool, error)
+}
+
+// MFAChallengeTTL is how long a user has to enter the second factor after
+// the first one succeeded.
+const MFAChallengeTTL = 5 * time.Minute
+
+// mfaChallengeMaxFailures caps wrong codes per challenge; after that the
+// login has to start over from the password. Across challenges the codes of
+// a user are bounded by LoginLimits.MaxMFAFailuresPerUser.
+const mfaChallengeMaxFailures = 5
+
+// completeLogin opens a session for a user who passed the first factor, or
+// hands out an MFA challenge if the user has a second one. loginSubject is
+// the login limiter key the first factor would have reset; with a challenge
+// it is reset only once the second factor passes.
+func (s *Service) completeLogin(
+	ctx context.Context,
+	user models.User,
+	client models.SessionClient,
+	loginSubject string,
+) (models.LoginResult, error) {
+	// Checked here as well as in createSession, so a suspended user with
+	// MFA is not asked for a code that cannot get them in.
+	if err := checkSuspension(user); err != nil {
+		return models.LoginResult{}, err
+	}
+
+	enabled, err := s.mfa.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return models.LoginResult{}, err
+	}
+
+	if !enabled {
+		pair, err := s.createSession(ctx, user, client, nil)
+		if err != nil {
+			return models.LoginResult{}, err
+		}
+		return models.LoginResult{Tokens: pair}, nil
+	}
+
+	token, hash, err := newChallengeToken()
+	if err != nil {
+		return models.LoginResult{}, err
+	}
+
+	if err = s.mfaChallenges.Create(ctx, hash, user.ID, loginSubject, MFAChallengeTTL); err != nil {
+		return models.LoginResult{}, fmt.Errorf("store mfa challenge: %w", err)
+	}
+
+	return models.LoginResult{
+		Challenge: &models.MFAChallenge{
+			Token:     token,
+			ExpiresAt: time.Now().Add(MFAChallengeTTL),
+		},
+	}, nil
+}
+
+// LoginByMFA finishes a login that was answered with an MFA challenge. Wrong
+// codes count against the user as well as the challenge, so a password that
+// keeps handing out fresh challenges doesn't get unlimited guesses at the
+// code.
+func (s *Service) LoginByMFA(
+	ctx context.Context,
+	challenge, code string,
+	client models.SessionClient,
+) (pair models.TokensPair, err error) {
+	var userID uuid.UUID
+	defer func() {
+		s.recordLogin(ctx, models.AuthEventLoginMFA, userID, client, models.LoginResult{Tokens: pair}, err)
+	}()
+
+	hash := hashChallengeToken(challenge)
+
+	userID, loginSubject, err := s.mfaChallenges.Get(ctx, hash)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
+	subjects := s.mfaSubjects(userID)
+	if err = s.checkLoginLocked(ctx, subjects); err != nil {
+		return models.TokensPair{}, err
+	}
+
+	err = s.mfa.Verify(ctx, userID, code)
+	switch {
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		s.recordLoginFailure(ctx, subjects)
+		if failures, ferr := s.mfaChallenges.AddFailure(ctx, hash); ferr == nil && failures >= mfaChallengeMaxFailures {
+			_, _ = s.mfaChallenges.Delete(ctx, hash)
+		}
+		return models.TokensPair{}, err
+	case errors.Is(err, errx.ErrorMFANotEnabled):
+		// MFA was turned off while the challenge was pending.
+		return models.TokensPair{}, errx.ErrorMFAChallengeInvalid.Raise(err)
+	case err != nil:
+		return models.TokensPair{}, err
+	}
+
+	consumed, err := s.mfaChallenges.Delete(ctx, hash)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+	if !consumed {
+		return models.TokensPair{}, errx.ErrorMFAChallengeInvalid.Raise(
+			fmt.Errorf("mfa challenge of user %s already used", userID),
+		)
+	}
+
+	for _, sub := range subjects {
+		_ = s.loginAttempts.Reset(ctx, sub.key)
+	}
+	if loginSubject != "" {
+		_ = s.loginAttempts.Reset(ctx, loginSubject)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
+	return s.createSession(ctx, user, client, nil)
+}
+
+func newChallengeToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generate mfa challenge: %w", err)
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, hashChallengeToken(token), nil
+}
+
+func hashChallengeToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
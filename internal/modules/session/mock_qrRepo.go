@@ -6,6 +6,7 @@ import (
 	context "context"
 	time "time"
 
+	models "github.com/netbill/auth-svc/internal/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,23 +15,69 @@ type mockQrRepo struct {
 	mock.Mock
 }
 
+// AddFailure provides a mock function with given fields: ctx, token
+func (_m *mockQrRepo) AddFailure(ctx context.Context, token string) (int, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFailure")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, login, ttl
+func (_m *mockQrRepo) Create(ctx context.Context, login models.QRLogin, ttl time.Duration) error {
+	ret := _m.Called(ctx, login, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.QRLogin, time.Duration) error); ok {
+		r0 = rf(ctx, login, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, token
-func (_m *mockQrRepo) Get(ctx context.Context, token string) (string, error) {
+func (_m *mockQrRepo) Get(ctx context.Context, token string) (models.QRLogin, error) {
 	ret := _m.Called(ctx, token)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
-	var r0 string
+	var r0 models.QRLogin
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.QRLogin, error)); ok {
 		return rf(ctx, token)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.QRLogin); ok {
 		r0 = rf(ctx, token)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(models.QRLogin)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
@@ -42,22 +89,32 @@ func (_m *mockQrRepo) Get(ctx context.Context, token string) (string, error) {
 	return r0, r1
 }
 
-// Set provides a mock function with given fields: ctx, token, status, ttl
-func (_m *mockQrRepo) Set(ctx context.Context, token string, status string, ttl time.Duration) error {
+// Resolve provides a mock function with given fields: ctx, token, status, ttl
+func (_m *mockQrRepo) Resolve(ctx context.Context, token string, status string, ttl time.Duration) (bool, error) {
 	ret := _m.Called(ctx, token, status, ttl)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Set")
+		panic("no return value specified for Resolve")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) error); ok {
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (bool, error)); ok {
+		return rf(ctx, token, status, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) bool); ok {
 		r0 = rf(ctx, token, status, ttl)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, token, status, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // newMockQrRepo creates a new instance of mockQrRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
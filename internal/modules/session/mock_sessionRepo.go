@@ -18,9 +18,9 @@ type mockSessionRepo struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, sessionID, userID, hashToken
-func (_m *mockSessionRepo) Create(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID, hashToken string) (models.Session, error) {
-	ret := _m.Called(ctx, sessionID, userID, hashToken)
+// Create provides a mock function with given fields: ctx, sessionID, userID, hashToken, client, grant
+func (_m *mockSessionRepo) Create(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID, hashToken string, client models.SessionClient, grant *models.OAuthGrant) (models.Session, error) {
+	ret := _m.Called(ctx, sessionID, userID, hashToken, client, grant)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -28,17 +28,17 @@ func (_m *mockSessionRepo) Create(ctx context.Context, sessionID uuid.UUID, user
 
 	var r0 models.Session
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (models.Session, error)); ok {
-		return rf(ctx, sessionID, userID, hashToken)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, models.SessionClient, *models.OAuthGrant) (models.Session, error)); ok {
+		return rf(ctx, sessionID, userID, hashToken, client, grant)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) models.Session); ok {
-		r0 = rf(ctx, sessionID, userID, hashToken)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, models.SessionClient, *models.OAuthGrant) models.Session); ok {
+		r0 = rf(ctx, sessionID, userID, hashToken, client, grant)
 	} else {
 		r0 = ret.Get(0).(models.Session)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, sessionID, userID, hashToken)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string, models.SessionClient, *models.OAuthGrant) error); ok {
+		r1 = rf(ctx, sessionID, userID, hashToken, client, grant)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -47,41 +47,81 @@ func (_m *mockSessionRepo) Create(ctx context.Context, sessionID uuid.UUID, user
 }
 
 // Delete provides a mock function with given fields: ctx, sessionID
-func (_m *mockSessionRepo) Delete(ctx context.Context, sessionID uuid.UUID) error {
+func (_m *mockSessionRepo) Delete(ctx context.Context, sessionID uuid.UUID) (models.EndedSession, error) {
 	ret := _m.Called(ctx, sessionID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+	var r0 models.EndedSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.EndedSession, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.EndedSession); ok {
 		r0 = rf(ctx, sessionID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(models.EndedSession)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteFilteredForUser provides a mock function with given fields: ctx, userID, currentSessionID, params
+func (_m *mockSessionRepo) DeleteFilteredForUser(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID, params DeleteSessionsParams) ([]models.EndedSession, error) {
+	ret := _m.Called(ctx, userID, currentSessionID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFilteredForUser")
+	}
+
+	var r0 []models.EndedSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, DeleteSessionsParams) ([]models.EndedSession, error)); ok {
+		return rf(ctx, userID, currentSessionID, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, DeleteSessionsParams) []models.EndedSession); ok {
+		r0 = rf(ctx, userID, currentSessionID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EndedSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, DeleteSessionsParams) error); ok {
+		r1 = rf(ctx, userID, currentSessionID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // DeleteManyForUser provides a mock function with given fields: ctx, userID
-func (_m *mockSessionRepo) DeleteManyForUser(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+func (_m *mockSessionRepo) DeleteManyForUser(ctx context.Context, userID uuid.UUID) ([]models.EndedSession, error) {
 	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteManyForUser")
 	}
 
-	var r0 []uuid.UUID
+	var r0 []models.EndedSession
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]uuid.UUID, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.EndedSession, error)); ok {
 		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.EndedSession); ok {
 		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]uuid.UUID)
+			r0 = ret.Get(0).([]models.EndedSession)
 		}
 	}
 
@@ -95,16 +135,44 @@ func (_m *mockSessionRepo) DeleteManyForUser(ctx context.Context, userID uuid.UU
 }
 
 // DeleteOneForUser provides a mock function with given fields: ctx, userID, sessionID
-func (_m *mockSessionRepo) DeleteOneForUser(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error {
+func (_m *mockSessionRepo) DeleteOneForUser(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) (models.EndedSession, error) {
 	ret := _m.Called(ctx, userID, sessionID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteOneForUser")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+	var r0 models.EndedSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (models.EndedSession, error)); ok {
+		return rf(ctx, userID, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) models.EndedSession); ok {
 		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Get(0).(models.EndedSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// End provides a mock function with given fields: ctx, sessionID, reason
+func (_m *mockSessionRepo) End(ctx context.Context, sessionID uuid.UUID, reason string) error {
+	ret := _m.Called(ctx, sessionID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for End")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, sessionID, reason)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -112,6 +180,36 @@ func (_m *mockSessionRepo) DeleteOneForUser(ctx context.Context, userID uuid.UUI
 	return r0
 }
 
+// EvictLeastRecentlyUsed provides a mock function with given fields: ctx, userID, keep
+func (_m *mockSessionRepo) EvictLeastRecentlyUsed(ctx context.Context, userID uuid.UUID, keep int) ([]models.EndedSession, error) {
+	ret := _m.Called(ctx, userID, keep)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvictLeastRecentlyUsed")
+	}
+
+	var r0 []models.EndedSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.EndedSession, error)); ok {
+		return rf(ctx, userID, keep)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.EndedSession); ok {
+		r0 = rf(ctx, userID, keep)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EndedSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, userID, keep)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: ctx, sessionID
 func (_m *mockSessionRepo) GetByID(ctx context.Context, sessionID uuid.UUID) (models.Session, error) {
 	ret := _m.Called(ctx, sessionID)
@@ -204,22 +302,22 @@ func (_m *mockSessionRepo) GetListForUser(ctx context.Context, userID uuid.UUID,
 }
 
 // GetToken provides a mock function with given fields: ctx, sessionID
-func (_m *mockSessionRepo) GetToken(ctx context.Context, sessionID uuid.UUID) (string, error) {
+func (_m *mockSessionRepo) GetToken(ctx context.Context, sessionID uuid.UUID) (models.SessionToken, error) {
 	ret := _m.Called(ctx, sessionID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetToken")
 	}
 
-	var r0 string
+	var r0 models.SessionToken
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.SessionToken, error)); ok {
 		return rf(ctx, sessionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.SessionToken); ok {
 		r0 = rf(ctx, sessionID)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(models.SessionToken)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
@@ -231,9 +329,37 @@ func (_m *mockSessionRepo) GetToken(ctx context.Context, sessionID uuid.UUID) (s
 	return r0, r1
 }
 
-// UpdateToken provides a mock function with given fields: ctx, sessionID, token
-func (_m *mockSessionRepo) UpdateToken(ctx context.Context, sessionID uuid.UUID, token string) (models.Session, error) {
-	ret := _m.Called(ctx, sessionID, token)
+// UpdateDeviceName provides a mock function with given fields: ctx, userID, sessionID, name
+func (_m *mockSessionRepo) UpdateDeviceName(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, name string) (models.Session, error) {
+	ret := _m.Called(ctx, userID, sessionID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDeviceName")
+	}
+
+	var r0 models.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (models.Session, error)); ok {
+		return rf(ctx, userID, sessionID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) models.Session); ok {
+		r0 = rf(ctx, userID, sessionID, name)
+	} else {
+		r0 = ret.Get(0).(models.Session)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, sessionID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateToken provides a mock function with given fields: ctx, sessionID, oldToken, token, client
+func (_m *mockSessionRepo) UpdateToken(ctx context.Context, sessionID uuid.UUID, oldToken string, token string, client models.SessionClient) (models.Session, error) {
+	ret := _m.Called(ctx, sessionID, oldToken, token, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateToken")
@@ -241,17 +367,17 @@ func (_m *mockSessionRepo) UpdateToken(ctx context.Context, sessionID uuid.UUID,
 
 	var r0 models.Session
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (models.Session, error)); ok {
-		return rf(ctx, sessionID, token)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, models.SessionClient) (models.Session, error)); ok {
+		return rf(ctx, sessionID, oldToken, token, client)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) models.Session); ok {
-		r0 = rf(ctx, sessionID, token)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, models.SessionClient) models.Session); ok {
+		r0 = rf(ctx, sessionID, oldToken, token, client)
 	} else {
 		r0 = ret.Get(0).(models.Session)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, sessionID, token)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, models.SessionClient) error); ok {
+		r1 = rf(ctx, sessionID, oldToken, token, client)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -44,6 +44,34 @@ func (_m *mockTokenManager) GenerateAccess(user models.User, sessionID uuid.UUID
 	return r0, r1
 }
 
+// GenerateClientAccess provides a mock function with given fields: user, sessionID, clientID, scopes
+func (_m *mockTokenManager) GenerateClientAccess(user models.User, sessionID uuid.UUID, clientID string, scopes []string) (string, error) {
+	ret := _m.Called(user, sessionID, clientID, scopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateClientAccess")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.User, uuid.UUID, string, []string) (string, error)); ok {
+		return rf(user, sessionID, clientID, scopes)
+	}
+	if rf, ok := ret.Get(0).(func(models.User, uuid.UUID, string, []string) string); ok {
+		r0 = rf(user, sessionID, clientID, scopes)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.User, uuid.UUID, string, []string) error); ok {
+		r1 = rf(user, sessionID, clientID, scopes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateRefresh provides a mock function with given fields: user, sessionID
 func (_m *mockTokenManager) GenerateRefresh(user models.User, sessionID uuid.UUID) (string, error) {
 	ret := _m.Called(user, sessionID)
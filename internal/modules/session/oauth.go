@@ -0,0 +1,32 @@
+package session
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+// LoginByOAuthCode opens the session an OpenID Connect client gets in
+// exchange for an authorization code. The user signed in, second factor
+// included, before approving the request, so no factor is checked here;
+// the client details are those of the client's token request. The session
+// is bound to the grant: its access tokens are issued to grant.ClientID with
+// the granted scopes, and only RefreshForClient of that client renews them.
+func (s *Service) LoginByOAuthCode(
+	ctx context.Context,
+	userID uuid.UUID,
+	grant models.OAuthGrant,
+	client models.SessionClient,
+) (pair models.TokensPair, err error) {
+	defer func() {
+		s.recordLogin(ctx, models.AuthEventLoginOAuthCode, userID, client, models.LoginResult{Tokens: pair}, err)
+	}()
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
+	return s.createSession(ctx, user, client, &grant)
+}
@@ -0,0 +1,150 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+//go:generate mockery --name=oauthStates --inpackage
+type oauthStates interface {
+	Set(ctx context.Context, stateHash string, v models.OAuthLoginState, ttl time.Duration) error
+	Get(ctx context.Context, stateHash string) (models.OAuthLoginState, error)
+	Consume(ctx context.Context, stateHash string) (models.OAuthLoginState, error)
+}
+
+// OAuthStateTTL is how long a user has to come back from the provider's
+// consent screen.
+const OAuthStateTTL = 10 * time.Minute
+
+// StartOAuthLogin prepares a login through an external provider: a fresh
+// state, nonce and PKCE verifier, remembered until the provider calls back,
+// and the binding the browser has to bring back to the callback.
+// redirectURI is where the browser goes after the login and must be one of
+// Config.PostLoginRedirects; empty means the callback answers with JSON.
+func (s *Service) StartOAuthLogin(
+	ctx context.Context,
+	provider string,
+	redirectURI string,
+) (models.OAuthLoginStart, error) {
+	return s.startOAuth(ctx, provider, redirectURI, uuid.Nil)
+}
+
+// StartOAuthLink is StartOAuthLogin for a signed in user linking a provider
+// account: the callback links the account to the user instead of logging
+// in with it.
+func (s *Service) StartOAuthLink(
+	ctx context.Context,
+	actor models.UserActor,
+	provider string,
+	redirectURI string,
+) (models.OAuthLoginStart, error) {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return models.OAuthLoginStart{}, err
+	}
+
+	return s.startOAuth(ctx, provider, redirectURI, actor.ID)
+}
+
+func (s *Service) startOAuth(
+	ctx context.Context,
+	provider string,
+	redirectURI string,
+	linkUserID uuid.UUID,
+) (models.OAuthLoginStart, error) {
+	if redirectURI != "" && !slices.Contains(s.config.PostLoginRedirects, redirectURI) {
+		return models.OAuthLoginStart{}, errx.ErrorOAuthPostLoginRedirectNotAllowed.Raise(
+			fmt.Errorf("post-login redirect %q is not allowed", redirectURI),
+		)
+	}
+
+	var values [4]string
+	for i := range values {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return models.OAuthLoginStart{}, fmt.Errorf("generate oauth login state: %w", err)
+		}
+		values[i] = base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	start := models.OAuthLoginStart{
+		State:   values[0],
+		Binding: values[3],
+		OAuthLoginState: models.OAuthLoginState{
+			Provider:     provider,
+			Nonce:        values[1],
+			CodeVerifier: values[2],
+			RedirectURI:  redirectURI,
+			LinkUserID:   linkUserID,
+			BindingHash:  hashOAuthState(values[3]),
+		},
+	}
+
+	if err := s.oauthStates.Set(ctx, hashOAuthState(start.State), start.OAuthLoginState, OAuthStateTTL); err != nil {
+		return models.OAuthLoginStart{}, fmt.Errorf("store oauth login state: %w", err)
+	}
+
+	return start, nil
+}
+
+// ConsumeOAuthLoginState resolves the state the callback of provider came
+// back with. A state is good for one callback only, only for the provider
+// the flow was started with and only in the browser that started it, which
+// proves that with binding. Without the binding an attacker could send the
+// callback URL of a flow of their own to a victim and log the victim into
+// the attacker's account, or link the attacker's provider account to the
+// victim's. The binding is checked before the state is used up, so a
+// callback from another browser cannot spoil the flow either.
+func (s *Service) ConsumeOAuthLoginState(
+	ctx context.Context,
+	provider string,
+	state string,
+	binding string,
+) (models.OAuthLoginState, error) {
+	if state == "" {
+		return models.OAuthLoginState{}, errx.ErrorOAuthStateInvalid.Raise(
+			fmt.Errorf("oauth state is empty"),
+		)
+	}
+	if binding == "" {
+		return models.OAuthLoginState{}, errx.ErrorOAuthStateInvalid.Raise(
+			fmt.Errorf("oauth state binding is empty"),
+		)
+	}
+
+	stateHash := hashOAuthState(state)
+
+	loginState, err := s.oauthStates.Get(ctx, stateHash)
+	if err != nil {
+		return models.OAuthLoginState{}, err
+	}
+
+	if loginState.Provider != provider {
+		return models.OAuthLoginState{}, errx.ErrorOAuthStateInvalid.Raise(
+			fmt.Errorf("oauth state was issued for provider %q, not %q", loginState.Provider, provider),
+		)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashOAuthState(binding)), []byte(loginState.BindingHash)) != 1 {
+		return models.OAuthLoginState{}, errx.ErrorOAuthStateInvalid.Raise(
+			fmt.Errorf("oauth state was issued to another browser"),
+		)
+	}
+
+	return s.oauthStates.Consume(ctx, stateHash)
+}
+
+func hashOAuthState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,45 @@
+package session
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/webauthn"
+)
+
+//go:generate mockery --name=passkeys --inpackage
+type passkeys interface {
+	BeginLogin(ctx context.Context) (webauthn.RequestOptions, error)
+	FinishLogin(ctx context.Context, cred webauthn.AssertionCredential) (uuid.UUID, error)
+}
+
+func (s *Service) BeginPasskeyLogin(ctx context.Context) (webauthn.RequestOptions, error) {
+	return s.passkeys.BeginLogin(ctx)
+}
+
+// LoginByPasskey opens a session for the owner of the passkey the browser
+// signed with. It does not ask for an MFA code: a passkey already proves
+// both possession of the device and the user verifying on it.
+func (s *Service) LoginByPasskey(
+	ctx context.Context,
+	cred webauthn.AssertionCredential,
+	client models.SessionClient,
+) (pair models.TokensPair, err error) {
+	var userID uuid.UUID
+	defer func() {
+		s.recordLogin(ctx, models.AuthEventLoginPasskey, userID, client, models.LoginResult{Tokens: pair}, err)
+	}()
+
+	userID, err = s.passkeys.FinishLogin(ctx, cred)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
+	return s.createSession(ctx, user, client, nil)
+}
@@ -0,0 +1,112 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+// Policy bounds how long sessions live and how many a user may hold at once.
+// A zero value disables the corresponding limit.
+type Policy struct {
+	// IdleTimeout ends a session whose refresh token went unused this long.
+	IdleTimeout time.Duration
+
+	// MaxAge ends a session this long after it was opened, however actively
+	// it is used.
+	MaxAge time.Duration
+
+	// MaxActive caps the sessions a user may hold; opening one more ends the
+	// least recently used ones.
+	MaxActive int
+
+	// MaxActiveByRole overrides MaxActive for users of the listed roles. A
+	// zero lifts the cap for that role.
+	MaxActiveByRole map[string]int
+}
+
+func (p Policy) maxActive(role string) int {
+	if limit, ok := p.MaxActiveByRole[role]; ok {
+		return limit
+	}
+	return p.MaxActive
+}
+
+// endReason tells whether the policy no longer allows the session to be
+// refreshed, and why.
+func (p Policy) endReason(token models.SessionToken, now time.Time) (string, bool) {
+	if p.MaxAge > 0 && now.Sub(token.CreatedAt) > p.MaxAge {
+		return models.SessionRevokedMaxAge, true
+	}
+	if p.IdleTimeout > 0 && now.Sub(token.LastUsed) > p.IdleTimeout {
+		return models.SessionRevokedIdleTimeout, true
+	}
+	return "", false
+}
+
+// endedSessionError is what refreshing a session ended for reason fails with.
+func endedSessionError(sessionID uuid.UUID, reason string) error {
+	switch reason {
+	case models.SessionRevokedIdleTimeout:
+		return errx.ErrorSessionIdleTimeout.Raise(fmt.Errorf("session %s was idle too long", sessionID))
+	case models.SessionRevokedMaxAge:
+		return errx.ErrorSessionLifetimeExceeded.Raise(fmt.Errorf("session %s is too old", sessionID))
+	case models.SessionRevokedEvicted:
+		return errx.ErrorSessionEvicted.Raise(
+			fmt.Errorf("session %s was evicted by a newer one over the session limit", sessionID),
+		)
+	default:
+		return errx.ErrorSessionNotFound.Raise(fmt.Errorf("session %s was ended: %s", sessionID, reason))
+	}
+}
+
+// endSession ends a session the policy no longer allows; lastUsed is when
+// it was last refreshed. The returned error is always non-nil: it is the one
+// the refresh that noticed fails with.
+func (s *Service) endSession(
+	ctx context.Context,
+	userID, sessionID uuid.UUID,
+	lastUsed time.Time,
+	reason string,
+) error {
+	if err := s.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.sessionRepo.End(ctx, sessionID, reason); err != nil {
+			return err
+		}
+
+		return s.messenger.WriteSessionsRevoked(ctx, userID, []uuid.UUID{sessionID}, reason)
+	}); err != nil {
+		return fmt.Errorf("end session by policy: %w", err)
+	}
+
+	s.metrics.RecordSessionsEndedByPolicy(ctx, reason, 1)
+
+	detached := context.WithoutCancel(ctx)
+	go s.sessionsCache.Delete(detached, sessionID)
+	go s.revokedSessions.Revoke(detached, []models.EndedSession{{ID: sessionID, LastUsed: lastUsed}})
+
+	return endedSessionError(sessionID, reason)
+}
+
+// evictSessions ends the least recently used sessions of the user above the
+// cap. It runs in the transaction that created the newest session, which is
+// the most recently used one and so always kept.
+func (s *Service) evictSessions(ctx context.Context, user models.User) ([]models.EndedSession, error) {
+	limit := s.config.Policy.maxActive(user.Role)
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	evicted, err := s.sessionRepo.EvictLeastRecentlyUsed(ctx, user.ID, limit)
+	if err != nil || len(evicted) == 0 {
+		return nil, err
+	}
+
+	return evicted, s.messenger.WriteSessionsRevoked(
+		ctx, user.ID, models.EndedSessionIDs(evicted), models.SessionRevokedEvicted,
+	)
+}
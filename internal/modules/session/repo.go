@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/user"
 	"github.com/netbill/restkit/pagi"
 )
 
@@ -15,7 +16,7 @@ type transaction interface {
 
 //go:generate mockery --name=userRepo --inpackage
 type userRepo interface {
-	GetByID(ctx context.Context, userID uuid.UUID) (models.User, error)
+	GetByID(ctx context.Context, userID uuid.UUID, opts ...user.GetUserOption) (models.User, error)
 }
 
 //go:generate mockery --name=emailRepo --inpackage
@@ -34,6 +35,8 @@ type sessionRepo interface {
 		ctx context.Context,
 		sessionID, userID uuid.UUID,
 		hashToken string,
+		client models.SessionClient,
+		grant *models.OAuthGrant,
 	) (models.Session, error)
 
 	GetByID(ctx context.Context, sessionID uuid.UUID) (models.Session, error)
@@ -44,11 +47,33 @@ type sessionRepo interface {
 		opts ...ListSessionsOption,
 	) (pagi.Page[[]models.Session], error)
 
-	GetToken(ctx context.Context, sessionID uuid.UUID) (string, error)
+	GetToken(ctx context.Context, sessionID uuid.UUID) (models.SessionToken, error)
 
-	UpdateToken(ctx context.Context, sessionID uuid.UUID, token string) (models.Session, error)
+	// UpdateToken rotates the refresh token hash only while oldToken is the
+	// current one and fails with ErrorSessionTokenMismatch otherwise.
+	UpdateToken(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		oldToken, token string,
+		client models.SessionClient,
+	) (models.Session, error)
+	UpdateDeviceName(ctx context.Context, userID, sessionID uuid.UUID, name string) (models.Session, error)
+
+	Delete(ctx context.Context, sessionID uuid.UUID) (models.EndedSession, error)
+	DeleteOneForUser(ctx context.Context, userID, sessionID uuid.UUID) (models.EndedSession, error)
+	DeleteManyForUser(ctx context.Context, userID uuid.UUID) ([]models.EndedSession, error)
+	DeleteFilteredForUser(
+		ctx context.Context,
+		userID, currentSessionID uuid.UUID,
+		params DeleteSessionsParams,
+	) ([]models.EndedSession, error)
+
+	End(ctx context.Context, sessionID uuid.UUID, reason string) error
+	EvictLeastRecentlyUsed(ctx context.Context, userID uuid.UUID, keep int) ([]models.EndedSession, error)
+}
 
-	Delete(ctx context.Context, sessionID uuid.UUID) error
-	DeleteOneForUser(ctx context.Context, userID, sessionID uuid.UUID) error
-	DeleteManyForUser(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+//go:generate mockery --name=identityRepo --inpackage
+type identityRepo interface {
+	GetByProviderSubject(ctx context.Context, provider, subject string) (models.UserIdentity, error)
+	RecordUse(ctx context.Context, id uuid.UUID, email string) error
 }
@@ -2,7 +2,9 @@ package session
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/errx"
@@ -15,6 +17,11 @@ type auth interface {
 	ValidateSession(ctx context.Context, actor models.UserActor) (models.User, models.Session, error)
 }
 
+//go:generate mockery --name=users --inpackage
+type users interface {
+	RegistrationByOAuth(ctx context.Context, identity models.ExternalIdentity) (models.User, error)
+}
+
 //go:generate mockery --name=passwordManager --inpackage
 type passwordManager interface {
 	CheckMatch(password, hash string) error
@@ -28,6 +35,7 @@ type tokenManager interface {
 	HashRefresh(token string) (string, error)
 
 	GenerateAccess(user models.User, sessionID uuid.UUID) (string, error)
+	GenerateClientAccess(user models.User, sessionID uuid.UUID, clientID string, scopes []string) (string, error)
 	GenerateRefresh(user models.User, sessionID uuid.UUID) (string, error)
 }
 
@@ -36,33 +44,94 @@ type bus interface {
 	PublishQRToken(ctx context.Context, key string, payload []byte) error
 }
 
+//go:generate mockery --name=messenger --inpackage
+type messenger interface {
+	WriteSessionTokenReused(ctx context.Context, userID, sessionID uuid.UUID, revoked []uuid.UUID) error
+	WriteSessionCreated(ctx context.Context, session models.Session) error
+	WriteSessionRefreshed(ctx context.Context, session models.Session) error
+	WriteSessionsRevoked(ctx context.Context, userID uuid.UUID, revoked []uuid.UUID, reason string) error
+}
+
+//go:generate mockery --name=metrics --inpackage
+type metrics interface {
+	lockoutMetrics
+	RecordSessionTokenReuse(ctx context.Context, scope string)
+	RecordSessionsEndedByPolicy(ctx context.Context, reason string, count int)
+}
+
+type lockoutMetrics interface {
+	RecordLoginLockout(ctx context.Context, key string)
+}
+
+type Config struct {
+	// RevokeAllOnTokenReuse widens the reaction to a replayed refresh token
+	// from the affected session to every session of its user.
+	RevokeAllOnTokenReuse bool
+
+	LoginLimits LoginLimits
+
+	Policy Policy
+
+	// PostLoginRedirects lists the pages a login through an external
+	// provider may send the browser back to.
+	PostLoginRedirects []string
+
+	// OAuthSignUpProviders lists the external providers whose logins may
+	// create the account when the provider account is not linked to anyone
+	// and no user has its verified email yet.
+	OAuthSignUpProviders []string
+}
+
 type Service struct {
-	auth auth
+	config Config
+
+	auth  auth
+	users users
 
 	userRepo     userRepo
 	emailRepo    emailRepo
 	passwordRepo passwordRepo
 	sessionRepo  sessionRepo
+	identityRepo identityRepo
 	tx           transaction
 
 	passwordCache passwordCache
 	userCache     userCache
 	sessionsCache sessionsCache
 
+	revokedSessions revokedSessions
+
 	qrRepo qrRepo
 	bus    bus
 
+	loginAttempts loginAttempts
+
+	mfa           mfa
+	mfaChallenges mfaChallenges
+
+	passkeys passkeys
+
+	oauthStates oauthStates
+
 	passManager  passwordManager
 	tokenManager tokenManager
+
+	messenger messenger
+	metrics   metrics
+	auditLog  auditLog
 }
 
 type ServiceDeps struct {
-	Auth auth
+	Config Config
+
+	Auth  auth
+	Users users
 
 	UserRepo     userRepo
 	EmailRepo    emailRepo
 	PasswordRepo passwordRepo
 	SessionRepo  sessionRepo
+	IdentityRepo identityRepo
 
 	Tx transaction
 
@@ -70,27 +139,54 @@ type ServiceDeps struct {
 	UserCache     userCache
 	SessionsCache sessionsCache
 
+	RevokedSessions revokedSessions
+
 	PassManager  passwordManager
 	TokenManager tokenManager
 	QRStore      qrRepo
 	Bus          bus
+
+	LoginAttempts loginAttempts
+
+	MFA           mfa
+	MFAChallenges mfaChallenges
+
+	Passkeys passkeys
+
+	OAuthStates oauthStates
+
+	Messenger messenger
+	Metrics   metrics
+	AuditLog  auditLog
 }
 
 func New(deps ServiceDeps) *Service {
 	return &Service{
-		auth:          deps.Auth,
-		userRepo:      deps.UserRepo,
-		emailRepo:     deps.EmailRepo,
-		passwordRepo:  deps.PasswordRepo,
-		sessionRepo:   deps.SessionRepo,
-		tx:            deps.Tx,
-		passwordCache: deps.PasswordCache,
-		userCache:     deps.UserCache,
-		sessionsCache: deps.SessionsCache,
-		passManager:   deps.PassManager,
-		tokenManager:  deps.TokenManager,
-		qrRepo:        deps.QRStore,
-		bus:           deps.Bus,
+		config:          deps.Config,
+		auth:            deps.Auth,
+		users:           deps.Users,
+		userRepo:        deps.UserRepo,
+		emailRepo:       deps.EmailRepo,
+		passwordRepo:    deps.PasswordRepo,
+		sessionRepo:     deps.SessionRepo,
+		identityRepo:    deps.IdentityRepo,
+		tx:              deps.Tx,
+		passwordCache:   deps.PasswordCache,
+		userCache:       deps.UserCache,
+		sessionsCache:   deps.SessionsCache,
+		revokedSessions: deps.RevokedSessions,
+		passManager:     deps.PassManager,
+		tokenManager:    deps.TokenManager,
+		qrRepo:          deps.QRStore,
+		bus:             deps.Bus,
+		loginAttempts:   deps.LoginAttempts,
+		mfa:             deps.MFA,
+		mfaChallenges:   deps.MFAChallenges,
+		passkeys:        deps.Passkeys,
+		oauthStates:     deps.OAuthStates,
+		messenger:       deps.Messenger,
+		metrics:         deps.Metrics,
+		auditLog:        deps.AuditLog,
 	}
 }
 
@@ -123,32 +219,82 @@ func (s *Service) GetMySession(
 	return session, nil
 }
 
+// Refresh rotates the tokens of a first-party session. Sessions opened for
+// an OpenID Connect client are refused; see RefreshForClient.
 func (s *Service) Refresh(
 	ctx context.Context,
 	oldRefreshToken string,
+	client models.SessionClient,
+) (models.TokensPair, error) {
+	return s.refresh(ctx, "", oldRefreshToken, client)
+}
+
+// RefreshForClient rotates the tokens of a session LoginByOAuthCode opened
+// for clientID. Sessions of other clients and first-party ones are refused.
+func (s *Service) RefreshForClient(
+	ctx context.Context,
+	clientID, oldRefreshToken string,
+	client models.SessionClient,
 ) (models.TokensPair, error) {
+	return s.refresh(ctx, clientID, oldRefreshToken, client)
+}
+
+func (s *Service) refresh(
+	ctx context.Context,
+	clientID, oldRefreshToken string,
+	client models.SessionClient,
+) (pair models.TokensPair, err error) {
+	var userID, sessionID uuid.UUID
+	defer func() { s.recordSessionEvent(ctx, models.AuthEventRefresh, userID, sessionID, client, err) }()
+
 	claims, err := s.tokenManager.ParseUserAuthRefresh(oldRefreshToken)
 	if err != nil {
 		return models.TokensPair{}, errx.ErrorSessionExpired.Raise(err)
 	}
+	sessionID = claims.SessionID
 
-	storedHash, err := s.sessionRepo.GetToken(ctx, claims.SessionID)
+	stored, err := s.sessionRepo.GetToken(ctx, claims.SessionID)
 	if err != nil {
 		return models.TokensPair{}, err
 	}
+	userID = stored.UserID
+
+	if stored.EndReason != nil {
+		return models.TokensPair{}, endedSessionError(claims.SessionID, *stored.EndReason)
+	}
+
+	var boundTo string
+	if stored.Grant != nil {
+		boundTo = stored.Grant.ClientID
+	}
+	if boundTo != clientID {
+		return models.TokensPair{}, errx.ErrorSessionTokenMismatch.Raise(
+			fmt.Errorf("session %v belongs to client %q, not %q", claims.SessionID, boundTo, clientID),
+		)
+	}
 
 	tokenHash, err := s.tokenManager.HashRefresh(oldRefreshToken)
 	if err != nil {
 		return models.TokensPair{}, err
 	}
 
-	if storedHash != tokenHash {
+	if stored.Hash != tokenHash {
+		if stored.PreviousHash != nil && *stored.PreviousHash == tokenHash {
+			return models.TokensPair{}, s.revokeReusedToken(ctx, stored.UserID, claims.SessionID)
+		}
+
 		return models.TokensPair{}, errx.ErrorSessionTokenMismatch.Raise(
 			fmt.Errorf("refresh token hash mismatch for session %v", claims.SessionID),
 		)
 	}
 
-	userID, err := uuid.Parse(claims.Subject)
+	// Checked only once the token proved to be the current one, so only the
+	// holder of the session can set it off.
+	if reason, ok := s.config.Policy.endReason(stored, time.Now()); ok {
+		return models.TokensPair{}, s.endSession(ctx, stored.UserID, claims.SessionID, stored.LastUsed, reason)
+	}
+
+	userID, err = uuid.Parse(claims.Subject)
 	if err != nil {
 		return models.TokensPair{}, err
 	}
@@ -161,6 +307,10 @@ func (s *Service) Refresh(
 		}
 	}
 
+	if err = checkSuspension(user); err != nil {
+		return models.TokensPair{}, err
+	}
+
 	newRefreshToken, err := s.tokenManager.GenerateRefresh(user, claims.SessionID)
 	if err != nil {
 		return models.TokensPair{}, err
@@ -173,13 +323,22 @@ func (s *Service) Refresh(
 
 	var session models.Session
 	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
-		session, err = s.sessionRepo.UpdateToken(ctx, claims.SessionID, newHash)
-		return err
+		session, err = s.sessionRepo.UpdateToken(ctx, claims.SessionID, tokenHash, newHash, describeClient(client))
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteSessionRefreshed(ctx, session)
 	}); err != nil {
+		// Another refresh with the same token rotated it after the check
+		// above: one of the two holders of the token is not its owner.
+		if errors.Is(err, errx.ErrorSessionTokenMismatch) {
+			return models.TokensPair{}, s.revokeReusedToken(ctx, stored.UserID, claims.SessionID)
+		}
 		return models.TokensPair{}, err
 	}
 
-	accessToken, err := s.tokenManager.GenerateAccess(user, session.ID)
+	accessToken, err := s.generateAccess(user, session.ID, stored.Grant)
 	if err != nil {
 		return models.TokensPair{}, err
 	}
@@ -198,13 +357,34 @@ func (s *Service) Refresh(
 func (s *Service) Logout(
 	ctx context.Context,
 	actor models.UserActor,
-) error {
-	if err := s.sessionRepo.Delete(ctx, actor.SessionID); err != nil {
+) (err error) {
+	defer func() {
+		s.recordSessionEvent(ctx, models.AuthEventLogout, actor.ID, actor.SessionID, actor.Client, err)
+	}()
+
+	var ended models.EndedSession
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		ended, err = s.sessionRepo.Delete(ctx, actor.SessionID)
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteSessionsRevoked(
+			ctx, actor.ID, []uuid.UUID{actor.SessionID}, models.SessionRevokedLogout,
+		)
+	}); err != nil {
 		return err
 	}
 
 	go s.sessionsCache.Delete(context.WithoutCancel(ctx), actor.SessionID)
 
+	// The session is gone from the database, but its access tokens stay
+	// valid until the revocation list has it.
+	if err = s.revokedSessions.Revoke(ctx, []models.EndedSession{ended}); err != nil {
+		return fmt.Errorf("revoke session %s: %w", actor.SessionID, err)
+	}
+
 	return nil
 }
 
@@ -212,38 +392,107 @@ func (s *Service) DeleteMySession(
 	ctx context.Context,
 	actor models.UserActor,
 	sessionID uuid.UUID,
-) error {
-	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+) (err error) {
+	defer func() {
+		s.recordSessionEvent(ctx, models.AuthEventSessionDeleted, actor.ID, sessionID, actor.Client, err)
+	}()
+
+	if _, _, err = s.auth.ValidateSession(ctx, actor); err != nil {
 		return err
 	}
 
-	if err := s.sessionRepo.DeleteOneForUser(ctx, actor.ID, sessionID); err != nil {
+	var ended models.EndedSession
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		ended, err = s.sessionRepo.DeleteOneForUser(ctx, actor.ID, sessionID)
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteSessionsRevoked(
+			ctx, actor.ID, []uuid.UUID{sessionID}, models.SessionRevokedByUser,
+		)
+	}); err != nil {
 		return err
 	}
 
 	go s.sessionsCache.Delete(context.WithoutCancel(ctx), sessionID)
 
+	if err = s.revokedSessions.Revoke(ctx, []models.EndedSession{ended}); err != nil {
+		return fmt.Errorf("revoke session %s: %w", sessionID, err)
+	}
+
 	return nil
 }
 
+// DeleteSessionsParams narrows down which sessions DeleteMySessions ends.
+// A session has to match every field that is set; the zero value matches
+// all of them.
+type DeleteSessionsParams struct {
+	// KeepCurrent spares the session making the request, i.e. "sign out
+	// everywhere else".
+	KeepCurrent bool
+
+	LastUsedBefore *time.Time
+	CreatedBefore  *time.Time
+
+	// SessionIDs limits the deletion to the listed sessions.
+	SessionIDs []uuid.UUID
+}
+
+// All reports whether the params match every session.
+func (p DeleteSessionsParams) All() bool {
+	return !p.KeepCurrent && p.LastUsedBefore == nil && p.CreatedBefore == nil && len(p.SessionIDs) == 0
+}
+
+// DeleteMySessions ends the active sessions of the actor that match params
+// and reports how many it ended.
 func (s *Service) DeleteMySessions(
 	ctx context.Context,
 	actor models.UserActor,
-) error {
-	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
-		return err
+	params DeleteSessionsParams,
+) (_ int, err error) {
+	defer func() {
+		s.recordSessionEvent(ctx, models.AuthEventSessionsDeleted, actor.ID, actor.SessionID, actor.Client, err)
+	}()
+
+	if _, _, err = s.auth.ValidateSession(ctx, actor); err != nil {
+		return 0, err
 	}
 
-	sessionIDs, err := s.sessionRepo.DeleteManyForUser(ctx, actor.ID)
-	if err != nil {
-		return err
+	reason := models.SessionRevokedSelectedByUser
+	switch {
+	case params.All():
+		reason = models.SessionRevokedAllByUser
+	case params.LastUsedBefore == nil && params.CreatedBefore == nil && len(params.SessionIDs) == 0:
+		reason = models.SessionRevokedOthersByUser
+	}
+
+	var ended []models.EndedSession
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if params.All() {
+			ended, err = s.sessionRepo.DeleteManyForUser(ctx, actor.ID)
+		} else {
+			ended, err = s.sessionRepo.DeleteFilteredForUser(ctx, actor.ID, actor.SessionID, params)
+		}
+		if err != nil || len(ended) == 0 {
+			return err
+		}
+
+		return s.messenger.WriteSessionsRevoked(ctx, actor.ID, models.EndedSessionIDs(ended), reason)
+	}); err != nil {
+		return 0, err
 	}
 
 	detached := context.WithoutCancel(ctx)
-	for _, id := range sessionIDs {
-		id := id
-		go s.sessionsCache.Delete(detached, id)
+	for _, e := range ended {
+		go s.sessionsCache.Delete(detached, e.ID)
 	}
 
-	return nil
+	if err = s.revokedSessions.Revoke(ctx, ended); err != nil {
+		return 0, fmt.Errorf("revoke %d sessions: %w", len(ended), err)
+	}
+
+	return len(ended), nil
 }
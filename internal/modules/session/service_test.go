@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/errx"
 	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/webauthn"
 	"github.com/netbill/restkit/tokens"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -26,53 +27,161 @@ func (f *fakeTx) Transaction(ctx context.Context, fn func(context.Context) error
 type SessionServiceSuite struct {
 	suite.Suite
 
-	auth          *mockAuth
-	userRepo      *mockUserRepo
-	emailRepo     *mockEmailRepo
-	passwordRepo  *mockPasswordRepo
-	sessionRepo   *mockSessionRepo
-	userCache     *mockUserCache
-	passwordCache *mockPasswordCache
-	sessionsCache *mockSessionsCache
-	passManager   *mockPasswordManager
-	tokenManager  *mockTokenManager
-	qrRepo        *mockQrRepo
-	bus           *mockBus
+	auth            *mockAuth
+	users           *mockUsers
+	userRepo        *mockUserRepo
+	emailRepo       *mockEmailRepo
+	passwordRepo    *mockPasswordRepo
+	sessionRepo     *mockSessionRepo
+	identityRepo    *mockIdentityRepo
+	userCache       *mockUserCache
+	passwordCache   *mockPasswordCache
+	sessionsCache   *mockSessionsCache
+	revokedSessions *mockRevokedSessions
+	passManager     *mockPasswordManager
+	tokenManager    *mockTokenManager
+	qrRepo          *mockQrRepo
+	bus             *mockBus
+	loginAttempts   *mockLoginAttempts
+	messenger       *mockMessenger
+	metrics         *mockMetrics
+	mfa             *mockMfa
+	mfaChallenges   *mockMfaChallenges
+	passkeys        *mockPasskeys
+	oauthStates     *mockOauthStates
+	auditLog        *mockAuditLog
 
 	svc *Service
 }
 
 func (s *SessionServiceSuite) SetupTest() {
 	s.auth = newMockAuth(s.T())
+	s.users = newMockUsers(s.T())
 	s.userRepo = newMockUserRepo(s.T())
 	s.emailRepo = newMockEmailRepo(s.T())
 	s.passwordRepo = newMockPasswordRepo(s.T())
 	s.sessionRepo = newMockSessionRepo(s.T())
+	s.identityRepo = newMockIdentityRepo(s.T())
 	s.userCache = newMockUserCache(s.T())
 	s.passwordCache = newMockPasswordCache(s.T())
 	s.sessionsCache = newMockSessionsCache(s.T())
+	s.revokedSessions = newMockRevokedSessions(s.T())
+	s.revokedSessions.On("Revoke", mock.Anything, mock.Anything).Return(nil).Maybe()
 	s.passManager = newMockPasswordManager(s.T())
 	s.tokenManager = newMockTokenManager(s.T())
 	s.qrRepo = newMockQrRepo(s.T())
 	s.bus = newMockBus(s.T())
+	s.loginAttempts = newMockLoginAttempts(s.T())
+	s.messenger = newMockMessenger(s.T())
+	s.messenger.On("WriteSessionCreated", mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.messenger.On("WriteSessionRefreshed", mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.messenger.On("WriteSessionsRevoked", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.metrics = newMockMetrics(s.T())
+	s.mfa = newMockMfa(s.T())
+	s.mfaChallenges = newMockMfaChallenges(s.T())
+	s.passkeys = newMockPasskeys(s.T())
+	s.oauthStates = newMockOauthStates(s.T())
+	s.auditLog = newMockAuditLog(s.T())
+	s.auditLog.On("Record", mock.Anything, mock.Anything).Return().Maybe()
 
 	s.svc = New(ServiceDeps{
-		Auth:          s.auth,
-		UserRepo:      s.userRepo,
-		EmailRepo:     s.emailRepo,
-		PasswordRepo:  s.passwordRepo,
-		SessionRepo:   s.sessionRepo,
-		Tx:            &fakeTx{},
-		PasswordCache: s.passwordCache,
-		UserCache:     s.userCache,
-		SessionsCache: s.sessionsCache,
-		PassManager:   s.passManager,
-		TokenManager:  s.tokenManager,
-		QRStore:       s.qrRepo,
-		Bus:           s.bus,
+		Config: Config{
+			PostLoginRedirects:   []string{"https://app.netbill.local/after-login"},
+			OAuthSignUpProviders: []string{models.IdentityProviderGoogle},
+		},
+		Auth:            s.auth,
+		Users:           s.users,
+		UserRepo:        s.userRepo,
+		EmailRepo:       s.emailRepo,
+		PasswordRepo:    s.passwordRepo,
+		SessionRepo:     s.sessionRepo,
+		IdentityRepo:    s.identityRepo,
+		Tx:              &fakeTx{},
+		PasswordCache:   s.passwordCache,
+		UserCache:       s.userCache,
+		SessionsCache:   s.sessionsCache,
+		RevokedSessions: s.revokedSessions,
+		PassManager:     s.passManager,
+		TokenManager:    s.tokenManager,
+		QRStore:         s.qrRepo,
+		Bus:             s.bus,
+		LoginAttempts:   s.loginAttempts,
+		Messenger:       s.messenger,
+		Metrics:         s.metrics,
+		MFA:             s.mfa,
+		MFAChallenges:   s.mfaChallenges,
+		Passkeys:        s.passkeys,
+		OAuthStates:     s.oauthStates,
+		AuditLog:        s.auditLog,
 	})
 }
 
+// recordedEvents replaces the catch-all audit log expectation with one that
+// hands every recorded event to the returned channel.
+func (s *SessionServiceSuite) recordedEvents() <-chan models.AuthEvent {
+	events := make(chan models.AuthEvent, 4)
+
+	s.auditLog = newMockAuditLog(s.T())
+	s.auditLog.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		events <- args.Get(1).(models.AuthEvent)
+	}).Return()
+	s.svc.auditLog = s.auditLog
+
+	return events
+}
+
+func (s *SessionServiceSuite) nextEvent(events <-chan models.AuthEvent) models.AuthEvent {
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(time.Second):
+		s.T().Fatal("no auth event recorded")
+		return models.AuthEvent{}
+	}
+}
+
+// failingMessenger replaces the catch-all messenger expectations with one
+// that fails every session lifecycle event.
+func (s *SessionServiceSuite) failingMessenger(err error) {
+	s.messenger = newMockMessenger(s.T())
+	s.messenger.On("WriteSessionCreated", mock.Anything, mock.Anything).Return(err).Maybe()
+	s.messenger.On("WriteSessionRefreshed", mock.Anything, mock.Anything).Return(err).Maybe()
+	s.messenger.On("WriteSessionsRevoked", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(err).Maybe()
+	s.svc.messenger = s.messenger
+}
+
+// captureRevoked replaces the catch-all denylist expectation with one that
+// hands every revoked batch to the returned channel.
+func (s *SessionServiceSuite) captureRevoked() <-chan []models.EndedSession {
+	revoked := make(chan []models.EndedSession, 4)
+
+	s.revokedSessions = newMockRevokedSessions(s.T())
+	s.revokedSessions.On("Revoke", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		revoked <- args.Get(1).([]models.EndedSession)
+	}).Return(nil)
+	s.svc.revokedSessions = s.revokedSessions
+
+	return revoked
+}
+
+// failingRevocations replaces the catch-all denylist expectation with one
+// that fails every write.
+func (s *SessionServiceSuite) failingRevocations(err error) {
+	s.revokedSessions = newMockRevokedSessions(s.T())
+	s.revokedSessions.On("Revoke", mock.Anything, mock.Anything).Return(err)
+	s.svc.revokedSessions = s.revokedSessions
+}
+
+func (s *SessionServiceSuite) nextRevoked(revoked <-chan []models.EndedSession) []models.EndedSession {
+	select {
+	case sessions := <-revoked:
+		return sessions
+	case <-time.After(time.Second):
+		s.T().Fatal("no sessions revoked")
+		return nil
+	}
+}
+
 func TestSessionService(t *testing.T) {
 	suite.Run(t, new(SessionServiceSuite))
 }
@@ -158,7 +267,7 @@ func (s *SessionServiceSuite) TestRefresh_ParseTokenError() {
 	parseErr := errors.New("invalid token")
 	s.tokenManager.On("ParseUserAuthRefresh", "bad_token").Return(tokens.AccountAuthClaims{}, parseErr)
 
-	_, err := s.svc.Refresh(context.Background(), "bad_token")
+	_, err := s.svc.Refresh(context.Background(), "bad_token", models.SessionClient{})
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, errx.ErrorSessionExpired)
@@ -171,9 +280,9 @@ func (s *SessionServiceSuite) TestRefresh_GetTokenError() {
 	repoErr := errors.New("db error")
 
 	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
-	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return("", repoErr)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{}, repoErr)
 
-	_, err := s.svc.Refresh(context.Background(), "token")
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, repoErr)
@@ -186,10 +295,10 @@ func (s *SessionServiceSuite) TestRefresh_HashError() {
 	hashErr := errors.New("hash error")
 
 	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
-	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return("storedhash", nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{Hash: "storedhash"}, nil)
 	s.tokenManager.On("HashRefresh", "token").Return("", hashErr)
 
-	_, err := s.svc.Refresh(context.Background(), "token")
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, hashErr)
@@ -201,15 +310,82 @@ func (s *SessionServiceSuite) TestRefresh_TokenMismatch() {
 	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
 
 	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
-	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return("storedhash", nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{Hash: "storedhash"}, nil)
 	s.tokenManager.On("HashRefresh", "token").Return("differenthash", nil)
 
-	_, err := s.svc.Refresh(context.Background(), "token")
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, errx.ErrorSessionTokenMismatch)
 }
 
+func (s *SessionServiceSuite) TestRefresh_TokenReused() {
+	sessionID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	previous := "oldhash"
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).
+		Return(models.SessionToken{UserID: userID, Hash: "storedhash", PreviousHash: &previous}, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("oldhash", nil)
+	s.sessionRepo.On("Delete", mock.Anything, sessionID).Return(models.EndedSession{ID: sessionID}, nil)
+	s.messenger.On("WriteSessionTokenReused", mock.Anything, userID, sessionID, []uuid.UUID{sessionID}).Return(nil)
+	s.metrics.On("RecordSessionTokenReuse", mock.Anything, "single").Return()
+	s.sessionsCache.On("Delete", mock.Anything, sessionID).Return(nil).Maybe()
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorSessionTokenReused)
+}
+
+func (s *SessionServiceSuite) TestRefresh_TokenReused_RevokeAll() {
+	s.svc.config.RevokeAllOnTokenReuse = true
+
+	sessionID := uuid.New()
+	otherID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	previous := "oldhash"
+	revoked := []models.EndedSession{{ID: sessionID}, {ID: otherID}}
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).
+		Return(models.SessionToken{UserID: userID, Hash: "storedhash", PreviousHash: &previous}, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("oldhash", nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, userID).Return(revoked, nil)
+	s.messenger.On("WriteSessionTokenReused", mock.Anything, userID, sessionID, models.EndedSessionIDs(revoked)).
+		Return(nil)
+	s.metrics.On("RecordSessionTokenReuse", mock.Anything, "all").Return()
+	s.sessionsCache.On("Delete", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorSessionTokenReused)
+}
+
+func (s *SessionServiceSuite) TestRefresh_TokenReused_RevokeError() {
+	sessionID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	previous := "oldhash"
+	repoErr := errors.New("db error")
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).
+		Return(models.SessionToken{UserID: userID, Hash: "storedhash", PreviousHash: &previous}, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("oldhash", nil)
+	s.sessionRepo.On("Delete", mock.Anything, sessionID).Return(models.EndedSession{}, repoErr)
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, repoErr)
+	assert.NotErrorIs(s.T(), err, errx.ErrorSessionTokenReused)
+}
+
 func (s *SessionServiceSuite) TestRefresh_UserCacheHit() {
 	sessionID := uuid.New()
 	userID := uuid.New()
@@ -218,21 +394,113 @@ func (s *SessionServiceSuite) TestRefresh_UserCacheHit() {
 	session := models.Session{ID: sessionID}
 
 	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
-	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return("hash", nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{Hash: "hash"}, nil)
 	s.tokenManager.On("HashRefresh", "token").Return("hash", nil)
 	s.userCache.On("Get", mock.Anything, userID).Return(user, nil)
 	s.tokenManager.On("GenerateRefresh", user, sessionID).Return("newrefresh", nil)
 	s.tokenManager.On("HashRefresh", "newrefresh").Return("newhash", nil)
-	s.sessionRepo.On("UpdateToken", mock.Anything, sessionID, "newhash").Return(session, nil)
+	s.sessionRepo.On("UpdateToken", mock.Anything, sessionID, "hash", "newhash", mock.Anything).Return(session, nil)
 	s.tokenManager.On("GenerateAccess", user, sessionID).Return("access", nil)
 	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
 	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
 
-	pair, err := s.svc.Refresh(context.Background(), "token")
+	pair, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
 
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), "newrefresh", pair.Refresh)
 	assert.Equal(s.T(), "access", pair.Access)
+	s.messenger.AssertCalled(s.T(), "WriteSessionRefreshed", mock.Anything, session)
+}
+
+func (s *SessionServiceSuite) TestRefresh_ClientSessionRefused() {
+	sessionID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	stored := models.SessionToken{Hash: "hash", Grant: &models.OAuthGrant{ClientID: "web-app"}}
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(stored, nil)
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	assert.ErrorIs(s.T(), err, errx.ErrorSessionTokenMismatch)
+	s.sessionRepo.AssertNotCalled(s.T(), "UpdateToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestRefreshForClient_WrongSession() {
+	cases := map[string]*models.OAuthGrant{
+		"other client": {ClientID: "other-app"},
+		"first party":  nil,
+	}
+
+	for name, grant := range cases {
+		s.Run(name, func() {
+			sessionID := uuid.New()
+			claims := tokens.AccountAuthClaims{
+				RegisteredClaims: jwtlib.RegisteredClaims{Subject: uuid.NewString()},
+				SessionID:        sessionID,
+			}
+
+			s.tokenManager.On("ParseUserAuthRefresh", "token-"+name).Return(claims, nil)
+			s.sessionRepo.On("GetToken", mock.Anything, sessionID).
+				Return(models.SessionToken{Hash: "hash", Grant: grant}, nil)
+
+			_, err := s.svc.RefreshForClient(context.Background(), "web-app", "token-"+name, models.SessionClient{})
+
+			assert.ErrorIs(s.T(), err, errx.ErrorSessionTokenMismatch)
+			s.tokenManager.AssertNotCalled(s.T(), "HashRefresh", "token-"+name)
+		})
+	}
+}
+
+func (s *SessionServiceSuite) TestRefreshForClient_HappyPath() {
+	sessionID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	user := models.User{ID: userID}
+	session := models.Session{ID: sessionID}
+	grant := &models.OAuthGrant{ClientID: "web-app", Scopes: []string{"openid", "email"}}
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{Hash: "hash", Grant: grant}, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("hash", nil)
+	s.userCache.On("Get", mock.Anything, userID).Return(user, nil)
+	s.tokenManager.On("GenerateRefresh", user, sessionID).Return("newrefresh", nil)
+	s.tokenManager.On("HashRefresh", "newrefresh").Return("newhash", nil)
+	s.sessionRepo.On("UpdateToken", mock.Anything, sessionID, "hash", "newhash", mock.Anything).Return(session, nil)
+	s.tokenManager.On("GenerateClientAccess", user, sessionID, "web-app", grant.Scopes).Return("client-access", nil)
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+
+	pair, err := s.svc.RefreshForClient(context.Background(), "web-app", "token", models.SessionClient{})
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "newrefresh", pair.Refresh)
+	assert.Equal(s.T(), "client-access", pair.Access)
+	s.tokenManager.AssertNotCalled(s.T(), "GenerateAccess", mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestRefresh_OutboxError() {
+	sessionID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	user := models.User{ID: userID}
+	outboxErr := errors.New("outbox error")
+
+	s.failingMessenger(outboxErr)
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{Hash: "hash"}, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("hash", nil)
+	s.userCache.On("Get", mock.Anything, userID).Return(user, nil)
+	s.tokenManager.On("GenerateRefresh", user, sessionID).Return("newrefresh", nil)
+	s.tokenManager.On("HashRefresh", "newrefresh").Return("newhash", nil)
+	s.sessionRepo.On("UpdateToken", mock.Anything, sessionID, "hash", "newhash", mock.Anything).
+		Return(models.Session{ID: sessionID, UserID: userID}, nil)
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, outboxErr)
 }
 
 func (s *SessionServiceSuite) TestRefresh_UserCacheMiss_RepoSuccess() {
@@ -243,18 +511,18 @@ func (s *SessionServiceSuite) TestRefresh_UserCacheMiss_RepoSuccess() {
 	session := models.Session{ID: sessionID}
 
 	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
-	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return("hash", nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{Hash: "hash"}, nil)
 	s.tokenManager.On("HashRefresh", "token").Return("hash", nil)
 	s.userCache.On("Get", mock.Anything, userID).Return(models.User{}, errors.New("miss"))
 	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
 	s.tokenManager.On("GenerateRefresh", user, sessionID).Return("newrefresh", nil)
 	s.tokenManager.On("HashRefresh", "newrefresh").Return("newhash", nil)
-	s.sessionRepo.On("UpdateToken", mock.Anything, sessionID, "newhash").Return(session, nil)
+	s.sessionRepo.On("UpdateToken", mock.Anything, sessionID, "hash", "newhash", mock.Anything).Return(session, nil)
 	s.tokenManager.On("GenerateAccess", user, sessionID).Return("access", nil)
 	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
 	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
 
-	_, err := s.svc.Refresh(context.Background(), "token")
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
 
 	require.NoError(s.T(), err)
 }
@@ -266,12 +534,12 @@ func (s *SessionServiceSuite) TestRefresh_UserRepoError() {
 	repoErr := errors.New("db error")
 
 	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
-	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return("hash", nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{Hash: "hash"}, nil)
 	s.tokenManager.On("HashRefresh", "token").Return("hash", nil)
 	s.userCache.On("Get", mock.Anything, userID).Return(models.User{}, errors.New("miss"))
 	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{}, repoErr)
 
-	_, err := s.svc.Refresh(context.Background(), "token")
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, repoErr)
@@ -285,14 +553,225 @@ func (s *SessionServiceSuite) TestRefresh_UpdateTokenError() {
 	repoErr := errors.New("db error")
 
 	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
-	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return("hash", nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{Hash: "hash"}, nil)
 	s.tokenManager.On("HashRefresh", "token").Return("hash", nil)
 	s.userCache.On("Get", mock.Anything, userID).Return(user, nil)
 	s.tokenManager.On("GenerateRefresh", user, sessionID).Return("newrefresh", nil)
 	s.tokenManager.On("HashRefresh", "newrefresh").Return("newhash", nil)
-	s.sessionRepo.On("UpdateToken", mock.Anything, sessionID, "newhash").Return(models.Session{}, repoErr)
+	s.sessionRepo.On("UpdateToken", mock.Anything, sessionID, "hash", "newhash", mock.Anything).Return(models.Session{}, repoErr)
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, repoErr)
+}
+
+func (s *SessionServiceSuite) TestRefresh_ConcurrentRotationIsReuse() {
+	// Two refreshes with the same token both pass the hash check; the one
+	// whose rotation finds the hash already replaced is treated as reuse.
+	sessionID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	user := models.User{ID: userID}
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{UserID: userID, Hash: "hash"}, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("hash", nil)
+	s.userCache.On("Get", mock.Anything, userID).Return(user, nil)
+	s.tokenManager.On("GenerateRefresh", user, sessionID).Return("newrefresh", nil)
+	s.tokenManager.On("HashRefresh", "newrefresh").Return("newhash", nil)
+	s.sessionRepo.On("UpdateToken", mock.Anything, sessionID, "hash", "newhash", mock.Anything).
+		Return(models.Session{}, errx.ErrorSessionTokenMismatch.Raise(errors.New("already rotated")))
+	s.sessionRepo.On("Delete", mock.Anything, sessionID).Return(models.EndedSession{ID: sessionID}, nil)
+	s.messenger.On("WriteSessionTokenReused", mock.Anything, userID, sessionID, []uuid.UUID{sessionID}).Return(nil)
+	s.metrics.On("RecordSessionTokenReuse", mock.Anything, "single").Return()
+	s.sessionsCache.On("Delete", mock.Anything, sessionID).Return(nil).Maybe()
+
+	pair, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorSessionTokenReused)
+	assert.Empty(s.T(), pair.Refresh)
+	s.sessionRepo.AssertCalled(s.T(), "Delete", mock.Anything, sessionID)
+	s.messenger.AssertCalled(s.T(), "WriteSessionTokenReused", mock.Anything, userID, sessionID, []uuid.UUID{sessionID})
+	s.tokenManager.AssertNotCalled(s.T(), "GenerateAccess", mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestRefresh_UserSuspended() {
+	sessionID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	suspendedAt := time.Now().Add(-time.Minute)
+	user := models.User{ID: userID, SuspendedAt: &suspendedAt}
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(models.SessionToken{Hash: "hash"}, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("hash", nil)
+	s.userCache.On("Get", mock.Anything, userID).Return(user, nil)
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorUserSuspended)
+}
+
+// ─── Session policies ────────────────────────────────────────────────────────
+
+func (s *SessionServiceSuite) TestRefresh_IdleTimeout() {
+	s.svc.config.Policy.IdleTimeout = time.Hour
+
+	sessionID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	stored := models.SessionToken{
+		UserID:    userID,
+		Hash:      "hash",
+		CreatedAt: time.Now().Add(-3 * time.Hour),
+		LastUsed:  time.Now().Add(-2 * time.Hour),
+	}
+	revoked := s.captureRevoked()
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(stored, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("hash", nil)
+	s.sessionRepo.On("End", mock.Anything, sessionID, models.SessionRevokedIdleTimeout).Return(nil)
+	s.metrics.On("RecordSessionsEndedByPolicy", mock.Anything, models.SessionRevokedIdleTimeout, 1).Return()
+	s.sessionsCache.On("Delete", mock.Anything, sessionID).Return(nil).Maybe()
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorSessionIdleTimeout)
+	assert.Equal(s.T(),
+		[]models.EndedSession{{ID: sessionID, LastUsed: stored.LastUsed}}, s.nextRevoked(revoked))
+	s.messenger.AssertCalled(s.T(), "WriteSessionsRevoked",
+		mock.Anything, userID, []uuid.UUID{sessionID}, models.SessionRevokedIdleTimeout)
+}
+
+func (s *SessionServiceSuite) TestRefresh_MaxAge() {
+	s.svc.config.Policy.IdleTimeout = time.Hour
+	s.svc.config.Policy.MaxAge = 24 * time.Hour
+
+	sessionID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	stored := models.SessionToken{
+		UserID:    userID,
+		Hash:      "hash",
+		CreatedAt: time.Now().Add(-25 * time.Hour),
+		LastUsed:  time.Now().Add(-time.Minute),
+	}
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(stored, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("hash", nil)
+	s.sessionRepo.On("End", mock.Anything, sessionID, models.SessionRevokedMaxAge).Return(nil)
+	s.metrics.On("RecordSessionsEndedByPolicy", mock.Anything, models.SessionRevokedMaxAge, 1).Return()
+	s.sessionsCache.On("Delete", mock.Anything, sessionID).Return(nil).Maybe()
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorSessionLifetimeExceeded)
+}
+
+func (s *SessionServiceSuite) TestRefresh_IdleTimeout_TokenMismatchDoesNotEnd() {
+	s.svc.config.Policy.IdleTimeout = time.Hour
+
+	sessionID := uuid.New()
+	claims := tokens.AccountAuthClaims{SessionID: sessionID}
+	stored := models.SessionToken{Hash: "storedhash", LastUsed: time.Now().Add(-2 * time.Hour)}
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).Return(stored, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("differenthash", nil)
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorSessionTokenMismatch)
+	s.sessionRepo.AssertNotCalled(s.T(), "End", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestRefresh_EndedSession() {
+	cases := map[string]error{
+		models.SessionRevokedIdleTimeout: errx.ErrorSessionIdleTimeout,
+		models.SessionRevokedMaxAge:      errx.ErrorSessionLifetimeExceeded,
+		models.SessionRevokedEvicted:     errx.ErrorSessionEvicted,
+	}
+
+	for reason, want := range cases {
+		s.Run(reason, func() {
+			s.SetupTest()
+
+			sessionID := uuid.New()
+			claims := tokens.AccountAuthClaims{SessionID: sessionID}
+			reason := reason
+
+			s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+			s.sessionRepo.On("GetToken", mock.Anything, sessionID).
+				Return(models.SessionToken{Hash: "hash", EndReason: &reason}, nil)
+
+			_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+
+			require.Error(s.T(), err)
+			assert.ErrorIs(s.T(), err, want)
+		})
+	}
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_EvictsOverSessionLimit() {
+	s.svc.config.Policy.MaxActive = 5
+	s.svc.config.Policy.MaxActiveByRole = map[string]int{"admin": 2}
+
+	userID := uuid.New()
+	user := models.User{ID: userID, Role: "admin"}
+	session := models.Session{ID: uuid.New(), UserID: userID}
+	evicted := []models.EndedSession{{ID: uuid.New(), LastUsed: time.Now().Add(-time.Hour)}}
+	revoked := s.captureRevoked()
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(models.UserPassword{UserID: userID, Hash: "hash"}, nil)
+	s.passManager.On("CheckMatch", "Password1!", "hash").Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.sessionRepo.On("EvictLeastRecentlyUsed", mock.Anything, userID, 2).Return(evicted, nil)
+	s.metrics.On("RecordSessionsEndedByPolicy", mock.Anything, models.SessionRevokedEvicted, 1).Return()
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+	s.sessionsCache.On("Delete", mock.Anything, evicted[0].ID).Return(nil).Maybe()
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), evicted, s.nextRevoked(revoked))
+	s.messenger.AssertCalled(s.T(), "WriteSessionsRevoked",
+		mock.Anything, userID, models.EndedSessionIDs(evicted), models.SessionRevokedEvicted)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_EvictError() {
+	s.svc.config.Policy.MaxActive = 1
+
+	userID := uuid.New()
+	user := models.User{ID: userID}
+	session := models.Session{ID: uuid.New(), UserID: userID}
+	repoErr := errors.New("db error")
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(models.UserPassword{UserID: userID, Hash: "hash"}, nil)
+	s.passManager.On("CheckMatch", "Password1!", "hash").Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.sessionRepo.On("EvictLeastRecentlyUsed", mock.Anything, userID, 1).Return(nil, repoErr)
 
-	_, err := s.svc.Refresh(context.Background(), "token")
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, repoErr)
@@ -304,7 +783,7 @@ func (s *SessionServiceSuite) TestLogout_RepoError() {
 	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
 	repoErr := errors.New("db error")
 
-	s.sessionRepo.On("Delete", mock.Anything, actor.SessionID).Return(repoErr)
+	s.sessionRepo.On("Delete", mock.Anything, actor.SessionID).Return(models.EndedSession{}, repoErr)
 
 	err := s.svc.Logout(context.Background(), actor)
 
@@ -315,12 +794,45 @@ func (s *SessionServiceSuite) TestLogout_RepoError() {
 func (s *SessionServiceSuite) TestLogout_HappyPath() {
 	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
 
-	s.sessionRepo.On("Delete", mock.Anything, actor.SessionID).Return(nil)
+	s.sessionRepo.On("Delete", mock.Anything, actor.SessionID).Return(models.EndedSession{ID: actor.SessionID}, nil)
 	s.sessionsCache.On("Delete", mock.Anything, actor.SessionID).Return(nil).Maybe()
 
+	revoked := s.captureRevoked()
+
 	err := s.svc.Logout(context.Background(), actor)
 
 	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []models.EndedSession{{ID: actor.SessionID}}, s.nextRevoked(revoked))
+	s.messenger.AssertCalled(s.T(), "WriteSessionsRevoked",
+		mock.Anything, actor.ID, []uuid.UUID{actor.SessionID}, models.SessionRevokedLogout)
+}
+
+func (s *SessionServiceSuite) TestLogout_OutboxError() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	outboxErr := errors.New("outbox error")
+
+	s.failingMessenger(outboxErr)
+	s.sessionRepo.On("Delete", mock.Anything, actor.SessionID).Return(models.EndedSession{ID: actor.SessionID}, nil)
+
+	err := s.svc.Logout(context.Background(), actor)
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, outboxErr)
+	s.sessionsCache.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestLogout_RevocationError() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	revokeErr := errors.New("redis down")
+
+	s.failingRevocations(revokeErr)
+	s.sessionRepo.On("Delete", mock.Anything, actor.SessionID).Return(models.EndedSession{ID: actor.SessionID}, nil)
+	s.sessionsCache.On("Delete", mock.Anything, actor.SessionID).Return(nil).Maybe()
+
+	err := s.svc.Logout(context.Background(), actor)
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, revokeErr)
 }
 
 // ─── DeleteMySession ─────────────────────────────────────────────────────────
@@ -344,7 +856,7 @@ func (s *SessionServiceSuite) TestDeleteMySession_RepoError() {
 	repoErr := errors.New("db error")
 
 	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
-	s.sessionRepo.On("DeleteOneForUser", mock.Anything, actor.ID, sessionID).Return(repoErr)
+	s.sessionRepo.On("DeleteOneForUser", mock.Anything, actor.ID, sessionID).Return(models.EndedSession{}, repoErr)
 
 	err := s.svc.DeleteMySession(context.Background(), actor, sessionID)
 
@@ -357,76 +869,201 @@ func (s *SessionServiceSuite) TestDeleteMySession_HappyPath() {
 	sessionID := uuid.New()
 
 	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
-	s.sessionRepo.On("DeleteOneForUser", mock.Anything, actor.ID, sessionID).Return(nil)
+	s.sessionRepo.On("DeleteOneForUser", mock.Anything, actor.ID, sessionID).Return(models.EndedSession{ID: sessionID}, nil)
 	s.sessionsCache.On("Delete", mock.Anything, sessionID).Return(nil).Maybe()
 
 	err := s.svc.DeleteMySession(context.Background(), actor, sessionID)
 
 	require.NoError(s.T(), err)
+	s.messenger.AssertCalled(s.T(), "WriteSessionsRevoked",
+		mock.Anything, actor.ID, []uuid.UUID{sessionID}, models.SessionRevokedByUser)
 }
 
-// ─── DeleteMySessions ────────────────────────────────────────────────────────
+// ─── UpdateMySession ─────────────────────────────────────────────────────────
 
-func (s *SessionServiceSuite) TestDeleteMySessions_ValidateSessionError() {
+func (s *SessionServiceSuite) TestUpdateMySession_ValidateSessionError() {
 	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
 	authErr := errors.New("invalid session")
 
 	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, authErr)
 
-	err := s.svc.DeleteMySessions(context.Background(), actor)
+	_, err := s.svc.UpdateMySession(context.Background(), actor, uuid.New(), UpdateSessionParams{DeviceName: "Phone"})
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, authErr)
 }
 
-func (s *SessionServiceSuite) TestDeleteMySessions_RepoError() {
+func (s *SessionServiceSuite) TestUpdateMySession_HappyPath() {
 	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
-	repoErr := errors.New("db error")
+	sessionID := uuid.New()
+	name := "Work laptop"
+	session := models.Session{ID: sessionID, UserID: actor.ID, DeviceName: &name}
 
 	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
-	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]uuid.UUID(nil), repoErr)
+	s.sessionRepo.On("UpdateDeviceName", mock.Anything, actor.ID, sessionID, name).Return(session, nil)
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
 
-	err := s.svc.DeleteMySessions(context.Background(), actor)
+	got, err := s.svc.UpdateMySession(context.Background(), actor, sessionID, UpdateSessionParams{
+		DeviceName: "  Work laptop ",
+	})
 
-	require.Error(s.T(), err)
-	assert.ErrorIs(s.T(), err, repoErr)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), session, got)
 }
 
-func (s *SessionServiceSuite) TestDeleteMySessions_HappyPath() {
+func (s *SessionServiceSuite) TestUpdateMySession_NotFound() {
 	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
-	id1, id2 := uuid.New(), uuid.New()
+	sessionID := uuid.New()
+	repoErr := errx.ErrorSessionNotFound.Raise(errors.New("no rows"))
 
 	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
-	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]uuid.UUID{id1, id2}, nil)
-	s.sessionsCache.On("Delete", mock.Anything, id1).Return(nil).Maybe()
-	s.sessionsCache.On("Delete", mock.Anything, id2).Return(nil).Maybe()
+	s.sessionRepo.On("UpdateDeviceName", mock.Anything, actor.ID, sessionID, "").Return(models.Session{}, repoErr)
 
-	err := s.svc.DeleteMySessions(context.Background(), actor)
+	_, err := s.svc.UpdateMySession(context.Background(), actor, sessionID, UpdateSessionParams{})
 
-	require.NoError(s.T(), err)
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorSessionNotFound)
 }
 
-// ─── LoginByEmail ────────────────────────────────────────────────────────────
+// ─── DeleteMySessions ────────────────────────────────────────────────────────
 
-func (s *SessionServiceSuite) TestLoginByEmail_EmailRepoError() {
-	repoErr := errors.New("email not found")
-	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{}, repoErr)
+func (s *SessionServiceSuite) TestDeleteMySessions_ValidateSessionError() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	authErr := errors.New("invalid session")
 
-	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!")
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, authErr)
+
+	_, err := s.svc.DeleteMySessions(context.Background(), actor, DeleteSessionsParams{})
 
 	require.Error(s.T(), err)
-	assert.ErrorIs(s.T(), err, repoErr)
+	assert.ErrorIs(s.T(), err, authErr)
 }
 
-func (s *SessionServiceSuite) TestLoginByEmail_UserRepoError() {
-	userID := uuid.New()
-	emailRecord := models.UserEmail{UserID: userID}
+func (s *SessionServiceSuite) TestDeleteMySessions_RepoError() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
 	repoErr := errors.New("db error")
 
-	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(emailRecord, nil)
-	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{}, repoErr)
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]models.EndedSession(nil), repoErr)
 
-	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!")
+	_, err := s.svc.DeleteMySessions(context.Background(), actor, DeleteSessionsParams{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, repoErr)
+}
+
+func (s *SessionServiceSuite) TestDeleteMySessions_HappyPath() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	id1, id2 := uuid.New(), uuid.New()
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]models.EndedSession{{ID: id1}, {ID: id2}}, nil)
+	s.sessionsCache.On("Delete", mock.Anything, id1).Return(nil).Maybe()
+	s.sessionsCache.On("Delete", mock.Anything, id2).Return(nil).Maybe()
+
+	revoked := s.captureRevoked()
+
+	count, err := s.svc.DeleteMySessions(context.Background(), actor, DeleteSessionsParams{})
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, count)
+	assert.Equal(s.T(), []models.EndedSession{{ID: id1}, {ID: id2}}, s.nextRevoked(revoked))
+	s.messenger.AssertCalled(s.T(), "WriteSessionsRevoked",
+		mock.Anything, actor.ID, []uuid.UUID{id1, id2}, models.SessionRevokedAllByUser)
+}
+
+func (s *SessionServiceSuite) TestDeleteMySessions_RevocationError() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	id := uuid.New()
+	revokeErr := errors.New("redis down")
+
+	s.failingRevocations(revokeErr)
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]models.EndedSession{{ID: id}}, nil)
+	s.sessionsCache.On("Delete", mock.Anything, id).Return(nil).Maybe()
+
+	_, err := s.svc.DeleteMySessions(context.Background(), actor, DeleteSessionsParams{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, revokeErr)
+}
+
+func (s *SessionServiceSuite) TestDeleteMySessions_KeepCurrent() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	params := DeleteSessionsParams{KeepCurrent: true}
+	other := uuid.New()
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.sessionRepo.On("DeleteFilteredForUser", mock.Anything, actor.ID, actor.SessionID, params).
+		Return([]models.EndedSession{{ID: other}}, nil)
+	s.sessionsCache.On("Delete", mock.Anything, other).Return(nil).Maybe()
+
+	revoked := s.captureRevoked()
+
+	count, err := s.svc.DeleteMySessions(context.Background(), actor, params)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, count)
+	assert.Equal(s.T(), []models.EndedSession{{ID: other}}, s.nextRevoked(revoked))
+	s.sessionRepo.AssertNotCalled(s.T(), "DeleteManyForUser", mock.Anything, mock.Anything)
+	s.messenger.AssertCalled(s.T(), "WriteSessionsRevoked",
+		mock.Anything, actor.ID, []uuid.UUID{other}, models.SessionRevokedOthersByUser)
+}
+
+func (s *SessionServiceSuite) TestDeleteMySessions_Filtered() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	before := time.Now().Add(-24 * time.Hour)
+	params := DeleteSessionsParams{KeepCurrent: true, LastUsedBefore: &before}
+	stale := uuid.New()
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.sessionRepo.On("DeleteFilteredForUser", mock.Anything, actor.ID, actor.SessionID, params).
+		Return([]models.EndedSession{{ID: stale}}, nil)
+	s.sessionsCache.On("Delete", mock.Anything, stale).Return(nil).Maybe()
+
+	count, err := s.svc.DeleteMySessions(context.Background(), actor, params)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, count)
+	s.messenger.AssertCalled(s.T(), "WriteSessionsRevoked",
+		mock.Anything, actor.ID, []uuid.UUID{stale}, models.SessionRevokedSelectedByUser)
+}
+
+func (s *SessionServiceSuite) TestDeleteMySessions_NothingDeleted() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]models.EndedSession(nil), nil)
+
+	count, err := s.svc.DeleteMySessions(context.Background(), actor, DeleteSessionsParams{})
+
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), count)
+	s.messenger.AssertNotCalled(s.T(), "WriteSessionsRevoked",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ─── LoginByEmail ────────────────────────────────────────────────────────────
+
+func (s *SessionServiceSuite) TestLoginByEmail_EmailRepoError() {
+	repoErr := errors.New("email not found")
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{}, repoErr)
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, repoErr)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_UserRepoError() {
+	userID := uuid.New()
+	emailRecord := models.UserEmail{UserID: userID}
+	repoErr := errors.New("db error")
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(emailRecord, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{}, repoErr)
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, repoErr)
@@ -434,168 +1071,1040 @@ func (s *SessionServiceSuite) TestLoginByEmail_UserRepoError() {
 
 func (s *SessionServiceSuite) TestLoginByEmail_WrongPassword() {
 	userID := uuid.New()
-	emailRecord := models.UserEmail{UserID: userID}
-	user := models.User{ID: userID}
-	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
-	checkErr := errors.New("password mismatch")
+	emailRecord := models.UserEmail{UserID: userID}
+	user := models.User{ID: userID}
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+	checkErr := errors.New("password mismatch")
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(emailRecord, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "wrongpass", pwd.Hash).Return(checkErr)
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "wrongpass", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, checkErr)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_UserSuspended() {
+	userID := uuid.New()
+	suspendedAt := time.Now().Add(-time.Minute)
+	user := models.User{ID: userID, SuspendedAt: &suspendedAt}
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorUserSuspended)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_SuspensionEnded() {
+	userID := uuid.New()
+	suspendedAt := time.Now().Add(-2 * time.Hour)
+	suspendedUntil := time.Now().Add(-time.Hour)
+	user := models.User{ID: userID, SuspendedAt: &suspendedAt, SuspendedUntil: &suspendedUntil}
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+	session := models.Session{ID: uuid.New(), UserID: userID}
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+
+	require.NoError(s.T(), err)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_HappyPath() {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	emailRecord := models.UserEmail{UserID: userID}
+	user := models.User{ID: userID}
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+	session := models.Session{ID: sessionID, UserID: userID}
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(emailRecord, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+
+	res, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "refresh", res.Tokens.Refresh)
+	assert.Equal(s.T(), "access", res.Tokens.Access)
+	assert.Nil(s.T(), res.Challenge)
+	s.messenger.AssertCalled(s.T(), "WriteSessionCreated", mock.Anything, session)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_RecordsClient() {
+	userID := uuid.New()
+	user := models.User{ID: userID}
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+	session := models.Session{ID: uuid.New(), UserID: userID}
+	ua := "Mozilla/5.0 (X11; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0"
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", models.SessionClient{
+		UserAgent: ua,
+		IP:        "203.0.113.7",
+		Platform:  "Linux",
+		Browser:   "Firefox",
+	}, (*models.OAuthGrant)(nil)).Return(session, nil)
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{
+		UserAgent: ua,
+		IP:        "203.0.113.7",
+	})
+
+	require.NoError(s.T(), err)
+}
+
+// ─── LoginLimits ─────────────────────────────────────────────────────────────
+
+var testLoginLimits = LoginLimits{
+	Window:              15 * time.Minute,
+	MaxFailuresPerEmail: 3,
+	MaxFailuresPerIP:    10,
+	LockoutBase:         time.Minute,
+	LockoutMax:          time.Hour,
+}
+
+const testEmailSubject = "email:b4c9a289323b21a01c3e940f150eb9b8c542587f1abfd8f0e1cc1ffc5e475514"
+
+func (s *SessionServiceSuite) TestLoginByEmail_Locked() {
+	s.svc.config.LoginLimits = testLoginLimits
+
+	s.loginAttempts.On("LockedFor", mock.Anything, testEmailSubject).Return(time.Duration(0), nil)
+	s.loginAttempts.On("LockedFor", mock.Anything, "ip:203.0.113.7").Return(90*time.Second, nil)
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{
+		IP: "203.0.113.7",
+	})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorTooManyLoginAttempts)
+
+	wait, ok := errx.RetryAfter(err)
+	require.True(s.T(), ok)
+	assert.Equal(s.T(), 90*time.Second, wait)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_LockStoreError_FailsOpen() {
+	s.svc.config.LoginLimits = testLoginLimits
+	repoErr := errors.New("db error")
+
+	s.loginAttempts.On("LockedFor", mock.Anything, testEmailSubject).Return(time.Duration(0), errors.New("redis down"))
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{}, repoErr)
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, repoErr)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_WrongPassword_BelowThreshold() {
+	s.svc.config.LoginLimits = testLoginLimits
+	userID := uuid.New()
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+
+	s.loginAttempts.On("LockedFor", mock.Anything, testEmailSubject).Return(time.Duration(0), nil)
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID}, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "wrongpass", pwd.Hash).Return(errx.ErrorPasswordInvalid.Raise(errors.New("mismatch")))
+	s.loginAttempts.On("AddFailure", mock.Anything, testEmailSubject, testLoginLimits.Window).Return(2, nil)
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "wrongpass", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorPasswordInvalid)
+	s.loginAttempts.AssertNotCalled(s.T(), "Lock", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_UnknownEmail_LocksWithBackoff() {
+	s.svc.config.LoginLimits = testLoginLimits
+
+	s.loginAttempts.On("LockedFor", mock.Anything, testEmailSubject).Return(time.Duration(0), nil)
+	s.loginAttempts.On("LockedFor", mock.Anything, "ip:203.0.113.7").Return(time.Duration(0), nil)
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").
+		Return(models.UserEmail{}, errx.ErrorUserNotFound.Raise(errors.New("no such email")))
+	// Two failures past the email threshold: the base lockout doubles twice.
+	s.loginAttempts.On("AddFailure", mock.Anything, testEmailSubject, testLoginLimits.Window).Return(5, nil)
+	s.loginAttempts.On("AddFailure", mock.Anything, "ip:203.0.113.7", testLoginLimits.Window).Return(5, nil)
+	s.loginAttempts.On("Lock", mock.Anything, testEmailSubject, 4*time.Minute).Return(nil)
+	s.metrics.On("RecordLoginLockout", mock.Anything, "email").Return()
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{
+		IP: "203.0.113.7",
+	})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorUserNotFound)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_HappyPath_ResetsEmailFailures() {
+	s.svc.config.LoginLimits = testLoginLimits
+	userID := uuid.New()
+	user := models.User{ID: userID}
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+	session := models.Session{ID: uuid.New(), UserID: userID}
+
+	s.loginAttempts.On("LockedFor", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+	s.emailRepo.On("GetByEmail", mock.Anything, "User@Example.com ").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.loginAttempts.On("Reset", mock.Anything, testEmailSubject).Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+
+	_, err := s.svc.LoginByEmail(context.Background(), "User@Example.com ", "Password1!", models.SessionClient{
+		IP: "203.0.113.7",
+	})
+
+	require.NoError(s.T(), err)
+}
+
+func (s *SessionServiceSuite) TestLoginByEmail_RepoError_NotCounted() {
+	s.svc.config.LoginLimits = testLoginLimits
+	repoErr := errors.New("db error")
+
+	s.loginAttempts.On("LockedFor", mock.Anything, testEmailSubject).Return(time.Duration(0), nil)
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{}, repoErr)
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	s.loginAttempts.AssertNotCalled(s.T(), "AddFailure", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLockoutFor(t *testing.T) {
+	capped := LoginLimits{LockoutBase: time.Minute, LockoutMax: 10 * time.Minute}
+	uncapped := LoginLimits{LockoutBase: time.Minute}
+
+	tests := []struct {
+		name   string
+		limits LoginLimits
+		over   int
+		want   time.Duration
+	}{
+		{"capped/at threshold", capped, 0, time.Minute},
+		{"capped/one over", capped, 1, 2 * time.Minute},
+		{"capped/three over", capped, 3, 8 * time.Minute},
+		{"capped/reaches max", capped, 4, 10 * time.Minute},
+		{"capped/stays at max", capped, 100, 10 * time.Minute},
+		{"uncapped/at threshold", uncapped, 0, time.Minute},
+		{"uncapped/one over", uncapped, 1, 2 * time.Minute},
+		{"uncapped/keeps doubling", uncapped, 6, 64 * time.Minute},
+		{"uncapped/does not overflow", uncapped, 100, time.Minute << 27},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, lockoutFor(tt.over, tt.limits))
+		})
+	}
+}
+
+// ─── LoginByOIDC ─────────────────────────────────────────────────────────────
+
+func googleIdentity(email string) models.ExternalIdentity {
+	return models.ExternalIdentity{
+		Provider:      models.IdentityProviderGoogle,
+		Subject:       "sub-" + email,
+		Email:         email,
+		EmailVerified: true,
+	}
+}
+
+func (s *SessionServiceSuite) identityNotFound(identity models.ExternalIdentity) {
+	s.identityRepo.On("GetByProviderSubject", mock.Anything, identity.Provider, identity.Subject).
+		Return(models.UserIdentity{}, errx.ErrorIdentityNotFound.Raise(errors.New("no rows")))
+}
+
+func (s *SessionServiceSuite) TestLoginByOIDC_IdentityRepoError() {
+	identity := googleIdentity("user@gmail.com")
+	repoErr := errors.New("db error")
+
+	s.identityRepo.On("GetByProviderSubject", mock.Anything, identity.Provider, identity.Subject).
+		Return(models.UserIdentity{}, repoErr)
+
+	_, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, repoErr)
+}
+
+func (s *SessionServiceSuite) TestLoginByOIDC_UserRepoError() {
+	identity := googleIdentity("user@gmail.com")
+	linked := models.UserIdentity{ID: uuid.New(), UserID: uuid.New()}
+	repoErr := errors.New("db error")
+
+	s.identityRepo.On("GetByProviderSubject", mock.Anything, identity.Provider, identity.Subject).Return(linked, nil)
+	s.userRepo.On("GetByID", mock.Anything, linked.UserID).Return(models.User{}, repoErr)
+
+	_, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, repoErr)
+}
+
+func (s *SessionServiceSuite) TestLoginByOIDC_HappyPath() {
+	// The email changed at Google since the account was linked: the login
+	// still finds the user by sub and stores the new email.
+	identity := googleIdentity("renamed@gmail.com")
+	userID := uuid.New()
+	linked := models.UserIdentity{ID: uuid.New(), UserID: userID, Email: "user@gmail.com"}
+	user := models.User{ID: userID}
+	session := models.Session{ID: uuid.New(), UserID: userID}
+
+	s.identityRepo.On("GetByProviderSubject", mock.Anything, identity.Provider, identity.Subject).Return(linked, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.identityRepo.On("RecordUse", mock.Anything, linked.ID, "renamed@gmail.com").Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+
+	res, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "refresh", res.Tokens.Refresh)
+	s.emailRepo.AssertNotCalled(s.T(), "GetByEmail", mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestLoginByOIDC_EmailOfUnlinkedAccount() {
+	identity := googleIdentity("user@gmail.com")
+
+	s.identityNotFound(identity)
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@gmail.com").Return(models.UserEmail{UserID: uuid.New()}, nil)
+
+	_, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+	assert.ErrorIs(s.T(), err, errx.ErrorIdentityNotLinked)
+	s.userRepo.AssertNotCalled(s.T(), "GetByID", mock.Anything, mock.Anything)
+	s.users.AssertNotCalled(s.T(), "RegistrationByOAuth", mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestLoginByOIDC_SignsUpNewEmail() {
+	identity := googleIdentity("new@gmail.com")
+	user := models.User{ID: uuid.New()}
+	session := models.Session{ID: uuid.New(), UserID: user.ID}
+	notFound := errx.ErrorUserNotFound.Raise(errors.New("no rows"))
+
+	s.identityNotFound(identity)
+	s.emailRepo.On("GetByEmail", mock.Anything, "new@gmail.com").Return(models.UserEmail{}, notFound)
+	s.users.On("RegistrationByOAuth", mock.Anything, identity).Return(user, nil)
+	s.mfa.On("IsEnabled", mock.Anything, user.ID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, user.ID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+
+	res, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "access", res.Tokens.Access)
+}
+
+func (s *SessionServiceSuite) TestLoginByOIDC_SignUpDisabled() {
+	identity := googleIdentity("new@gmail.com")
+	identity.Provider = "keycloak"
+	notFound := errx.ErrorUserNotFound.Raise(errors.New("no rows"))
+
+	s.identityNotFound(identity)
+	s.emailRepo.On("GetByEmail", mock.Anything, "new@gmail.com").Return(models.UserEmail{}, notFound)
+
+	_, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+	assert.ErrorIs(s.T(), err, errx.ErrorUserNotFound)
+	s.users.AssertNotCalled(s.T(), "RegistrationByOAuth", mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestLoginByOIDC_UnverifiedEmailNotSignedUp() {
+	// An address the provider does not vouch for neither signs up nor
+	// tells whether someone registered it.
+	identity := googleIdentity("user@gmail.com")
+	identity.EmailVerified = false
+
+	s.identityNotFound(identity)
+
+	_, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+	assert.ErrorIs(s.T(), err, errx.ErrorUserNotFound)
+	s.emailRepo.AssertNotCalled(s.T(), "GetByEmail", mock.Anything, mock.Anything)
+	s.users.AssertNotCalled(s.T(), "RegistrationByOAuth", mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestLoginByOIDC_LinkedWithUnverifiedEmail() {
+	identity := googleIdentity("user@gmail.com")
+	identity.EmailVerified = false
+	userID := uuid.New()
+	linked := models.UserIdentity{ID: uuid.New(), UserID: userID}
+	user := models.User{ID: userID}
+
+	s.identityRepo.On("GetByProviderSubject", mock.Anything, identity.Provider, identity.Subject).Return(linked, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.identityRepo.On("RecordUse", mock.Anything, linked.ID, "user@gmail.com").Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(true, nil)
+	s.mfaChallenges.On("Create", mock.Anything, mock.Anything, userID, "", MFAChallengeTTL).Return(nil)
+
+	res, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+	require.NoError(s.T(), err)
+	assert.NotNil(s.T(), res.Challenge)
+}
+
+func (s *SessionServiceSuite) TestLoginByOIDC_EmailOfDeletedUser() {
+	identity := googleIdentity("gone@gmail.com")
+	notFound := errx.ErrorUserNotFound.Raise(errors.New("no rows"))
+
+	s.identityNotFound(identity)
+	s.emailRepo.On("GetByEmail", mock.Anything, "gone@gmail.com").Return(models.UserEmail{}, notFound)
+	s.users.On("RegistrationByOAuth", mock.Anything, identity).
+		Return(models.User{}, errx.ErrorEmailAlreadyExist.Raise(errors.New("duplicate key")))
+
+	_, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+	assert.ErrorIs(s.T(), err, errx.ErrorUserDeleted)
+}
+
+func (s *SessionServiceSuite) TestLoginByOIDC_ConcurrentSignUp() {
+	identity := googleIdentity("new@gmail.com")
+	userID := uuid.New()
+	linked := models.UserIdentity{ID: uuid.New(), UserID: userID}
+	user := models.User{ID: userID}
+	session := models.Session{ID: uuid.New(), UserID: userID}
+	notFound := errx.ErrorUserNotFound.Raise(errors.New("no rows"))
+
+	s.identityRepo.On("GetByProviderSubject", mock.Anything, identity.Provider, identity.Subject).
+		Return(models.UserIdentity{}, errx.ErrorIdentityNotFound.Raise(errors.New("no rows"))).Once()
+	s.emailRepo.On("GetByEmail", mock.Anything, "new@gmail.com").Return(models.UserEmail{}, notFound)
+	s.users.On("RegistrationByOAuth", mock.Anything, identity).
+		Return(models.User{}, errx.ErrorIdentityAlreadyLinked.Raise(errors.New("duplicate key")))
+	s.identityRepo.On("GetByProviderSubject", mock.Anything, identity.Provider, identity.Subject).Return(linked, nil).Once()
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.identityRepo.On("RecordUse", mock.Anything, linked.ID, "new@gmail.com").Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+
+	res, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "access", res.Tokens.Access)
+}
+
+// ─── OAuth login state ──────────────────────────────────────────────────────
+
+func (s *SessionServiceSuite) TestStartOAuthLogin_StoresStateHash() {
+	var stored models.OAuthLoginState
+	var storedKey string
+	s.oauthStates.On("Set", mock.Anything, mock.Anything, mock.Anything, OAuthStateTTL).
+		Run(func(args mock.Arguments) {
+			storedKey = args.String(1)
+			stored = args.Get(2).(models.OAuthLoginState)
+		}).
+		Return(nil)
+
+	start, err := s.svc.StartOAuthLogin(context.Background(), models.IdentityProviderGoogle, "https://app.netbill.local/after-login")
+
+	require.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), start.State)
+	assert.NotEqual(s.T(), start.Nonce, start.CodeVerifier)
+	assert.Equal(s.T(), hashOAuthState(start.State), storedKey)
+	assert.Equal(s.T(), start.OAuthLoginState, stored)
+	assert.Equal(s.T(), models.IdentityProviderGoogle, stored.Provider)
+	assert.NotEmpty(s.T(), start.Binding)
+	assert.NotEqual(s.T(), start.State, start.Binding)
+	assert.Equal(s.T(), hashOAuthState(start.Binding), stored.BindingHash)
+}
+
+func (s *SessionServiceSuite) TestStartOAuthLogin_RedirectNotAllowed() {
+	_, err := s.svc.StartOAuthLogin(context.Background(), models.IdentityProviderGoogle, "https://evil.example.com/")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorOAuthPostLoginRedirectNotAllowed)
+	s.oauthStates.AssertNotCalled(s.T(), "Set")
+}
+
+func (s *SessionServiceSuite) TestStartOAuthLink_BindsStateToUser() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+
+	var stored models.OAuthLoginState
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{ID: actor.ID}, models.Session{}, nil)
+	s.oauthStates.On("Set", mock.Anything, mock.Anything, mock.Anything, OAuthStateTTL).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(2).(models.OAuthLoginState)
+		}).
+		Return(nil)
+
+	_, err := s.svc.StartOAuthLink(context.Background(), actor, models.IdentityProviderGoogle, "")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), actor.ID, stored.LinkUserID)
+}
+
+func (s *SessionServiceSuite) TestStartOAuthLogin_NotBoundToUser() {
+	var stored models.OAuthLoginState
+	s.oauthStates.On("Set", mock.Anything, mock.Anything, mock.Anything, OAuthStateTTL).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(2).(models.OAuthLoginState)
+		}).
+		Return(nil)
+
+	_, err := s.svc.StartOAuthLogin(context.Background(), models.IdentityProviderGoogle, "")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), uuid.Nil, stored.LinkUserID)
+}
+
+func boundOAuthState(provider string) models.OAuthLoginState {
+	return models.OAuthLoginState{
+		Provider:     provider,
+		Nonce:        "nonce",
+		CodeVerifier: "verifier",
+		BindingHash:  hashOAuthState("binding"),
+	}
+}
+
+func (s *SessionServiceSuite) TestConsumeOAuthLoginState() {
+	state := boundOAuthState("google")
+	s.oauthStates.On("Get", mock.Anything, hashOAuthState("state")).Return(state, nil)
+	s.oauthStates.On("Consume", mock.Anything, hashOAuthState("state")).Return(state, nil)
+
+	got, err := s.svc.ConsumeOAuthLoginState(context.Background(), "google", "state", "binding")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), state, got)
+
+	_, err = s.svc.ConsumeOAuthLoginState(context.Background(), "google", "", "binding")
+	assert.ErrorIs(s.T(), err, errx.ErrorOAuthStateInvalid)
+}
+
+func (s *SessionServiceSuite) TestConsumeOAuthLoginState_OtherProvider() {
+	// A callback of one provider must not finish a flow started with
+	// another: the code would be exchanged with the wrong token endpoint.
+	s.oauthStates.On("Get", mock.Anything, hashOAuthState("state")).Return(boundOAuthState("keycloak"), nil)
+
+	_, err := s.svc.ConsumeOAuthLoginState(context.Background(), "google", "state", "binding")
+	assert.ErrorIs(s.T(), err, errx.ErrorOAuthStateInvalid)
+	s.oauthStates.AssertNotCalled(s.T(), "Consume", mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestConsumeOAuthLoginState_WithoutBinding() {
+	// The callback URL of someone else's flow, opened in a browser that
+	// never started it.
+	_, err := s.svc.ConsumeOAuthLoginState(context.Background(), "google", "state", "")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorOAuthStateInvalid)
+	s.oauthStates.AssertNotCalled(s.T(), "Get", mock.Anything, mock.Anything)
+	s.oauthStates.AssertNotCalled(s.T(), "Consume", mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestConsumeOAuthLoginState_WrongBinding() {
+	s.oauthStates.On("Get", mock.Anything, hashOAuthState("state")).Return(boundOAuthState("google"), nil)
+
+	_, err := s.svc.ConsumeOAuthLoginState(context.Background(), "google", "state", "attacker-binding")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorOAuthStateInvalid)
+	s.oauthStates.AssertNotCalled(s.T(), "Consume", mock.Anything, mock.Anything)
+}
+
+// ─── checkPassword (через LoginByEmail) ──────────────────────────────────────
+
+func (s *SessionServiceSuite) TestCheckPassword_CacheMiss_RepoSuccess() {
+	userID := uuid.New()
+	emailRecord := models.UserEmail{UserID: userID}
+	user := models.User{ID: userID}
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+	session := models.Session{ID: uuid.New()}
+
+	s.emailRepo.On("GetByEmail", mock.Anything, mock.Anything).Return(emailRecord, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(models.UserPassword{}, errors.New("miss"))
+	s.passwordRepo.On("GetByID", mock.Anything, userID).Return(pwd, nil)
+	s.passwordCache.On("Set", mock.Anything, pwd).Return(nil).Maybe()
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+
+	require.NoError(s.T(), err)
+}
+
+func (s *SessionServiceSuite) TestCheckPassword_CacheMiss_RepoError() {
+	userID := uuid.New()
+	emailRecord := models.UserEmail{UserID: userID}
+	user := models.User{ID: userID}
+	repoErr := errors.New("db error")
+
+	s.emailRepo.On("GetByEmail", mock.Anything, mock.Anything).Return(emailRecord, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(models.UserPassword{}, errors.New("miss"))
+	s.passwordRepo.On("GetByID", mock.Anything, userID).Return(models.UserPassword{}, repoErr)
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, repoErr)
+}
+
+// ─── MFA ─────────────────────────────────────────────────────────────────────
+
+func (s *SessionServiceSuite) TestLoginByOIDC_MFAEnabled_ReturnsChallenge() {
+	userID := uuid.New()
+	user := models.User{ID: userID}
+	identity := googleIdentity("user@gmail.com")
+	linked := models.UserIdentity{ID: uuid.New(), UserID: userID}
+
+	s.identityRepo.On("GetByProviderSubject", mock.Anything, identity.Provider, identity.Subject).Return(linked, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.identityRepo.On("RecordUse", mock.Anything, linked.ID, "user@gmail.com").Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(true, nil)
+	s.mfaChallenges.On("Create", mock.Anything, mock.Anything, userID, "", MFAChallengeTTL).Return(nil)
+
+	res, err := s.svc.LoginByOIDC(context.Background(), identity, models.SessionClient{})
+
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res.Challenge)
+	assert.NotEmpty(s.T(), res.Challenge.Token)
+	assert.Empty(s.T(), res.Tokens.Access)
+	s.mfaChallenges.AssertCalled(s.T(), "Create", mock.Anything, hashChallengeToken(res.Challenge.Token), userID, "", MFAChallengeTTL)
+	s.sessionRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestLoginByMFA_ChallengeInvalid() {
+	hash := hashChallengeToken("challenge")
+	s.mfaChallenges.On("Get", mock.Anything, hash).Return(uuid.Nil, "", errx.ErrorMFAChallengeInvalid.Raise(errors.New("missing")))
+
+	_, err := s.svc.LoginByMFA(context.Background(), "challenge", "123456", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorMFAChallengeInvalid)
+}
+
+func (s *SessionServiceSuite) TestLoginByMFA_WrongCode_CountsFailure() {
+	userID := uuid.New()
+	hash := hashChallengeToken("challenge")
+	codeErr := errx.ErrorMFACodeInvalid.Raise(errors.New("mismatch"))
 
-	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(emailRecord, nil)
-	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
-	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
-	s.passManager.On("CheckMatch", "wrongpass", pwd.Hash).Return(checkErr)
+	s.mfaChallenges.On("Get", mock.Anything, hash).Return(userID, "", nil)
+	s.mfa.On("Verify", mock.Anything, userID, "000000").Return(codeErr)
+	s.mfaChallenges.On("AddFailure", mock.Anything, hash).Return(1, nil)
 
-	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "wrongpass")
+	_, err := s.svc.LoginByMFA(context.Background(), "challenge", "000000", models.SessionClient{})
 
 	require.Error(s.T(), err)
-	assert.ErrorIs(s.T(), err, checkErr)
+	assert.ErrorIs(s.T(), err, errx.ErrorMFACodeInvalid)
+	s.mfaChallenges.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
 }
 
-func (s *SessionServiceSuite) TestLoginByEmail_HappyPath() {
+func (s *SessionServiceSuite) TestLoginByMFA_WrongCode_DropsChallengeAtLimit() {
 	userID := uuid.New()
-	sessionID := uuid.New()
-	emailRecord := models.UserEmail{UserID: userID}
-	user := models.User{ID: userID}
-	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
-	session := models.Session{ID: sessionID, UserID: userID}
+	hash := hashChallengeToken("challenge")
+	codeErr := errx.ErrorMFACodeInvalid.Raise(errors.New("mismatch"))
 
-	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(emailRecord, nil)
-	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
-	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
-	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
-	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
-	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
-	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash").Return(session, nil)
-	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
-	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
-	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+	s.mfaChallenges.On("Get", mock.Anything, hash).Return(userID, "", nil)
+	s.mfa.On("Verify", mock.Anything, userID, "000000").Return(codeErr)
+	s.mfaChallenges.On("AddFailure", mock.Anything, hash).Return(mfaChallengeMaxFailures, nil)
+	s.mfaChallenges.On("Delete", mock.Anything, hash).Return(true, nil)
 
-	pair, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!")
+	_, err := s.svc.LoginByMFA(context.Background(), "challenge", "000000", models.SessionClient{})
 
-	require.NoError(s.T(), err)
-	assert.Equal(s.T(), "refresh", pair.Refresh)
-	assert.Equal(s.T(), "access", pair.Access)
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorMFACodeInvalid)
 }
 
-// ─── LoginByGoogle ───────────────────────────────────────────────────────────
+func (s *SessionServiceSuite) TestLoginByMFA_MFADisabledMeanwhile() {
+	userID := uuid.New()
+	hash := hashChallengeToken("challenge")
 
-func (s *SessionServiceSuite) TestLoginByGoogle_EmailRepoError() {
-	repoErr := errors.New("email not found")
-	s.emailRepo.On("GetByEmail", mock.Anything, "user@gmail.com").Return(models.UserEmail{}, repoErr)
+	s.mfaChallenges.On("Get", mock.Anything, hash).Return(userID, "", nil)
+	s.mfa.On("Verify", mock.Anything, userID, "123456").Return(errx.ErrorMFANotEnabled.Raise(errors.New("gone")))
 
-	_, err := s.svc.LoginByGoogle(context.Background(), "user@gmail.com")
+	_, err := s.svc.LoginByMFA(context.Background(), "challenge", "123456", models.SessionClient{})
 
 	require.Error(s.T(), err)
-	assert.ErrorIs(s.T(), err, repoErr)
+	assert.ErrorIs(s.T(), err, errx.ErrorMFAChallengeInvalid)
 }
 
-func (s *SessionServiceSuite) TestLoginByGoogle_UserRepoError() {
+func (s *SessionServiceSuite) TestLoginByMFA_AlreadyConsumed() {
 	userID := uuid.New()
-	emailRecord := models.UserEmail{UserID: userID}
-	repoErr := errors.New("db error")
+	hash := hashChallengeToken("challenge")
 
-	s.emailRepo.On("GetByEmail", mock.Anything, "user@gmail.com").Return(emailRecord, nil)
-	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{}, repoErr)
+	s.mfaChallenges.On("Get", mock.Anything, hash).Return(userID, "", nil)
+	s.mfa.On("Verify", mock.Anything, userID, "123456").Return(nil)
+	s.mfaChallenges.On("Delete", mock.Anything, hash).Return(false, nil)
 
-	_, err := s.svc.LoginByGoogle(context.Background(), "user@gmail.com")
+	_, err := s.svc.LoginByMFA(context.Background(), "challenge", "123456", models.SessionClient{})
 
 	require.Error(s.T(), err)
-	assert.ErrorIs(s.T(), err, repoErr)
+	assert.ErrorIs(s.T(), err, errx.ErrorMFAChallengeInvalid)
+	s.sessionRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
-func (s *SessionServiceSuite) TestLoginByGoogle_HappyPath() {
+func (s *SessionServiceSuite) TestLoginByMFA_HappyPath() {
 	userID := uuid.New()
-	emailRecord := models.UserEmail{UserID: userID}
 	user := models.User{ID: userID}
 	session := models.Session{ID: uuid.New(), UserID: userID}
+	hash := hashChallengeToken("challenge")
 
-	s.emailRepo.On("GetByEmail", mock.Anything, "user@gmail.com").Return(emailRecord, nil)
+	s.mfaChallenges.On("Get", mock.Anything, hash).Return(userID, "", nil)
+	s.mfa.On("Verify", mock.Anything, userID, "123456").Return(nil)
+	s.mfaChallenges.On("Delete", mock.Anything, hash).Return(true, nil)
 	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
 	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
 	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
-	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash").Return(session, nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
 	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
 	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
 	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
 
-	pair, err := s.svc.LoginByGoogle(context.Background(), "user@gmail.com")
+	pair, err := s.svc.LoginByMFA(context.Background(), "challenge", "123456", models.SessionClient{})
 
 	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "access", pair.Access)
 	assert.Equal(s.T(), "refresh", pair.Refresh)
 }
 
-// ─── checkPassword (через LoginByEmail) ──────────────────────────────────────
-
-func (s *SessionServiceSuite) TestCheckPassword_CacheMiss_RepoSuccess() {
+func (s *SessionServiceSuite) TestLoginByMFA_HappyPath_ResetsLimits() {
+	s.svc.config.LoginLimits = testLoginLimits
+	s.svc.config.LoginLimits.MaxMFAFailuresPerUser = 10
 	userID := uuid.New()
-	emailRecord := models.UserEmail{UserID: userID}
 	user := models.User{ID: userID}
+	session := models.Session{ID: uuid.New(), UserID: userID}
+	hash := hashChallengeToken("challenge")
+	mfaSubject := "mfa:" + userID.String()
+
+	s.mfaChallenges.On("Get", mock.Anything, hash).Return(userID, testEmailSubject, nil)
+	s.loginAttempts.On("LockedFor", mock.Anything, mfaSubject).Return(time.Duration(0), nil)
+	s.mfa.On("Verify", mock.Anything, userID, "123456").Return(nil)
+	s.mfaChallenges.On("Delete", mock.Anything, hash).Return(true, nil)
+	s.loginAttempts.On("Reset", mock.Anything, mfaSubject).Return(nil)
+	s.loginAttempts.On("Reset", mock.Anything, testEmailSubject).Return(nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+
+	_, err := s.svc.LoginByMFA(context.Background(), "challenge", "123456", models.SessionClient{})
+
+	require.NoError(s.T(), err)
+	s.loginAttempts.AssertCalled(s.T(), "Reset", mock.Anything, mfaSubject)
+	s.loginAttempts.AssertCalled(s.T(), "Reset", mock.Anything, testEmailSubject)
+}
+
+func (s *SessionServiceSuite) TestLoginByMFA_UserLocked() {
+	s.svc.config.LoginLimits = testLoginLimits
+	s.svc.config.LoginLimits.MaxMFAFailuresPerUser = 10
+	userID := uuid.New()
+	hash := hashChallengeToken("challenge")
+
+	s.mfaChallenges.On("Get", mock.Anything, hash).Return(userID, "", nil)
+	s.loginAttempts.On("LockedFor", mock.Anything, "mfa:"+userID.String()).Return(2*time.Minute, nil)
+
+	_, err := s.svc.LoginByMFA(context.Background(), "challenge", "123456", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorTooManyLoginAttempts)
+	s.mfa.AssertNotCalled(s.T(), "Verify", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// fakeLoginAttempts keeps the login limiter in memory, so a test can run
+// many attempts against it the way Redis would count them.
+type fakeLoginAttempts struct {
+	failures map[string]int
+	locked   map[string]time.Duration
+}
+
+func newFakeLoginAttempts() *fakeLoginAttempts {
+	return &fakeLoginAttempts{failures: map[string]int{}, locked: map[string]time.Duration{}}
+}
+
+func (f *fakeLoginAttempts) LockedFor(_ context.Context, subject string) (time.Duration, error) {
+	return f.locked[subject], nil
+}
+
+func (f *fakeLoginAttempts) AddFailure(_ context.Context, subject string, _ time.Duration) (int, error) {
+	f.failures[subject]++
+	return f.failures[subject], nil
+}
+
+func (f *fakeLoginAttempts) Lock(_ context.Context, subject string, d time.Duration) error {
+	f.locked[subject] = d
+	return nil
+}
+
+func (f *fakeLoginAttempts) Reset(_ context.Context, subject string) error {
+	delete(f.failures, subject)
+	return nil
+}
+
+func (s *SessionServiceSuite) TestLoginByMFA_FreshChallengesLockOut() {
+	// Knowing the password hands out a fresh challenge every time; the
+	// wrong codes still add up against the user until the second factor
+	// locks, even for the right code.
+	s.svc.config.LoginLimits = testLoginLimits
+	s.svc.config.LoginLimits.MaxMFAFailuresPerUser = 10
+	attempts := newFakeLoginAttempts()
+	attempts.failures[testEmailSubject] = 2
+	s.svc.loginAttempts = attempts
+
+	userID := uuid.New()
 	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
-	session := models.Session{ID: uuid.New()}
+	codeErr := errx.ErrorMFACodeInvalid.Raise(errors.New("mismatch"))
 
-	s.emailRepo.On("GetByEmail", mock.Anything, mock.Anything).Return(emailRecord, nil)
-	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
-	s.passwordCache.On("Get", mock.Anything, userID).Return(models.UserPassword{}, errors.New("miss"))
-	s.passwordRepo.On("GetByID", mock.Anything, userID).Return(pwd, nil)
-	s.passwordCache.On("Set", mock.Anything, pwd).Return(nil).Maybe()
+	var challengeHash string
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID}, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
 	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(true, nil)
+	s.mfaChallenges.On("Create", mock.Anything, mock.Anything, userID, testEmailSubject, MFAChallengeTTL).
+		Run(func(args mock.Arguments) { challengeHash = args.String(1) }).
+		Return(nil)
+	s.mfaChallenges.On("Get", mock.Anything, mock.Anything).Return(userID, testEmailSubject, nil)
+	s.mfaChallenges.On("AddFailure", mock.Anything, mock.Anything).Return(1, nil)
+	s.mfa.On("Verify", mock.Anything, userID, "000000").Return(codeErr)
+	s.metrics.On("RecordLoginLockout", mock.Anything, "mfa").Return()
+
+	login := func() string {
+		res, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+		require.NoError(s.T(), err)
+		require.NotNil(s.T(), res.Challenge)
+		assert.Equal(s.T(), hashChallengeToken(res.Challenge.Token), challengeHash)
+		return res.Challenge.Token
+	}
+
+	for i := 0; i < s.svc.config.LoginLimits.MaxMFAFailuresPerUser; i++ {
+		_, err := s.svc.LoginByMFA(context.Background(), login(), "000000", models.SessionClient{})
+		require.ErrorIs(s.T(), err, errx.ErrorMFACodeInvalid)
+	}
+
+	_, err := s.svc.LoginByMFA(context.Background(), login(), "123456", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorTooManyLoginAttempts)
+	s.mfa.AssertNotCalled(s.T(), "Verify", mock.Anything, userID, "123456")
+	// A correct password doesn't forgive earlier wrong ones while the code
+	// is still wrong.
+	assert.Equal(s.T(), 2, attempts.failures[testEmailSubject])
+}
+
+func (s *SessionServiceSuite) TestMFALimiter_SharesBudgetWithLogin() {
+	limits := testLoginLimits
+	limits.MaxMFAFailuresPerUser = 3
+	attempts := newFakeLoginAttempts()
+	userID := uuid.New()
+	subject := "mfa:" + userID.String()
+	// Two wrong codes at login already count.
+	attempts.failures[subject] = 2
+
+	s.metrics.On("RecordLoginLockout", mock.Anything, "mfa").Return().Once()
+
+	limiter := NewMFALimiter(attempts, limits, s.metrics)
+
+	require.NoError(s.T(), limiter.CheckLocked(context.Background(), userID))
+	limiter.RecordFailure(context.Background(), userID)
+
+	err := limiter.CheckLocked(context.Background(), userID)
+	assert.ErrorIs(s.T(), err, errx.ErrorTooManyLoginAttempts)
+	assert.Equal(s.T(), limits.LockoutBase, attempts.locked[subject])
+
+	limiter.Reset(context.Background(), userID)
+	assert.Zero(s.T(), attempts.failures[subject])
+}
+
+// ─── LoginByPasskey ─────────────────────────────────────────────────────────
+
+func (s *SessionServiceSuite) TestLoginByPasskey_VerificationFailed() {
+	cred := webauthn.AssertionCredential{ID: "cred"}
+	s.passkeys.On("FinishLogin", mock.Anything, cred).
+		Return(uuid.Nil, errx.ErrorPasskeyInvalid.Raise(errors.New("bad signature")))
+
+	_, err := s.svc.LoginByPasskey(context.Background(), cred, models.SessionClient{})
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasskeyInvalid)
+	s.sessionRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestLoginByPasskey_UserDeleted() {
+	userID := uuid.New()
+	cred := webauthn.AssertionCredential{ID: "cred"}
+	s.passkeys.On("FinishLogin", mock.Anything, cred).Return(userID, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).
+		Return(models.User{}, errx.ErrorUserNotFound.Raise(errors.New("no rows")))
+
+	_, err := s.svc.LoginByPasskey(context.Background(), cred, models.SessionClient{})
+
+	assert.ErrorIs(s.T(), err, errx.ErrorUserNotFound)
+}
+
+func (s *SessionServiceSuite) TestLoginByPasskey_SkipsMFA() {
+	userID := uuid.New()
+	user := models.User{ID: userID}
+	session := models.Session{ID: uuid.New(), UserID: userID}
+	cred := webauthn.AssertionCredential{ID: "cred"}
+
+	s.passkeys.On("FinishLogin", mock.Anything, cred).Return(userID, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
 	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
 	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
-	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash").Return(session, nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
 	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
 	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
 	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
 
-	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!")
+	pair, err := s.svc.LoginByPasskey(context.Background(), cred, models.SessionClient{})
 
 	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "access", pair.Access)
+	assert.Equal(s.T(), "refresh", pair.Refresh)
+	s.mfa.AssertNotCalled(s.T(), "IsEnabled", mock.Anything, mock.Anything)
 }
 
-func (s *SessionServiceSuite) TestCheckPassword_CacheMiss_RepoError() {
+// ─── LoginByOAuthCode ───────────────────────────────────────────────────────
+
+func (s *SessionServiceSuite) TestLoginByOAuthCode_UserDeleted() {
+	userID := uuid.New()
+	s.userRepo.On("GetByID", mock.Anything, userID).
+		Return(models.User{}, errx.ErrorUserNotFound.Raise(errors.New("no rows")))
+
+	_, err := s.svc.LoginByOAuthCode(context.Background(), userID, models.OAuthGrant{}, models.SessionClient{})
+
+	assert.ErrorIs(s.T(), err, errx.ErrorUserNotFound)
+	s.sessionRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestLoginByOAuthCode_HappyPath() {
 	userID := uuid.New()
-	emailRecord := models.UserEmail{UserID: userID}
 	user := models.User{ID: userID}
-	repoErr := errors.New("db error")
+	session := models.Session{ID: uuid.New(), UserID: userID}
+	client := models.SessionClient{UserAgent: "Go-http-client/1.1", IP: "10.0.0.7"}
+	grant := models.OAuthGrant{ClientID: "web-app", Scopes: []string{"openid", "email"}}
 
-	s.emailRepo.On("GetByEmail", mock.Anything, mock.Anything).Return(emailRecord, nil)
 	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
-	s.passwordCache.On("Get", mock.Anything, userID).Return(models.UserPassword{}, errors.New("miss"))
-	s.passwordRepo.On("GetByID", mock.Anything, userID).Return(models.UserPassword{}, repoErr)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash",
+		mock.MatchedBy(func(c models.SessionClient) bool { return c.IP == client.IP }),
+		&grant,
+	).Return(session, nil)
+	s.tokenManager.On("GenerateClientAccess", user, session.ID, "web-app", grant.Scopes).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
 
-	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!")
+	pair, err := s.svc.LoginByOAuthCode(context.Background(), userID, grant, client)
 
-	require.Error(s.T(), err)
-	assert.ErrorIs(s.T(), err, repoErr)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), session.ID, pair.SessionID)
+	assert.Equal(s.T(), "access", pair.Access)
+	s.tokenManager.AssertNotCalled(s.T(), "GenerateAccess", mock.Anything, mock.Anything)
+	s.mfa.AssertNotCalled(s.T(), "IsEnabled", mock.Anything, mock.Anything)
 }
 
 // ─── CreateQRToken ───────────────────────────────────────────────────────────
 
 func (s *SessionServiceSuite) TestCreateQRToken_RepoError() {
 	repoErr := errors.New("redis error")
-	s.qrRepo.On("Set", mock.Anything, mock.Anything, "pending", QRTokenTTL).Return(repoErr)
+	s.qrRepo.On("Create", mock.Anything, mock.Anything, QRTokenTTL).Return(repoErr)
 
-	_, err := s.svc.CreateQRToken(context.Background())
+	_, err := s.svc.CreateQRToken(context.Background(), models.SessionClient{})
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, repoErr)
 }
 
 func (s *SessionServiceSuite) TestCreateQRToken_HappyPath() {
-	s.qrRepo.On("Set", mock.Anything, mock.Anything, "pending", QRTokenTTL).Return(nil)
+	client := models.SessionClient{
+		IP:        "203.0.113.7",
+		UserAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36",
+	}
+	s.qrRepo.On("Create", mock.Anything, mock.Anything, QRTokenTTL).Return(nil)
+
+	login, err := s.svc.CreateQRToken(context.Background(), client)
+
+	require.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), login.Token)
+	assert.Equal(s.T(), models.QRLoginPending, login.Status)
+	assert.Regexp(s.T(), `^[0-9]{6}$`, login.Code)
+	assert.Equal(s.T(), client.IP, login.Client.IP)
+	assert.NotEmpty(s.T(), login.Client.Browser)
+	assert.Equal(s.T(), QRTokenTTL, login.ExpiresAt.Sub(login.CreatedAt))
+	s.qrRepo.AssertCalled(s.T(), "Create", mock.Anything, login, QRTokenTTL)
+}
 
-	token, err := s.svc.CreateQRToken(context.Background())
+// ─── GetQRLogin ──────────────────────────────────────────────────────────────
+
+func (s *SessionServiceSuite) TestGetQRLogin_HidesCode() {
+	stored := models.QRLogin{Token: "qr_token", Status: models.QRLoginPending, Code: "042917"}
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(stored, nil)
+
+	login, err := s.svc.GetQRLogin(context.Background(), "qr_token")
 
 	require.NoError(s.T(), err)
-	assert.NotEmpty(s.T(), token)
+	assert.Equal(s.T(), "qr_token", login.Token)
+	assert.Empty(s.T(), login.Code)
 }
 
 // ─── ConfirmQRToken ──────────────────────────────────────────────────────────
 
+func pendingQRLogin() models.QRLogin {
+	return models.QRLogin{
+		Token:  "qr_token",
+		Status: models.QRLoginPending,
+		Code:   "042917",
+		Client: models.SessionClient{IP: "203.0.113.7", UserAgent: "curl/8.0"},
+	}
+}
+
 func (s *SessionServiceSuite) TestConfirmQRToken_NotFound() {
 	actor := models.UserActor{ID: uuid.New()}
 
-	s.qrRepo.On("Get", mock.Anything, "qr_token").Return("", errx.ErrorQRTokenNotFound)
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(models.QRLogin{}, errx.ErrorQRTokenNotFound)
 
-	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token")
+	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token", "042917")
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, errx.ErrorQRTokenNotFound)
@@ -603,47 +2112,319 @@ func (s *SessionServiceSuite) TestConfirmQRToken_NotFound() {
 
 func (s *SessionServiceSuite) TestConfirmQRToken_AlreadyConfirmed() {
 	actor := models.UserActor{ID: uuid.New()}
+	login := pendingQRLogin()
+	login.Status = models.QRLoginConfirmed
 
-	s.qrRepo.On("Get", mock.Anything, "qr_token").Return("confirmed", nil)
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(login, nil)
 
-	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token")
+	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token", "042917")
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, errx.ErrorQRTokenAlreadyConfirmed)
 }
 
+func (s *SessionServiceSuite) TestConfirmQRToken_Rejected() {
+	actor := models.UserActor{ID: uuid.New()}
+	login := pendingQRLogin()
+	login.Status = models.QRLoginRejected
+
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(login, nil)
+
+	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token", "042917")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorQRTokenRejected)
+}
+
+func (s *SessionServiceSuite) TestConfirmQRToken_CodeMismatch() {
+	actor := models.UserActor{ID: uuid.New()}
+
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(pendingQRLogin(), nil)
+	s.qrRepo.On("AddFailure", mock.Anything, "qr_token").Return(1, nil)
+
+	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token", "000000")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorQRCodeMismatch)
+	s.qrRepo.AssertCalled(s.T(), "AddFailure", mock.Anything, "qr_token")
+	s.qrRepo.AssertNotCalled(s.T(), "Resolve", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	s.sessionRepo.AssertNotCalled(s.T(), "Create",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestConfirmQRToken_TooManyFailuresRejects() {
+	actor := models.UserActor{ID: uuid.New()}
+
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(pendingQRLogin(), nil)
+	s.qrRepo.On("AddFailure", mock.Anything, "qr_token").Return(qrMaxFailures, nil)
+	s.qrRepo.On("Resolve", mock.Anything, "qr_token", models.QRLoginRejected, qrResolvedTTL).Return(true, nil)
+
+	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token", "000000")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorQRTokenRejected)
+	s.qrRepo.AssertCalled(s.T(), "Resolve", mock.Anything, "qr_token", models.QRLoginRejected, qrResolvedTTL)
+	s.sessionRepo.AssertNotCalled(s.T(), "Create",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SessionServiceSuite) TestConfirmQRToken_TooManyFailuresLostRace() {
+	actor := models.UserActor{ID: uuid.New()}
+
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(pendingQRLogin(), nil)
+	s.qrRepo.On("AddFailure", mock.Anything, "qr_token").Return(qrMaxFailures, nil)
+	s.qrRepo.On("Resolve", mock.Anything, "qr_token", models.QRLoginRejected, qrResolvedTTL).Return(false, nil)
+
+	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token", "000000")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorQRCodeMismatch)
+}
+
 func (s *SessionServiceSuite) TestConfirmQRToken_UserRepoError() {
 	actor := models.UserActor{ID: uuid.New()}
 	repoErr := errors.New("db error")
 
-	s.qrRepo.On("Get", mock.Anything, "qr_token").Return("pending", nil)
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(pendingQRLogin(), nil)
 	s.userRepo.On("GetByID", mock.Anything, actor.ID).Return(models.User{}, repoErr)
 
-	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token")
+	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token", "042917")
 
 	require.Error(s.T(), err)
 	assert.ErrorIs(s.T(), err, repoErr)
 }
 
+func (s *SessionServiceSuite) TestConfirmQRToken_LostRace() {
+	actor := models.UserActor{ID: uuid.New()}
+	rejected := pendingQRLogin()
+	rejected.Status = models.QRLoginRejected
+
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(pendingQRLogin(), nil).Once()
+	s.userRepo.On("GetByID", mock.Anything, actor.ID).Return(models.User{ID: actor.ID}, nil)
+	s.qrRepo.On("Resolve", mock.Anything, "qr_token", models.QRLoginConfirmed, qrResolvedTTL).Return(false, nil)
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(rejected, nil).Once()
+
+	_, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token", "042917")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorQRTokenRejected)
+	s.sessionRepo.AssertNotCalled(s.T(), "Create",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (s *SessionServiceSuite) TestConfirmQRToken_HappyPath() {
 	actor := models.UserActor{ID: uuid.New()}
 	user := models.User{ID: actor.ID}
 	session := models.Session{ID: uuid.New()}
+	login := pendingQRLogin()
 
-	s.qrRepo.On("Get", mock.Anything, "qr_token").Return("pending", nil)
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(login, nil)
 	s.userRepo.On("GetByID", mock.Anything, actor.ID).Return(user, nil)
+	s.qrRepo.On("Resolve", mock.Anything, "qr_token", models.QRLoginConfirmed, qrResolvedTTL).Return(true, nil)
 	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
 	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
-	s.sessionRepo.On("Create", mock.Anything, mock.Anything, actor.ID, "hash").Return(session, nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, actor.ID, "hash", mock.Anything, mock.Anything).Return(session, nil)
 	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
-	s.qrRepo.On("Set", mock.Anything, "qr_token", "confirmed", qrConfirmedTTL).Return(nil)
 	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
 	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
 
-	pair, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token")
+	pair, err := s.svc.ConfirmQRToken(context.Background(), actor, "qr_token", "042917")
 
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), "refresh", pair.Refresh)
+
+	// The session belongs to the device that opened the QR login.
+	s.sessionRepo.AssertCalled(s.T(), "Create",
+		mock.Anything, mock.Anything, actor.ID, "hash", describeClient(login.Client), (*models.OAuthGrant)(nil))
+}
+
+// ─── RejectQRToken ───────────────────────────────────────────────────────────
+
+func (s *SessionServiceSuite) TestRejectQRToken_HappyPath() {
+	events := s.recordedEvents()
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+
+	s.qrRepo.On("Resolve", mock.Anything, "qr_token", models.QRLoginRejected, qrResolvedTTL).Return(true, nil)
+
+	err := s.svc.RejectQRToken(context.Background(), actor, "qr_token")
+
+	require.NoError(s.T(), err)
+	ev := s.nextEvent(events)
+	assert.Equal(s.T(), models.AuthEventQRRejected, ev.Type)
+	assert.Equal(s.T(), models.AuthEventSuccess, ev.Outcome)
+}
+
+func (s *SessionServiceSuite) TestRejectQRToken_AlreadyConfirmed() {
+	actor := models.UserActor{ID: uuid.New()}
+	login := pendingQRLogin()
+	login.Status = models.QRLoginConfirmed
+
+	s.qrRepo.On("Resolve", mock.Anything, "qr_token", models.QRLoginRejected, qrResolvedTTL).Return(false, nil)
+	s.qrRepo.On("Get", mock.Anything, "qr_token").Return(login, nil)
+
+	err := s.svc.RejectQRToken(context.Background(), actor, "qr_token")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorQRTokenAlreadyConfirmed)
+}
+
+func (s *SessionServiceSuite) TestRejectQRToken_NotFound() {
+	actor := models.UserActor{ID: uuid.New()}
+
+	s.qrRepo.On("Resolve", mock.Anything, "qr_token", models.QRLoginRejected, qrResolvedTTL).
+		Return(false, errx.ErrorQRTokenNotFound)
+
+	err := s.svc.RejectQRToken(context.Background(), actor, "qr_token")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorQRTokenNotFound)
+}
+
+// ─── Audit log ───────────────────────────────────────────────────────────────
+
+func (s *SessionServiceSuite) TestAudit_LoginByEmail_WrongPassword() {
+	events := s.recordedEvents()
+	userID := uuid.New()
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+	client := models.SessionClient{IP: "203.0.113.7", UserAgent: "curl/8.0"}
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID}, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "wrongpass", pwd.Hash).Return(errx.ErrorPasswordInvalid.Raise(errors.New("mismatch")))
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "wrongpass", client)
+	require.ErrorIs(s.T(), err, errx.ErrorPasswordInvalid)
+
+	ev := s.nextEvent(events)
+	assert.Equal(s.T(), models.AuthEventLoginEmail, ev.Type)
+	assert.Equal(s.T(), models.AuthEventFailure, ev.Outcome)
+	require.NotNil(s.T(), ev.UserID)
+	assert.Equal(s.T(), userID, *ev.UserID)
+	require.NotNil(s.T(), ev.Reason)
+	assert.Equal(s.T(), "password_invalid", *ev.Reason)
+	assert.Equal(s.T(), "203.0.113.7", ev.IP)
+	assert.Equal(s.T(), "curl/8.0", ev.UserAgent)
+	assert.Nil(s.T(), ev.SessionID)
+}
+
+func (s *SessionServiceSuite) TestAudit_LoginByEmail_UnknownEmail() {
+	events := s.recordedEvents()
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "nobody@example.com").
+		Return(models.UserEmail{}, errx.ErrorUserNotFound.Raise(errors.New("no such email")))
+
+	_, err := s.svc.LoginByEmail(context.Background(), "nobody@example.com", "Password1!", models.SessionClient{})
+	require.Error(s.T(), err)
+
+	ev := s.nextEvent(events)
+	assert.Equal(s.T(), models.AuthEventFailure, ev.Outcome)
+	assert.Nil(s.T(), ev.UserID)
+	require.NotNil(s.T(), ev.Reason)
+	assert.Equal(s.T(), "user_not_found", *ev.Reason)
+}
+
+func (s *SessionServiceSuite) TestAudit_LoginByEmail_Challenged() {
+	events := s.recordedEvents()
+	userID := uuid.New()
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID}, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(true, nil)
+	s.mfaChallenges.On("Create", mock.Anything, mock.Anything, userID, "", MFAChallengeTTL).Return(nil)
+
+	res, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res.Challenge)
+
+	ev := s.nextEvent(events)
+	assert.Equal(s.T(), models.AuthEventChallenged, ev.Outcome)
+	assert.Nil(s.T(), ev.Reason)
+	assert.Nil(s.T(), ev.SessionID)
+}
+
+func (s *SessionServiceSuite) TestAudit_LoginByEmail_Success() {
+	events := s.recordedEvents()
+	userID := uuid.New()
+	user := models.User{ID: userID}
+	pwd := models.UserPassword{UserID: userID, Hash: "hash"}
+	session := models.Session{ID: uuid.New(), UserID: userID}
+
+	s.emailRepo.On("GetByEmail", mock.Anything, "user@example.com").Return(models.UserEmail{UserID: userID}, nil)
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+	s.passwordCache.On("Get", mock.Anything, userID).Return(pwd, nil)
+	s.passManager.On("CheckMatch", "Password1!", pwd.Hash).Return(nil)
+	s.mfa.On("IsEnabled", mock.Anything, userID).Return(false, nil)
+	s.tokenManager.On("GenerateRefresh", user, mock.Anything).Return("refresh", nil)
+	s.tokenManager.On("HashRefresh", "refresh").Return("hash", nil)
+	s.sessionRepo.On("Create", mock.Anything, mock.Anything, userID, "hash", mock.Anything, mock.Anything).Return(session, nil)
+	s.tokenManager.On("GenerateAccess", user, session.ID).Return("access", nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.sessionsCache.On("Set", mock.Anything, session).Return(nil).Maybe()
+
+	_, err := s.svc.LoginByEmail(context.Background(), "user@example.com", "Password1!", models.SessionClient{})
+	require.NoError(s.T(), err)
+
+	ev := s.nextEvent(events)
+	assert.Equal(s.T(), models.AuthEventSuccess, ev.Outcome)
+	require.NotNil(s.T(), ev.SessionID)
+	assert.Equal(s.T(), session.ID, *ev.SessionID)
+}
+
+func (s *SessionServiceSuite) TestAudit_Refresh_TokenReused() {
+	events := s.recordedEvents()
+	sessionID := uuid.New()
+	userID := uuid.New()
+	claims := tokens.AccountAuthClaims{RegisteredClaims: jwtlib.RegisteredClaims{Subject: userID.String()}, SessionID: sessionID}
+	previous := "oldhash"
+
+	s.tokenManager.On("ParseUserAuthRefresh", "token").Return(claims, nil)
+	s.sessionRepo.On("GetToken", mock.Anything, sessionID).
+		Return(models.SessionToken{UserID: userID, Hash: "storedhash", PreviousHash: &previous}, nil)
+	s.tokenManager.On("HashRefresh", "token").Return("oldhash", nil)
+	s.sessionRepo.On("Delete", mock.Anything, sessionID).Return(models.EndedSession{ID: sessionID}, nil)
+	s.messenger.On("WriteSessionTokenReused", mock.Anything, userID, sessionID, []uuid.UUID{sessionID}).Return(nil)
+	s.metrics.On("RecordSessionTokenReuse", mock.Anything, "single").Return()
+	s.sessionsCache.On("Delete", mock.Anything, sessionID).Return(nil).Maybe()
+
+	_, err := s.svc.Refresh(context.Background(), "token", models.SessionClient{})
+	require.ErrorIs(s.T(), err, errx.ErrorSessionTokenReused)
+
+	ev := s.nextEvent(events)
+	assert.Equal(s.T(), models.AuthEventRefresh, ev.Type)
+	assert.Equal(s.T(), models.AuthEventFailure, ev.Outcome)
+	require.NotNil(s.T(), ev.UserID)
+	assert.Equal(s.T(), userID, *ev.UserID)
+	require.NotNil(s.T(), ev.SessionID)
+	assert.Equal(s.T(), sessionID, *ev.SessionID)
+	require.NotNil(s.T(), ev.Reason)
+	assert.Equal(s.T(), "token_reused", *ev.Reason)
+}
+
+func (s *SessionServiceSuite) TestAudit_DeleteMySession_RecordsTargetSession() {
+	events := s.recordedEvents()
+	actor := models.UserActor{
+		ID:        uuid.New(),
+		SessionID: uuid.New(),
+		Client:    models.SessionClient{IP: "198.51.100.1"},
+	}
+	target := uuid.New()
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.sessionRepo.On("DeleteOneForUser", mock.Anything, actor.ID, target).Return(models.EndedSession{ID: target}, nil)
+	s.sessionsCache.On("Delete", mock.Anything, target).Return(nil).Maybe()
+
+	require.NoError(s.T(), s.svc.DeleteMySession(context.Background(), actor, target))
+
+	ev := s.nextEvent(events)
+	assert.Equal(s.T(), models.AuthEventSessionDeleted, ev.Type)
+	assert.Equal(s.T(), models.AuthEventSuccess, ev.Outcome)
+	require.NotNil(s.T(), ev.SessionID)
+	assert.Equal(s.T(), target, *ev.SessionID)
+	assert.Equal(s.T(), "198.51.100.1", ev.IP)
 }
 
 // ─── PublishQRToken ──────────────────────────────────────────────────────────
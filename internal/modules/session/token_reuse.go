@@ -0,0 +1,51 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+// revokeReusedToken reacts to a refresh token that was already rotated out
+// being presented again. Either the legitimate client or whoever copied the
+// token has moved on with the newer one, and there is no telling which, so
+// the session (or, per config, every session of the user) is revoked. The
+// returned error is always non-nil.
+func (s *Service) revokeReusedToken(ctx context.Context, userID, sessionID uuid.UUID) error {
+	scope := "single"
+	var revoked []models.EndedSession
+
+	if err := s.tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if s.config.RevokeAllOnTokenReuse {
+			scope = "all"
+			revoked, err = s.sessionRepo.DeleteManyForUser(ctx, userID)
+		} else {
+			var ended models.EndedSession
+			ended, err = s.sessionRepo.Delete(ctx, sessionID)
+			revoked = []models.EndedSession{ended}
+		}
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteSessionTokenReused(ctx, userID, sessionID, models.EndedSessionIDs(revoked))
+	}); err != nil {
+		return fmt.Errorf("revoke sessions after refresh token reuse: %w", err)
+	}
+
+	s.metrics.RecordSessionTokenReuse(ctx, scope)
+
+	detached := context.WithoutCancel(ctx)
+	go s.revokedSessions.Revoke(detached, revoked)
+	for _, e := range revoked {
+		go s.sessionsCache.Delete(detached, e.ID)
+	}
+
+	return errx.ErrorSessionTokenReused.Raise(
+		fmt.Errorf("refresh token of session %s was used after rotation", sessionID),
+	)
+}
@@ -0,0 +1,31 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/audit"
+)
+
+//go:generate mockery --name=auditLog --inpackage
+type auditLog interface {
+	Record(ctx context.Context, event models.AuthEvent)
+}
+
+// recordEvent adds an entry about userID to the audit log in the
+// background. sessionID is the session the user acted from, if any.
+func (s *Service) recordEvent(
+	ctx context.Context,
+	eventType string,
+	userID, sessionID uuid.UUID,
+	client models.SessionClient,
+	err error,
+) {
+	event := audit.NewEvent(eventType, userID, client, err)
+	if sessionID != uuid.Nil {
+		event.SessionID = &sessionID
+	}
+
+	go s.auditLog.Record(context.WithoutCancel(ctx), event)
+}
@@ -36,3 +36,30 @@ type passwordCache interface {
 type sessionsCache interface {
 	Delete(ctx context.Context, sessionID uuid.UUID) error
 }
+
+// revokedSessions takes the sessions ended by deleting the account or
+// resetting its password; without it their access tokens would keep working
+// until they expire.
+//
+//go:generate mockery --name=revokedSessions --inpackage
+type revokedSessions interface {
+	Revoke(ctx context.Context, sessions []models.EndedSession) error
+}
+
+//go:generate mockery --name=emailVerificationCache --inpackage
+type emailVerificationCache interface {
+	Set(ctx context.Context, tokenHash string, v models.EmailVerification) error
+	Consume(ctx context.Context, tokenHash string) (models.EmailVerification, error)
+}
+
+//go:generate mockery --name=emailChangeCache --inpackage
+type emailChangeCache interface {
+	Set(ctx context.Context, tokenHash string, v models.EmailChange) error
+	Consume(ctx context.Context, tokenHash string) (models.EmailChange, error)
+}
+
+//go:generate mockery --name=passwordResetCache --inpackage
+type passwordResetCache interface {
+	Set(ctx context.Context, tokenHash string, v models.PasswordReset) error
+	Consume(ctx context.Context, tokenHash string) (models.PasswordReset, error)
+}
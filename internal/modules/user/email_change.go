@@ -0,0 +1,106 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+// RequestEmailChange starts moving the actor to newEmail: the confirmation
+// token goes to the new address, and the current one gets a notice so a
+// hijacked session cannot move the account away silently. Nothing changes
+// until ConfirmEmailChange.
+func (s *Service) RequestEmailChange(
+	ctx context.Context,
+	actor models.UserActor,
+	newEmail string,
+) error {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return err
+	}
+
+	email, err := s.emailRepo.GetByID(ctx, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	if email.Email == newEmail {
+		return errx.ErrorEmailUnchanged.Raise(
+			fmt.Errorf("user %s already uses this email", actor.ID),
+		)
+	}
+
+	// Checked up front only to fail early; the swap itself is guarded by
+	// the UNIQUE constraint.
+	_, err = s.emailRepo.GetByEmail(ctx, newEmail)
+	switch {
+	case err == nil:
+		return errx.ErrorEmailAlreadyExist.Raise(
+			fmt.Errorf("email is already used by another user"),
+		)
+	case !errors.Is(err, errx.ErrorUserNotFound):
+		return err
+	}
+
+	token, hash, err := newOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	if err = s.emailChanges.Set(ctx, hash, models.EmailChange{
+		UserID:   actor.ID,
+		OldEmail: email.Email,
+		NewEmail: newEmail,
+	}); err != nil {
+		return fmt.Errorf("store email change token: %w", err)
+	}
+
+	if err = s.mailer.SendEmailChange(ctx, newEmail, token); err != nil {
+		return fmt.Errorf("send email change confirmation: %w", err)
+	}
+
+	if err = s.mailer.SendEmailChangeNotice(ctx, email.Email, newEmail); err != nil {
+		return fmt.Errorf("send email change notice: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange redeems a token sent by RequestEmailChange and swaps
+// the address. A token issued before the email changed some other way is
+// rejected, and so is a new address someone else took in the meantime.
+func (s *Service) ConfirmEmailChange(
+	ctx context.Context,
+	token string,
+) (models.UserEmail, error) {
+	change, err := s.emailChanges.Consume(ctx, hashOpaqueToken(token))
+	if err != nil {
+		return models.UserEmail{}, err
+	}
+
+	var email models.UserEmail
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		email, err = s.emailRepo.UpdateEmail(ctx, change.UserID, change.OldEmail, change.NewEmail)
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteUserEmailUpdated(ctx, email)
+	}); err != nil {
+		if errors.Is(err, errx.ErrorUserNotFound) {
+			return models.UserEmail{}, errx.ErrorEmailChangeTokenInvalid.Raise(
+				fmt.Errorf("email of user %s is no longer the one the token was issued for: %w", change.UserID, err),
+			)
+		}
+		return models.UserEmail{}, err
+	}
+
+	detached := context.WithoutCancel(ctx)
+	go s.emailCache.DeleteByEmail(detached, change.OldEmail)
+	go s.emailCache.Set(detached, email)
+
+	return email, nil
+}
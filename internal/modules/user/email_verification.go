@@ -0,0 +1,118 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+// RequestEmailVerification sends a fresh verification token to the actor's
+// current email. Tokens issued earlier stay valid until they expire.
+func (s *Service) RequestEmailVerification(
+	ctx context.Context,
+	actor models.UserActor,
+) error {
+	email, err := s.emailRepo.GetByID(ctx, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	if email.Verified {
+		return errx.ErrorEmailAlreadyVerified.Raise(
+			fmt.Errorf("email of user %s is already verified", actor.ID),
+		)
+	}
+
+	return s.sendEmailVerification(ctx, email)
+}
+
+// ConfirmEmailVerification redeems a token sent by RequestEmailVerification
+// or Registration. The token is consumed even if the email turns out to be
+// verified already.
+func (s *Service) ConfirmEmailVerification(
+	ctx context.Context,
+	token string,
+) (models.UserEmail, error) {
+	v, err := s.emailVerifications.Consume(ctx, hashOpaqueToken(token))
+	if err != nil {
+		return models.UserEmail{}, err
+	}
+
+	email, err := s.emailRepo.GetByID(ctx, v.UserID)
+	switch {
+	case errors.Is(err, errx.ErrorUserNotFound):
+		return models.UserEmail{}, errx.ErrorEmailVerificationTokenInvalid.Raise(err)
+	case err != nil:
+		return models.UserEmail{}, err
+	}
+
+	// The user changed their address after the token was issued.
+	if email.Email != v.Email {
+		return models.UserEmail{}, errx.ErrorEmailVerificationTokenInvalid.Raise(
+			fmt.Errorf("token was issued for another email of user %s", v.UserID),
+		)
+	}
+
+	if email.Verified {
+		return email, nil
+	}
+
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		email, err = s.emailRepo.Verify(ctx, v.UserID, v.Email)
+		if err != nil {
+			return err
+		}
+
+		return s.messenger.WriteUserEmailUpdated(ctx, email)
+	}); err != nil {
+		return models.UserEmail{}, err
+	}
+
+	go s.emailCache.Set(context.WithoutCancel(ctx), email)
+
+	return email, nil
+}
+
+func (s *Service) sendEmailVerification(ctx context.Context, email models.UserEmail) error {
+	token, hash, err := newOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	if err = s.emailVerifications.Set(ctx, hash, models.EmailVerification{
+		UserID: email.UserID,
+		Email:  email.Email,
+	}); err != nil {
+		return fmt.Errorf("store email verification token: %w", err)
+	}
+
+	if err = s.mailer.SendEmailVerification(ctx, email.Email, token); err != nil {
+		return fmt.Errorf("send email verification: %w", err)
+	}
+
+	return nil
+}
+
+// newOpaqueToken returns a random URL-safe token for the user together with
+// the hash that gets stored, so a leaked store doesn't leak usable tokens.
+func newOpaqueToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generate token: %w", err)
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, hashOpaqueToken(token), nil
+}
+
+func hashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
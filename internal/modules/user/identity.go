@@ -0,0 +1,72 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+func (s *Service) ListMyIdentities(
+	ctx context.Context,
+	actor models.UserActor,
+) ([]models.UserIdentity, error) {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return nil, err
+	}
+
+	return s.identityRepo.ListForUser(ctx, actor.ID)
+}
+
+// LinkIdentity links a provider account to the user. It is called from the
+// provider callback of a link the user started while signed in, so there is
+// no session to check here, only that the user still exists.
+func (s *Service) LinkIdentity(
+	ctx context.Context,
+	userID uuid.UUID,
+	identity models.ExternalIdentity,
+) (models.UserIdentity, error) {
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return models.UserIdentity{}, err
+	}
+
+	return s.identityRepo.Create(ctx, models.UserIdentity{
+		UserID:   userID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	})
+}
+
+// UnlinkMyIdentity removes a linked provider account unless it is the last
+// way the user has to sign in.
+func (s *Service) UnlinkMyIdentity(
+	ctx context.Context,
+	actor models.UserActor,
+	identityID uuid.UUID,
+) error {
+	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+		return err
+	}
+
+	return s.tx.Transaction(ctx, func(ctx context.Context) error {
+		credentials, err := s.identityRepo.CountCredentials(ctx, actor.ID)
+		if err != nil {
+			return err
+		}
+
+		if err = s.identityRepo.Delete(ctx, actor.ID, identityID); err != nil {
+			return err
+		}
+
+		if credentials <= 1 {
+			return errx.ErrorLastCredential.Raise(
+				fmt.Errorf("identity %s is the last credential of user %s", identityID, actor.ID),
+			)
+		}
+
+		return nil
+	})
+}
@@ -44,6 +44,34 @@ func (_m *mockEmailRepo) Create(ctx context.Context, params models.UserEmail) (m
 	return r0, r1
 }
 
+// GetByEmail provides a mock function with given fields: ctx, email
+func (_m *mockEmailRepo) GetByEmail(ctx context.Context, email string) (models.UserEmail, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByEmail")
+	}
+
+	var r0 models.UserEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.UserEmail, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.UserEmail); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(models.UserEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: ctx, userID, opts
 func (_m *mockEmailRepo) GetByID(ctx context.Context, userID uuid.UUID, opts ...GetUserOption) (models.UserEmail, error) {
 	_va := make([]interface{}, len(opts))
@@ -79,6 +107,62 @@ func (_m *mockEmailRepo) GetByID(ctx context.Context, userID uuid.UUID, opts ...
 	return r0, r1
 }
 
+// UpdateEmail provides a mock function with given fields: ctx, userID, oldEmail, newEmail
+func (_m *mockEmailRepo) UpdateEmail(ctx context.Context, userID uuid.UUID, oldEmail string, newEmail string) (models.UserEmail, error) {
+	ret := _m.Called(ctx, userID, oldEmail, newEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmail")
+	}
+
+	var r0 models.UserEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (models.UserEmail, error)); ok {
+		return rf(ctx, userID, oldEmail, newEmail)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) models.UserEmail); ok {
+		r0 = rf(ctx, userID, oldEmail, newEmail)
+	} else {
+		r0 = ret.Get(0).(models.UserEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = rf(ctx, userID, oldEmail, newEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Verify provides a mock function with given fields: ctx, userID, email
+func (_m *mockEmailRepo) Verify(ctx context.Context, userID uuid.UUID, email string) (models.UserEmail, error) {
+	ret := _m.Called(ctx, userID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 models.UserEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (models.UserEmail, error)); ok {
+		return rf(ctx, userID, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) models.UserEmail); ok {
+		r0 = rf(ctx, userID, email)
+	} else {
+		r0 = ret.Get(0).(models.UserEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // newMockEmailRepo creates a new instance of mockEmailRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMockEmailRepo(t interface {
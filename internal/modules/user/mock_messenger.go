@@ -50,6 +50,24 @@ func (_m *mockMessenger) WriteUserDeleted(ctx context.Context, user models.User,
 	return r0
 }
 
+// WriteUserEmailUpdated provides a mock function with given fields: ctx, email
+func (_m *mockMessenger) WriteUserEmailUpdated(ctx context.Context, email models.UserEmail) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteUserEmailUpdated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserEmail) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WriteUserUpdated provides a mock function with given fields: ctx, user
 func (_m *mockMessenger) WriteUserUpdated(ctx context.Context, user models.User) error {
 	ret := _m.Called(ctx, user)
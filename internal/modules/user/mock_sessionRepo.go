@@ -7,6 +7,8 @@ import (
 
 	uuid "github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/netbill/auth-svc/internal/models"
 )
 
 // mockSessionRepo is an autogenerated mock type for the sessionRepo type
@@ -15,23 +17,23 @@ type mockSessionRepo struct {
 }
 
 // DeleteManyForUser provides a mock function with given fields: ctx, userID
-func (_m *mockSessionRepo) DeleteManyForUser(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+func (_m *mockSessionRepo) DeleteManyForUser(ctx context.Context, userID uuid.UUID) ([]models.EndedSession, error) {
 	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteManyForUser")
 	}
 
-	var r0 []uuid.UUID
+	var r0 []models.EndedSession
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]uuid.UUID, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.EndedSession, error)); ok {
 		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.EndedSession); ok {
 		r0 = rf(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]uuid.UUID)
+			r0 = ret.Get(0).([]models.EndedSession)
 		}
 	}
 
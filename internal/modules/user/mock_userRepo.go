@@ -130,9 +130,16 @@ func (_m *mockUserRepo) Filter(ctx context.Context, params FilterParams, limit u
 	return r0, r1
 }
 
-// GetByID provides a mock function with given fields: ctx, userID
-func (_m *mockUserRepo) GetByID(ctx context.Context, userID uuid.UUID) (models.User, error) {
-	ret := _m.Called(ctx, userID)
+// GetByID provides a mock function with given fields: ctx, userID, opts
+func (_m *mockUserRepo) GetByID(ctx context.Context, userID uuid.UUID, opts ...GetUserOption) (models.User, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, userID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -140,17 +147,17 @@ func (_m *mockUserRepo) GetByID(ctx context.Context, userID uuid.UUID) (models.U
 
 	var r0 models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.User, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...GetUserOption) (models.User, error)); ok {
+		return rf(ctx, userID, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.User); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...GetUserOption) models.User); ok {
+		r0 = rf(ctx, userID, opts...)
 	} else {
 		r0 = ret.Get(0).(models.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...GetUserOption) error); ok {
+		r1 = rf(ctx, userID, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
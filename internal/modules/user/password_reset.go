@@ -0,0 +1,112 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+// RequestPasswordReset mails a reset token to the given address. An unknown
+// or deleted address is not an error: callers must not be able to tell
+// whether an account exists. For the same reason the token is stored and
+// mailed in the background, so the call takes as long either way, and a
+// token that could not be stored or mailed is only logged.
+func (s *Service) RequestPasswordReset(
+	ctx context.Context,
+	address string,
+) error {
+	email, err := s.emailRepo.GetByEmail(ctx, address)
+	switch {
+	case errors.Is(err, errx.ErrorUserNotFound):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	detached := context.WithoutCancel(ctx)
+	go func() {
+		if err := s.sendPasswordReset(detached, email); err != nil {
+			s.log.WithError(err).Error("failed to send password reset", "user_id", email.UserID)
+		}
+	}()
+
+	return nil
+}
+
+func (s *Service) sendPasswordReset(ctx context.Context, email models.UserEmail) error {
+	token, hash, err := newOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	if err = s.passwordResets.Set(ctx, hash, models.PasswordReset{
+		UserID: email.UserID,
+	}); err != nil {
+		return fmt.Errorf("store password reset token: %w", err)
+	}
+
+	if err = s.mailer.SendPasswordReset(ctx, email.Email, token); err != nil {
+		return fmt.Errorf("send password reset: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmPasswordReset redeems a token sent by RequestPasswordReset, sets
+// the new password and signs the user out everywhere. The password is
+// checked before the token is consumed, so a rejected password doesn't burn
+// the token.
+func (s *Service) ConfirmPasswordReset(
+	ctx context.Context,
+	token, newPassword string,
+	client models.SessionClient,
+) (err error) {
+	if err = s.checkPasswordRequirements(newPassword); err != nil {
+		return err
+	}
+
+	reset, err := s.passwordResets.Consume(ctx, hashOpaqueToken(token))
+	if err != nil {
+		return err
+	}
+
+	// Attempts with a bad token name nobody and are left out of the log.
+	defer func() { s.recordEvent(ctx, models.AuthEventPasswordReset, reset.UserID, uuid.Nil, client, err) }()
+
+	hash, err := s.passManager.GenerateHash(newPassword)
+	if err != nil {
+		return err
+	}
+
+	var ended []models.EndedSession
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		_, err = s.setPassword(ctx, reset.UserID, hash)
+		if err != nil {
+			return err
+		}
+
+		ended, err = s.sessionRepo.DeleteManyForUser(ctx, reset.UserID)
+		return err
+	}); err != nil {
+		// The user was deleted after the token was issued.
+		if errors.Is(err, errx.ErrorUserNotFound) || errors.Is(err, errx.ErrorUserDeleted) {
+			return errx.ErrorPasswordResetTokenInvalid.Raise(err)
+		}
+		return err
+	}
+
+	detached := context.WithoutCancel(ctx)
+
+	go s.passwordCache.Delete(detached, reset.UserID)
+	go s.revokedSessions.Revoke(detached, ended)
+
+	for _, e := range ended {
+		go s.sessionsCache.Delete(detached, e.ID)
+	}
+
+	return nil
+}
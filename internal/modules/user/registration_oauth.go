@@ -0,0 +1,97 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/username"
+	"github.com/netbill/restkit/tokens"
+)
+
+// oauthRegistrationAttempts bounds how many generated usernames are tried
+// before giving up; a collision needs the same email prefix and six random
+// digits, so more than one retry is rare.
+const oauthRegistrationAttempts = 5
+
+// RegistrationByOAuth creates the account of someone who signed in through
+// an external provider for the first time, linked to their provider
+// account. The provider vouched for the email, so it is stored verified;
+// the username is generated and there is no password until the user sets
+// one with UpdatePassword.
+func (s *Service) RegistrationByOAuth(ctx context.Context, identity models.ExternalIdentity) (models.User, error) {
+	for attempt := 1; ; attempt++ {
+		user, err := s.registerByOAuth(ctx, identity)
+		if errors.Is(err, errx.ErrorUsernameTaken) && attempt < oauthRegistrationAttempts {
+			continue
+		}
+		return user, err
+	}
+}
+
+func (s *Service) registerByOAuth(ctx context.Context, identity models.ExternalIdentity) (models.User, error) {
+	email := identity.Email
+
+	name, err := username.FromEmail(email)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	taken, err := s.userRepo.ExistByUsername(ctx, name)
+	if err != nil {
+		return models.User{}, err
+	}
+	if taken {
+		return models.User{}, errx.ErrorUsernameTaken.Raise(
+			fmt.Errorf("generated username %q is taken", name),
+		)
+	}
+
+	var user models.User
+	var userEmail models.UserEmail
+
+	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
+		user, err = s.userRepo.Create(ctx, RegistrationParams{
+			Email:    email,
+			Username: name,
+			Role:     tokens.RoleSystemUser,
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err = s.emailRepo.Create(ctx, models.UserEmail{
+			UserID: user.ID,
+			Email:  email,
+		}); err != nil {
+			return err
+		}
+
+		userEmail, err = s.emailRepo.Verify(ctx, user.ID, email)
+		if err != nil {
+			return err
+		}
+
+		if _, err = s.identityRepo.Create(ctx, models.UserIdentity{
+			UserID:   user.ID,
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+			Email:    email,
+		}); err != nil {
+			return err
+		}
+
+		return s.messenger.WriteUserCreated(ctx, user, userEmail)
+	}); err != nil {
+		return models.User{}, err
+	}
+
+	detached := context.WithoutCancel(ctx)
+
+	go s.userCache.Set(detached, user)
+	go s.emailCache.Set(detached, userEmail)
+
+	return user, nil
+}
@@ -16,7 +16,7 @@ type transaction interface {
 //go:generate mockery --name=userRepo --inpackage
 type userRepo interface {
 	Create(ctx context.Context, params RegistrationParams) (models.User, error)
-	GetByID(ctx context.Context, userID uuid.UUID) (models.User, error)
+	GetByID(ctx context.Context, userID uuid.UUID, opts ...GetUserOption) (models.User, error)
 	GetByUsername(ctx context.Context, username string) (models.User, error)
 	ExistByUsername(ctx context.Context, username string) (bool, error)
 	Update(ctx context.Context, userID uuid.UUID, params UpdateParams) (models.User, error)
@@ -60,11 +60,14 @@ type usernameValidator interface {
 type emailRepo interface {
 	Create(ctx context.Context, params models.UserEmail) (models.UserEmail, error)
 	GetByID(ctx context.Context, userID uuid.UUID, opts ...GetUserOption) (models.UserEmail, error)
+	GetByEmail(ctx context.Context, email string) (models.UserEmail, error)
+	Verify(ctx context.Context, userID uuid.UUID, email string) (models.UserEmail, error)
+	UpdateEmail(ctx context.Context, userID uuid.UUID, oldEmail, newEmail string) (models.UserEmail, error)
 }
 
 //go:generate mockery --name=sessionRepo --inpackage
 type sessionRepo interface {
-	DeleteManyForUser(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	DeleteManyForUser(ctx context.Context, userID uuid.UUID) ([]models.EndedSession, error)
 }
 
 //go:generate mockery --name=passwordRepo --inpackage
@@ -77,3 +80,11 @@ type passwordRepo interface {
 		passwordHash string,
 	) (models.UserPassword, error)
 }
+
+//go:generate mockery --name=identityRepo --inpackage
+type identityRepo interface {
+	Create(ctx context.Context, identity models.UserIdentity) (models.UserIdentity, error)
+	ListForUser(ctx context.Context, userID uuid.UUID) ([]models.UserIdentity, error)
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+	CountCredentials(ctx context.Context, userID uuid.UUID) (int, error)
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"unicode"
@@ -9,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/errx"
 	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
 	"github.com/netbill/restkit/pagi"
 	"github.com/netbill/restkit/tokens"
 )
@@ -53,20 +55,31 @@ type Service struct {
 	emailRepo    emailRepo
 	passwordRepo passwordRepo
 	sessionRepo  sessionRepo
+	identityRepo identityRepo
 
 	tx transaction
 
-	userCache     userCache
-	emailCache    emailCache
-	passwordCache passwordCache
-	sessionsCache sessionsCache
+	userCache          userCache
+	emailCache         emailCache
+	passwordCache      passwordCache
+	sessionsCache      sessionsCache
+	emailVerifications emailVerificationCache
+	emailChanges       emailChangeCache
+	passwordResets     passwordResetCache
+
+	revokedSessions revokedSessions
 
 	passManager passwordManager
 
 	messenger messenger
+	mailer    mailer
 
 	bucket   media
 	username usernameValidator
+
+	auditLog auditLog
+
+	log *log.Logger
 }
 
 type ServiceDeps struct {
@@ -76,38 +89,57 @@ type ServiceDeps struct {
 	EmailRepo    emailRepo
 	PasswordRepo passwordRepo
 	SessionRepo  sessionRepo
+	IdentityRepo identityRepo
 
 	Tx transaction
 
-	UserCache     userCache
-	EmailCache    emailCache
-	PasswordCache passwordCache
-	SessionsCache sessionsCache
+	UserCache          userCache
+	EmailCache         emailCache
+	PasswordCache      passwordCache
+	SessionsCache      sessionsCache
+	EmailVerifications emailVerificationCache
+	EmailChanges       emailChangeCache
+	PasswordResets     passwordResetCache
+
+	RevokedSessions revokedSessions
 
 	PassManager passwordManager
 
 	Messenger messenger
+	Mailer    mailer
 
 	Bucket   media
 	Username usernameValidator
+
+	AuditLog auditLog
+
+	Log *log.Logger
 }
 
 func New(deps ServiceDeps) *Service {
 	return &Service{
-		auth:          deps.Auth,
-		userRepo:      deps.UserRepo,
-		emailRepo:     deps.EmailRepo,
-		passwordRepo:  deps.PasswordRepo,
-		sessionRepo:   deps.SessionRepo,
-		tx:            deps.Tx,
-		userCache:     deps.UserCache,
-		emailCache:    deps.EmailCache,
-		passwordCache: deps.PasswordCache,
-		sessionsCache: deps.SessionsCache,
-		passManager:   deps.PassManager,
-		messenger:     deps.Messenger,
-		bucket:        deps.Bucket,
-		username:      deps.Username,
+		auth:               deps.Auth,
+		userRepo:           deps.UserRepo,
+		emailRepo:          deps.EmailRepo,
+		passwordRepo:       deps.PasswordRepo,
+		sessionRepo:        deps.SessionRepo,
+		identityRepo:       deps.IdentityRepo,
+		tx:                 deps.Tx,
+		userCache:          deps.UserCache,
+		emailCache:         deps.EmailCache,
+		passwordCache:      deps.PasswordCache,
+		sessionsCache:      deps.SessionsCache,
+		emailVerifications: deps.EmailVerifications,
+		emailChanges:       deps.EmailChanges,
+		passwordResets:     deps.PasswordResets,
+		revokedSessions:    deps.RevokedSessions,
+		passManager:        deps.PassManager,
+		messenger:          deps.Messenger,
+		mailer:             deps.Mailer,
+		bucket:             deps.Bucket,
+		username:           deps.Username,
+		auditLog:           deps.AuditLog,
+		log:                deps.Log,
 	}
 }
 
@@ -121,9 +153,18 @@ type passwordManager interface {
 type messenger interface {
 	WriteUserCreated(ctx context.Context, user models.User, email models.UserEmail) error
 	WriteUserUpdated(ctx context.Context, user models.User) error
+	WriteUserEmailUpdated(ctx context.Context, email models.UserEmail) error
 	WriteUserDeleted(ctx context.Context, user models.User, email models.UserEmail) error
 }
 
+//go:generate mockery --name=mailer --inpackage
+type mailer interface {
+	SendEmailVerification(ctx context.Context, to string, token string) error
+	SendPasswordReset(ctx context.Context, to string, token string) error
+	SendEmailChange(ctx context.Context, to string, token string) error
+	SendEmailChangeNotice(ctx context.Context, to string, newEmail string) error
+}
+
 type RegistrationParams struct {
 	Email    string
 	Password string
@@ -188,6 +229,11 @@ func (s *Service) Registration(
 	go s.userCache.Set(detached, user)
 	go s.emailCache.Set(detached, email)
 	go s.passwordCache.Set(detached, password)
+	go func() {
+		if err := s.sendEmailVerification(detached, email); err != nil {
+			s.log.WithError(err).Error("failed to send email verification", "user_id", user.ID)
+		}
+	}()
 
 	return user, nil
 }
@@ -246,6 +292,10 @@ func (s *Service) GetByUsername(
 
 type FilterParams struct {
 	Text *string
+
+	// Deleted is left at DeletedFilterActive by the public search; only
+	// the admin API looks at deleted users.
+	Deleted DeletedFilter
 }
 
 func (s *Service) GetList(
@@ -403,21 +453,35 @@ func (s *Service) UpdatePassword(
 	ctx context.Context,
 	actor models.UserActor,
 	oldPassword, newPassword string,
-) error {
-	if _, _, err := s.auth.ValidateSession(ctx, actor); err != nil {
+) (err error) {
+	defer func() {
+		s.recordEvent(ctx, models.AuthEventPasswordChanged, actor.ID, actor.SessionID, actor.Client, err)
+	}()
+
+	if _, _, err = s.auth.ValidateSession(ctx, actor); err != nil {
 		return err
 	}
 
 	pwd, err := s.passwordCache.Get(ctx, actor.ID)
 	if err != nil {
 		pwd, err = s.passwordRepo.GetByID(ctx, actor.ID)
-		if err != nil {
-			return err
-		}
 	}
 
-	if err = s.passManager.CheckMatch(oldPassword, pwd.Hash); err != nil {
+	switch {
+	case errors.Is(err, errx.ErrorPasswordNotSet):
+		// Accounts created through Google set their first password without
+		// an old one; sending one anyway is a mistake worth reporting.
+		if oldPassword != "" {
+			return errx.ErrorPasswordInvalid.Raise(
+				fmt.Errorf("user %s has no password to check the old one against", actor.ID),
+			)
+		}
+	case err != nil:
 		return err
+	default:
+		if err = s.passManager.CheckMatch(oldPassword, pwd.Hash); err != nil {
+			return err
+		}
 	}
 
 	if err = s.checkPasswordRequirements(newPassword); err != nil {
@@ -431,7 +495,7 @@ func (s *Service) UpdatePassword(
 
 	var updated models.UserPassword
 	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
-		updated, err = s.passwordRepo.UpdatePassword(ctx, actor.ID, hash)
+		updated, err = s.setPassword(ctx, actor.ID, hash)
 		return err
 	}); err != nil {
 		return err
@@ -442,6 +506,25 @@ func (s *Service) UpdatePassword(
 	return nil
 }
 
+// setPassword replaces the user's password, or creates the first one for an
+// account that was made without it. Run it inside a transaction.
+func (s *Service) setPassword(ctx context.Context, userID uuid.UUID, hash string) (models.UserPassword, error) {
+	pwd, err := s.passwordRepo.UpdatePassword(ctx, userID, hash)
+	if !errors.Is(err, errx.ErrorPasswordNotSet) {
+		return pwd, err
+	}
+
+	// No password row; don't create one for a deleted user.
+	if _, err = s.userRepo.GetByID(ctx, userID); err != nil {
+		return models.UserPassword{}, err
+	}
+
+	return s.passwordRepo.Create(ctx, models.UserPassword{
+		UserID: userID,
+		Hash:   hash,
+	})
+}
+
 func (s *Service) DeleteMyUser(
 	ctx context.Context,
 	actor models.UserActor,
@@ -451,10 +534,10 @@ func (s *Service) DeleteMyUser(
 	}
 
 	var (
-		user       models.User
-		email      models.UserEmail
-		sessionIDs []uuid.UUID
-		err        error
+		user  models.User
+		email models.UserEmail
+		ended []models.EndedSession
+		err   error
 	)
 
 	if err = s.tx.Transaction(ctx, func(ctx context.Context) error {
@@ -468,7 +551,7 @@ func (s *Service) DeleteMyUser(
 			return err
 		}
 
-		sessionIDs, err = s.sessionRepo.DeleteManyForUser(ctx, actor.ID)
+		ended, err = s.sessionRepo.DeleteManyForUser(ctx, actor.ID)
 		if err != nil {
 			return err
 		}
@@ -483,9 +566,10 @@ func (s *Service) DeleteMyUser(
 	go s.userCache.Delete(detached, actor.ID)
 	go s.emailCache.DeleteByID(detached, actor.ID)
 	go s.passwordCache.Delete(detached, actor.ID)
+	go s.revokedSessions.Revoke(detached, ended)
 
-	for _, id := range sessionIDs {
-		go s.sessionsCache.Delete(detached, id)
+	for _, e := range ended {
+		go s.sessionsCache.Delete(detached, e.ID)
 	}
 
 	return nil
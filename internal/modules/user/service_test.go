@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/errx"
 	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -23,19 +25,26 @@ func (f *fakeTx) Transaction(ctx context.Context, fn func(context.Context) error
 type UserServiceSuite struct {
 	suite.Suite
 
-	auth          *mockAuth
-	userRepo      *mockUserRepo
-	emailRepo     *mockEmailRepo
-	passwordRepo  *mockPasswordRepo
-	sessionRepo   *mockSessionRepo
-	userCache     *mockUserCache
-	emailCache    *mockEmailCache
-	passwordCache *mockPasswordCache
-	sessionsCache *mockSessionsCache
-	passManager   *mockPasswordManager
-	messenger     *mockMessenger
-	bucket        *mockMedia
-	username      *mockUsernameValidator
+	auth               *mockAuth
+	userRepo           *mockUserRepo
+	emailRepo          *mockEmailRepo
+	passwordRepo       *mockPasswordRepo
+	sessionRepo        *mockSessionRepo
+	identityRepo       *mockIdentityRepo
+	userCache          *mockUserCache
+	emailCache         *mockEmailCache
+	passwordCache      *mockPasswordCache
+	sessionsCache      *mockSessionsCache
+	revokedSessions    *mockRevokedSessions
+	emailVerifications *mockEmailVerificationCache
+	emailChanges       *mockEmailChangeCache
+	passwordResets     *mockPasswordResetCache
+	passManager        *mockPasswordManager
+	messenger          *mockMessenger
+	mailer             *mockMailer
+	bucket             *mockMedia
+	username           *mockUsernameValidator
+	auditLog           *mockAuditLog
 
 	svc *Service
 }
@@ -46,30 +55,47 @@ func (s *UserServiceSuite) SetupTest() {
 	s.emailRepo = newMockEmailRepo(s.T())
 	s.passwordRepo = newMockPasswordRepo(s.T())
 	s.sessionRepo = newMockSessionRepo(s.T())
+	s.identityRepo = newMockIdentityRepo(s.T())
 	s.userCache = newMockUserCache(s.T())
 	s.emailCache = newMockEmailCache(s.T())
 	s.passwordCache = newMockPasswordCache(s.T())
 	s.sessionsCache = newMockSessionsCache(s.T())
+	s.revokedSessions = newMockRevokedSessions(s.T())
+	s.revokedSessions.On("Revoke", mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.emailVerifications = newMockEmailVerificationCache(s.T())
+	s.emailChanges = newMockEmailChangeCache(s.T())
+	s.passwordResets = newMockPasswordResetCache(s.T())
 	s.passManager = newMockPasswordManager(s.T())
 	s.messenger = newMockMessenger(s.T())
+	s.mailer = newMockMailer(s.T())
 	s.bucket = newMockMedia(s.T())
 	s.username = newMockUsernameValidator(s.T())
+	s.auditLog = newMockAuditLog(s.T())
+	s.auditLog.On("Record", mock.Anything, mock.Anything).Return().Maybe()
 
 	s.svc = New(ServiceDeps{
-		Auth:          s.auth,
-		UserRepo:      s.userRepo,
-		EmailRepo:     s.emailRepo,
-		PasswordRepo:  s.passwordRepo,
-		SessionRepo:   s.sessionRepo,
-		Tx:            &fakeTx{},
-		UserCache:     s.userCache,
-		EmailCache:    s.emailCache,
-		PasswordCache: s.passwordCache,
-		SessionsCache: s.sessionsCache,
-		PassManager:   s.passManager,
-		Messenger:     s.messenger,
-		Bucket:        s.bucket,
-		Username:      s.username,
+		Auth:               s.auth,
+		UserRepo:           s.userRepo,
+		EmailRepo:          s.emailRepo,
+		PasswordRepo:       s.passwordRepo,
+		SessionRepo:        s.sessionRepo,
+		IdentityRepo:       s.identityRepo,
+		Tx:                 &fakeTx{},
+		UserCache:          s.userCache,
+		EmailCache:         s.emailCache,
+		PasswordCache:      s.passwordCache,
+		SessionsCache:      s.sessionsCache,
+		RevokedSessions:    s.revokedSessions,
+		EmailVerifications: s.emailVerifications,
+		EmailChanges:       s.emailChanges,
+		PasswordResets:     s.passwordResets,
+		PassManager:        s.passManager,
+		Messenger:          s.messenger,
+		Mailer:             s.mailer,
+		Bucket:             s.bucket,
+		Username:           s.username,
+		AuditLog:           s.auditLog,
+		Log:                log.New("error", "text", "test"),
 	})
 }
 
@@ -103,6 +129,11 @@ func (s *UserServiceSuite) TestRegistration_HappyPath() {
 	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
 	s.emailCache.On("Set", mock.Anything, email).Return(nil).Maybe()
 	s.passwordCache.On("Set", mock.Anything, password).Return(nil).Maybe()
+	s.emailVerifications.On("Set", mock.Anything, mock.Anything, models.EmailVerification{
+		UserID: userID,
+		Email:  params.Email,
+	}).Return(nil).Maybe()
+	s.mailer.On("SendEmailVerification", mock.Anything, params.Email, mock.Anything).Return(nil).Maybe()
 
 	got, err := s.svc.Registration(ctx, params)
 
@@ -323,6 +354,507 @@ func (s *UserServiceSuite) TestGetMyEmailByID_CacheMiss_RepoError() {
 	assert.ErrorIs(s.T(), err, repoErr)
 }
 
+// ─── EmailVerification ───────────────────────────────────────────────────────
+
+func (s *UserServiceSuite) TestRequestEmailVerification_HappyPath() {
+	userID := uuid.New()
+	email := models.UserEmail{UserID: userID, Email: "user@example.com"}
+
+	var storedHash, sentToken string
+
+	s.emailRepo.On("GetByID", mock.Anything, userID).Return(email, nil)
+	s.emailVerifications.On("Set", mock.Anything, mock.Anything, models.EmailVerification{
+		UserID: userID,
+		Email:  email.Email,
+	}).Run(func(args mock.Arguments) {
+		storedHash = args.String(1)
+	}).Return(nil)
+	s.mailer.On("SendEmailVerification", mock.Anything, email.Email, mock.Anything).Run(func(args mock.Arguments) {
+		sentToken = args.String(2)
+	}).Return(nil)
+
+	err := s.svc.RequestEmailVerification(context.Background(), models.UserActor{ID: userID})
+
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), sentToken)
+	assert.NotEqual(s.T(), sentToken, storedHash, "the raw token must never be stored")
+	assert.Equal(s.T(), hashOpaqueToken(sentToken), storedHash)
+}
+
+func (s *UserServiceSuite) TestRequestEmailVerification_AlreadyVerified() {
+	userID := uuid.New()
+	email := models.UserEmail{UserID: userID, Email: "user@example.com", Verified: true}
+
+	s.emailRepo.On("GetByID", mock.Anything, userID).Return(email, nil)
+
+	err := s.svc.RequestEmailVerification(context.Background(), models.UserActor{ID: userID})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorEmailAlreadyVerified)
+}
+
+func (s *UserServiceSuite) TestRequestEmailVerification_MailerError() {
+	userID := uuid.New()
+	email := models.UserEmail{UserID: userID, Email: "user@example.com"}
+	mailErr := errors.New("smtp down")
+
+	s.emailRepo.On("GetByID", mock.Anything, userID).Return(email, nil)
+	s.emailVerifications.On("Set", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.mailer.On("SendEmailVerification", mock.Anything, email.Email, mock.Anything).Return(mailErr)
+
+	err := s.svc.RequestEmailVerification(context.Background(), models.UserActor{ID: userID})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, mailErr)
+}
+
+func (s *UserServiceSuite) TestConfirmEmailVerification_HappyPath() {
+	userID := uuid.New()
+	token := "token"
+	pending := models.UserEmail{UserID: userID, Email: "user@example.com", Version: 1}
+	verified := models.UserEmail{UserID: userID, Email: "user@example.com", Verified: true, Version: 2}
+
+	s.emailVerifications.On("Consume", mock.Anything, hashOpaqueToken(token)).Return(models.EmailVerification{
+		UserID: userID,
+		Email:  pending.Email,
+	}, nil)
+	s.emailRepo.On("GetByID", mock.Anything, userID).Return(pending, nil)
+	s.emailRepo.On("Verify", mock.Anything, userID, pending.Email).Return(verified, nil)
+	s.messenger.On("WriteUserEmailUpdated", mock.Anything, verified).Return(nil)
+	s.emailCache.On("Set", mock.Anything, verified).Return(nil).Maybe()
+
+	got, err := s.svc.ConfirmEmailVerification(context.Background(), token)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), verified, got)
+}
+
+func (s *UserServiceSuite) TestConfirmEmailVerification_InvalidToken() {
+	s.emailVerifications.On("Consume", mock.Anything, mock.Anything).Return(
+		models.EmailVerification{},
+		errx.ErrorEmailVerificationTokenInvalid.Raise(errors.New("not found")),
+	)
+
+	_, err := s.svc.ConfirmEmailVerification(context.Background(), "token")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorEmailVerificationTokenInvalid)
+}
+
+func (s *UserServiceSuite) TestConfirmEmailVerification_EmailChanged() {
+	userID := uuid.New()
+
+	s.emailVerifications.On("Consume", mock.Anything, mock.Anything).Return(models.EmailVerification{
+		UserID: userID,
+		Email:  "old@example.com",
+	}, nil)
+	s.emailRepo.On("GetByID", mock.Anything, userID).Return(models.UserEmail{
+		UserID: userID,
+		Email:  "new@example.com",
+	}, nil)
+
+	_, err := s.svc.ConfirmEmailVerification(context.Background(), "token")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorEmailVerificationTokenInvalid)
+}
+
+func (s *UserServiceSuite) TestConfirmEmailVerification_AlreadyVerified() {
+	userID := uuid.New()
+	email := models.UserEmail{UserID: userID, Email: "user@example.com", Verified: true}
+
+	s.emailVerifications.On("Consume", mock.Anything, mock.Anything).Return(models.EmailVerification{
+		UserID: userID,
+		Email:  email.Email,
+	}, nil)
+	s.emailRepo.On("GetByID", mock.Anything, userID).Return(email, nil)
+
+	got, err := s.svc.ConfirmEmailVerification(context.Background(), "token")
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), email, got)
+}
+
+func (s *UserServiceSuite) TestConfirmEmailVerification_MessengerError() {
+	userID := uuid.New()
+	msgErr := errors.New("kafka error")
+	email := models.UserEmail{UserID: userID, Email: "user@example.com"}
+
+	s.emailVerifications.On("Consume", mock.Anything, mock.Anything).Return(models.EmailVerification{
+		UserID: userID,
+		Email:  email.Email,
+	}, nil)
+	s.emailRepo.On("GetByID", mock.Anything, userID).Return(email, nil)
+	s.emailRepo.On("Verify", mock.Anything, userID, email.Email).Return(models.UserEmail{}, nil)
+	s.messenger.On("WriteUserEmailUpdated", mock.Anything, mock.Anything).Return(msgErr)
+
+	_, err := s.svc.ConfirmEmailVerification(context.Background(), "token")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, msgErr)
+}
+
+// ─── EmailChange ─────────────────────────────────────────────────────────────
+
+func (s *UserServiceSuite) TestRequestEmailChange_HappyPath() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	current := models.UserEmail{UserID: actor.ID, Email: "old@example.com", Verified: true}
+
+	var storedHash, sentToken string
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.emailRepo.On("GetByID", mock.Anything, actor.ID).Return(current, nil)
+	s.emailRepo.On("GetByEmail", mock.Anything, "new@example.com").Return(
+		models.UserEmail{}, errx.ErrorUserNotFound.Raise(errors.New("not found")),
+	)
+	s.emailChanges.On("Set", mock.Anything, mock.Anything, models.EmailChange{
+		UserID:   actor.ID,
+		OldEmail: "old@example.com",
+		NewEmail: "new@example.com",
+	}).Run(func(args mock.Arguments) {
+		storedHash = args.String(1)
+	}).Return(nil)
+	s.mailer.On("SendEmailChange", mock.Anything, "new@example.com", mock.Anything).Run(func(args mock.Arguments) {
+		sentToken = args.String(2)
+	}).Return(nil)
+	s.mailer.On("SendEmailChangeNotice", mock.Anything, "old@example.com", "new@example.com").Return(nil)
+
+	err := s.svc.RequestEmailChange(context.Background(), actor, "new@example.com")
+
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), sentToken)
+	assert.Equal(s.T(), hashOpaqueToken(sentToken), storedHash)
+}
+
+func (s *UserServiceSuite) TestRequestEmailChange_ValidateSessionError() {
+	authErr := errors.New("session invalid")
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, authErr)
+
+	err := s.svc.RequestEmailChange(context.Background(), actor, "new@example.com")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, authErr)
+}
+
+func (s *UserServiceSuite) TestRequestEmailChange_SameEmail() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.emailRepo.On("GetByID", mock.Anything, actor.ID).Return(
+		models.UserEmail{UserID: actor.ID, Email: "user@example.com"}, nil,
+	)
+
+	err := s.svc.RequestEmailChange(context.Background(), actor, "user@example.com")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorEmailUnchanged)
+}
+
+func (s *UserServiceSuite) TestRequestEmailChange_EmailTaken() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.emailRepo.On("GetByID", mock.Anything, actor.ID).Return(
+		models.UserEmail{UserID: actor.ID, Email: "old@example.com"}, nil,
+	)
+	s.emailRepo.On("GetByEmail", mock.Anything, "new@example.com").Return(
+		models.UserEmail{UserID: uuid.New(), Email: "new@example.com"}, nil,
+	)
+
+	err := s.svc.RequestEmailChange(context.Background(), actor, "new@example.com")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorEmailAlreadyExist)
+}
+
+func (s *UserServiceSuite) TestConfirmEmailChange_HappyPath() {
+	userID := uuid.New()
+	token := "token"
+	changed := models.UserEmail{UserID: userID, Email: "new@example.com", Verified: true, Version: 3}
+
+	s.emailChanges.On("Consume", mock.Anything, hashOpaqueToken(token)).Return(models.EmailChange{
+		UserID:   userID,
+		OldEmail: "old@example.com",
+		NewEmail: "new@example.com",
+	}, nil)
+	s.emailRepo.On("UpdateEmail", mock.Anything, userID, "old@example.com", "new@example.com").Return(changed, nil)
+	s.messenger.On("WriteUserEmailUpdated", mock.Anything, changed).Return(nil)
+	s.emailCache.On("DeleteByEmail", mock.Anything, "old@example.com").Return(nil).Maybe()
+	s.emailCache.On("Set", mock.Anything, changed).Return(nil).Maybe()
+
+	got, err := s.svc.ConfirmEmailChange(context.Background(), token)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), changed, got)
+}
+
+func (s *UserServiceSuite) TestConfirmEmailChange_InvalidToken() {
+	s.emailChanges.On("Consume", mock.Anything, mock.Anything).Return(
+		models.EmailChange{},
+		errx.ErrorEmailChangeTokenInvalid.Raise(errors.New("not found")),
+	)
+
+	_, err := s.svc.ConfirmEmailChange(context.Background(), "token")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorEmailChangeTokenInvalid)
+}
+
+func (s *UserServiceSuite) TestConfirmEmailChange_EmailChangedSince() {
+	userID := uuid.New()
+
+	s.emailChanges.On("Consume", mock.Anything, mock.Anything).Return(models.EmailChange{
+		UserID:   userID,
+		OldEmail: "old@example.com",
+		NewEmail: "new@example.com",
+	}, nil)
+	s.emailRepo.On("UpdateEmail", mock.Anything, userID, "old@example.com", "new@example.com").Return(
+		models.UserEmail{}, errx.ErrorUserNotFound.Raise(errors.New("no rows")),
+	)
+
+	_, err := s.svc.ConfirmEmailChange(context.Background(), "token")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorEmailChangeTokenInvalid)
+}
+
+func (s *UserServiceSuite) TestConfirmEmailChange_EmailTakenMeanwhile() {
+	userID := uuid.New()
+
+	s.emailChanges.On("Consume", mock.Anything, mock.Anything).Return(models.EmailChange{
+		UserID:   userID,
+		OldEmail: "old@example.com",
+		NewEmail: "new@example.com",
+	}, nil)
+	s.emailRepo.On("UpdateEmail", mock.Anything, userID, "old@example.com", "new@example.com").Return(
+		models.UserEmail{}, errx.ErrorEmailAlreadyExist.Raise(errors.New("unique violation")),
+	)
+
+	_, err := s.svc.ConfirmEmailChange(context.Background(), "token")
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorEmailAlreadyExist)
+}
+
+// ─── PasswordReset ───────────────────────────────────────────────────────────
+
+// waitFor fails the test unless done is closed within a second.
+func (s *UserServiceSuite) waitFor(done <-chan struct{}, what string) {
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		s.T().Fatal(what)
+	}
+}
+
+func (s *UserServiceSuite) TestRequestPasswordReset_HappyPath() {
+	userID := uuid.New()
+	email := models.UserEmail{UserID: userID, Email: "user@example.com"}
+	sent := make(chan struct{})
+
+	var storedHash, sentToken string
+
+	s.emailRepo.On("GetByEmail", mock.Anything, email.Email).Return(email, nil)
+	s.passwordResets.On("Set", mock.Anything, mock.Anything, models.PasswordReset{
+		UserID: userID,
+	}).Run(func(args mock.Arguments) {
+		storedHash = args.String(1)
+	}).Return(nil)
+	s.mailer.On("SendPasswordReset", mock.Anything, email.Email, mock.Anything).Run(func(args mock.Arguments) {
+		sentToken = args.String(2)
+		close(sent)
+	}).Return(nil)
+
+	err := s.svc.RequestPasswordReset(context.Background(), email.Email)
+
+	require.NoError(s.T(), err)
+	s.waitFor(sent, "no password reset sent")
+	require.NotEmpty(s.T(), sentToken)
+	assert.NotEqual(s.T(), sentToken, storedHash, "the raw token must never be stored")
+	assert.Equal(s.T(), hashOpaqueToken(sentToken), storedHash)
+}
+
+func (s *UserServiceSuite) TestRequestPasswordReset_UnknownEmail() {
+	s.emailRepo.On("GetByEmail", mock.Anything, "ghost@example.com").Return(
+		models.UserEmail{},
+		errx.ErrorUserNotFound.Raise(errors.New("no rows")),
+	)
+
+	err := s.svc.RequestPasswordReset(context.Background(), "ghost@example.com")
+
+	require.NoError(s.T(), err, "unknown emails must look the same as known ones")
+}
+
+func (s *UserServiceSuite) TestRequestPasswordReset_DoesNotWaitForMail() {
+	email := models.UserEmail{UserID: uuid.New(), Email: "user@example.com"}
+	release := make(chan struct{})
+	sent := make(chan struct{})
+
+	s.emailRepo.On("GetByEmail", mock.Anything, email.Email).Return(email, nil)
+	s.passwordResets.On("Set", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.mailer.On("SendPasswordReset", mock.Anything, email.Email, mock.Anything).Run(func(mock.Arguments) {
+		<-release
+		close(sent)
+	}).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := s.svc.RequestPasswordReset(ctx, email.Email)
+	cancel()
+
+	require.NoError(s.T(), err)
+	// The request is answered while the mail is still on its way, and a
+	// finished request doesn't cancel it.
+	close(release)
+	s.waitFor(sent, "no password reset sent")
+}
+
+func (s *UserServiceSuite) TestRequestPasswordReset_MailerError() {
+	email := models.UserEmail{UserID: uuid.New(), Email: "user@example.com"}
+	mailErr := errors.New("smtp down")
+	sent := make(chan struct{})
+
+	s.emailRepo.On("GetByEmail", mock.Anything, email.Email).Return(email, nil)
+	s.passwordResets.On("Set", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.mailer.On("SendPasswordReset", mock.Anything, email.Email, mock.Anything).Run(func(mock.Arguments) {
+		close(sent)
+	}).Return(mailErr)
+
+	err := s.svc.RequestPasswordReset(context.Background(), email.Email)
+
+	require.NoError(s.T(), err, "a failed mail must look the same as an unknown email")
+	s.waitFor(sent, "no password reset sent")
+}
+
+func (s *UserServiceSuite) TestRequestPasswordReset_StoreError() {
+	email := models.UserEmail{UserID: uuid.New(), Email: "user@example.com"}
+	stored := make(chan struct{})
+
+	s.emailRepo.On("GetByEmail", mock.Anything, email.Email).Return(email, nil)
+	s.passwordResets.On("Set", mock.Anything, mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		close(stored)
+	}).Return(errors.New("redis down"))
+
+	err := s.svc.RequestPasswordReset(context.Background(), email.Email)
+
+	require.NoError(s.T(), err, "a failed token store must look the same as an unknown email")
+	s.waitFor(stored, "no password reset token stored")
+	s.mailer.AssertNotCalled(s.T(), "SendPasswordReset", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *UserServiceSuite) TestConfirmPasswordReset_HappyPath() {
+	userID := uuid.New()
+	token := "token"
+	sessions := []models.EndedSession{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	s.passwordResets.On("Consume", mock.Anything, hashOpaqueToken(token)).Return(models.PasswordReset{
+		UserID: userID,
+	}, nil)
+	s.passManager.On("GenerateHash", "NewPass1!").Return("newhash", nil)
+	s.passwordRepo.On("UpdatePassword", mock.Anything, userID, "newhash").Return(models.UserPassword{
+		UserID: userID,
+		Hash:   "newhash",
+	}, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, userID).Return(sessions, nil)
+	s.passwordCache.On("Delete", mock.Anything, userID).Return(nil).Maybe()
+	for _, e := range sessions {
+		s.sessionsCache.On("Delete", mock.Anything, e.ID).Return(nil).Maybe()
+	}
+
+	err := s.svc.ConfirmPasswordReset(context.Background(), token, "NewPass1!", models.SessionClient{})
+
+	require.NoError(s.T(), err)
+}
+
+func (s *UserServiceSuite) TestConfirmPasswordReset_PasswordNotAllowed_KeepsToken() {
+	err := s.svc.ConfirmPasswordReset(context.Background(), "token", "short", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorPasswordIsNotAllowed)
+	s.passwordResets.AssertNotCalled(s.T(), "Consume", mock.Anything, mock.Anything)
+}
+
+func (s *UserServiceSuite) TestConfirmPasswordReset_InvalidToken() {
+	s.passwordResets.On("Consume", mock.Anything, mock.Anything).Return(
+		models.PasswordReset{},
+		errx.ErrorPasswordResetTokenInvalid.Raise(errors.New("not found")),
+	)
+
+	err := s.svc.ConfirmPasswordReset(context.Background(), "token", "NewPass1!", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorPasswordResetTokenInvalid)
+}
+
+func (s *UserServiceSuite) TestConfirmPasswordReset_UserGone() {
+	userID := uuid.New()
+
+	s.passwordResets.On("Consume", mock.Anything, mock.Anything).Return(models.PasswordReset{
+		UserID: userID,
+	}, nil)
+	s.passManager.On("GenerateHash", "NewPass1!").Return("newhash", nil)
+	s.passwordRepo.On("UpdatePassword", mock.Anything, userID, "newhash").Return(
+		models.UserPassword{},
+		errx.ErrorUserNotFound.Raise(errors.New("no rows")),
+	)
+
+	err := s.svc.ConfirmPasswordReset(context.Background(), "token", "NewPass1!", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, errx.ErrorPasswordResetTokenInvalid)
+}
+
+func (s *UserServiceSuite) TestConfirmPasswordReset_SessionRepoError() {
+	userID := uuid.New()
+	repoErr := errors.New("db error")
+
+	s.passwordResets.On("Consume", mock.Anything, mock.Anything).Return(models.PasswordReset{
+		UserID: userID,
+	}, nil)
+	s.passManager.On("GenerateHash", "NewPass1!").Return("newhash", nil)
+	s.passwordRepo.On("UpdatePassword", mock.Anything, userID, "newhash").Return(models.UserPassword{}, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, userID).Return(nil, repoErr)
+
+	err := s.svc.ConfirmPasswordReset(context.Background(), "token", "NewPass1!", models.SessionClient{})
+
+	require.Error(s.T(), err)
+	assert.ErrorIs(s.T(), err, repoErr)
+}
+
+func (s *UserServiceSuite) TestConfirmPasswordReset_RecordsEvent() {
+	userID := uuid.New()
+	client := models.SessionClient{IP: "203.0.113.7", UserAgent: "curl/8.0"}
+	events := make(chan models.AuthEvent, 1)
+
+	s.auditLog = newMockAuditLog(s.T())
+	s.auditLog.On("Record", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		events <- args.Get(1).(models.AuthEvent)
+	}).Return()
+	s.svc.auditLog = s.auditLog
+
+	s.passwordResets.On("Consume", mock.Anything, mock.Anything).Return(models.PasswordReset{
+		UserID: userID,
+	}, nil)
+	s.passManager.On("GenerateHash", "NewPass1!").Return("newhash", nil)
+	s.passwordRepo.On("UpdatePassword", mock.Anything, userID, "newhash").Return(models.UserPassword{}, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, userID).Return(nil, nil)
+	s.passwordCache.On("Delete", mock.Anything, userID).Return(nil).Maybe()
+
+	require.NoError(s.T(), s.svc.ConfirmPasswordReset(context.Background(), "token", "NewPass1!", client))
+
+	select {
+	case ev := <-events:
+		assert.Equal(s.T(), models.AuthEventPasswordReset, ev.Type)
+		assert.Equal(s.T(), models.AuthEventSuccess, ev.Outcome)
+		require.NotNil(s.T(), ev.UserID)
+		assert.Equal(s.T(), userID, *ev.UserID)
+		assert.Equal(s.T(), client.IP, ev.IP)
+	case <-time.After(time.Second):
+		s.T().Fatal("no auth event recorded")
+	}
+}
+
 // ─── UpdatePassword ──────────────────────────────────────────────────────────
 
 func (s *UserServiceSuite) TestUpdatePassword_ValidateSessionError() {
@@ -448,6 +980,212 @@ func (s *UserServiceSuite) TestUpdatePassword_UpdateRepoError() {
 	assert.ErrorIs(s.T(), err, repoErr)
 }
 
+func (s *UserServiceSuite) TestUpdatePassword_SetsInitialPassword() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	created := models.UserPassword{UserID: actor.ID, Hash: "newhash"}
+	notSet := errx.ErrorPasswordNotSet.Raise(errors.New("no rows"))
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.passwordCache.On("Get", mock.Anything, actor.ID).Return(models.UserPassword{}, errors.New("miss"))
+	s.passwordRepo.On("GetByID", mock.Anything, actor.ID).Return(models.UserPassword{}, notSet)
+	s.passManager.On("GenerateHash", "NewPass1!").Return("newhash", nil)
+	s.passwordRepo.On("UpdatePassword", mock.Anything, actor.ID, "newhash").Return(models.UserPassword{}, notSet)
+	s.userRepo.On("GetByID", mock.Anything, actor.ID).Return(models.User{ID: actor.ID}, nil)
+	s.passwordRepo.On("Create", mock.Anything, created).Return(created, nil)
+	s.passwordCache.On("Set", mock.Anything, created).Return(nil).Maybe()
+
+	err := s.svc.UpdatePassword(context.Background(), actor, "", "NewPass1!")
+
+	require.NoError(s.T(), err)
+	s.passManager.AssertNotCalled(s.T(), "CheckMatch", mock.Anything, mock.Anything)
+}
+
+func (s *UserServiceSuite) TestUpdatePassword_OldPasswordWithoutPassword() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.passwordCache.On("Get", mock.Anything, actor.ID).Return(models.UserPassword{}, errors.New("miss"))
+	s.passwordRepo.On("GetByID", mock.Anything, actor.ID).
+		Return(models.UserPassword{}, errx.ErrorPasswordNotSet.Raise(errors.New("no rows")))
+
+	err := s.svc.UpdatePassword(context.Background(), actor, "OldPass1!", "NewPass1!")
+
+	assert.ErrorIs(s.T(), err, errx.ErrorPasswordInvalid)
+}
+
+// ─── RegistrationByOAuth ────────────────────────────────────────────────────
+
+func (s *UserServiceSuite) TestRegistrationByOAuth_HappyPath() {
+	userID := uuid.New()
+	user := models.User{ID: userID, Username: "alice123456"}
+	email := models.UserEmail{UserID: userID, Email: "alice@example.com"}
+	verified := models.UserEmail{UserID: userID, Email: "alice@example.com", Verified: true}
+
+	var params RegistrationParams
+	s.userRepo.On("ExistByUsername", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
+	s.userRepo.On("Create", mock.Anything, mock.AnythingOfType("user.RegistrationParams")).
+		Run(func(args mock.Arguments) { params = args.Get(1).(RegistrationParams) }).
+		Return(user, nil)
+	s.emailRepo.On("Create", mock.Anything, email).Return(email, nil)
+	s.emailRepo.On("Verify", mock.Anything, userID, email.Email).Return(verified, nil)
+	s.identityRepo.On("Create", mock.Anything, models.UserIdentity{
+		UserID:   userID,
+		Provider: models.IdentityProviderGoogle,
+		Subject:  "google-alice",
+		Email:    "alice@example.com",
+	}).Return(models.UserIdentity{ID: uuid.New(), UserID: userID}, nil)
+	s.messenger.On("WriteUserCreated", mock.Anything, user, verified).Return(nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.emailCache.On("Set", mock.Anything, verified).Return(nil).Maybe()
+
+	got, err := s.svc.RegistrationByOAuth(context.Background(), googleIdentity("alice"))
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), user, got)
+	assert.Equal(s.T(), "user", params.Role)
+	assert.Empty(s.T(), params.Password)
+	assert.Regexp(s.T(), `^alice\d{6}$`, params.Username)
+	s.passwordRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *UserServiceSuite) TestRegistrationByOAuth_RetriesTakenUsername() {
+	userID := uuid.New()
+	user := models.User{ID: userID}
+	email := models.UserEmail{UserID: userID, Email: "bob@example.com"}
+
+	s.userRepo.On("ExistByUsername", mock.Anything, mock.AnythingOfType("string")).Return(true, nil).Once()
+	s.userRepo.On("ExistByUsername", mock.Anything, mock.AnythingOfType("string")).Return(false, nil).Once()
+	s.userRepo.On("Create", mock.Anything, mock.AnythingOfType("user.RegistrationParams")).
+		Return(models.User{}, errx.ErrorUsernameTaken.Raise(errors.New("duplicate key"))).Once()
+	s.userRepo.On("ExistByUsername", mock.Anything, mock.AnythingOfType("string")).Return(false, nil).Once()
+	s.userRepo.On("Create", mock.Anything, mock.AnythingOfType("user.RegistrationParams")).Return(user, nil).Once()
+	s.emailRepo.On("Create", mock.Anything, email).Return(email, nil)
+	s.emailRepo.On("Verify", mock.Anything, userID, email.Email).Return(email, nil)
+	s.identityRepo.On("Create", mock.Anything, mock.AnythingOfType("models.UserIdentity")).
+		Return(models.UserIdentity{ID: uuid.New(), UserID: userID}, nil)
+	s.messenger.On("WriteUserCreated", mock.Anything, user, email).Return(nil)
+	s.userCache.On("Set", mock.Anything, user).Return(nil).Maybe()
+	s.emailCache.On("Set", mock.Anything, email).Return(nil).Maybe()
+
+	got, err := s.svc.RegistrationByOAuth(context.Background(), googleIdentity("bob"))
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), user, got)
+}
+
+func (s *UserServiceSuite) TestRegistrationByOAuth_EmailTaken() {
+	userID := uuid.New()
+
+	s.userRepo.On("ExistByUsername", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
+	s.userRepo.On("Create", mock.Anything, mock.AnythingOfType("user.RegistrationParams")).
+		Return(models.User{ID: userID}, nil)
+	s.emailRepo.On("Create", mock.Anything, models.UserEmail{UserID: userID, Email: "carol@example.com"}).
+		Return(models.UserEmail{}, errx.ErrorEmailAlreadyExist.Raise(errors.New("duplicate key")))
+
+	_, err := s.svc.RegistrationByOAuth(context.Background(), googleIdentity("carol"))
+
+	assert.ErrorIs(s.T(), err, errx.ErrorEmailAlreadyExist)
+}
+
+func (s *UserServiceSuite) TestRegistrationByOAuth_IdentityAlreadyLinked() {
+	userID := uuid.New()
+	email := models.UserEmail{UserID: userID, Email: "dave@example.com"}
+
+	s.userRepo.On("ExistByUsername", mock.Anything, mock.AnythingOfType("string")).Return(false, nil)
+	s.userRepo.On("Create", mock.Anything, mock.AnythingOfType("user.RegistrationParams")).
+		Return(models.User{ID: userID}, nil)
+	s.emailRepo.On("Create", mock.Anything, email).Return(email, nil)
+	s.emailRepo.On("Verify", mock.Anything, userID, email.Email).Return(email, nil)
+	s.identityRepo.On("Create", mock.Anything, mock.AnythingOfType("models.UserIdentity")).
+		Return(models.UserIdentity{}, errx.ErrorIdentityAlreadyLinked.Raise(errors.New("duplicate key")))
+
+	_, err := s.svc.RegistrationByOAuth(context.Background(), googleIdentity("dave"))
+
+	assert.ErrorIs(s.T(), err, errx.ErrorIdentityAlreadyLinked)
+	s.messenger.AssertNotCalled(s.T(), "WriteUserCreated", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ─── Identities ───────────────────────────────────────────────────────────
+
+func googleIdentity(name string) models.ExternalIdentity {
+	return models.ExternalIdentity{
+		Provider: models.IdentityProviderGoogle,
+		Subject:  "google-" + name,
+		Email:    name + "@example.com",
+	}
+}
+
+func (s *UserServiceSuite) TestLinkIdentity_HappyPath() {
+	userID := uuid.New()
+	identity := googleIdentity("erin")
+	linked := models.UserIdentity{ID: uuid.New(), UserID: userID, Provider: identity.Provider, Subject: identity.Subject}
+
+	s.userRepo.On("GetByID", mock.Anything, userID).Return(models.User{ID: userID}, nil)
+	s.identityRepo.On("Create", mock.Anything, models.UserIdentity{
+		UserID:   userID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}).Return(linked, nil)
+
+	got, err := s.svc.LinkIdentity(context.Background(), userID, identity)
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), linked, got)
+}
+
+func (s *UserServiceSuite) TestLinkIdentity_UserDeleted() {
+	userID := uuid.New()
+
+	s.userRepo.On("GetByID", mock.Anything, userID).
+		Return(models.User{}, errx.ErrorUserDeleted.Raise(errors.New("deleted")))
+
+	_, err := s.svc.LinkIdentity(context.Background(), userID, googleIdentity("frank"))
+
+	assert.ErrorIs(s.T(), err, errx.ErrorUserDeleted)
+	s.identityRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *UserServiceSuite) TestUnlinkMyIdentity_HappyPath() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	identityID := uuid.New()
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.identityRepo.On("CountCredentials", mock.Anything, actor.ID).Return(2, nil)
+	s.identityRepo.On("Delete", mock.Anything, actor.ID, identityID).Return(nil)
+
+	err := s.svc.UnlinkMyIdentity(context.Background(), actor, identityID)
+
+	require.NoError(s.T(), err)
+}
+
+func (s *UserServiceSuite) TestUnlinkMyIdentity_LastCredential() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	identityID := uuid.New()
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.identityRepo.On("CountCredentials", mock.Anything, actor.ID).Return(1, nil)
+	s.identityRepo.On("Delete", mock.Anything, actor.ID, identityID).Return(nil)
+
+	err := s.svc.UnlinkMyIdentity(context.Background(), actor, identityID)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorLastCredential)
+}
+
+func (s *UserServiceSuite) TestUnlinkMyIdentity_NotFound() {
+	actor := models.UserActor{ID: uuid.New(), SessionID: uuid.New()}
+	identityID := uuid.New()
+
+	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
+	s.identityRepo.On("CountCredentials", mock.Anything, actor.ID).Return(1, nil)
+	s.identityRepo.On("Delete", mock.Anything, actor.ID, identityID).
+		Return(errx.ErrorIdentityNotFound.Raise(errors.New("no rows")))
+
+	err := s.svc.UnlinkMyIdentity(context.Background(), actor, identityID)
+
+	assert.ErrorIs(s.T(), err, errx.ErrorIdentityNotFound)
+}
+
 // ─── DeleteMyUser ─────────────────────────────────────────────────────────
 
 func (s *UserServiceSuite) TestDeleteMyUser_ValidateSessionError() {
@@ -499,7 +1237,7 @@ func (s *UserServiceSuite) TestDeleteMyUser_SessionRepoError() {
 	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
 	s.userRepo.On("Delete", mock.Anything, actor.ID).Return(user, nil)
 	s.emailRepo.On("GetByID", mock.Anything, actor.ID, mock.Anything).Return(email, nil)
-	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]uuid.UUID(nil), repoErr)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]models.EndedSession(nil), repoErr)
 
 	err := s.svc.DeleteMyUser(context.Background(), actor)
 
@@ -516,7 +1254,7 @@ func (s *UserServiceSuite) TestDeleteMyUser_MessengerError() {
 	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
 	s.userRepo.On("Delete", mock.Anything, actor.ID).Return(user, nil)
 	s.emailRepo.On("GetByID", mock.Anything, actor.ID, mock.Anything).Return(email, nil)
-	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]uuid.UUID{}, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]models.EndedSession{}, nil)
 	s.messenger.On("WriteUserDeleted", mock.Anything, user, email).Return(msgErr)
 
 	err := s.svc.DeleteMyUser(context.Background(), actor)
@@ -534,14 +1272,21 @@ func (s *UserServiceSuite) TestDeleteMyUser_HappyPath() {
 	s.auth.On("ValidateSession", mock.Anything, actor).Return(models.User{}, models.Session{}, nil)
 	s.userRepo.On("Delete", mock.Anything, actor.ID).Return(user, nil)
 	s.emailRepo.On("GetByID", mock.Anything, actor.ID, mock.Anything).Return(email, nil)
-	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]uuid.UUID{sessionID}, nil)
+	s.sessionRepo.On("DeleteManyForUser", mock.Anything, actor.ID).Return([]models.EndedSession{{ID: sessionID}}, nil)
 	s.messenger.On("WriteUserDeleted", mock.Anything, user, email).Return(nil)
 	s.userCache.On("Delete", mock.Anything, actor.ID).Return(nil).Maybe()
 	s.emailCache.On("DeleteByID", mock.Anything, actor.ID).Return(nil).Maybe()
 	s.passwordCache.On("Delete", mock.Anything, actor.ID).Return(nil).Maybe()
 	s.sessionsCache.On("Delete", mock.Anything, sessionID).Return(nil).Maybe()
 
+	revoked := make(chan struct{})
+	s.revokedSessions = newMockRevokedSessions(s.T())
+	s.revokedSessions.On("Revoke", mock.Anything, []models.EndedSession{{ID: sessionID}}).
+		Run(func(mock.Arguments) { close(revoked) }).Return(nil).Once()
+	s.svc.revokedSessions = s.revokedSessions
+
 	err := s.svc.DeleteMyUser(context.Background(), actor)
 
 	require.NoError(s.T(), err)
+	s.waitFor(revoked, "no sessions revoked")
 }
@@ -14,9 +14,10 @@ func (m *Metrics) RecordEmailLogin(ctx context.Context, err *error) {
 	))
 }
 
-func (m *Metrics) RecordGoogleLogin(ctx context.Context, err *error) {
+func (m *Metrics) RecordOAuthLogin(ctx context.Context, provider string, err *error) {
 	m.logins.Add(ctx, 1, metric.WithAttributes(
-		attribute.String("method", "google"),
+		attribute.String("method", "oauth"),
+		attribute.String("provider", provider),
 		attribute.String("status", statusFromErr(err)),
 	))
 }
@@ -28,6 +29,20 @@ func (m *Metrics) RecordQRLogin(ctx context.Context, err *error) {
 	))
 }
 
+func (m *Metrics) RecordMFALogin(ctx context.Context, err *error) {
+	m.logins.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("method", "mfa"),
+		attribute.String("status", statusFromErr(err)),
+	))
+}
+
+func (m *Metrics) RecordPasskeyLogin(ctx context.Context, err *error) {
+	m.logins.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("method", "passkey"),
+		attribute.String("status", statusFromErr(err)),
+	))
+}
+
 func (m *Metrics) RecordRegistration(ctx context.Context, err *error) {
 	m.registrations.Add(ctx, 1, metric.WithAttributes(
 		attribute.String("status", statusFromErr(err)),
@@ -46,3 +61,31 @@ func (m *Metrics) RecordTokenRefresh(ctx context.Context, err *error) {
 		attribute.String("status", statusFromErr(err)),
 	))
 }
+
+func (m *Metrics) RecordSessionTokenReuse(ctx context.Context, scope string) {
+	m.tokenReuses.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("scope", scope),
+	))
+}
+
+func (m *Metrics) RecordLoginLockout(ctx context.Context, key string) {
+	m.loginLockouts.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("key", key),
+	))
+}
+
+func (m *Metrics) RecordSessionsEndedByPolicy(ctx context.Context, reason string, count int) {
+	m.policyEnds.Add(ctx, int64(count), metric.WithAttributes(
+		attribute.String("reason", reason),
+	))
+}
+
+func (m *Metrics) RecordSessionsPurged(ctx context.Context, kind string, count int64) {
+	m.sessionPurges.Add(ctx, count, metric.WithAttributes(
+		attribute.String("kind", kind),
+	))
+}
+
+func (m *Metrics) RecordSessionRevocationFailure(ctx context.Context, count int) {
+	m.revokeFails.Add(ctx, int64(count))
+}
@@ -14,6 +14,11 @@ type Metrics struct {
 	registrations  metric.Int64Counter
 	sessionDeletes metric.Int64Counter
 	tokenRefreshes metric.Int64Counter
+	tokenReuses    metric.Int64Counter
+	loginLockouts  metric.Int64Counter
+	policyEnds     metric.Int64Counter
+	sessionPurges  metric.Int64Counter
+	revokeFails    metric.Int64Counter
 	cacheOps       metric.Int64Counter
 }
 
@@ -23,7 +28,7 @@ func New() (*Metrics, error) {
 	meter := otel.GetMeterProvider().Meter("auth-svc")
 
 	logins, err := meter.Int64Counter("auth.logins_total",
-		metric.WithDescription("Login attempts by method (email|google|qr) and status (ok|fail)"),
+		metric.WithDescription("Login attempts by method (email|oauth|qr|mfa|passkey), provider for oauth, and status (ok|fail)"),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("create logins counter: %w", err)
@@ -37,7 +42,7 @@ func New() (*Metrics, error) {
 	}
 
 	sessionDeletes, err := meter.Int64Counter("auth.sessions_deleted_total",
-		metric.WithDescription("Sessions deleted by scope (single|all)"),
+		metric.WithDescription("Sessions deleted by scope (single|all|selected)"),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("create session_deletes counter: %w", err)
@@ -50,6 +55,41 @@ func New() (*Metrics, error) {
 		return nil, fmt.Errorf("create token_refreshes counter: %w", err)
 	}
 
+	tokenReuses, err := meter.Int64Counter("auth.session_token_reuses_total",
+		metric.WithDescription("Replayed refresh tokens by revocation scope (single|all)"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create token_reuses counter: %w", err)
+	}
+
+	loginLockouts, err := meter.Int64Counter("auth.login_lockouts_total",
+		metric.WithDescription("Login lockouts by limiter key (email|ip)"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create login_lockouts counter: %w", err)
+	}
+
+	policyEnds, err := meter.Int64Counter("auth.sessions_ended_by_policy_total",
+		metric.WithDescription("Sessions ended by a session policy by reason (idle_timeout|max_age|session_limit)"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create policy_ends counter: %w", err)
+	}
+
+	sessionPurges, err := meter.Int64Counter("auth.sessions_purged_total",
+		metric.WithDescription("Sessions hard-deleted by the janitor by kind (deleted|idle)"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create session_purges counter: %w", err)
+	}
+
+	revokeFails, err := meter.Int64Counter("auth.session_revocation_failures_total",
+		metric.WithDescription("Sessions that could not be written to the shared revocation list"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create revoke_fails counter: %w", err)
+	}
+
 	cacheOps, err := meter.Int64Counter("auth.cache_operations_total",
 		metric.WithDescription("Cache operations by entity (user|session|email|password) and result (hit|miss)"),
 	)
@@ -62,6 +102,11 @@ func New() (*Metrics, error) {
 		registrations:  registrations,
 		sessionDeletes: sessionDeletes,
 		tokenRefreshes: tokenRefreshes,
+		tokenReuses:    tokenReuses,
+		loginLockouts:  loginLockouts,
+		policyEnds:     policyEnds,
+		sessionPurges:  sessionPurges,
+		revokeFails:    revokeFails,
 		cacheOps:       cacheOps,
 	}, nil
 }
@@ -0,0 +1,115 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/segmentio/kafka-go"
+)
+
+// Publisher delivers one outbox event to the message broker. The relay
+// treats a returned error as a failed delivery and retries the event later,
+// so delivery is at least once.
+type Publisher interface {
+	Publish(ctx context.Context, event models.OutboxEvent) error
+}
+
+// Headers every published message carries next to its key and payload.
+const (
+	HeaderEventID      = "event_id"
+	HeaderEventType    = "event_type"
+	HeaderEventVersion = "event_version"
+	HeaderProducer     = "producer"
+)
+
+// KafkaPublisher writes each event to its topic, keyed by the event key so
+// the events about one entity land in one partition.
+type KafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaPublisher(brokers []string) *KafkaPublisher {
+	return &KafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			// The relay hands over one event at a time and waits for it, so
+			// there is nothing to batch.
+			BatchSize:    1,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, event models.OutboxEvent) error {
+	err := p.writer.WriteMessages(ctx, kafka.Message{
+		Topic: event.Topic,
+		Key:   []byte(event.Key),
+		Value: event.Payload,
+		Headers: []kafka.Header{
+			{Key: HeaderEventID, Value: []byte(event.ID.String())},
+			{Key: HeaderEventType, Value: []byte(event.Type)},
+			{Key: HeaderEventVersion, Value: []byte(strconv.Itoa(int(event.Version)))},
+			{Key: HeaderProducer, Value: []byte(event.Producer)},
+		},
+		Time: event.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("write kafka message: %w", err)
+	}
+
+	return nil
+}
+
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
+
+// MemoryPublisher keeps published events in memory. It stands in for Kafka
+// in tests and local runs.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []models.OutboxEvent
+	fail   func(models.OutboxEvent) error
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// FailWith makes Publish return fn's error for the events it fails.
+// A nil error lets the event through.
+func (p *MemoryPublisher) FailWith(fn func(models.OutboxEvent) error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.fail = fn
+}
+
+func (p *MemoryPublisher) Publish(_ context.Context, event models.OutboxEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.fail != nil {
+		if err := p.fail(event); err != nil {
+			return err
+		}
+	}
+	p.events = append(p.events, event)
+
+	return nil
+}
+
+// Published returns the events published so far, in order.
+func (p *MemoryPublisher) Published() []models.OutboxEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Clone(p.events)
+}
@@ -0,0 +1,196 @@
+// Package outbox publishes the transactional outbox to Kafka from within the
+// service, for deployments that do not run Debezium.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
+)
+
+// pruneBatch caps the rows one DELETE removes, so catching up on a large
+// backlog does not hold a long lock on the table.
+const pruneBatch = 1000
+
+type Config struct {
+	// BatchSize is how many events one poll claims at most.
+	BatchSize uint
+
+	// PollInterval is how long the relay waits after a poll that found
+	// nothing to publish.
+	PollInterval time.Duration
+
+	// BackoffBase is the delay before the first retry of a failed event;
+	// each further failure doubles it, up to BackoffMax.
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+
+	// MaxAttempts is how many failed deliveries an event gets before it is
+	// parked: left undelivered for an operator, so that it stops holding
+	// back the events after it.
+	MaxAttempts int32
+
+	// ClaimTimeout is how long claimed events are reserved for the relay
+	// that claimed them. It must outlast publishing a batch; the events of
+	// a relay that died mid-batch are claimed again once it runs out.
+	ClaimTimeout time.Duration
+
+	// Retention is how long published events stay in the table before
+	// being pruned.
+	Retention time.Duration
+
+	// PruneInterval is how often published events are pruned.
+	PruneInterval time.Duration
+}
+
+type Relay struct {
+	config Config
+
+	events    eventRepo
+	publisher Publisher
+
+	log *log.Logger
+}
+
+type RelayDeps struct {
+	Config Config
+
+	Events    eventRepo
+	Publisher Publisher
+
+	Log *log.Logger
+}
+
+func NewRelay(deps RelayDeps) *Relay {
+	return &Relay{
+		config:    deps.Config,
+		events:    deps.Events,
+		publisher: deps.Publisher,
+		log:       deps.Log,
+	}
+}
+
+// RelayBatch claims a batch of pending events and publishes them one by one.
+// Published events are marked as such; a failed one is scheduled for a retry
+// with backoff, or parked after Config.MaxAttempts, and does not stop the
+// rest of the batch. No transaction is held open while publishing: the
+// claim and the marks are short statements of their own. It reports how
+// many events were claimed.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	events, err := r.events.ClaimPending(ctx, r.config.BatchSize, time.Now().Add(r.config.ClaimTimeout))
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	published := make([]uuid.UUID, 0, len(events))
+	for _, event := range events {
+		if err = r.publisher.Publish(ctx, event); err != nil {
+			if err = r.markFailed(ctx, event, err); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		published = append(published, event.ID)
+	}
+
+	if len(published) > 0 {
+		if err = r.events.MarkPublished(ctx, published); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return len(events), errors.Join(errs...)
+}
+
+// markFailed schedules the retry of an event that failed to publish, or
+// parks it once it has used up Config.MaxAttempts.
+func (r *Relay) markFailed(ctx context.Context, event models.OutboxEvent, cause error) error {
+	attempts := event.Attempts + 1
+
+	if attempts >= r.config.MaxAttempts {
+		r.log.WithError(cause).Error(
+			"parking outbox event after too many failed attempts",
+			"event_id", event.ID, "type", event.Type, "key", event.Key, "attempts", attempts,
+		)
+		return r.events.MarkParked(ctx, event.ID, cause.Error())
+	}
+
+	r.log.WithError(cause).Warn(
+		"failed to publish outbox event",
+		"event_id", event.ID, "type", event.Type, "attempts", attempts,
+	)
+	return r.events.MarkFailed(ctx, event.ID, time.Now().Add(r.backoff(attempts)), cause.Error())
+}
+
+// backoff is the delay before the retry that follows the given number of
+// failed attempts.
+func (r *Relay) backoff(attempts int32) time.Duration {
+	delay := r.config.BackoffBase
+	for i := int32(1); i < attempts && delay < r.config.BackoffMax; i++ {
+		delay *= 2
+	}
+
+	return min(delay, r.config.BackoffMax)
+}
+
+// Prune deletes the events published more than Config.Retention ago and
+// reports how many it deleted.
+func (r *Relay) Prune(ctx context.Context) (int64, error) {
+	before := time.Now().Add(-r.config.Retention)
+
+	var total int64
+	for {
+		n, err := r.events.DeletePublishedBefore(ctx, before, pruneBatch)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < pruneBatch {
+			return total, nil
+		}
+	}
+}
+
+// Run relays events until ctx is done. A poll that claimed events is
+// followed by the next one right away, so a backlog drains without waiting
+// out Config.PollInterval between batches.
+func (r *Relay) Run(ctx context.Context) {
+	poll := time.NewTimer(0)
+	defer poll.Stop()
+
+	prune := time.NewTicker(r.config.PruneInterval)
+	defer prune.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-prune.C:
+			n, err := r.Prune(ctx)
+			switch {
+			case err != nil && ctx.Err() == nil:
+				r.log.WithError(err).Error("failed to prune outbox events")
+			case n > 0:
+				r.log.Info("pruned outbox events", "count", n)
+			}
+
+		case <-poll.C:
+			n, err := r.RelayBatch(ctx)
+			if err != nil && ctx.Err() == nil {
+				r.log.WithError(err).Error("failed to relay outbox events")
+			}
+
+			next := r.config.PollInterval
+			if err == nil && n > 0 {
+				next = 0
+			}
+			poll.Reset(next)
+		}
+	}
+}
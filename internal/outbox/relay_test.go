@@ -0,0 +1,255 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelaySuite struct {
+	suite.Suite
+
+	events    *mockEventRepo
+	publisher *MemoryPublisher
+
+	relay *Relay
+}
+
+func (s *RelaySuite) SetupTest() {
+	s.events = newMockEventRepo(s.T())
+	s.publisher = NewMemoryPublisher()
+
+	s.relay = NewRelay(RelayDeps{
+		Config: Config{
+			BatchSize:     10,
+			PollInterval:  time.Second,
+			BackoffBase:   time.Second,
+			BackoffMax:    time.Minute,
+			MaxAttempts:   5,
+			ClaimTimeout:  time.Minute,
+			Retention:     24 * time.Hour,
+			PruneInterval: time.Hour,
+		},
+		Events:    s.events,
+		Publisher: s.publisher,
+		Log:       log.New("error", "text", "test"),
+	})
+}
+
+func TestRelay(t *testing.T) {
+	suite.Run(t, new(RelaySuite))
+}
+
+func newEvent(key string) models.OutboxEvent {
+	return models.OutboxEvent{
+		ID:       uuid.New(),
+		Topic:    "users.v1",
+		Key:      key,
+		Type:     "session_created",
+		Version:  1,
+		Producer: "auth-svc",
+		Payload:  []byte(`{}`),
+	}
+}
+
+// ─── RelayBatch ─────────────────────────────────────────────────────────────
+
+func (s *RelaySuite) TestRelayBatch_PublishesAndMarks() {
+	first, second := newEvent("a"), newEvent("b")
+
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Return([]models.OutboxEvent{first, second}, nil)
+	s.events.On("MarkPublished", mock.Anything, []uuid.UUID{first.ID, second.ID}).Return(nil)
+
+	n, err := s.relay.RelayBatch(context.Background())
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, n)
+	assert.Equal(s.T(), []models.OutboxEvent{first, second}, s.publisher.Published())
+}
+
+func (s *RelaySuite) TestRelayBatch_LeasesClaimedEvents() {
+	before := time.Now()
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.MatchedBy(func(leaseUntil time.Time) bool {
+		return !leaseUntil.Before(before.Add(time.Minute)) && !leaseUntil.After(time.Now().Add(time.Minute))
+	})).Return([]models.OutboxEvent(nil), nil)
+
+	_, err := s.relay.RelayBatch(context.Background())
+
+	require.NoError(s.T(), err)
+}
+
+func (s *RelaySuite) TestRelayBatch_NothingPending() {
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Return([]models.OutboxEvent(nil), nil)
+
+	n, err := s.relay.RelayBatch(context.Background())
+
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), n)
+	s.events.AssertNotCalled(s.T(), "MarkPublished", mock.Anything, mock.Anything)
+}
+
+func (s *RelaySuite) TestRelayBatch_FailedEventIsRetriedLater() {
+	failing, ok := newEvent("a"), newEvent("b")
+	failing.Attempts = 2
+	brokerErr := errors.New("broker unavailable")
+
+	s.publisher.FailWith(func(e models.OutboxEvent) error {
+		if e.ID == failing.ID {
+			return brokerErr
+		}
+		return nil
+	})
+
+	before := time.Now()
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Return([]models.OutboxEvent{failing, ok}, nil)
+	s.events.On("MarkFailed", mock.Anything, failing.ID, mock.MatchedBy(func(retryAt time.Time) bool {
+		// Third failure: base doubled twice.
+		return !retryAt.Before(before.Add(4*time.Second)) && retryAt.Before(time.Now().Add(5*time.Second))
+	}), brokerErr.Error()).Return(nil)
+	s.events.On("MarkPublished", mock.Anything, []uuid.UUID{ok.ID}).Return(nil)
+
+	n, err := s.relay.RelayBatch(context.Background())
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, n)
+	assert.Equal(s.T(), []models.OutboxEvent{ok}, s.publisher.Published())
+}
+
+func (s *RelaySuite) TestRelayBatch_ParksEventAfterMaxAttempts() {
+	poison, ok := newEvent("a"), newEvent("b")
+	poison.Attempts = 4
+	brokerErr := errors.New("message too large")
+
+	s.publisher.FailWith(func(e models.OutboxEvent) error {
+		if e.ID == poison.ID {
+			return brokerErr
+		}
+		return nil
+	})
+
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Return([]models.OutboxEvent{poison, ok}, nil)
+	s.events.On("MarkParked", mock.Anything, poison.ID, brokerErr.Error()).Return(nil)
+	s.events.On("MarkPublished", mock.Anything, []uuid.UUID{ok.ID}).Return(nil)
+
+	_, err := s.relay.RelayBatch(context.Background())
+
+	require.NoError(s.T(), err)
+	s.events.AssertNotCalled(s.T(), "MarkFailed", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *RelaySuite) TestRelayBatch_MarkFailedErrorStillMarksPublished() {
+	failing, ok := newEvent("a"), newEvent("b")
+	repoErr := errors.New("db error")
+
+	s.publisher.FailWith(func(e models.OutboxEvent) error {
+		if e.ID == failing.ID {
+			return errors.New("broker unavailable")
+		}
+		return nil
+	})
+
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Return([]models.OutboxEvent{failing, ok}, nil)
+	s.events.On("MarkFailed", mock.Anything, failing.ID, mock.Anything, mock.Anything).Return(repoErr)
+	s.events.On("MarkPublished", mock.Anything, []uuid.UUID{ok.ID}).Return(nil)
+
+	n, err := s.relay.RelayBatch(context.Background())
+
+	assert.ErrorIs(s.T(), err, repoErr)
+	assert.Equal(s.T(), 2, n)
+}
+
+func (s *RelaySuite) TestRelayBatch_AllFailed() {
+	event := newEvent("a")
+	s.publisher.FailWith(func(models.OutboxEvent) error { return errors.New("broker unavailable") })
+
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Return([]models.OutboxEvent{event}, nil)
+	s.events.On("MarkFailed", mock.Anything, event.ID, mock.Anything, mock.Anything).Return(nil)
+
+	_, err := s.relay.RelayBatch(context.Background())
+
+	require.NoError(s.T(), err)
+	s.events.AssertNotCalled(s.T(), "MarkPublished", mock.Anything, mock.Anything)
+}
+
+func (s *RelaySuite) TestRelayBatch_ClaimError() {
+	repoErr := errors.New("db error")
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Return([]models.OutboxEvent(nil), repoErr)
+
+	_, err := s.relay.RelayBatch(context.Background())
+
+	assert.ErrorIs(s.T(), err, repoErr)
+	assert.Empty(s.T(), s.publisher.Published())
+}
+
+func (s *RelaySuite) TestRelayBatch_MarkPublishedError() {
+	event := newEvent("a")
+	repoErr := errors.New("db error")
+
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Return([]models.OutboxEvent{event}, nil)
+	s.events.On("MarkPublished", mock.Anything, []uuid.UUID{event.ID}).Return(repoErr)
+
+	_, err := s.relay.RelayBatch(context.Background())
+
+	assert.ErrorIs(s.T(), err, repoErr)
+}
+
+// ─── backoff ────────────────────────────────────────────────────────────────
+
+func (s *RelaySuite) TestBackoff_DoublesUpToMax() {
+	assert.Equal(s.T(), time.Second, s.relay.backoff(1))
+	assert.Equal(s.T(), 2*time.Second, s.relay.backoff(2))
+	assert.Equal(s.T(), 32*time.Second, s.relay.backoff(6))
+	assert.Equal(s.T(), time.Minute, s.relay.backoff(7))
+	assert.Equal(s.T(), time.Minute, s.relay.backoff(1000))
+}
+
+// ─── Prune ──────────────────────────────────────────────────────────────────
+
+func (s *RelaySuite) TestPrune_DeletesInBatches() {
+	s.events.On("DeletePublishedBefore", mock.Anything, mock.Anything, uint(pruneBatch)).
+		Return(int64(pruneBatch), nil).Once()
+	s.events.On("DeletePublishedBefore", mock.Anything, mock.Anything, uint(pruneBatch)).
+		Return(int64(3), nil).Once()
+
+	n, err := s.relay.Prune(context.Background())
+
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(pruneBatch+3), n)
+}
+
+// ─── Run ────────────────────────────────────────────────────────────────────
+
+func (s *RelaySuite) TestRun_DrainsBacklogWithoutWaiting() {
+	first, second := newEvent("a"), newEvent("a")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Return([]models.OutboxEvent{first}, nil).Once()
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Return([]models.OutboxEvent{second}, nil).Once()
+	s.events.On("ClaimPending", mock.Anything, uint(10), mock.Anything).Run(func(mock.Arguments) { cancel() }).
+		Return([]models.OutboxEvent(nil), nil)
+	s.events.On("MarkPublished", mock.Anything, mock.Anything).Return(nil)
+
+	done := make(chan struct{})
+	go func() {
+		s.relay.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(500 * time.Millisecond):
+		s.T().Fatal("relay waited for the poll interval between non-empty batches")
+	}
+
+	assert.Equal(s.T(), []models.OutboxEvent{first, second}, s.publisher.Published())
+}
@@ -0,0 +1,31 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+//go:generate mockery --name=eventRepo --inpackage
+type eventRepo interface {
+	// ClaimPending leases up to limit events due for delivery until
+	// leaseUntil, at most one per topic and key, oldest first. A leased
+	// event is not claimed again before the lease runs out.
+	ClaimPending(ctx context.Context, limit uint, leaseUntil time.Time) ([]models.OutboxEvent, error)
+
+	MarkPublished(ctx context.Context, ids []uuid.UUID) error
+
+	// MarkFailed counts a failed delivery and holds the event back until
+	// retryAt.
+	MarkFailed(ctx context.Context, id uuid.UUID, retryAt time.Time, reason string) error
+
+	// MarkParked counts a failed delivery and gives up on the event, so it
+	// no longer holds back its topic and key.
+	MarkParked(ctx context.Context, id uuid.UUID, reason string) error
+
+	// DeletePublishedBefore removes up to limit events published before the
+	// given time and reports how many it removed.
+	DeletePublishedBefore(ctx context.Context, before time.Time, limit uint) (int64, error)
+}
@@ -0,0 +1,58 @@
+package chache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+// EmailChangeCache keeps pending email changes under the hash of the token
+// sent to the new address. Entries expire on their own after ttl.
+type EmailChangeCache struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewEmailChangeCache(client *redis.Client, ttl time.Duration) *EmailChangeCache {
+	return &EmailChangeCache{client: client, ttl: ttl}
+}
+
+func emailChangeKey(tokenHash string) string {
+	return fmt.Sprintf("user:email:change:%s", tokenHash)
+}
+
+func (c *EmailChangeCache) Set(ctx context.Context, tokenHash string, v models.EmailChange) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal email change: %w", err)
+	}
+
+	return c.client.Set(ctx, emailChangeKey(tokenHash), data, c.ttl).Err()
+}
+
+// Consume returns the change behind tokenHash and removes it in the same
+// round trip, so a token can be redeemed only once.
+func (c *EmailChangeCache) Consume(ctx context.Context, tokenHash string) (models.EmailChange, error) {
+	val, err := c.client.GetDel(ctx, emailChangeKey(tokenHash)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return models.EmailChange{}, errx.ErrorEmailChangeTokenInvalid.Raise(
+			fmt.Errorf("email change token not found or expired"),
+		)
+	case err != nil:
+		return models.EmailChange{}, err
+	}
+
+	var v models.EmailChange
+	if err = json.Unmarshal([]byte(val), &v); err != nil {
+		return models.EmailChange{}, fmt.Errorf("unmarshal email change: %w", err)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,59 @@
+package chache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+// EmailVerificationCache keeps pending email verification tokens. Keys are
+// token hashes, never the tokens themselves, and every entry expires on its
+// own after ttl.
+type EmailVerificationCache struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewEmailVerificationCache(client *redis.Client, ttl time.Duration) *EmailVerificationCache {
+	return &EmailVerificationCache{client: client, ttl: ttl}
+}
+
+func emailVerificationKey(tokenHash string) string {
+	return fmt.Sprintf("user:email:verify:%s", tokenHash)
+}
+
+func (c *EmailVerificationCache) Set(ctx context.Context, tokenHash string, v models.EmailVerification) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal email verification: %w", err)
+	}
+
+	return c.client.Set(ctx, emailVerificationKey(tokenHash), data, c.ttl).Err()
+}
+
+// Consume returns the verification behind tokenHash and removes it in the
+// same round trip, so a token can be redeemed only once.
+func (c *EmailVerificationCache) Consume(ctx context.Context, tokenHash string) (models.EmailVerification, error) {
+	val, err := c.client.GetDel(ctx, emailVerificationKey(tokenHash)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return models.EmailVerification{}, errx.ErrorEmailVerificationTokenInvalid.Raise(
+			fmt.Errorf("email verification token not found or expired"),
+		)
+	case err != nil:
+		return models.EmailVerification{}, err
+	}
+
+	var v models.EmailVerification
+	if err = json.Unmarshal([]byte(val), &v); err != nil {
+		return models.EmailVerification{}, fmt.Errorf("unmarshal email verification: %w", err)
+	}
+
+	return v, nil
+}
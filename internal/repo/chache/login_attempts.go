@@ -0,0 +1,92 @@
+package chache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// LoginAttemptCache counts failed logins per subject (a hashed email or a
+// client IP) in a sliding window and keeps lockouts. Failures live in a sorted
+// set scored by time, so the window slides instead of resetting on a fixed
+// boundary.
+type LoginAttemptCache struct {
+	client *redis.Client
+	log    *log.Logger
+}
+
+func NewLoginAttemptCache(client *redis.Client, log *log.Logger) *LoginAttemptCache {
+	return &LoginAttemptCache{client: client, log: log}
+}
+
+func loginFailuresKey(subject string) string {
+	return fmt.Sprintf("login:failures:%s", subject)
+}
+
+func loginLockKey(subject string) string {
+	return fmt.Sprintf("login:lock:%s", subject)
+}
+
+// LockedFor returns how long subject stays locked out, zero if it isn't.
+func (c *LoginAttemptCache) LockedFor(ctx context.Context, subject string) (time.Duration, error) {
+	ttl, err := c.client.PTTL(ctx, loginLockKey(subject)).Result()
+	if err != nil {
+		c.log.WithError(err).Error("login lock lookup failed", "subject", subject)
+		return 0, err
+	}
+
+	// PTTL reports -2 for a missing key; a lock is never stored without one.
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+// AddFailure records a failed attempt and returns how many failures subject
+// has within the last window, this one included.
+func (c *LoginAttemptCache) AddFailure(ctx context.Context, subject string, window time.Duration) (int, error) {
+	key := loginFailuresKey(subject)
+	now := time.Now()
+
+	var card *redis.IntCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, redis.Z{
+			Score:  float64(now.UnixMilli()),
+			Member: strconv.FormatInt(now.UnixNano(), 10),
+		})
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-window).UnixMilli(), 10))
+		card = pipe.ZCard(ctx, key)
+		pipe.PExpire(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		c.log.WithError(err).Error("login failure record failed", "subject", subject)
+		return 0, err
+	}
+
+	return int(card.Val()), nil
+}
+
+func (c *LoginAttemptCache) Lock(ctx context.Context, subject string, d time.Duration) error {
+	if err := c.client.Set(ctx, loginLockKey(subject), 1, d).Err(); err != nil {
+		c.log.WithError(err).Error("login lock set failed", "subject", subject)
+		return err
+	}
+	return nil
+}
+
+// Reset forgets the failures of subject. An active lockout is left to expire.
+func (c *LoginAttemptCache) Reset(ctx context.Context, subject string) error {
+	err := c.client.Del(ctx, loginFailuresKey(subject)).Err()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		c.log.WithError(err).Error("login failures reset failed", "subject", subject)
+		return err
+	}
+	return nil
+}
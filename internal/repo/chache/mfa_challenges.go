@@ -0,0 +1,105 @@
+package chache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/redis/go-redis/v9"
+)
+
+// MFAChallengeCache keeps pending MFA challenges: logins that passed the
+// first factor and wait for a code. Keys are challenge token hashes; each
+// entry is a hash holding the user, the number of wrong codes so far and the
+// login limiter key to forgive once the code is right.
+type MFAChallengeCache struct {
+	client *redis.Client
+}
+
+func NewMFAChallengeCache(client *redis.Client) *MFAChallengeCache {
+	return &MFAChallengeCache{client: client}
+}
+
+func mfaChallengeKey(tokenHash string) string {
+	return fmt.Sprintf("mfa:challenge:%s", tokenHash)
+}
+
+// incrIfExists keeps a failure counted against an expired challenge from
+// recreating the key without a TTL.
+var incrIfExists = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+end
+return -1
+`)
+
+func (c *MFAChallengeCache) Create(
+	ctx context.Context,
+	tokenHash string,
+	userID uuid.UUID,
+	loginSubject string,
+	ttl time.Duration,
+) error {
+	key := mfaChallengeKey(tokenHash)
+
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "user_id", userID.String(), "login_subject", loginSubject, "failures", 0)
+		pipe.PExpire(ctx, key, ttl)
+		return nil
+	})
+	return err
+}
+
+// Get returns the user of the challenge and the login limiter key it was
+// created with, empty if there is none.
+func (c *MFAChallengeCache) Get(ctx context.Context, tokenHash string) (uuid.UUID, string, error) {
+	vals, err := c.client.HMGet(ctx, mfaChallengeKey(tokenHash), "user_id", "login_subject").Result()
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	raw, ok := vals[0].(string)
+	if !ok {
+		return uuid.Nil, "", errx.ErrorMFAChallengeInvalid.Raise(
+			fmt.Errorf("mfa challenge not found or expired"),
+		)
+	}
+
+	userID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("parse mfa challenge user id: %w", err)
+	}
+
+	loginSubject, _ := vals[1].(string)
+
+	return userID, loginSubject, nil
+}
+
+// AddFailure counts a wrong code and returns the failures so far.
+func (c *MFAChallengeCache) AddFailure(ctx context.Context, tokenHash string) (int, error) {
+	n, err := incrIfExists.Run(ctx, c.client, []string{mfaChallengeKey(tokenHash)}, "failures").Int()
+	if err != nil {
+		return 0, err
+	}
+
+	if n < 0 {
+		return 0, errx.ErrorMFAChallengeInvalid.Raise(
+			fmt.Errorf("mfa challenge not found or expired"),
+		)
+	}
+
+	return n, nil
+}
+
+// Delete removes the challenge and reports whether it was still there, so of
+// two concurrent requests only one gets to finish the login.
+func (c *MFAChallengeCache) Delete(ctx context.Context, tokenHash string) (bool, error) {
+	n, err := c.client.Del(ctx, mfaChallengeKey(tokenHash)).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
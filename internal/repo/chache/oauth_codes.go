@@ -0,0 +1,62 @@
+package chache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+// OAuthCodeCache keeps issued OpenID Connect authorization codes until the
+// client exchanges them. Keys are code hashes.
+type OAuthCodeCache struct {
+	client *redis.Client
+}
+
+func NewOAuthCodeCache(client *redis.Client) *OAuthCodeCache {
+	return &OAuthCodeCache{client: client}
+}
+
+func oauthCodeKey(codeHash string) string {
+	return fmt.Sprintf("oauth:code:%s", codeHash)
+}
+
+func (c *OAuthCodeCache) Set(
+	ctx context.Context,
+	codeHash string,
+	v models.OAuthAuthorization,
+	ttl time.Duration,
+) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal oauth authorization: %w", err)
+	}
+
+	return c.client.Set(ctx, oauthCodeKey(codeHash), data, ttl).Err()
+}
+
+// Consume returns the authorization and removes it in the same round trip,
+// so a code can be exchanged only once.
+func (c *OAuthCodeCache) Consume(ctx context.Context, codeHash string) (models.OAuthAuthorization, error) {
+	val, err := c.client.GetDel(ctx, oauthCodeKey(codeHash)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return models.OAuthAuthorization{}, errx.ErrorOAuthGrantInvalid.Raise(
+			fmt.Errorf("authorization code not found or expired"),
+		)
+	case err != nil:
+		return models.OAuthAuthorization{}, err
+	}
+
+	var v models.OAuthAuthorization
+	if err = json.Unmarshal([]byte(val), &v); err != nil {
+		return models.OAuthAuthorization{}, fmt.Errorf("unmarshal oauth authorization: %w", err)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,73 @@
+package chache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+// OAuthStateCache keeps logins through an external provider that were sent
+// to the provider and wait for its callback. Keys are state hashes.
+type OAuthStateCache struct {
+	client *redis.Client
+}
+
+func NewOAuthStateCache(client *redis.Client) *OAuthStateCache {
+	return &OAuthStateCache{client: client}
+}
+
+func oauthStateKey(stateHash string) string {
+	return fmt.Sprintf("oauth:state:%s", stateHash)
+}
+
+func (c *OAuthStateCache) Set(
+	ctx context.Context,
+	stateHash string,
+	v models.OAuthLoginState,
+	ttl time.Duration,
+) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal oauth login state: %w", err)
+	}
+
+	return c.client.Set(ctx, oauthStateKey(stateHash), data, ttl).Err()
+}
+
+// Get returns the login state without using it up, so a callback can be
+// checked before it consumes the state.
+func (c *OAuthStateCache) Get(ctx context.Context, stateHash string) (models.OAuthLoginState, error) {
+	val, err := c.client.Get(ctx, oauthStateKey(stateHash)).Result()
+	return decodeOAuthState(val, err)
+}
+
+// Consume returns the login state and removes it in the same round trip, so
+// a callback can be completed only once.
+func (c *OAuthStateCache) Consume(ctx context.Context, stateHash string) (models.OAuthLoginState, error) {
+	val, err := c.client.GetDel(ctx, oauthStateKey(stateHash)).Result()
+	return decodeOAuthState(val, err)
+}
+
+func decodeOAuthState(val string, err error) (models.OAuthLoginState, error) {
+	switch {
+	case errors.Is(err, redis.Nil):
+		return models.OAuthLoginState{}, errx.ErrorOAuthStateInvalid.Raise(
+			fmt.Errorf("oauth state not found or expired"),
+		)
+	case err != nil:
+		return models.OAuthLoginState{}, err
+	}
+
+	var v models.OAuthLoginState
+	if err = json.Unmarshal([]byte(val), &v); err != nil {
+		return models.OAuthLoginState{}, fmt.Errorf("unmarshal oauth login state: %w", err)
+	}
+
+	return v, nil
+}
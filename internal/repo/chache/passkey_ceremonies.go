@@ -0,0 +1,63 @@
+package chache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+// PasskeyCeremonyCache keeps WebAuthn ceremonies between their begin and
+// finish calls. Like QRCache it holds short-lived state only; keys are
+// hashes of the ceremony challenge.
+type PasskeyCeremonyCache struct {
+	client *redis.Client
+}
+
+func NewPasskeyCeremonyCache(client *redis.Client) *PasskeyCeremonyCache {
+	return &PasskeyCeremonyCache{client: client}
+}
+
+func passkeyCeremonyKey(challengeHash string) string {
+	return fmt.Sprintf("passkey:ceremony:%s", challengeHash)
+}
+
+func (c *PasskeyCeremonyCache) Set(
+	ctx context.Context,
+	challengeHash string,
+	v models.PasskeyCeremony,
+	ttl time.Duration,
+) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal passkey ceremony: %w", err)
+	}
+
+	return c.client.Set(ctx, passkeyCeremonyKey(challengeHash), data, ttl).Err()
+}
+
+// Consume returns the ceremony and removes it in the same round trip, so a
+// challenge can be answered only once.
+func (c *PasskeyCeremonyCache) Consume(ctx context.Context, challengeHash string) (models.PasskeyCeremony, error) {
+	val, err := c.client.GetDel(ctx, passkeyCeremonyKey(challengeHash)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return models.PasskeyCeremony{}, errx.ErrorPasskeyChallengeInvalid.Raise(
+			fmt.Errorf("passkey ceremony not found or expired"),
+		)
+	case err != nil:
+		return models.PasskeyCeremony{}, err
+	}
+
+	var v models.PasskeyCeremony
+	if err = json.Unmarshal([]byte(val), &v); err != nil {
+		return models.PasskeyCeremony{}, fmt.Errorf("unmarshal passkey ceremony: %w", err)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,59 @@
+package chache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+// PasswordResetCache keeps pending password reset tokens. Like
+// EmailVerificationCache it is keyed by token hashes and relies on ttl to
+// drop tokens nobody redeemed.
+type PasswordResetCache struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewPasswordResetCache(client *redis.Client, ttl time.Duration) *PasswordResetCache {
+	return &PasswordResetCache{client: client, ttl: ttl}
+}
+
+func passwordResetKey(tokenHash string) string {
+	return fmt.Sprintf("user:password:reset:%s", tokenHash)
+}
+
+func (c *PasswordResetCache) Set(ctx context.Context, tokenHash string, v models.PasswordReset) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal password reset: %w", err)
+	}
+
+	return c.client.Set(ctx, passwordResetKey(tokenHash), data, c.ttl).Err()
+}
+
+// Consume returns the reset behind tokenHash and removes it in the same
+// round trip, so a token can be redeemed only once.
+func (c *PasswordResetCache) Consume(ctx context.Context, tokenHash string) (models.PasswordReset, error) {
+	val, err := c.client.GetDel(ctx, passwordResetKey(tokenHash)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return models.PasswordReset{}, errx.ErrorPasswordResetTokenInvalid.Raise(
+			fmt.Errorf("password reset token not found or expired"),
+		)
+	case err != nil:
+		return models.PasswordReset{}, err
+	}
+
+	var v models.PasswordReset
+	if err = json.Unmarshal([]byte(val), &v); err != nil {
+		return models.PasswordReset{}, fmt.Errorf("unmarshal password reset: %w", err)
+	}
+
+	return v, nil
+}
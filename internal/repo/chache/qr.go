@@ -2,15 +2,17 @@ package chache
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
 	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
 	"github.com/netbill/auth-svc/pkg/log"
 	"github.com/redis/go-redis/v9"
 )
 
+// QRCache keeps QR logins. Each entry is a hash holding the status, the
+// verification code and the client that requested the login.
 type QRCache struct {
 	client *redis.Client
 	log    *log.Logger
@@ -24,18 +26,104 @@ func qrKey(token string) string {
 	return fmt.Sprintf("qr:%s", token)
 }
 
-func (c *QRCache) Set(ctx context.Context, token string, status string, ttl time.Duration) error {
-	return c.client.Set(ctx, qrKey(token), status, ttl).Err()
+func qrTokenNotFound(token string) error {
+	return errx.ErrorQRTokenNotFound.Raise(fmt.Errorf("qr token %s not found or expired", token))
 }
 
-func (c *QRCache) Get(ctx context.Context, token string) (string, error) {
-	val, err := c.client.Get(ctx, qrKey(token)).Result()
+// resolveIfPending moves a pending QR login to another status. It returns -1
+// when the login is gone and 0 when it was already resolved, so a confirm and
+// a reject racing each other cannot both win.
+var resolveIfPending = redis.NewScript(`
+local status = redis.call("HGET", KEYS[1], "status")
+if not status then
+	return -1
+end
+if status ~= ARGV[1] then
+	return 0
+end
+redis.call("HSET", KEYS[1], "status", ARGV[2])
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+return 1
+`)
+
+func (c *QRCache) Create(ctx context.Context, login models.QRLogin, ttl time.Duration) error {
+	key := qrKey(login.Token)
+
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			"status", login.Status,
+			"code", login.Code,
+			"failures", 0,
+			"user_agent", login.Client.UserAgent,
+			"ip", login.Client.IP,
+			"platform", login.Client.Platform,
+			"browser", login.Client.Browser,
+			"created_at", login.CreatedAt.UTC().Format(time.RFC3339Nano),
+			"expires_at", login.ExpiresAt.UTC().Format(time.RFC3339Nano),
+		)
+		pipe.PExpire(ctx, key, ttl)
+		return nil
+	})
+	return err
+}
+
+func (c *QRCache) Get(ctx context.Context, token string) (models.QRLogin, error) {
+	vals, err := c.client.HGetAll(ctx, qrKey(token)).Result()
+	switch {
+	case err != nil:
+		return models.QRLogin{}, err
+	case len(vals) == 0:
+		return models.QRLogin{}, qrTokenNotFound(token)
+	}
+
+	login := models.QRLogin{
+		Token:  token,
+		Status: vals["status"],
+		Code:   vals["code"],
+		Client: models.SessionClient{
+			UserAgent: vals["user_agent"],
+			IP:        vals["ip"],
+			Platform:  vals["platform"],
+			Browser:   vals["browser"],
+		},
+	}
+
+	if login.CreatedAt, err = time.Parse(time.RFC3339Nano, vals["created_at"]); err != nil {
+		return models.QRLogin{}, fmt.Errorf("parse qr login created_at: %w", err)
+	}
+	if login.ExpiresAt, err = time.Parse(time.RFC3339Nano, vals["expires_at"]); err != nil {
+		return models.QRLogin{}, fmt.Errorf("parse qr login expires_at: %w", err)
+	}
+
+	return login, nil
+}
+
+// Resolve moves a pending QR login to status, keeping it around for ttl, and
+// reports whether it was still pending.
+func (c *QRCache) Resolve(ctx context.Context, token string, status string, ttl time.Duration) (bool, error) {
+	n, err := resolveIfPending.Run(
+		ctx, c.client, []string{qrKey(token)}, models.QRLoginPending, status, ttl.Milliseconds(),
+	).Int()
+	switch {
+	case err != nil:
+		return false, err
+	case n < 0:
+		return false, qrTokenNotFound(token)
+	}
+
+	return n == 1, nil
+}
+
+// AddFailure counts a wrong verification code against the QR login and
+// returns the number of failures so far.
+func (c *QRCache) AddFailure(ctx context.Context, token string) (int, error) {
+	n, err := incrIfExists.Run(ctx, c.client, []string{qrKey(token)}, "failures").Int()
 	switch {
-	case errors.Is(err, redis.Nil):
-		return "", errx.ErrorQRTokenNotFound.Raise(fmt.Errorf("qr token %s not found or expired", token))
 	case err != nil:
-		return "", err
+		return 0, err
+	case n < 0:
+		return 0, qrTokenNotFound(token)
 	}
 
-	return val, nil
+	return n, nil
 }
@@ -0,0 +1,71 @@
+package chache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// revokedSessionsKey holds the revoked sessions of every user in one sorted
+// set. A member's score is the time it may be forgotten, in unix
+// milliseconds: by then every access token issued for the session has
+// expired.
+const revokedSessionsKey = "sessions:revoked"
+
+// RevokedSessionCache is the denylist shared by all replicas.
+type RevokedSessionCache struct {
+	client *redis.Client
+	log    *log.Logger
+}
+
+func NewRevokedSessionCache(client *redis.Client, log *log.Logger) *RevokedSessionCache {
+	return &RevokedSessionCache{client: client, log: log}
+}
+
+// Add revokes each session until its time. Entries that have run out are
+// dropped on the way.
+func (c *RevokedSessionCache) Add(ctx context.Context, until map[uuid.UUID]time.Time) error {
+	members := make([]redis.Z, 0, len(until))
+	for id, t := range until {
+		members = append(members, redis.Z{
+			Score:  float64(t.UnixMilli()),
+			Member: id.String(),
+		})
+	}
+
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, revokedSessionsKey, members...)
+		pipe.ZRemRangeByScore(ctx, revokedSessionsKey, "-inf", strconv.FormatInt(time.Now().UnixMilli(), 10))
+		return nil
+	})
+	if err != nil {
+		c.log.WithError(err).Error("revoked sessions add failed", "count", len(until))
+		return err
+	}
+
+	return nil
+}
+
+// Contains reports whether the session is revoked and until when.
+func (c *RevokedSessionCache) Contains(ctx context.Context, sessionID uuid.UUID) (time.Time, bool, error) {
+	score, err := c.client.ZScore(ctx, revokedSessionsKey, sessionID.String()).Result()
+	if errors.Is(err, redis.Nil) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		c.log.WithError(err).Error("revoked sessions lookup failed", "session_id", sessionID)
+		return time.Time{}, false, err
+	}
+
+	until := time.UnixMilli(int64(score))
+	if !until.After(time.Now()) {
+		return time.Time{}, false, nil
+	}
+
+	return until, true, nil
+}
@@ -0,0 +1,162 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/audit"
+	"github.com/netbill/pgdbx"
+	"github.com/netbill/restkit/pagi"
+)
+
+const (
+	authEventsTable = "auth_events"
+	authEventsCols  = "id, user_id, session_id, type, outcome, reason, ip, user_agent, created_at"
+)
+
+type AuthEventRepo struct {
+	db *pgdbx.DB
+}
+
+func NewAuthEventRepo(db *pgdbx.DB) *AuthEventRepo {
+	return &AuthEventRepo{db: db}
+}
+
+func scanAuthEvent(row pgx.Row) (e models.AuthEvent, err error) {
+	var ip, userAgent *string
+	err = row.Scan(
+		&e.ID,
+		&e.UserID,
+		&e.SessionID,
+		&e.Type,
+		&e.Outcome,
+		&e.Reason,
+		&ip,
+		&userAgent,
+		&e.CreatedAt,
+	)
+	if err != nil {
+		return models.AuthEvent{}, fmt.Errorf("scan auth event: %w", err)
+	}
+
+	if ip != nil {
+		e.IP = *ip
+	}
+	if userAgent != nil {
+		e.UserAgent = *userAgent
+	}
+
+	return e, nil
+}
+
+func (r *AuthEventRepo) Create(ctx context.Context, event models.AuthEvent) error {
+	const query = `
+		INSERT INTO ` + authEventsTable + ` (user_id, session_id, type, outcome, reason, ip, user_agent)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	if _, err := r.db.Exec(ctx, query,
+		event.UserID,
+		event.SessionID,
+		event.Type,
+		event.Outcome,
+		event.Reason,
+		nullIfEmpty(&event.IP),
+		nullIfEmpty(&event.UserAgent),
+	); err != nil {
+		return fmt.Errorf("insert auth event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AuthEventRepo) Filter(
+	ctx context.Context,
+	params audit.FilterParams,
+	limit, offset uint,
+) (pagi.Page[[]models.AuthEvent], error) {
+	if limit == 0 {
+		limit = 10
+	}
+
+	where := " WHERE TRUE"
+	var args []interface{}
+
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		where += fmt.Sprintf(" AND "+cond, len(args))
+	}
+
+	if params.UserID != nil {
+		add("user_id = $%d", *params.UserID)
+	}
+	if params.Type != nil {
+		add("type = $%d", *params.Type)
+	}
+	if params.Outcome != nil {
+		add("outcome = $%d", *params.Outcome)
+	}
+	if params.IP != nil {
+		add("ip = $%d", *params.IP)
+	}
+	if params.Since != nil {
+		add("created_at >= $%d", *params.Since)
+	}
+	if params.Until != nil {
+		add("created_at < $%d", *params.Until)
+	}
+
+	const countQuery = `SELECT COUNT(*) FROM ` + authEventsTable
+	var total uint
+	if err := r.db.QueryRow(ctx, countQuery+where, args...).Scan(&total); err != nil {
+		return pagi.Page[[]models.AuthEvent]{}, fmt.Errorf("failed to count auth events: %w", err)
+	}
+
+	listQuery := `SELECT ` + authEventsCols + ` FROM ` + authEventsTable + where +
+		` ORDER BY created_at DESC, id` +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+
+	rows, err := r.db.Query(ctx, listQuery, append(args, limit, offset)...)
+	if err != nil {
+		return pagi.Page[[]models.AuthEvent]{}, fmt.Errorf("failed to filter auth events: %w", err)
+	}
+	defer rows.Close()
+
+	collection := make([]models.AuthEvent, 0, limit)
+	for rows.Next() {
+		e, err := scanAuthEvent(rows)
+		if err != nil {
+			return pagi.Page[[]models.AuthEvent]{}, fmt.Errorf("failed to filter auth events: %w", err)
+		}
+		collection = append(collection, e)
+	}
+	if err = rows.Err(); err != nil {
+		return pagi.Page[[]models.AuthEvent]{}, fmt.Errorf("failed to filter auth events: %w", err)
+	}
+
+	return pagi.Page[[]models.AuthEvent]{
+		Data:  collection,
+		Page:  uint(offset/limit) + 1,
+		Size:  uint(len(collection)),
+		Total: total,
+	}, nil
+}
+
+func (r *AuthEventRepo) DeleteBefore(ctx context.Context, before time.Time, limit uint) (int64, error) {
+	const query = `
+		DELETE FROM ` + authEventsTable + `
+		WHERE id IN (
+			SELECT id FROM ` + authEventsTable + `
+			WHERE created_at < $1
+			LIMIT $2
+		)`
+
+	tag, err := r.db.Exec(ctx, query, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("delete auth events: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -87,3 +87,39 @@ func (r *EmailRepo) GetByEmail(ctx context.Context, email string) (models.UserEm
 
 	return scanEmail(r.db.QueryRow(ctx, query, email))
 }
+
+func (r *EmailRepo) Verify(ctx context.Context, userID uuid.UUID, email string) (models.UserEmail, error) {
+	const query = `
+		UPDATE ` + emailsTable + `
+		SET verified = TRUE, version = version + 1, updated_at = now()
+		WHERE user_id = $1 AND email = $2 AND deleted_at IS NULL
+		RETURNING ` + emailsCols
+
+	return scanEmail(r.db.QueryRow(ctx, query, userID, email))
+}
+
+// UpdateEmail swaps the user's address from oldEmail to newEmail. The row
+// must still hold oldEmail, so a change requested before another one went
+// through finds nothing to update. The new address is verified by the very
+// confirmation that triggers the swap.
+func (r *EmailRepo) UpdateEmail(
+	ctx context.Context,
+	userID uuid.UUID,
+	oldEmail, newEmail string,
+) (models.UserEmail, error) {
+	const query = `
+		UPDATE ` + emailsTable + `
+		SET email = $3, verified = TRUE, version = version + 1, updated_at = now()
+		WHERE user_id = $1 AND email = $2 AND deleted_at IS NULL
+		RETURNING ` + emailsCols
+
+	result, err := scanEmail(r.db.QueryRow(ctx, query, userID, oldEmail, newEmail))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.UserEmail{}, errx.ErrorEmailAlreadyExist.Raise(err)
+		}
+		return models.UserEmail{}, err
+	}
+	return result, nil
+}
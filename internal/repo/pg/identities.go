@@ -0,0 +1,171 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/pgdbx"
+)
+
+const (
+	identitiesTable = "user_identities"
+	identitiesCols  = "id, user_id, provider, subject, email, last_used_at, created_at"
+)
+
+type IdentityRepo struct {
+	db *pgdbx.DB
+}
+
+func NewIdentityRepo(db *pgdbx.DB) *IdentityRepo {
+	return &IdentityRepo{db: db}
+}
+
+func scanIdentity(row pgx.Row) (i models.UserIdentity, err error) {
+	err = row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return models.UserIdentity{}, errx.ErrorIdentityNotFound.Raise(err)
+	case err != nil:
+		return models.UserIdentity{}, fmt.Errorf("scan identity: %w", err)
+	}
+	return i, nil
+}
+
+func (r *IdentityRepo) Create(ctx context.Context, i models.UserIdentity) (models.UserIdentity, error) {
+	const query = `
+		INSERT INTO ` + identitiesTable + ` (user_id, provider, subject, email)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + identitiesCols
+
+	result, err := scanIdentity(r.db.QueryRow(ctx, query, i.UserID, i.Provider, i.Subject, i.Email))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.UserIdentity{}, errx.ErrorIdentityAlreadyLinked.Raise(err)
+		}
+		return models.UserIdentity{}, err
+	}
+	return result, nil
+}
+
+func (r *IdentityRepo) GetByProviderSubject(ctx context.Context, provider, subject string) (models.UserIdentity, error) {
+	const query = `
+		SELECT ` + identitiesCols + `
+		FROM ` + identitiesTable + `
+		WHERE provider = $1 AND subject = $2`
+
+	return scanIdentity(r.db.QueryRow(ctx, query, provider, subject))
+}
+
+func (r *IdentityRepo) ListForUser(ctx context.Context, userID uuid.UUID) ([]models.UserIdentity, error) {
+	const query = `
+		SELECT ` + identitiesCols + `
+		FROM ` + identitiesTable + `
+		WHERE user_id = $1
+		ORDER BY created_at`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list identities: %w", err)
+	}
+	defer rows.Close()
+
+	identities := make([]models.UserIdentity, 0)
+	for rows.Next() {
+		i, err := scanIdentity(rows)
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, i)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate identities: %w", err)
+	}
+
+	return identities, nil
+}
+
+// RecordUse stores the email the provider reported on a successful login.
+func (r *IdentityRepo) RecordUse(ctx context.Context, id uuid.UUID, email string) error {
+	const query = `
+		UPDATE ` + identitiesTable + `
+		SET
+			email        = $2,
+			last_used_at = now()
+		WHERE id = $1`
+
+	tag, err := r.db.Exec(ctx, query, id, email)
+	if err != nil {
+		return fmt.Errorf("record identity use: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errx.ErrorIdentityNotFound.Raise(fmt.Errorf("identity %s not found", id))
+	}
+
+	return nil
+}
+
+func (r *IdentityRepo) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	const query = `
+		DELETE FROM ` + identitiesTable + `
+		WHERE id = $1 AND user_id = $2`
+
+	tag, err := r.db.Exec(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("delete identity: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errx.ErrorIdentityNotFound.Raise(fmt.Errorf("identity %s of user %s not found", id, userID))
+	}
+
+	return nil
+}
+
+// CountCredentials counts the ways the user can sign in: a password,
+// passkeys and linked identities. It locks the user's row first, so within
+// a transaction two removals cannot both see the other's credential left.
+func (r *IdentityRepo) CountCredentials(ctx context.Context, userID uuid.UUID) (int, error) {
+	const lock = `
+		SELECT id FROM users
+		WHERE id = $1
+		FOR UPDATE`
+
+	var id uuid.UUID
+	err := r.db.QueryRow(ctx, lock, userID).Scan(&id)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return 0, errx.ErrorUserNotFound.Raise(err)
+	case err != nil:
+		return 0, fmt.Errorf("lock user: %w", err)
+	}
+
+	const query = `
+		SELECT
+			(SELECT count(*) FROM ` + passwordsTable + ` WHERE user_id = $1 AND deleted_at IS NULL) +
+			(SELECT count(*) FROM ` + passkeysTable + ` WHERE user_id = $1) +
+			(SELECT count(*) FROM ` + identitiesTable + ` WHERE user_id = $1)`
+
+	var n int
+	if err = r.db.QueryRow(ctx, query, userID).Scan(&n); err != nil {
+		return 0, fmt.Errorf("count credentials: %w", err)
+	}
+
+	return n, nil
+}
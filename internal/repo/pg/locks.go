@@ -0,0 +1,30 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/netbill/pgdbx"
+)
+
+// Locker takes Postgres advisory locks, for work that only one replica
+// should be doing at a time.
+type Locker struct {
+	db *pgdbx.DB
+}
+
+func NewLocker(db *pgdbx.DB) *Locker {
+	return &Locker{db: db}
+}
+
+// TryXactLock takes the advisory lock key until the current transaction
+// ends, or reports false at once when it is held elsewhere. Outside a
+// transaction the lock would be released as soon as it is taken.
+func (l *Locker) TryXactLock(ctx context.Context, key int64) (bool, error) {
+	var locked bool
+	if err := l.db.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, key).Scan(&locked); err != nil {
+		return false, fmt.Errorf("try advisory lock: %w", err)
+	}
+
+	return locked, nil
+}
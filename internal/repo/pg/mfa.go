@@ -0,0 +1,204 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/pgdbx"
+)
+
+const (
+	totpTable = "user_mfa_totp"
+	totpCols  = "user_id, secret, confirmed_at, last_used_step, created_at, updated_at"
+
+	recoveryCodesTable = "user_mfa_recovery_codes"
+)
+
+type MFARepo struct {
+	db *pgdbx.DB
+}
+
+func NewMFARepo(db *pgdbx.DB) *MFARepo {
+	return &MFARepo{db: db}
+}
+
+func scanTOTP(row pgx.Row) (t models.UserTOTP, err error) {
+	err = row.Scan(
+		&t.UserID,
+		&t.Secret,
+		&t.ConfirmedAt,
+		&t.LastUsedStep,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return models.UserTOTP{}, errx.ErrorMFANotEnabled.Raise(err)
+	case err != nil:
+		return models.UserTOTP{}, fmt.Errorf("scan totp: %w", err)
+	}
+	return t, nil
+}
+
+// UpsertTOTP stores a new pending authenticator for the user, replacing an
+// earlier enrollment that was never confirmed. A confirmed one is left alone.
+func (r *MFARepo) UpsertTOTP(ctx context.Context, userID uuid.UUID, secret []byte) (models.UserTOTP, error) {
+	const query = `
+		INSERT INTO ` + totpTable + ` (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET
+			secret         = EXCLUDED.secret,
+			last_used_step = NULL,
+			created_at     = now(),
+			updated_at     = now()
+		WHERE ` + totpTable + `.confirmed_at IS NULL
+		RETURNING ` + totpCols
+
+	t, err := scanTOTP(r.db.QueryRow(ctx, query, userID, secret))
+	if errors.Is(err, errx.ErrorMFANotEnabled) {
+		return models.UserTOTP{}, errx.ErrorMFAAlreadyEnabled.Raise(
+			fmt.Errorf("totp of user %s is already confirmed", userID),
+		)
+	}
+
+	return t, err
+}
+
+func (r *MFARepo) GetTOTP(ctx context.Context, userID uuid.UUID) (models.UserTOTP, error) {
+	const query = `
+		SELECT ` + totpCols + `
+		FROM ` + totpTable + `
+		WHERE user_id = $1`
+
+	return scanTOTP(r.db.QueryRow(ctx, query, userID))
+}
+
+// ConfirmTOTP enables a pending authenticator. step is the step of the code
+// it was confirmed with, so that code can't be used again for a login.
+func (r *MFARepo) ConfirmTOTP(ctx context.Context, userID uuid.UUID, step int64) (models.UserTOTP, error) {
+	const query = `
+		UPDATE ` + totpTable + `
+		SET
+			confirmed_at   = now(),
+			last_used_step = $2,
+			updated_at     = now()
+		WHERE user_id = $1 AND confirmed_at IS NULL
+		RETURNING ` + totpCols
+
+	return scanTOTP(r.db.QueryRow(ctx, query, userID, step))
+}
+
+// UseTOTPStep marks step as spent. It fails with ErrorMFACodeInvalid when
+// that step or a later one has already been used, which is what stops a
+// seen code from being replayed within its validity window.
+func (r *MFARepo) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	const query = `
+		UPDATE ` + totpTable + `
+		SET
+			last_used_step = $2,
+			updated_at     = now()
+		WHERE user_id = $1
+			AND confirmed_at IS NOT NULL
+			AND (last_used_step IS NULL OR last_used_step < $2)`
+
+	tag, err := r.db.Exec(ctx, query, userID, step)
+	if err != nil {
+		return fmt.Errorf("use totp step: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errx.ErrorMFACodeInvalid.Raise(
+			fmt.Errorf("totp step %d of user %s already used", step, userID),
+		)
+	}
+
+	return nil
+}
+
+func (r *MFARepo) DeleteTOTP(ctx context.Context, userID uuid.UUID) error {
+	const query = `
+		DELETE FROM ` + totpTable + `
+		WHERE user_id = $1`
+
+	tag, err := r.db.Exec(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("delete totp: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errx.ErrorMFANotEnabled.Raise(fmt.Errorf("totp of user %s not found", userID))
+	}
+
+	return nil
+}
+
+// ReplaceRecoveryCodes drops every recovery code of the user, used or not,
+// and stores hashes instead.
+func (r *MFARepo) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, hashes []string) error {
+	if err := r.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+
+	const query = `
+		INSERT INTO ` + recoveryCodesTable + ` (user_id, code_hash)
+		SELECT $1, unnest($2::text[])`
+
+	if _, err := r.db.Exec(ctx, query, userID, hashes); err != nil {
+		return fmt.Errorf("insert recovery codes: %w", err)
+	}
+
+	return nil
+}
+
+// UseRecoveryCode spends the unused recovery code with the given hash.
+func (r *MFARepo) UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) error {
+	const query = `
+		UPDATE ` + recoveryCodesTable + `
+		SET used_at = now()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL`
+
+	tag, err := r.db.Exec(ctx, query, userID, hash)
+	if err != nil {
+		return fmt.Errorf("use recovery code: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errx.ErrorMFACodeInvalid.Raise(
+			fmt.Errorf("recovery code of user %s not found or already used", userID),
+		)
+	}
+
+	return nil
+}
+
+func (r *MFARepo) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error) {
+	const query = `
+		SELECT count(*)
+		FROM ` + recoveryCodesTable + `
+		WHERE user_id = $1 AND used_at IS NULL`
+
+	var n int
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&n); err != nil {
+		return 0, fmt.Errorf("count recovery codes: %w", err)
+	}
+
+	return n, nil
+}
+
+func (r *MFARepo) DeleteRecoveryCodes(ctx context.Context, userID uuid.UUID) error {
+	const query = `
+		DELETE FROM ` + recoveryCodesTable + `
+		WHERE user_id = $1`
+
+	if _, err := r.db.Exec(ctx, query, userID); err != nil {
+		return fmt.Errorf("delete recovery codes: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/oauthclient"
+	"github.com/netbill/pgdbx"
+)
+
+const (
+	oauthClientsTable = "oauth_clients"
+	oauthClientsCols  = "id, name, secret_hash, scopes, redirect_uris, created_at, updated_at"
+)
+
+type OAuthClientRepo struct {
+	db *pgdbx.DB
+}
+
+func NewOAuthClientRepo(db *pgdbx.DB) *OAuthClientRepo {
+	return &OAuthClientRepo{db: db}
+}
+
+func scanOAuthClient(row pgx.Row) (c models.OAuthClient, err error) {
+	err = row.Scan(
+		&c.ID,
+		&c.Name,
+		&c.SecretHash,
+		&c.Scopes,
+		&c.RedirectURIs,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return models.OAuthClient{}, errx.ErrorOAuthClientNotFound.Raise(err)
+	case err != nil:
+		return models.OAuthClient{}, fmt.Errorf("scan oauth client: %w", err)
+	}
+	return c, nil
+}
+
+func (r *OAuthClientRepo) Create(ctx context.Context, c models.OAuthClient) (models.OAuthClient, error) {
+	const query = `
+		INSERT INTO ` + oauthClientsTable + ` (id, name, secret_hash, scopes, redirect_uris)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + oauthClientsCols
+
+	result, err := scanOAuthClient(r.db.QueryRow(ctx, query,
+		c.ID, c.Name, c.SecretHash, nonNil(c.Scopes), nonNil(c.RedirectURIs),
+	))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.OAuthClient{}, errx.ErrorOAuthClientAlreadyExists.Raise(err)
+		}
+		return models.OAuthClient{}, err
+	}
+	return result, nil
+}
+
+func (r *OAuthClientRepo) GetByID(ctx context.Context, clientID string) (models.OAuthClient, error) {
+	const query = `
+		SELECT ` + oauthClientsCols + `
+		FROM ` + oauthClientsTable + `
+		WHERE id = $1`
+
+	return scanOAuthClient(r.db.QueryRow(ctx, query, clientID))
+}
+
+func (r *OAuthClientRepo) List(ctx context.Context) ([]models.OAuthClient, error) {
+	const query = `
+		SELECT ` + oauthClientsCols + `
+		FROM ` + oauthClientsTable + `
+		ORDER BY id`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("list oauth clients: %w", err)
+	}
+	defer rows.Close()
+
+	clients := make([]models.OAuthClient, 0)
+	for rows.Next() {
+		c, err := scanOAuthClient(rows)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate oauth clients: %w", err)
+	}
+
+	return clients, nil
+}
+
+func (r *OAuthClientRepo) Update(
+	ctx context.Context,
+	clientID string,
+	input oauthclient.UpdateParams,
+) (models.OAuthClient, error) {
+	sets := []string{"updated_at = now()"}
+	args := make([]interface{}, 0, 4)
+
+	if input.Name != nil {
+		args = append(args, *input.Name)
+		sets = append(sets, fmt.Sprintf("name = $%d", len(args)))
+	}
+	if input.Scopes != nil {
+		args = append(args, nonNil(*input.Scopes))
+		sets = append(sets, fmt.Sprintf("scopes = $%d", len(args)))
+	}
+	if input.RedirectURIs != nil {
+		args = append(args, nonNil(*input.RedirectURIs))
+		sets = append(sets, fmt.Sprintf("redirect_uris = $%d", len(args)))
+	}
+
+	args = append(args, clientID)
+	query := `UPDATE ` + oauthClientsTable + ` SET ` + strings.Join(sets, ", ") +
+		fmt.Sprintf(" WHERE id = $%d RETURNING ", len(args)) + oauthClientsCols
+
+	return scanOAuthClient(r.db.QueryRow(ctx, query, args...))
+}
+
+func (r *OAuthClientRepo) UpdateSecretHash(
+	ctx context.Context,
+	clientID string,
+	secretHash string,
+) (models.OAuthClient, error) {
+	const query = `
+		UPDATE ` + oauthClientsTable + `
+		SET
+			secret_hash = $2,
+			updated_at  = now()
+		WHERE id = $1
+		RETURNING ` + oauthClientsCols
+
+	return scanOAuthClient(r.db.QueryRow(ctx, query, clientID, secretHash))
+}
+
+func (r *OAuthClientRepo) Delete(ctx context.Context, clientID string) error {
+	const query = `
+		DELETE FROM ` + oauthClientsTable + `
+		WHERE id = $1`
+
+	tag, err := r.db.Exec(ctx, query, clientID)
+	if err != nil {
+		return fmt.Errorf("delete oauth client: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errx.ErrorOAuthClientNotFound.Raise(fmt.Errorf("oauth client %q not found", clientID))
+	}
+
+	return nil
+}
+
+// nonNil keeps NOT NULL array columns from receiving a NULL.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/models"
@@ -17,6 +18,89 @@ const (
 	payloadVersion = 1
 )
 
+// evtypes has no email-level event yet, so this one is declared here and
+// published on the users topic next to the evtypes ones.
+const userEmailUpdatedEvent = "user_email_updated"
+
+type userEmailUpdatedPayload struct {
+	UserEmail evtypes.UserEmail `json:"user_email"`
+}
+
+// Security events have no evtypes counterpart either; they go to the users
+// topic keyed by user so consumers see them in order with the user's other
+// events.
+const sessionTokenReusedEvent = "session_token_reused"
+
+type sessionTokenReusedPayload struct {
+	UserID            uuid.UUID   `json:"user_id"`
+	SessionID         uuid.UUID   `json:"session_id"`
+	RevokedSessionIDs []uuid.UUID `json:"revoked_session_ids"`
+}
+
+// Admin actions on a user. Each payload names the admin who did it.
+const (
+	userRoleUpdatedEvent     = "user_role_updated"
+	userSuspendedEvent       = "user_suspended"
+	userUnsuspendedEvent     = "user_unsuspended"
+	userRestoredEvent        = "user_restored"
+	userSessionsRevokedEvent = "user_sessions_revoked"
+)
+
+type userRoleUpdatedPayload struct {
+	User      evtypes.User `json:"user"`
+	Role      string       `json:"role"`
+	UpdatedBy uuid.UUID    `json:"updated_by"`
+}
+
+type userSuspensionPayload struct {
+	User              evtypes.User `json:"user"`
+	SuspendedAt       *time.Time   `json:"suspended_at,omitempty"`
+	SuspendedUntil    *time.Time   `json:"suspended_until,omitempty"`
+	Reason            *string      `json:"reason,omitempty"`
+	ChangedBy         uuid.UUID    `json:"changed_by"`
+	RevokedSessionIDs []uuid.UUID  `json:"revoked_session_ids,omitempty"`
+}
+
+type userRestoredPayload struct {
+	User       evtypes.User      `json:"user"`
+	UserEmail  evtypes.UserEmail `json:"user_email"`
+	RestoredBy uuid.UUID         `json:"restored_by"`
+}
+
+type userSessionsRevokedPayload struct {
+	UserID            uuid.UUID   `json:"user_id"`
+	RevokedBy         uuid.UUID   `json:"revoked_by"`
+	RevokedSessionIDs []uuid.UUID `json:"revoked_session_ids"`
+}
+
+// Session lifecycle events let other services drop cached auth decisions as
+// soon as a session ends instead of waiting for its access token to expire.
+// They share the users topic and key so they stay ordered with the user's
+// other events. The payloads carry no client details.
+const (
+	sessionCreatedEvent   = "session_created"
+	sessionRefreshedEvent = "session_refreshed"
+	sessionsRevokedEvent  = "sessions_revoked"
+)
+
+type evSession struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Version   int32     `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	LastUsed  time.Time `json:"last_used"`
+}
+
+type sessionPayload struct {
+	Session evSession `json:"session"`
+}
+
+type sessionsRevokedPayload struct {
+	UserID            uuid.UUID   `json:"user_id"`
+	RevokedSessionIDs []uuid.UUID `json:"revoked_session_ids"`
+	Reason            string      `json:"reason"`
+}
+
 type OutboxRepo struct {
 	db       *pgdbx.DB
 	producer string
@@ -75,6 +159,21 @@ func (r *OutboxRepo) WriteUserUpdated(
 	)
 }
 
+func (r *OutboxRepo) WriteUserEmailUpdated(
+	ctx context.Context,
+	email models.UserEmail,
+) error {
+	return r.write(
+		ctx,
+		evtypes.UsersTopicV1,
+		email.UserID.String(),
+		userEmailUpdatedEvent,
+		userEmailUpdatedPayload{
+			UserEmail: toEvUserEmail(email),
+		},
+	)
+}
+
 func (r *OutboxRepo) WriteUserDeleted(
 	ctx context.Context,
 	user models.User,
@@ -92,6 +191,177 @@ func (r *OutboxRepo) WriteUserDeleted(
 	)
 }
 
+func (r *OutboxRepo) WriteSessionTokenReused(
+	ctx context.Context,
+	userID, sessionID uuid.UUID,
+	revoked []uuid.UUID,
+) error {
+	return r.write(
+		ctx,
+		evtypes.UsersTopicV1,
+		userID.String(),
+		sessionTokenReusedEvent,
+		sessionTokenReusedPayload{
+			UserID:            userID,
+			SessionID:         sessionID,
+			RevokedSessionIDs: revoked,
+		},
+	)
+}
+
+func (r *OutboxRepo) WriteUserRoleUpdated(
+	ctx context.Context,
+	user models.User,
+	by uuid.UUID,
+) error {
+	return r.write(
+		ctx,
+		evtypes.UsersTopicV1,
+		user.ID.String(),
+		userRoleUpdatedEvent,
+		userRoleUpdatedPayload{
+			User:      toEvUser(user),
+			Role:      user.Role,
+			UpdatedBy: by,
+		},
+	)
+}
+
+func (r *OutboxRepo) WriteUserSuspended(
+	ctx context.Context,
+	user models.User,
+	by uuid.UUID,
+	revoked []uuid.UUID,
+) error {
+	return r.write(
+		ctx,
+		evtypes.UsersTopicV1,
+		user.ID.String(),
+		userSuspendedEvent,
+		userSuspensionPayload{
+			User:              toEvUser(user),
+			SuspendedAt:       user.SuspendedAt,
+			SuspendedUntil:    user.SuspendedUntil,
+			Reason:            user.SuspensionReason,
+			ChangedBy:         by,
+			RevokedSessionIDs: revoked,
+		},
+	)
+}
+
+func (r *OutboxRepo) WriteUserUnsuspended(
+	ctx context.Context,
+	user models.User,
+	by uuid.UUID,
+) error {
+	return r.write(
+		ctx,
+		evtypes.UsersTopicV1,
+		user.ID.String(),
+		userUnsuspendedEvent,
+		userSuspensionPayload{
+			User:      toEvUser(user),
+			ChangedBy: by,
+		},
+	)
+}
+
+func (r *OutboxRepo) WriteUserRestored(
+	ctx context.Context,
+	user models.User,
+	email models.UserEmail,
+	by uuid.UUID,
+) error {
+	return r.write(
+		ctx,
+		evtypes.UsersTopicV1,
+		user.ID.String(),
+		userRestoredEvent,
+		userRestoredPayload{
+			User:       toEvUser(user),
+			UserEmail:  toEvUserEmail(email),
+			RestoredBy: by,
+		},
+	)
+}
+
+func (r *OutboxRepo) WriteUserSessionsRevoked(
+	ctx context.Context,
+	userID, by uuid.UUID,
+	revoked []uuid.UUID,
+) error {
+	return r.write(
+		ctx,
+		evtypes.UsersTopicV1,
+		userID.String(),
+		userSessionsRevokedEvent,
+		userSessionsRevokedPayload{
+			UserID:            userID,
+			RevokedBy:         by,
+			RevokedSessionIDs: revoked,
+		},
+	)
+}
+
+func (r *OutboxRepo) WriteSessionCreated(
+	ctx context.Context,
+	session models.Session,
+) error {
+	return r.write(
+		ctx,
+		evtypes.UsersTopicV1,
+		session.UserID.String(),
+		sessionCreatedEvent,
+		sessionPayload{
+			Session: toEvSession(session),
+		},
+	)
+}
+
+func (r *OutboxRepo) WriteSessionRefreshed(
+	ctx context.Context,
+	session models.Session,
+) error {
+	return r.write(
+		ctx,
+		evtypes.UsersTopicV1,
+		session.UserID.String(),
+		sessionRefreshedEvent,
+		sessionPayload{
+			Session: toEvSession(session),
+		},
+	)
+}
+
+func (r *OutboxRepo) WriteSessionsRevoked(
+	ctx context.Context,
+	userID uuid.UUID,
+	revoked []uuid.UUID,
+	reason string,
+) error {
+	return r.write(
+		ctx,
+		evtypes.UsersTopicV1,
+		userID.String(),
+		sessionsRevokedEvent,
+		sessionsRevokedPayload{
+			UserID:            userID,
+			RevokedSessionIDs: revoked,
+			Reason:            reason,
+		},
+	)
+}
+
+func toEvSession(s models.Session) evSession {
+	return evSession{
+		ID:        s.ID,
+		UserID:    s.UserID,
+		Version:   s.Version,
+		CreatedAt: s.CreatedAt,
+		LastUsed:  s.LastUsed,
+	}
+}
+
 func toEvUser(u models.User) evtypes.User {
 	return evtypes.User{
 		ID:        u.ID,
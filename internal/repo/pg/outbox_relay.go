@@ -0,0 +1,139 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+)
+
+// ClaimPending leases up to limit events that are due for delivery until
+// leaseUntil, oldest first. Only the oldest undelivered event of each topic
+// and key is ever returned, so events about one user reach Kafka in the
+// order they were written even when several relays run or an earlier event
+// is waiting out its backoff. Parked events are skipped and no longer hold
+// back their key. The claim is a statement of its own: no lock outlives it,
+// and the lease keeps other relays off the events meanwhile.
+func (r *OutboxRepo) ClaimPending(ctx context.Context, limit uint, leaseUntil time.Time) ([]models.OutboxEvent, error) {
+	const query = `
+		WITH due AS (
+			SELECT o.event_id
+			FROM ` + outboxTable + ` o
+			WHERE o.published_at IS NULL
+			  AND o.parked_at IS NULL
+			  AND o.next_attempt_at <= now()
+			  AND NOT EXISTS (
+			      SELECT 1 FROM ` + outboxTable + ` e
+			      WHERE e.topic = o.topic
+			        AND e.key = o.key
+			        AND e.published_at IS NULL
+			        AND e.parked_at IS NULL
+			        AND e.seq < o.seq
+			  )
+			ORDER BY o.seq
+			LIMIT $1
+			FOR UPDATE OF o SKIP LOCKED
+		), leased AS (
+			UPDATE ` + outboxTable + ` u
+			SET next_attempt_at = $2
+			FROM due
+			WHERE u.event_id = due.event_id
+			RETURNING u.event_id, u.topic, u.key, u.type, u.version, u.producer, u.payload, u.attempts,
+			          u.created_at, u.seq
+		)
+		SELECT event_id, topic, key, type, version, producer, payload, attempts, created_at
+		FROM leased
+		ORDER BY seq`
+
+	rows, err := r.db.Query(ctx, query, limit, leaseUntil)
+	if err != nil {
+		return nil, fmt.Errorf("claim outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.OutboxEvent
+	for rows.Next() {
+		var e models.OutboxEvent
+		if err = rows.Scan(
+			&e.ID,
+			&e.Topic,
+			&e.Key,
+			&e.Type,
+			&e.Version,
+			&e.Producer,
+			&e.Payload,
+			&e.Attempts,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan outbox event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
+
+func (r *OutboxRepo) MarkPublished(ctx context.Context, ids []uuid.UUID) error {
+	const query = `
+		UPDATE ` + outboxTable + `
+		SET published_at = now(), last_error = NULL
+		WHERE event_id = ANY($1)`
+
+	if _, err := r.db.Exec(ctx, query, ids); err != nil {
+		return fmt.Errorf("mark outbox events published: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OutboxRepo) MarkFailed(ctx context.Context, id uuid.UUID, retryAt time.Time, reason string) error {
+	const query = `
+		UPDATE ` + outboxTable + `
+		SET attempts        = attempts + 1,
+		    next_attempt_at = $2,
+		    last_error      = $3
+		WHERE event_id = $1`
+
+	if _, err := r.db.Exec(ctx, query, id, retryAt, reason); err != nil {
+		return fmt.Errorf("mark outbox event failed: %w", err)
+	}
+
+	return nil
+}
+
+// MarkParked counts the last failed delivery and takes the event out of
+// delivery for good. It stays in the table, with last_error, until an
+// operator deals with it.
+func (r *OutboxRepo) MarkParked(ctx context.Context, id uuid.UUID, reason string) error {
+	const query = `
+		UPDATE ` + outboxTable + `
+		SET attempts   = attempts + 1,
+		    parked_at  = now(),
+		    last_error = $2
+		WHERE event_id = $1`
+
+	if _, err := r.db.Exec(ctx, query, id, reason); err != nil {
+		return fmt.Errorf("mark outbox event parked: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OutboxRepo) DeletePublishedBefore(ctx context.Context, before time.Time, limit uint) (int64, error) {
+	const query = `
+		DELETE FROM ` + outboxTable + `
+		WHERE event_id IN (
+			SELECT event_id FROM ` + outboxTable + `
+			WHERE published_at < $1
+			LIMIT $2
+		)`
+
+	tag, err := r.db.Exec(ctx, query, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("delete published outbox events: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
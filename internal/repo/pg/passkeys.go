@@ -0,0 +1,165 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/pgdbx"
+)
+
+const (
+	passkeysTable = "user_passkeys"
+	passkeysCols  = "id, user_id, credential_id, public_key, sign_count, aaguid, transports, name, last_used_at, created_at, updated_at"
+)
+
+type PasskeyRepo struct {
+	db *pgdbx.DB
+}
+
+func NewPasskeyRepo(db *pgdbx.DB) *PasskeyRepo {
+	return &PasskeyRepo{db: db}
+}
+
+func scanPasskey(row pgx.Row) (p models.Passkey, err error) {
+	var signCount int64
+	err = row.Scan(
+		&p.ID,
+		&p.UserID,
+		&p.CredentialID,
+		&p.PublicKey,
+		&signCount,
+		&p.AAGUID,
+		&p.Transports,
+		&p.Name,
+		&p.LastUsedAt,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return models.Passkey{}, errx.ErrorPasskeyNotFound.Raise(err)
+	case err != nil:
+		return models.Passkey{}, fmt.Errorf("scan passkey: %w", err)
+	}
+	p.SignCount = uint32(signCount)
+	return p, nil
+}
+
+func (r *PasskeyRepo) Create(ctx context.Context, p models.Passkey) (models.Passkey, error) {
+	const query = `
+		INSERT INTO ` + passkeysTable + ` (user_id, credential_id, public_key, sign_count, aaguid, transports, name)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + passkeysCols
+
+	transports := p.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+
+	result, err := scanPasskey(r.db.QueryRow(ctx, query,
+		p.UserID, p.CredentialID, p.PublicKey, int64(p.SignCount), p.AAGUID, transports, p.Name,
+	))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.Passkey{}, errx.ErrorPasskeyAlreadyExists.Raise(err)
+		}
+		return models.Passkey{}, err
+	}
+	return result, nil
+}
+
+func (r *PasskeyRepo) GetByCredentialID(ctx context.Context, credentialID []byte) (models.Passkey, error) {
+	const query = `
+		SELECT ` + passkeysCols + `
+		FROM ` + passkeysTable + `
+		WHERE credential_id = $1`
+
+	return scanPasskey(r.db.QueryRow(ctx, query, credentialID))
+}
+
+func (r *PasskeyRepo) ListForUser(ctx context.Context, userID uuid.UUID) ([]models.Passkey, error) {
+	const query = `
+		SELECT ` + passkeysCols + `
+		FROM ` + passkeysTable + `
+		WHERE user_id = $1
+		ORDER BY created_at`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list passkeys: %w", err)
+	}
+	defer rows.Close()
+
+	passkeys := make([]models.Passkey, 0)
+	for rows.Next() {
+		p, err := scanPasskey(rows)
+		if err != nil {
+			return nil, err
+		}
+		passkeys = append(passkeys, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate passkeys: %w", err)
+	}
+
+	return passkeys, nil
+}
+
+func (r *PasskeyRepo) Rename(ctx context.Context, userID, id uuid.UUID, name string) (models.Passkey, error) {
+	const query = `
+		UPDATE ` + passkeysTable + `
+		SET
+			name       = $3,
+			updated_at = now()
+		WHERE id = $1 AND user_id = $2
+		RETURNING ` + passkeysCols
+
+	return scanPasskey(r.db.QueryRow(ctx, query, id, userID, name))
+}
+
+// RecordUse stores the signature counter reported by a successful login.
+func (r *PasskeyRepo) RecordUse(ctx context.Context, id uuid.UUID, signCount uint32) error {
+	const query = `
+		UPDATE ` + passkeysTable + `
+		SET
+			sign_count   = $2,
+			last_used_at = now(),
+			updated_at   = now()
+		WHERE id = $1`
+
+	tag, err := r.db.Exec(ctx, query, id, int64(signCount))
+	if err != nil {
+		return fmt.Errorf("record passkey use: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errx.ErrorPasskeyNotFound.Raise(fmt.Errorf("passkey %s not found", id))
+	}
+
+	return nil
+}
+
+func (r *PasskeyRepo) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	const query = `
+		DELETE FROM ` + passkeysTable + `
+		WHERE id = $1 AND user_id = $2`
+
+	tag, err := r.db.Exec(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("delete passkey: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errx.ErrorPasskeyNotFound.Raise(fmt.Errorf("passkey %s of user %s not found", id, userID))
+	}
+
+	return nil
+}
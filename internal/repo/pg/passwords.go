@@ -38,7 +38,7 @@ func scanPassword(row pgx.Row) (p models.UserPassword, err error) {
 	case p.DeletedAt != nil:
 		return models.UserPassword{}, errx.ErrorUserDeleted.Raise(fmt.Errorf("user %v is deleted", p.UserID))
 	case errors.Is(err, pgx.ErrNoRows):
-		return models.UserPassword{}, errx.ErrorUserNotFound.Raise(err)
+		return models.UserPassword{}, errx.ErrorPasswordNotSet.Raise(err)
 	case err != nil:
 		return models.UserPassword{}, fmt.Errorf("scan password: %w", err)
 	}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/janitor"
 	"github.com/netbill/auth-svc/internal/models"
 	"github.com/netbill/auth-svc/internal/modules/session"
 	"github.com/netbill/pgdbx"
@@ -16,7 +18,8 @@ import (
 
 const (
 	sessionsTable = "sessions"
-	sessionsCols  = "id, user_id, version, created_at, updated_at, last_used, deleted_at"
+	sessionsCols  = "id, user_id, user_agent, client_ip, platform, browser, device_name, " +
+		"version, created_at, updated_at, last_used, deleted_at"
 )
 
 type SessionRepo struct {
@@ -31,6 +34,11 @@ func scanSession(row pgx.Row) (s models.Session, err error) {
 	err = row.Scan(
 		&s.ID,
 		&s.UserID,
+		&s.UserAgent,
+		&s.ClientIP,
+		&s.Platform,
+		&s.Browser,
+		&s.DeviceName,
 		&s.Version,
 		&s.CreatedAt,
 		&s.UpdatedAt,
@@ -51,13 +59,32 @@ func (r *SessionRepo) Create(
 	ctx context.Context,
 	sessionID, userID uuid.UUID,
 	hashToken string,
+	client models.SessionClient,
+	grant *models.OAuthGrant,
 ) (models.Session, error) {
 	const query = `
-		INSERT INTO ` + sessionsTable + ` (id, user_id, hash_token)
-		VALUES ($1, $2, $3)
+		INSERT INTO ` + sessionsTable + ` (
+			id, user_id, hash_token, user_agent, client_ip, platform, browser, client_id, client_scopes
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING ` + sessionsCols
 
-	return scanSession(r.db.QueryRow(ctx, query, sessionID, userID, hashToken))
+	var (
+		clientID     *string
+		clientScopes []string
+	)
+	if grant != nil {
+		clientID, clientScopes = &grant.ClientID, grant.Scopes
+	}
+
+	return scanSession(r.db.QueryRow(ctx, query,
+		sessionID, userID, hashToken,
+		nullIfEmpty(&client.UserAgent),
+		nullIfEmpty(&client.IP),
+		nullIfEmpty(&client.Platform),
+		nullIfEmpty(&client.Browser),
+		clientID, clientScopes,
+	))
 }
 
 func (r *SessionRepo) GetByID(ctx context.Context, sessionID uuid.UUID) (models.Session, error) {
@@ -148,106 +175,320 @@ func (r *SessionRepo) GetListForUser(
 	}, nil
 }
 
-func (r *SessionRepo) GetToken(ctx context.Context, sessionID uuid.UUID) (string, error) {
+// GetToken returns the token hashes of a live session, or of one a session
+// policy ended. Sessions deleted any other way are not found.
+func (r *SessionRepo) GetToken(ctx context.Context, sessionID uuid.UUID) (models.SessionToken, error) {
 	const query = `
-		SELECT hash_token
+		SELECT user_id, hash_token, previous_hash_token, created_at, last_used, end_reason,
+		       client_id, client_scopes
 		FROM ` + sessionsTable + `
-		WHERE id = $1 AND deleted_at IS NULL`
+		WHERE id = $1 AND (deleted_at IS NULL OR end_reason IS NOT NULL)`
 
-	var hash string
-	if err := r.db.QueryRow(ctx, query, sessionID).Scan(&hash); err != nil {
+	var (
+		t            models.SessionToken
+		clientID     *string
+		clientScopes []string
+	)
+	if err := r.db.QueryRow(ctx, query, sessionID).Scan(
+		&t.UserID, &t.Hash, &t.PreviousHash, &t.CreatedAt, &t.LastUsed, &t.EndReason,
+		&clientID, &clientScopes,
+	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return "", errx.ErrorSessionNotFound.Raise(err)
+			return models.SessionToken{}, errx.ErrorSessionNotFound.Raise(err)
 		}
-		return "", fmt.Errorf("get session token: %w", err)
+		return models.SessionToken{}, fmt.Errorf("get session token: %w", err)
+	}
+
+	if clientID != nil {
+		t.Grant = &models.OAuthGrant{ClientID: *clientID, Scopes: clientScopes}
 	}
 
-	return hash, nil
+	return t, nil
 }
 
-func (r *SessionRepo) UpdateToken(ctx context.Context, sessionID uuid.UUID, token string) (models.Session, error) {
+// UpdateToken rotates the refresh token hash from oldToken to token, keeping
+// the replaced one as previous_hash_token. Client details that are known
+// replace the stored ones; unknown ones keep what was recorded before.
+//
+// The rotation only happens while oldToken is still the current hash, so of
+// two refreshes presenting the same token only one rotates; the other gets
+// ErrorSessionTokenMismatch, as it does when the session has ended.
+func (r *SessionRepo) UpdateToken(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	oldToken, token string,
+	client models.SessionClient,
+) (models.Session, error) {
 	const query = `
 		UPDATE ` + sessionsTable + `
 		SET
-		    hash_token = $1,
-		    version    = version + 1,
-		    updated_at = now(),
-		    last_used  = now()
-		WHERE id = $2 AND deleted_at IS NULL
+		    previous_hash_token = hash_token,
+		    hash_token          = $1,
+		    user_agent          = COALESCE($3, user_agent),
+		    client_ip           = COALESCE($4, client_ip),
+		    platform            = COALESCE($5, platform),
+		    browser             = COALESCE($6, browser),
+		    version             = version + 1,
+		    updated_at          = now(),
+		    last_used           = now()
+		WHERE id = $2 AND hash_token = $7 AND deleted_at IS NULL
+		RETURNING ` + sessionsCols
+
+	session, err := scanSession(r.db.QueryRow(ctx, query,
+		token, sessionID,
+		nullIfEmpty(&client.UserAgent),
+		nullIfEmpty(&client.IP),
+		nullIfEmpty(&client.Platform),
+		nullIfEmpty(&client.Browser),
+		oldToken,
+	))
+	if errors.Is(err, errx.ErrorSessionNotFound) {
+		return models.Session{}, errx.ErrorSessionTokenMismatch.Raise(
+			fmt.Errorf("session %v ended or its refresh token was already rotated", sessionID),
+		)
+	}
+
+	return session, err
+}
+
+func (r *SessionRepo) UpdateDeviceName(
+	ctx context.Context,
+	userID, sessionID uuid.UUID,
+	name string,
+) (models.Session, error) {
+	const query = `
+		UPDATE ` + sessionsTable + `
+		SET
+		    device_name = $1,
+		    version     = version + 1,
+		    updated_at  = now()
+		WHERE id = $2 AND user_id = $3 AND deleted_at IS NULL
 		RETURNING ` + sessionsCols
 
-	return scanSession(r.db.QueryRow(ctx, query, token, sessionID))
+	return scanSession(r.db.QueryRow(ctx, query, nullIfEmpty(&name), sessionID, userID))
 }
 
-func (r *SessionRepo) Delete(ctx context.Context, sessionID uuid.UUID) error {
+func (r *SessionRepo) Delete(ctx context.Context, sessionID uuid.UUID) (models.EndedSession, error) {
 	const query = `
 		UPDATE ` + sessionsTable + `
 		SET
 		    deleted_at = now(),
 		    updated_at = now(),
 		    version    = version + 1
-		WHERE id = $1 AND deleted_at IS NULL`
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id, last_used`
+
+	var ended models.EndedSession
+	err := r.db.QueryRow(ctx, query, sessionID).Scan(&ended.ID, &ended.LastUsed)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return models.EndedSession{}, errx.ErrorSessionNotFound.Raise(
+			fmt.Errorf("session %v not found on delete", sessionID),
+		)
+	case err != nil:
+		return models.EndedSession{}, fmt.Errorf("delete session: %w", err)
+	}
+
+	return ended, nil
+}
+
+func (r *SessionRepo) DeleteOneForUser(
+	ctx context.Context,
+	userID, sessionID uuid.UUID,
+) (models.EndedSession, error) {
+	const query = `
+		UPDATE ` + sessionsTable + `
+		SET
+		    deleted_at = now(),
+		    updated_at = now(),
+		    version    = version + 1
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+		RETURNING id, last_used`
+
+	var ended models.EndedSession
+	err := r.db.QueryRow(ctx, query, sessionID, userID).Scan(&ended.ID, &ended.LastUsed)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return models.EndedSession{}, errx.ErrorSessionNotFound.Raise(
+			fmt.Errorf("session %v not found for user %v on delete", sessionID, userID),
+		)
+	case err != nil:
+		return models.EndedSession{}, fmt.Errorf("delete session for user: %w", err)
+	}
 
-	tag, err := r.db.Exec(ctx, query, sessionID)
+	return ended, nil
+}
+
+func (r *SessionRepo) DeleteManyForUser(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]models.EndedSession, error) {
+	const query = `
+		UPDATE ` + sessionsTable + `
+		SET
+		    deleted_at = now(),
+		    updated_at = now(),
+		    version    = version + 1
+		WHERE user_id = $1 AND deleted_at IS NULL
+		RETURNING id, last_used`
+
+	rows, err := r.db.Query(ctx, query, userID)
 	if err != nil {
-		return fmt.Errorf("delete session: %w", err)
+		return nil, fmt.Errorf("delete sessions for user: %w", err)
 	}
 
-	if tag.RowsAffected() == 0 {
-		return errx.ErrorSessionNotFound.Raise(fmt.Errorf("session %v not found on delete", sessionID))
+	return scanEndedSessions(rows)
+}
+
+// DeleteFilteredForUser soft-deletes the active sessions of the user that
+// match every set field of params and returns them.
+func (r *SessionRepo) DeleteFilteredForUser(
+	ctx context.Context,
+	userID, currentSessionID uuid.UUID,
+	params session.DeleteSessionsParams,
+) ([]models.EndedSession, error) {
+	where := " WHERE user_id = $1 AND deleted_at IS NULL"
+	args := []interface{}{userID}
+
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		where += fmt.Sprintf(" AND "+cond, len(args))
 	}
 
-	return nil
+	if params.KeepCurrent {
+		add("id <> $%d", currentSessionID)
+	}
+	if params.LastUsedBefore != nil {
+		add("last_used < $%d", *params.LastUsedBefore)
+	}
+	if params.CreatedBefore != nil {
+		add("created_at < $%d", *params.CreatedBefore)
+	}
+	if len(params.SessionIDs) > 0 {
+		add("id = ANY($%d)", params.SessionIDs)
+	}
+
+	const query = `
+		UPDATE ` + sessionsTable + `
+		SET
+		    deleted_at = now(),
+		    updated_at = now(),
+		    version    = version + 1`
+
+	rows, err := r.db.Query(ctx, query+where+" RETURNING id, last_used", args...)
+	if err != nil {
+		return nil, fmt.Errorf("delete filtered sessions for user: %w", err)
+	}
+
+	return scanEndedSessions(rows)
 }
 
-func (r *SessionRepo) DeleteOneForUser(ctx context.Context, userID, sessionID uuid.UUID) error {
+// End deletes a session on behalf of a session policy, recording why.
+func (r *SessionRepo) End(ctx context.Context, sessionID uuid.UUID, reason string) error {
 	const query = `
 		UPDATE ` + sessionsTable + `
 		SET
 		    deleted_at = now(),
 		    updated_at = now(),
+		    end_reason = $2,
 		    version    = version + 1
-		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL`
+		WHERE id = $1 AND deleted_at IS NULL`
 
-	tag, err := r.db.Exec(ctx, query, sessionID, userID)
+	tag, err := r.db.Exec(ctx, query, sessionID, reason)
 	if err != nil {
-		return fmt.Errorf("delete session for user: %w", err)
+		return fmt.Errorf("end session: %w", err)
 	}
 
 	if tag.RowsAffected() == 0 {
-		return errx.ErrorSessionNotFound.Raise(fmt.Errorf("session %v not found for user %v on delete", sessionID, userID))
+		return errx.ErrorSessionNotFound.Raise(fmt.Errorf("session %v not found on end", sessionID))
 	}
 
 	return nil
 }
 
-func (r *SessionRepo) DeleteManyForUser(
+// EvictLeastRecentlyUsed ends every active session of the user but the keep
+// most recently used ones and returns those it ended.
+func (r *SessionRepo) EvictLeastRecentlyUsed(
 	ctx context.Context,
 	userID uuid.UUID,
-) ([]uuid.UUID, error) {
+	keep int,
+) ([]models.EndedSession, error) {
 	const query = `
 		UPDATE ` + sessionsTable + `
 		SET
 		    deleted_at = now(),
 		    updated_at = now(),
+		    end_reason = $3,
 		    version    = version + 1
-		WHERE user_id = $1 AND deleted_at IS NULL
-		RETURNING id`
+		WHERE id IN (
+		    SELECT id
+		    FROM ` + sessionsTable + `
+		    WHERE user_id = $1 AND deleted_at IS NULL
+		    ORDER BY last_used DESC, created_at DESC
+		    OFFSET $2
+		    FOR UPDATE
+		)
+		RETURNING id, last_used`
+
+	rows, err := r.db.Query(ctx, query, userID, keep, models.SessionRevokedEvicted)
+	if err != nil {
+		return nil, fmt.Errorf("evict sessions for user: %w", err)
+	}
 
-	rows, err := r.db.Query(ctx, query, userID)
+	return scanEndedSessions(rows)
+}
+
+// scanEndedSessions reads the "id, last_used" rows returned by the queries
+// that end sessions, and closes them.
+func scanEndedSessions(rows pgx.Rows) ([]models.EndedSession, error) {
+	defer rows.Close()
+
+	var ended []models.EndedSession
+	for rows.Next() {
+		var e models.EndedSession
+		if err := rows.Scan(&e.ID, &e.LastUsed); err != nil {
+			return nil, fmt.Errorf("scan ended session: %w", err)
+		}
+		ended = append(ended, e)
+	}
+
+	return ended, rows.Err()
+}
+
+// PurgeSessions hard-deletes up to limit sessions soft-deleted before
+// deletedBefore or, never deleted, last used before idleBefore. Rows locked
+// by a running request are skipped until the next purge.
+func (r *SessionRepo) PurgeSessions(
+	ctx context.Context,
+	deletedBefore, idleBefore time.Time,
+	limit uint,
+) ([]janitor.PurgedSession, error) {
+	const query = `
+		DELETE FROM ` + sessionsTable + `
+		WHERE id IN (
+		    SELECT id
+		    FROM ` + sessionsTable + `
+		    WHERE deleted_at < $1
+		       OR (deleted_at IS NULL AND last_used < $2)
+		    LIMIT $3
+		    FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, user_id, last_used, deleted_at IS NULL`
+
+	rows, err := r.db.Query(ctx, query, deletedBefore, idleBefore, limit)
 	if err != nil {
-		return nil, fmt.Errorf("delete sessions for user: %w", err)
+		return nil, fmt.Errorf("purge sessions: %w", err)
 	}
 	defer rows.Close()
 
-	var ids []uuid.UUID
+	var purged []janitor.PurgedSession
 	for rows.Next() {
-		var id uuid.UUID
-		if err = rows.Scan(&id); err != nil {
-			return nil, fmt.Errorf("scan deleted session id: %w", err)
+		var p janitor.PurgedSession
+		if err = rows.Scan(&p.ID, &p.UserID, &p.LastUsed, &p.Idle); err != nil {
+			return nil, fmt.Errorf("scan purged session: %w", err)
 		}
-		ids = append(ids, id)
+		purged = append(purged, p)
 	}
 
-	return ids, rows.Err()
+	return purged, rows.Err()
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/netbill/auth-svc/internal/errx"
 	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/admin"
 	"github.com/netbill/auth-svc/internal/modules/user"
 	"github.com/netbill/pgdbx"
 	"github.com/netbill/restkit/pagi"
@@ -18,7 +19,8 @@ import (
 
 const (
 	usersTable = "users"
-	usersCols  = "id, role, username, pseudonym, description, avatar_key, version, created_at, updated_at, deleted_at"
+	usersCols  = "id, role, username, pseudonym, description, avatar_key, version, " +
+		"created_at, updated_at, deleted_at, suspended_at, suspended_until, suspended_by, suspension_reason"
 )
 
 type UserRepo struct {
@@ -41,6 +43,10 @@ func scanUser(row pgx.Row) (r models.User, err error) {
 		&r.CreatedAt,
 		&r.UpdatedAt,
 		&r.DeletedAt,
+		&r.SuspendedAt,
+		&r.SuspendedUntil,
+		&r.SuspendedBy,
+		&r.SuspensionReason,
 	)
 	switch {
 	case errors.Is(err, pgx.ErrNoRows):
@@ -79,15 +85,25 @@ func (r *UserRepo) Create(ctx context.Context, params user.RegistrationParams) (
 	return res, nil
 }
 
-func (r *UserRepo) GetByID(ctx context.Context, userID uuid.UUID) (models.User, error) {
-	const query = `
-		SELECT ` + usersCols + `
-		FROM ` + usersTable + `
-		WHERE id = $1 AND deleted_at IS NULL`
+func (r *UserRepo) GetByID(ctx context.Context, userID uuid.UUID, optFns ...user.GetUserOption) (models.User, error) {
+	opts := user.ApplyGetUserOptions(optFns)
+
+	query := `SELECT ` + usersCols + ` FROM ` + usersTable + ` WHERE id = $1` + deletedCond(opts.Deleted)
 
 	return scanUser(r.db.QueryRow(ctx, query, userID))
 }
 
+func deletedCond(f user.DeletedFilter) string {
+	switch f {
+	case user.DeletedFilterAll:
+		return ""
+	case user.DeletedFilterDeleted:
+		return ` AND deleted_at IS NOT NULL`
+	default: // DeletedFilterActive (0)
+		return ` AND deleted_at IS NULL`
+	}
+}
+
 func (r *UserRepo) GetByUsername(ctx context.Context, username string) (models.User, error) {
 	const query = `
 		SELECT ` + usersCols + `
@@ -176,7 +192,7 @@ func (r *UserRepo) Filter(
 		limit = 10
 	}
 
-	where := " WHERE deleted_at IS NULL"
+	where := " WHERE TRUE" + deletedCond(params.Deleted)
 	var args []interface{}
 
 	if params.Text != nil && *params.Text != "" {
@@ -261,3 +277,76 @@ func (r *UserRepo) Delete(ctx context.Context, userID uuid.UUID) (models.User, e
 
 	return res, nil
 }
+
+func (r *UserRepo) UpdateRole(ctx context.Context, userID uuid.UUID, role string) (models.User, error) {
+	const query = `
+		UPDATE ` + usersTable + `
+		SET role = $1, updated_at = now(), version = version + 1
+		WHERE id = $2 AND deleted_at IS NULL
+		RETURNING ` + usersCols
+
+	res, err := scanUser(r.db.QueryRow(ctx, query, role, userID))
+	if err != nil {
+		return models.User{}, fmt.Errorf("failed to update role of user %s, cause: %w", userID, err)
+	}
+
+	return res, nil
+}
+
+// Suspend marks an active user suspended. A user whose suspension is still
+// in force matches zero rows and surfaces as errx.ErrorUserNotFound, like a
+// missing one; an expired suspension is replaced.
+func (r *UserRepo) Suspend(
+	ctx context.Context,
+	userID, by uuid.UUID,
+	params admin.SuspendParams,
+) (models.User, error) {
+	const query = `
+		UPDATE ` + usersTable + `
+		SET suspended_at = now(), suspended_until = $1, suspended_by = $2, suspension_reason = $3,
+			updated_at = now(), version = version + 1
+		WHERE id = $4 AND deleted_at IS NULL
+			AND (suspended_at IS NULL OR suspended_until <= now())
+		RETURNING ` + usersCols
+
+	res, err := scanUser(r.db.QueryRow(ctx, query, params.Until, by, params.Reason, userID))
+	if err != nil {
+		return models.User{}, fmt.Errorf("suspend user %s, cause: %w", userID, err)
+	}
+
+	return res, nil
+}
+
+func (r *UserRepo) Unsuspend(ctx context.Context, userID uuid.UUID) (models.User, error) {
+	const query = `
+		UPDATE ` + usersTable + `
+		SET suspended_at = NULL, suspended_until = NULL, suspended_by = NULL, suspension_reason = NULL,
+			updated_at = now(), version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL AND suspended_at IS NOT NULL
+		RETURNING ` + usersCols
+
+	res, err := scanUser(r.db.QueryRow(ctx, query, userID))
+	if err != nil {
+		return models.User{}, fmt.Errorf("unsuspend user %s, cause: %w", userID, err)
+	}
+
+	return res, nil
+}
+
+// Restore undoes Delete. The username stays anonymized: the original one
+// may have been taken since. The email and password rows come back through
+// the cascade trigger.
+func (r *UserRepo) Restore(ctx context.Context, userID uuid.UUID) (models.User, error) {
+	const query = `
+		UPDATE ` + usersTable + `
+		SET deleted_at = NULL, updated_at = now(), version = version + 1
+		WHERE id = $1 AND deleted_at IS NOT NULL
+		RETURNING ` + usersCols
+
+	res, err := scanUser(r.db.QueryRow(ctx, query, userID))
+	if err != nil {
+		return models.User{}, fmt.Errorf("restore user %s, cause: %w", userID, err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,171 @@
+// Package revocation is the denylist of revoked sessions that the REST and
+// gRPC auth layers check access tokens against, so a deleted session stops
+// working before its access tokens expire.
+package revocation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
+)
+
+//go:generate mockery --name=store --inpackage
+type store interface {
+	Add(ctx context.Context, until map[uuid.UUID]time.Time) error
+	Contains(ctx context.Context, sessionID uuid.UUID) (time.Time, bool, error)
+}
+
+//go:generate mockery --name=metrics --inpackage
+type metrics interface {
+	RecordSessionRevocationFailure(ctx context.Context, count int)
+}
+
+type Config struct {
+	// TokenTTL is the access token lifetime. A revoked session is kept on
+	// the list until this long after its last access token was issued.
+	TokenTTL time.Duration
+
+	// LocalTTL is how long a replica trusts its own answer that a session is
+	// not revoked. It bounds how late a revocation made through another
+	// replica takes effect.
+	LocalTTL time.Duration
+
+	// LocalMax caps the entries of the in-process cache; zero means no cap.
+	// Past it arbitrary entries are dropped and asked of the store again.
+	LocalMax int
+}
+
+type entry struct {
+	revoked bool
+	expires time.Time
+}
+
+// List answers from an in-process cache in front of the shared store.
+// Revoked sessions are cached until they drop off the list; sessions found
+// not revoked only for Config.LocalTTL.
+type List struct {
+	config  Config
+	store   store
+	metrics metrics
+	log     *log.Logger
+
+	mu        sync.Mutex
+	local     map[uuid.UUID]entry
+	nextSweep time.Time
+}
+
+func New(store store, config Config, metrics metrics, log *log.Logger) *List {
+	return &List{
+		config:  config,
+		store:   store,
+		metrics: metrics,
+		log:     log,
+		local:   make(map[uuid.UUID]entry),
+	}
+}
+
+// Revoke puts the sessions on the list until their last access token
+// expires; sessions whose tokens have all expired already are skipped. When
+// the store cannot be written only this replica refuses the sessions, so the
+// failure is counted and returned for the caller to surface.
+func (l *List) Revoke(ctx context.Context, sessions []models.EndedSession) error {
+	now := time.Now()
+
+	until := make(map[uuid.UUID]time.Time, len(sessions))
+	for _, s := range sessions {
+		if exp := s.LastUsed.Add(l.config.TokenTTL); exp.After(now) {
+			until[s.ID] = exp
+		}
+	}
+	if len(until) == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	l.sweep(now)
+	for id, exp := range until {
+		l.put(id, entry{revoked: true, expires: exp})
+	}
+	l.mu.Unlock()
+
+	if err := l.store.Add(ctx, until); err != nil {
+		l.metrics.RecordSessionRevocationFailure(ctx, len(until))
+		l.log.WithError(err).Error("failed to revoke sessions", "count", len(until))
+		return err
+	}
+
+	return nil
+}
+
+// IsRevoked reports whether the session is on the list. When the store
+// cannot be reached the session is let through: the token's signature and
+// expiry have already been checked, which is all the auth layers relied on
+// before the list existed.
+func (l *List) IsRevoked(ctx context.Context, sessionID uuid.UUID) bool {
+	now := time.Now()
+
+	l.mu.Lock()
+	l.sweep(now)
+	e, ok := l.local[sessionID]
+	l.mu.Unlock()
+
+	if ok && now.Before(e.expires) {
+		return e.revoked
+	}
+
+	until, revoked, err := l.store.Contains(ctx, sessionID)
+	if err != nil {
+		l.log.WithError(err).Warn("failed to check session revocation", "session_id", sessionID)
+		return false
+	}
+
+	e = entry{revoked: false, expires: now.Add(l.config.LocalTTL)}
+	if revoked {
+		e = entry{revoked: true, expires: until}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// A Revoke through this replica may have landed while the store was
+	// being asked; its entry wins over a stale answer.
+	if cur, ok := l.local[sessionID]; ok && cur.revoked {
+		return true
+	}
+	l.put(sessionID, e)
+
+	return revoked
+}
+
+// put caches e, first dropping arbitrary entries when the cache is at
+// Config.LocalMax. Callers hold l.mu.
+func (l *List) put(sessionID uuid.UUID, e entry) {
+	if _, ok := l.local[sessionID]; !ok && l.config.LocalMax > 0 {
+		for id := range l.local {
+			if len(l.local) < l.config.LocalMax {
+				break
+			}
+			delete(l.local, id)
+		}
+	}
+	l.local[sessionID] = e
+}
+
+// sweep drops expired entries from the local cache, at most once per
+// Config.LocalTTL. Callers hold l.mu.
+func (l *List) sweep(now time.Time) {
+	if now.Before(l.nextSweep) {
+		return
+	}
+
+	for id, e := range l.local {
+		if !now.Before(e.expires) {
+			delete(l.local, id)
+		}
+	}
+	l.nextSweep = now.Add(l.config.LocalTTL)
+}
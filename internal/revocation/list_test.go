@@ -0,0 +1,161 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListSuite struct {
+	suite.Suite
+
+	store   *mockStore
+	metrics *mockMetrics
+	list    *List
+}
+
+func (s *ListSuite) SetupTest() {
+	s.store = newMockStore(s.T())
+	s.metrics = newMockMetrics(s.T())
+	s.list = New(s.store, Config{
+		TokenTTL: time.Hour,
+		LocalTTL: time.Minute,
+	}, s.metrics, log.New("error", "text", "test"))
+}
+
+func TestList(t *testing.T) {
+	suite.Run(t, new(ListSuite))
+}
+
+// ─── Revoke ─────────────────────────────────────────────────────────────────
+
+func (s *ListSuite) TestRevoke_StoresUntilLastTokenExpires() {
+	now := time.Now()
+	sessions := []models.EndedSession{
+		{ID: uuid.New(), LastUsed: now},
+		{ID: uuid.New(), LastUsed: now.Add(-30 * time.Minute)},
+	}
+
+	s.store.On("Add", mock.Anything, map[uuid.UUID]time.Time{
+		sessions[0].ID: now.Add(time.Hour),
+		sessions[1].ID: now.Add(30 * time.Minute),
+	}).Return(nil)
+
+	require.NoError(s.T(), s.list.Revoke(context.Background(), sessions))
+
+	// Answered locally, the store is not asked.
+	assert.True(s.T(), s.list.IsRevoked(context.Background(), sessions[0].ID))
+	assert.True(s.T(), s.list.IsRevoked(context.Background(), sessions[1].ID))
+}
+
+func (s *ListSuite) TestRevoke_SkipsExpiredTokens() {
+	live := models.EndedSession{ID: uuid.New(), LastUsed: time.Now()}
+	stale := models.EndedSession{ID: uuid.New(), LastUsed: time.Now().Add(-2 * time.Hour)}
+
+	s.store.On("Add", mock.Anything, mock.MatchedBy(func(until map[uuid.UUID]time.Time) bool {
+		_, ok := until[live.ID]
+		return ok && len(until) == 1
+	})).Return(nil)
+
+	require.NoError(s.T(), s.list.Revoke(context.Background(), []models.EndedSession{live, stale}))
+}
+
+func (s *ListSuite) TestRevoke_Nothing() {
+	stale := models.EndedSession{ID: uuid.New(), LastUsed: time.Now().Add(-2 * time.Hour)}
+
+	require.NoError(s.T(), s.list.Revoke(context.Background(), nil))
+	require.NoError(s.T(), s.list.Revoke(context.Background(), []models.EndedSession{stale}))
+	s.store.AssertNotCalled(s.T(), "Add", mock.Anything, mock.Anything)
+}
+
+func (s *ListSuite) TestRevoke_StoreError() {
+	ended := models.EndedSession{ID: uuid.New(), LastUsed: time.Now()}
+	storeErr := errors.New("redis down")
+	s.store.On("Add", mock.Anything, mock.Anything).Return(storeErr)
+	s.metrics.On("RecordSessionRevocationFailure", mock.Anything, 1).Once()
+
+	err := s.list.Revoke(context.Background(), []models.EndedSession{ended})
+
+	assert.ErrorIs(s.T(), err, storeErr)
+	// This replica still refuses the session.
+	assert.True(s.T(), s.list.IsRevoked(context.Background(), ended.ID))
+}
+
+// ─── IsRevoked ──────────────────────────────────────────────────────────────
+
+func (s *ListSuite) TestIsRevoked_RevokedElsewhere() {
+	id := uuid.New()
+	s.store.On("Contains", mock.Anything, id).Return(time.Now().Add(time.Hour), true, nil).Once()
+
+	assert.True(s.T(), s.list.IsRevoked(context.Background(), id))
+	assert.True(s.T(), s.list.IsRevoked(context.Background(), id))
+}
+
+func (s *ListSuite) TestIsRevoked_NotRevokedIsCachedForLocalTTL() {
+	id := uuid.New()
+	s.store.On("Contains", mock.Anything, id).Return(time.Time{}, false, nil).Once()
+
+	assert.False(s.T(), s.list.IsRevoked(context.Background(), id))
+	assert.False(s.T(), s.list.IsRevoked(context.Background(), id))
+}
+
+func (s *ListSuite) TestIsRevoked_AsksAgainAfterLocalTTL() {
+	s.list.config.LocalTTL = 10 * time.Millisecond
+	id := uuid.New()
+
+	s.store.On("Contains", mock.Anything, id).Return(time.Time{}, false, nil).Once()
+	s.store.On("Contains", mock.Anything, id).Return(time.Now().Add(time.Hour), true, nil).Once()
+
+	assert.False(s.T(), s.list.IsRevoked(context.Background(), id))
+	time.Sleep(20 * time.Millisecond)
+	assert.True(s.T(), s.list.IsRevoked(context.Background(), id))
+}
+
+func (s *ListSuite) TestIsRevoked_StoreErrorLetsThrough() {
+	id := uuid.New()
+	s.store.On("Contains", mock.Anything, id).Return(time.Time{}, false, errors.New("redis down")).Twice()
+
+	assert.False(s.T(), s.list.IsRevoked(context.Background(), id))
+	// Failures are not cached.
+	assert.False(s.T(), s.list.IsRevoked(context.Background(), id))
+}
+
+func (s *ListSuite) TestIsRevoked_ExpiredEntryIsSwept() {
+	s.list.config.LocalTTL = 10 * time.Millisecond
+	id := uuid.New()
+
+	s.store.On("Contains", mock.Anything, id).Return(time.Now().Add(5*time.Millisecond), true, nil).Once()
+	s.store.On("Contains", mock.Anything, mock.Anything).Return(time.Time{}, false, nil)
+
+	assert.True(s.T(), s.list.IsRevoked(context.Background(), id))
+	time.Sleep(20 * time.Millisecond)
+	s.list.IsRevoked(context.Background(), uuid.New())
+
+	s.list.mu.Lock()
+	_, ok := s.list.local[id]
+	s.list.mu.Unlock()
+	assert.False(s.T(), ok)
+}
+
+func (s *ListSuite) TestIsRevoked_LocalCacheIsCapped() {
+	s.list.config.LocalMax = 2
+	s.store.On("Contains", mock.Anything, mock.Anything).Return(time.Time{}, false, nil)
+
+	for range 5 {
+		s.list.IsRevoked(context.Background(), uuid.New())
+	}
+
+	s.list.mu.Lock()
+	n := len(s.list.local)
+	s.list.mu.Unlock()
+	assert.Equal(s.T(), 2, n)
+}
@@ -0,0 +1,15 @@
+-- +migrate Up
+ALTER TABLE sessions
+    ADD COLUMN user_agent  TEXT,
+    ADD COLUMN client_ip   VARCHAR(45),
+    ADD COLUMN platform    VARCHAR(32),
+    ADD COLUMN browser     VARCHAR(32),
+    ADD COLUMN device_name VARCHAR(64);
+
+-- +migrate Down
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS device_name,
+    DROP COLUMN IF EXISTS browser,
+    DROP COLUMN IF EXISTS platform,
+    DROP COLUMN IF EXISTS client_ip,
+    DROP COLUMN IF EXISTS user_agent;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE sessions
+    ADD COLUMN previous_hash_token TEXT;
+
+-- +migrate Down
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS previous_hash_token;
@@ -0,0 +1,25 @@
+-- +migrate Up
+CREATE TABLE user_mfa_totp (
+    user_id        UUID        PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret         BYTEA       NOT NULL,
+    confirmed_at   TIMESTAMPTZ,
+    last_used_step BIGINT,
+
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE user_mfa_recovery_codes (
+    id         UUID        PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id    UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash  TEXT        NOT NULL,
+    used_at    TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    UNIQUE (user_id, code_hash)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_mfa_recovery_codes CASCADE;
+DROP TABLE IF EXISTS user_mfa_totp CASCADE;
@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE user_passkeys (
+    id            UUID        PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id       UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    credential_id BYTEA       NOT NULL UNIQUE,
+    public_key    BYTEA       NOT NULL,
+    sign_count    BIGINT      NOT NULL DEFAULT 0,
+    aaguid        BYTEA,
+    transports    TEXT[]      NOT NULL DEFAULT '{}',
+    name          TEXT        NOT NULL,
+    last_used_at  TIMESTAMPTZ,
+
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX user_passkeys_user_id_idx ON user_passkeys (user_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_passkeys CASCADE;
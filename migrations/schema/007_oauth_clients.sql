@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE oauth_clients (
+    id            VARCHAR(64) PRIMARY KEY,
+    name          TEXT        NOT NULL,
+    -- sha256 of the client secret; NULL for public clients, which prove
+    -- themselves with PKCE only and cannot use the client_credentials grant.
+    secret_hash   TEXT,
+    scopes        TEXT[]      NOT NULL DEFAULT '{}',
+    redirect_uris TEXT[]      NOT NULL DEFAULT '{}',
+
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS oauth_clients CASCADE;
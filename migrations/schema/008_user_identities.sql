@@ -0,0 +1,18 @@
+-- +migrate Up
+CREATE TABLE user_identities (
+    id           UUID        PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id      UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider     TEXT        NOT NULL,
+    subject      TEXT        NOT NULL,
+    email        TEXT        NOT NULL,
+    last_used_at TIMESTAMPTZ,
+
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    UNIQUE (provider, subject)
+);
+
+CREATE INDEX user_identities_user_id_idx ON user_identities (user_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_identities CASCADE;
@@ -0,0 +1,57 @@
+-- +migrate Up
+ALTER TABLE users ADD COLUMN suspended_at TIMESTAMPTZ;
+
+-- Restoring a soft-deleted user brings back the email and password rows the
+-- delete took down with it, and only those: their deleted_at is the user's.
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION cascade_user_soft_delete()
+RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+    IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        UPDATE user_emails
+        SET deleted_at = NEW.deleted_at
+        WHERE user_id = NEW.id AND deleted_at IS NULL;
+
+        UPDATE user_passwords
+        SET deleted_at = NEW.deleted_at
+        WHERE user_id = NEW.id AND deleted_at IS NULL;
+    END IF;
+
+    IF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        UPDATE user_emails
+        SET deleted_at = NULL, updated_at = now(), version = version + 1
+        WHERE user_id = NEW.id AND deleted_at = OLD.deleted_at;
+
+        UPDATE user_passwords
+        SET deleted_at = NULL, updated_at = now(), version = version + 1
+        WHERE user_id = NEW.id AND deleted_at = OLD.deleted_at;
+    END IF;
+    RETURN NEW;
+END;
+$$;
+-- +migrate StatementEnd
+
+-- +migrate Down
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION cascade_user_soft_delete()
+RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+    IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        UPDATE user_emails
+        SET deleted_at = NEW.deleted_at
+        WHERE user_id = NEW.id AND deleted_at IS NULL;
+
+        UPDATE user_passwords
+        SET deleted_at = NEW.deleted_at
+        WHERE user_id = NEW.id AND deleted_at IS NULL;
+    END IF;
+    RETURN NEW;
+END;
+$$;
+-- +migrate StatementEnd
+
+ALTER TABLE users DROP COLUMN IF EXISTS suspended_at;
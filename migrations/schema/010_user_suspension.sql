@@ -0,0 +1,13 @@
+-- +migrate Up
+-- A suspension is in force while suspended_at is set and suspended_until is
+-- either NULL (until lifted) or still ahead.
+ALTER TABLE users
+    ADD COLUMN suspended_until   TIMESTAMPTZ,
+    ADD COLUMN suspended_by      UUID,
+    ADD COLUMN suspension_reason TEXT;
+
+-- +migrate Down
+ALTER TABLE users
+    DROP COLUMN suspension_reason,
+    DROP COLUMN suspended_by,
+    DROP COLUMN suspended_until;
@@ -0,0 +1,38 @@
+-- +migrate Up
+-- Security audit log. Rows are only ever inserted, and deleted by the
+-- retention job once they are old enough; there is no foreign key to users
+-- so the history outlives the account it is about.
+CREATE TABLE auth_events (
+    id         UUID        PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id    UUID,
+    session_id UUID,
+    type       TEXT        NOT NULL,
+    outcome    TEXT        NOT NULL CHECK (outcome IN ('success', 'failure', 'challenged')),
+    reason     TEXT,
+    ip         VARCHAR(45),
+    user_agent TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX auth_events_user_id_created_at_idx ON auth_events (user_id, created_at DESC);
+CREATE INDEX auth_events_created_at_idx ON auth_events (created_at);
+
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION reject_auth_event_update()
+RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+    RAISE EXCEPTION 'auth_events is append-only';
+END;
+$$;
+-- +migrate StatementEnd
+
+CREATE TRIGGER auth_events_append_only
+    BEFORE UPDATE ON auth_events
+    FOR EACH ROW EXECUTE FUNCTION reject_auth_event_update();
+
+-- +migrate Down
+DROP TABLE IF EXISTS auth_events CASCADE;
+DROP FUNCTION IF EXISTS reject_auth_event_update();
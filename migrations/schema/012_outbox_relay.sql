@@ -0,0 +1,41 @@
+-- +migrate Up
+-- Delivery state for the built-in relay (run outbox-relay). Debezium reads
+-- inserts from the WAL and ignores these columns; a deployment uses one or
+-- the other, not both.
+--
+-- seq orders events written in the same transaction, which share created_at.
+-- next_attempt_at also leases a claimed event to the relay publishing it.
+-- parked_at is set on an event the relay gave up on after too many failed
+-- attempts; it no longer holds back the events after it.
+ALTER TABLE outbox_events
+    ADD COLUMN seq             BIGSERIAL,
+    ADD COLUMN attempts        INT         NOT NULL DEFAULT 0,
+    ADD COLUMN next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN last_error      TEXT,
+    ADD COLUMN published_at    TIMESTAMPTZ,
+    ADD COLUMN parked_at       TIMESTAMPTZ;
+
+-- Events written before the relay existed were delivered by Debezium, or
+-- by nobody; either way the relay must not publish the whole history again.
+UPDATE outbox_events
+SET published_at = created_at;
+
+CREATE INDEX outbox_events_pending_idx
+    ON outbox_events (topic, key, seq)
+    WHERE published_at IS NULL AND parked_at IS NULL;
+
+CREATE INDEX outbox_events_published_at_idx
+    ON outbox_events (published_at)
+    WHERE published_at IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS outbox_events_published_at_idx;
+DROP INDEX IF EXISTS outbox_events_pending_idx;
+
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS parked_at,
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS seq;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- end_reason is set when a session policy (idle timeout, max age, session
+-- limit) ended the session, so its refresh token keeps failing with that
+-- reason instead of a plain "not found".
+ALTER TABLE sessions
+    ADD COLUMN end_reason VARCHAR(32);
+
+CREATE INDEX sessions_user_active_idx
+    ON sessions (user_id, last_used)
+    WHERE deleted_at IS NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS sessions_user_active_idx;
+
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS end_reason;
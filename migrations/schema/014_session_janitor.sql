@@ -0,0 +1,14 @@
+-- +migrate Up
+-- What the session janitor looks up: sessions soft-deleted long ago and
+-- live ones unused for long.
+CREATE INDEX sessions_deleted_at_idx
+    ON sessions (deleted_at)
+    WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX sessions_idle_idx
+    ON sessions (last_used)
+    WHERE deleted_at IS NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS sessions_idle_idx;
+DROP INDEX IF EXISTS sessions_deleted_at_idx;
@@ -0,0 +1,12 @@
+-- +migrate Up
+-- client_id is set on sessions opened for an OpenID Connect client by the
+-- authorization code grant; only that client may refresh them, and their
+-- access tokens carry the scopes the user granted it.
+ALTER TABLE sessions
+    ADD COLUMN client_id     VARCHAR(64),
+    ADD COLUMN client_scopes TEXT[];
+
+-- +migrate Down
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS client_scopes,
+    DROP COLUMN IF EXISTS client_id;
@@ -0,0 +1,82 @@
+// Package clientip finds the address of the client behind a request. The
+// address feeds the per-IP login limiter, so forwarding headers are only
+// believed when the connection comes from a configured trusted proxy;
+// anyone else could put whatever they like in them.
+package clientip
+
+import (
+	"net"
+	"net/netip"
+	"strings"
+)
+
+type Resolver struct {
+	trusted []netip.Prefix
+}
+
+// New returns a Resolver that reads forwarding headers only from peers in
+// trusted. With no trusted proxies it always returns the peer address.
+func New(trusted []netip.Prefix) *Resolver {
+	return &Resolver{trusted: trusted}
+}
+
+// Resolve returns the client address for a connection from peer, which may
+// carry a port. forwardedFor is the X-Forwarded-For value and realIP the
+// X-Real-IP one, both empty when absent.
+//
+// When peer is trusted, X-Forwarded-For is walked from the right and the
+// first hop that is not a trusted proxy wins: entries left of it were
+// written by the client and prove nothing. X-Real-IP is used only when
+// there is no X-Forwarded-For. It returns "" when peer is not an address.
+func (r *Resolver) Resolve(peer, forwardedFor, realIP string) string {
+	addr, ok := parse(peer)
+	if !ok {
+		return ""
+	}
+	if !r.isTrusted(addr) {
+		return addr.String()
+	}
+
+	if forwardedFor != "" {
+		hops := strings.Split(forwardedFor, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop, ok := parse(hops[i])
+			if !ok {
+				break
+			}
+			addr = hop
+			if !r.isTrusted(hop) {
+				break
+			}
+		}
+		return addr.String()
+	}
+
+	if hop, ok := parse(realIP); ok {
+		return hop.String()
+	}
+
+	return addr.String()
+}
+
+func (r *Resolver) isTrusted(addr netip.Addr) bool {
+	for _, p := range r.trusted {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func parse(s string) (netip.Addr, bool) {
+	s = strings.TrimSpace(s)
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
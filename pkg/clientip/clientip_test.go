@@ -0,0 +1,105 @@
+package clientip
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	r := New([]netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("fd00::/8"),
+	})
+
+	cases := []struct {
+		name         string
+		peer         string
+		forwardedFor string
+		realIP       string
+		want         string
+	}{
+		{
+			name: "direct client",
+			peer: "203.0.113.7:51234",
+			want: "203.0.113.7",
+		},
+		{
+			name:         "untrusted peer headers ignored",
+			peer:         "203.0.113.7:51234",
+			forwardedFor: "198.51.100.1",
+			realIP:       "198.51.100.2",
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "trusted proxy",
+			peer:         "10.0.0.2:443",
+			forwardedFor: "198.51.100.1",
+			want:         "198.51.100.1",
+		},
+		{
+			name:         "spoofed hops left of the proxy",
+			peer:         "10.0.0.2:443",
+			forwardedFor: "192.0.2.99, 198.51.100.1",
+			want:         "198.51.100.1",
+		},
+		{
+			name:         "chain of trusted proxies",
+			peer:         "10.0.0.2:443",
+			forwardedFor: "198.51.100.1, 10.0.0.9",
+			want:         "198.51.100.1",
+		},
+		{
+			name:         "only trusted hops",
+			peer:         "10.0.0.2:443",
+			forwardedFor: "10.0.0.9",
+			want:         "10.0.0.9",
+		},
+		{
+			name:         "garbage hop stops the walk",
+			peer:         "10.0.0.2:443",
+			forwardedFor: "198.51.100.1, junk, 10.0.0.9",
+			want:         "10.0.0.9",
+		},
+		{
+			name:   "x-real-ip from trusted proxy",
+			peer:   "10.0.0.2:443",
+			realIP: "198.51.100.1",
+			want:   "198.51.100.1",
+		},
+		{
+			name: "trusted peer without headers",
+			peer: "10.0.0.2:443",
+			want: "10.0.0.2",
+		},
+		{
+			name:         "ipv6 proxy and mapped client",
+			peer:         "[fd00::1]:443",
+			forwardedFor: "::ffff:198.51.100.1",
+			want:         "198.51.100.1",
+		},
+		{
+			name: "peer without port",
+			peer: "203.0.113.7",
+			want: "203.0.113.7",
+		},
+		{
+			name: "invalid peer",
+			peer: "pipe",
+			want: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, r.Resolve(tc.peer, tc.forwardedFor, tc.realIP))
+		})
+	}
+}
+
+func TestResolve_NoTrustedProxies(t *testing.T) {
+	r := New(nil)
+
+	require.Equal(t, "10.0.0.2", r.Resolve("10.0.0.2:443", "198.51.100.1", "198.51.100.2"))
+}
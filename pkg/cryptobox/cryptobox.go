@@ -0,0 +1,62 @@
+// Package cryptobox encrypts small secrets at rest with AES-256-GCM. The
+// nonce is stored in front of the ciphertext, so a sealed value is
+// self-contained.
+package cryptobox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+type Box struct {
+	aead cipher.AEAD
+}
+
+// New derives a 256-bit key from key, which may be any non-empty string
+// taken from the configuration.
+func New(key string) (*Box, error) {
+	if key == "" {
+		return nil, errors.New("cryptobox: empty key")
+	}
+
+	sum := sha256.Sum256([]byte(key))
+
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, fmt.Errorf("cryptobox: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("cryptobox: %w", err)
+	}
+
+	return &Box{aead: aead}, nil
+}
+
+func (b *Box) Seal(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, b.aead.NonceSize(), b.aead.NonceSize()+len(plaintext)+b.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("cryptobox: generate nonce: %w", err)
+	}
+
+	return b.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (b *Box) Open(sealed []byte) ([]byte, error) {
+	n := b.aead.NonceSize()
+	if len(sealed) < n {
+		return nil, errors.New("cryptobox: sealed value too short")
+	}
+
+	plaintext, err := b.aead.Open(nil, sealed[:n], sealed[n:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("cryptobox: %w", err)
+	}
+
+	return plaintext, nil
+}
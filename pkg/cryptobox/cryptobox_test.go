@@ -0,0 +1,50 @@
+package cryptobox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealOpen(t *testing.T) {
+	box, err := New("test-key")
+	require.NoError(t, err)
+
+	sealed, err := box.Seal([]byte("JBSWY3DPEHPK3PXP"))
+	require.NoError(t, err)
+	require.NotContains(t, string(sealed), "JBSWY3DPEHPK3PXP")
+
+	plain, err := box.Open(sealed)
+	require.NoError(t, err)
+	require.Equal(t, "JBSWY3DPEHPK3PXP", string(plain))
+
+	// Every seal uses a fresh nonce.
+	again, err := box.Seal([]byte("JBSWY3DPEHPK3PXP"))
+	require.NoError(t, err)
+	require.NotEqual(t, sealed, again)
+}
+
+func TestOpen_WrongKeyOrTampered(t *testing.T) {
+	box, err := New("test-key")
+	require.NoError(t, err)
+
+	sealed, err := box.Seal([]byte("secret"))
+	require.NoError(t, err)
+
+	other, err := New("other-key")
+	require.NoError(t, err)
+	_, err = other.Open(sealed)
+	require.Error(t, err)
+
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = box.Open(sealed)
+	require.Error(t, err)
+
+	_, err = box.Open([]byte("short"))
+	require.Error(t, err)
+}
+
+func TestNew_EmptyKey(t *testing.T) {
+	_, err := New("")
+	require.Error(t, err)
+}
@@ -21,6 +21,8 @@ const (
 	UserIDField        = "user_id"
 	UserSessionIDField = "user_session_id"
 
+	ClientIDField = "client_id"
+
 	HTTPMethodField = "http_method"
 	HTTPPathField   = "http_path"
 )
@@ -149,6 +151,17 @@ func (l *Logger) WithUserAuthClaims(auth interface {
 	)}
 }
 
+func (l *Logger) WithServiceAuthClaims(auth interface {
+	GetClientID() string
+}) *Logger {
+	if auth == nil {
+		return l
+	}
+	return &Logger{base: l.base.With(
+		slog.String(ClientIDField, auth.GetClientID()),
+	)}
+}
+
 func parseLevel(level string) slog.Level {
 	switch strings.ToLower(strings.TrimSpace(level)) {
 	case "debug":
@@ -16,6 +16,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // LoginAPIService LoginAPI service
@@ -40,6 +41,8 @@ func (r ApiAuthSvcV1LoginEmailPostRequest) Execute() (*TokensPair, *http.Respons
 AuthSvcV1LoginEmailPost Login by email
 
 Endpoint to login a user using their email and password.
+Failed attempts are counted per email and per client IP. Too many failures within the window lock further attempts out for a while; the lockout grows with every further failure.
+Users with MFA enabled get an MFA challenge instead of tokens; the login is finished via POST /auth-svc/v1/login/mfa.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiAuthSvcV1LoginEmailPostRequest
@@ -128,6 +131,194 @@ func (a *LoginAPIService) AuthSvcV1LoginEmailPostExecute(r ApiAuthSvcV1LoginEmai
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
+		if localVarHTTPResponse.StatusCode == 403 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 429 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiAuthSvcV1LoginMfaPostRequest struct {
+	ctx        context.Context
+	ApiService *LoginAPIService
+	loginByMFA *LoginByMFA
+}
+
+func (r ApiAuthSvcV1LoginMfaPostRequest) LoginByMFA(loginByMFA LoginByMFA) ApiAuthSvcV1LoginMfaPostRequest {
+	r.loginByMFA = &loginByMFA
+	return r
+}
+
+func (r ApiAuthSvcV1LoginMfaPostRequest) Execute() (*TokensPair, *http.Response, error) {
+	return r.ApiService.AuthSvcV1LoginMfaPostExecute(r)
+}
+
+/*
+AuthSvcV1LoginMfaPost Login by MFA challenge
+
+Finishes a login that was answered with an MFA challenge by presenting a code from the authenticator app or one of the recovery codes. Either is spent on success.
+A challenge accepts a limited number of wrong codes; after that the login has to start over. Wrong codes also count against the user across challenges, and too many of them lock the second factor for a while.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiAuthSvcV1LoginMfaPostRequest
+*/
+func (a *LoginAPIService) AuthSvcV1LoginMfaPost(ctx context.Context) ApiAuthSvcV1LoginMfaPostRequest {
+	return ApiAuthSvcV1LoginMfaPostRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return TokensPair
+func (a *LoginAPIService) AuthSvcV1LoginMfaPostExecute(r ApiAuthSvcV1LoginMfaPostRequest) (*TokensPair, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *TokensPair
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LoginAPIService.AuthSvcV1LoginMfaPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/auth-svc/v1/login/mfa"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.loginByMFA == nil {
+		return localVarReturnValue, nil, reportError("loginByMFA is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.loginByMFA
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 403 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 429 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Errors
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
@@ -153,41 +344,324 @@ func (a *LoginAPIService) AuthSvcV1LoginEmailPostExecute(r ApiAuthSvcV1LoginEmai
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiAuthSvcV1LoginGoogleCallbackGetRequest struct {
+type ApiAuthSvcV1LoginPasskeyBeginPostRequest struct {
 	ctx        context.Context
 	ApiService *LoginAPIService
+}
+
+func (r ApiAuthSvcV1LoginPasskeyBeginPostRequest) Execute() (*PasskeyRequestOptions, *http.Response, error) {
+	return r.ApiService.AuthSvcV1LoginPasskeyBeginPostExecute(r)
+}
+
+/*
+AuthSvcV1LoginPasskeyBeginPost Begin login by passkey
+
+Starts a passwordless login. The returned options are passed to navigator.credentials.get(); they name no user, so the authenticator offers whichever passkeys it holds for this site. The answer goes to /login/passkey/finish within the options' timeout.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiAuthSvcV1LoginPasskeyBeginPostRequest
+*/
+func (a *LoginAPIService) AuthSvcV1LoginPasskeyBeginPost(ctx context.Context) ApiAuthSvcV1LoginPasskeyBeginPostRequest {
+	return ApiAuthSvcV1LoginPasskeyBeginPostRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return PasskeyRequestOptions
+func (a *LoginAPIService) AuthSvcV1LoginPasskeyBeginPostExecute(r ApiAuthSvcV1LoginPasskeyBeginPostRequest) (*PasskeyRequestOptions, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *PasskeyRequestOptions
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LoginAPIService.AuthSvcV1LoginPasskeyBeginPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/auth-svc/v1/login/passkey/begin"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiAuthSvcV1LoginPasskeyFinishPostRequest struct {
+	ctx            context.Context
+	ApiService     *LoginAPIService
+	loginByPasskey *LoginByPasskey
+}
+
+func (r ApiAuthSvcV1LoginPasskeyFinishPostRequest) LoginByPasskey(loginByPasskey LoginByPasskey) ApiAuthSvcV1LoginPasskeyFinishPostRequest {
+	r.loginByPasskey = &loginByPasskey
+	return r
+}
+
+func (r ApiAuthSvcV1LoginPasskeyFinishPostRequest) Execute() (*TokensPair, *http.Response, error) {
+	return r.ApiService.AuthSvcV1LoginPasskeyFinishPostExecute(r)
+}
+
+/*
+AuthSvcV1LoginPasskeyFinishPost Finish login by passkey
+
+Verifies the assertion made for the options of /login/passkey/begin and opens a session for the owner of the passkey. A passkey already proves possession and user verification, so no second factor is asked for.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiAuthSvcV1LoginPasskeyFinishPostRequest
+*/
+func (a *LoginAPIService) AuthSvcV1LoginPasskeyFinishPost(ctx context.Context) ApiAuthSvcV1LoginPasskeyFinishPostRequest {
+	return ApiAuthSvcV1LoginPasskeyFinishPostRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return TokensPair
+func (a *LoginAPIService) AuthSvcV1LoginPasskeyFinishPostExecute(r ApiAuthSvcV1LoginPasskeyFinishPostRequest) (*TokensPair, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *TokensPair
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LoginAPIService.AuthSvcV1LoginPasskeyFinishPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/auth-svc/v1/login/passkey/finish"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.loginByPasskey == nil {
+		return localVarReturnValue, nil, reportError("loginByPasskey is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.loginByPasskey
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 403 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiAuthSvcV1LoginProviderCallbackGetRequest struct {
+	ctx        context.Context
+	ApiService *LoginAPIService
+	provider   string
+	state      *string
 	code       *string
+	error      *string
+}
+
+// State issued when the login was started
+func (r ApiAuthSvcV1LoginProviderCallbackGetRequest) State(state string) ApiAuthSvcV1LoginProviderCallbackGetRequest {
+	r.state = &state
+	return r
 }
 
-// OAuth authorization code returned by Google
-func (r ApiAuthSvcV1LoginGoogleCallbackGetRequest) Code(code string) ApiAuthSvcV1LoginGoogleCallbackGetRequest {
+// OAuth authorization code returned by the provider
+func (r ApiAuthSvcV1LoginProviderCallbackGetRequest) Code(code string) ApiAuthSvcV1LoginProviderCallbackGetRequest {
 	r.code = &code
 	return r
 }
 
-func (r ApiAuthSvcV1LoginGoogleCallbackGetRequest) Execute() (*TokensPair, *http.Response, error) {
-	return r.ApiService.AuthSvcV1LoginGoogleCallbackGetExecute(r)
+// Set by the provider when the user declined the consent screen
+func (r ApiAuthSvcV1LoginProviderCallbackGetRequest) Error(error string) ApiAuthSvcV1LoginProviderCallbackGetRequest {
+	r.error = &error
+	return r
+}
+
+func (r ApiAuthSvcV1LoginProviderCallbackGetRequest) Execute() (*TokensPair, *http.Response, error) {
+	return r.ApiService.AuthSvcV1LoginProviderCallbackGetExecute(r)
 }
 
 /*
-AuthSvcV1LoginGoogleCallbackGet Google OAuth callback
+AuthSvcV1LoginProviderCallbackGet OAuth callback
 
-Exchanges Google OAuth `code` for user info and returns an access/refresh tokens pair.
+Checks `state` against the login started by POST /auth-svc/v1/login/{provider} with the same provider, and the `oauth_binding` cookie against the one that start set, then clears the cookie. It exchanges the `code` with the PKCE verifier and verifies the returned ID token against the provider's JWKS, including its nonce. Returns an access/refresh tokens pair. Users with MFA enabled get an MFA challenge instead, see POST /auth-svc/v1/login/mfa. The user is found by the provider account ID, not by email. An account that is not linked to anyone and whose verified email is not registered gets a new user if the provider allows sign-up; one with the email of an existing user is refused with 409 until the owner links it, see POST /auth-svc/v1/me/identities/{provider}/link. A flow started there links the provider account and answers 201 with the identity instead of logging in.
+
+If the login was started with a `redirect_uri`, every outcome after the state check is a 303 redirect there, with the result in the URL fragment: `session_id`, `access_token` and `refresh_token`; `mfa_challenge` and `mfa_challenge_expires_at`; `identity_id` for a linked account; or `error` (`access_denied`, `invalid_request`, `identity_not_linked`, `identity_already_linked`, `server_error`).
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiAuthSvcV1LoginGoogleCallbackGetRequest
+	@param provider Name of a configured OpenID Connect provider, e.g. &#x60;google&#x60;
+	@return ApiAuthSvcV1LoginProviderCallbackGetRequest
 */
-func (a *LoginAPIService) AuthSvcV1LoginGoogleCallbackGet(ctx context.Context) ApiAuthSvcV1LoginGoogleCallbackGetRequest {
-	return ApiAuthSvcV1LoginGoogleCallbackGetRequest{
+func (a *LoginAPIService) AuthSvcV1LoginProviderCallbackGet(ctx context.Context, provider string) ApiAuthSvcV1LoginProviderCallbackGetRequest {
+	return ApiAuthSvcV1LoginProviderCallbackGetRequest{
 		ApiService: a,
 		ctx:        ctx,
+		provider:   provider,
 	}
 }
 
 // Execute executes the request
 //
 //	@return TokensPair
-func (a *LoginAPIService) AuthSvcV1LoginGoogleCallbackGetExecute(r ApiAuthSvcV1LoginGoogleCallbackGetRequest) (*TokensPair, *http.Response, error) {
+func (a *LoginAPIService) AuthSvcV1LoginProviderCallbackGetExecute(r ApiAuthSvcV1LoginProviderCallbackGetRequest) (*TokensPair, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -195,21 +669,28 @@ func (a *LoginAPIService) AuthSvcV1LoginGoogleCallbackGetExecute(r ApiAuthSvcV1L
 		localVarReturnValue *TokensPair
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LoginAPIService.AuthSvcV1LoginGoogleCallbackGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LoginAPIService.AuthSvcV1LoginProviderCallbackGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/auth-svc/v1/login/google/callback"
+	localVarPath := localBasePath + "/auth-svc/v1/login/{provider}/callback"
+	localVarPath = strings.Replace(localVarPath, "{"+"provider"+"}", url.PathEscape(parameterValueToString(r.provider, "provider")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.code == nil {
-		return localVarReturnValue, nil, reportError("code is required and must be specified")
+	if r.state == nil {
+		return localVarReturnValue, nil, reportError("state is required and must be specified")
 	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "code", r.code, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "state", r.state, "form", "")
+	if r.code != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "code", r.code, "form", "")
+	}
+	if r.error != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "error", r.error, "form", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -260,6 +741,28 @@ func (a *LoginAPIService) AuthSvcV1LoginGoogleCallbackGetExecute(r ApiAuthSvcV1L
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 403 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Errors
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
@@ -271,6 +774,17 @@ func (a *LoginAPIService) AuthSvcV1LoginGoogleCallbackGetExecute(r ApiAuthSvcV1L
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Errors
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
@@ -296,49 +810,63 @@ func (a *LoginAPIService) AuthSvcV1LoginGoogleCallbackGetExecute(r ApiAuthSvcV1L
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiAuthSvcV1LoginGooglePostRequest struct {
-	ctx        context.Context
-	ApiService *LoginAPIService
+type ApiAuthSvcV1LoginProviderPostRequest struct {
+	ctx         context.Context
+	ApiService  *LoginAPIService
+	provider    string
+	redirectUri *string
+}
+
+// Page to send the user-agent to once the login is done. Must be one of the configured post-login redirects. Without it the callback answers with JSON.
+func (r ApiAuthSvcV1LoginProviderPostRequest) RedirectUri(redirectUri string) ApiAuthSvcV1LoginProviderPostRequest {
+	r.redirectUri = &redirectUri
+	return r
 }
 
-func (r ApiAuthSvcV1LoginGooglePostRequest) Execute() (*http.Response, error) {
-	return r.ApiService.AuthSvcV1LoginGooglePostExecute(r)
+func (r ApiAuthSvcV1LoginProviderPostRequest) Execute() (*http.Response, error) {
+	return r.ApiService.AuthSvcV1LoginProviderPostExecute(r)
 }
 
 /*
-AuthSvcV1LoginGooglePost Start Google OAuth login
+AuthSvcV1LoginProviderPost Start OAuth login
 
-Redirects the user-agent to Google OAuth consent screen. This endpoint returns a redirect and does not return a JSON:API document.
+Redirects the user-agent to the consent screen of the provider, whose endpoints come from its OpenID Connect discovery document. A fresh state, nonce and PKCE verifier are generated for every call and kept for 10 minutes; the callback is refused unless it carries that state. The response also sets the HttpOnly `oauth_binding` cookie, scoped to the callback of the provider; a callback without it is refused as well, so the flow can only be finished in the browser that started it. This endpoint returns a redirect and does not return a JSON:API document.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiAuthSvcV1LoginGooglePostRequest
+	@param provider Name of a configured OpenID Connect provider, e.g. &#x60;google&#x60;
+	@return ApiAuthSvcV1LoginProviderPostRequest
 */
-func (a *LoginAPIService) AuthSvcV1LoginGooglePost(ctx context.Context) ApiAuthSvcV1LoginGooglePostRequest {
-	return ApiAuthSvcV1LoginGooglePostRequest{
+func (a *LoginAPIService) AuthSvcV1LoginProviderPost(ctx context.Context, provider string) ApiAuthSvcV1LoginProviderPostRequest {
+	return ApiAuthSvcV1LoginProviderPostRequest{
 		ApiService: a,
 		ctx:        ctx,
+		provider:   provider,
 	}
 }
 
 // Execute executes the request
-func (a *LoginAPIService) AuthSvcV1LoginGooglePostExecute(r ApiAuthSvcV1LoginGooglePostRequest) (*http.Response, error) {
+func (a *LoginAPIService) AuthSvcV1LoginProviderPostExecute(r ApiAuthSvcV1LoginProviderPostRequest) (*http.Response, error) {
 	var (
 		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
 		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LoginAPIService.AuthSvcV1LoginGooglePost")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LoginAPIService.AuthSvcV1LoginProviderPost")
 	if err != nil {
 		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/auth-svc/v1/login/google"
+	localVarPath := localBasePath + "/auth-svc/v1/login/{provider}"
+	localVarPath = strings.Replace(localVarPath, "{"+"provider"+"}", url.PathEscape(parameterValueToString(r.provider, "provider")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.redirectUri != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "redirect_uri", r.redirectUri, "form", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -378,6 +906,28 @@ func (a *LoginAPIService) AuthSvcV1LoginGooglePostExecute(r ApiAuthSvcV1LoginGoo
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Errors
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
@@ -13,9 +13,11 @@ package oapi
 import (
 	"bytes"
 	"context"
+	"github.com/google/uuid"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // QrAPIService QrAPI service
@@ -39,7 +41,7 @@ func (r ApiAuthSvcV1LoginQrConfirmPostRequest) Execute() (*http.Response, error)
 /*
 AuthSvcV1LoginQrConfirmPost Confirm QR token
 
-Confirms a pending QR token. The mobile client (already authenticated) scans the QR code and calls this endpoint. The server creates a new session and pushes the tokens to the desktop's SSE stream opened via GET /auth-svc/v1/login/qr.
+Confirms a pending QR token. The mobile client (already authenticated) scans the QR code, shows the requesting device from GET /auth-svc/v1/login/qr/{qr_token} and calls this endpoint with the verification code the user typed in. The server creates a new session for the requesting device and pushes the tokens to the desktop's SSE stream opened via GET /auth-svc/v1/login/qr.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiAuthSvcV1LoginQrConfirmPostRequest
@@ -136,6 +138,17 @@ func (a *QrAPIService) AuthSvcV1LoginQrConfirmPostExecute(r ApiAuthSvcV1LoginQrC
 			newErr.model = v
 			return localVarHTTPResponse, newErr
 		}
+		if localVarHTTPResponse.StatusCode == 403 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Errors
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
@@ -186,7 +199,7 @@ func (r ApiAuthSvcV1LoginQrGetRequest) Execute() (string, *http.Response, error)
 /*
 AuthSvcV1LoginQrGet Connect to QR login session
 
-Opens a Server-Sent Events (text/event-stream) connection. Upon connecting, the server generates a QR token and sends it as the first event. The client renders it as a QR code. When the mobile client confirms the token via POST /auth-svc/v1/login/qr/confirm, the server pushes the session tokens through this same stream and closes it. The stream closes with an `error` event if the token isn't confirmed within 5 minutes.
+Opens a Server-Sent Events (text/event-stream) connection. Upon connecting, the server generates a QR token and a verification code and sends them as the first event. The client renders the token as a QR code and shows the code next to it. The IP address and User-Agent of this request are recorded and shown to the confirming user by GET /auth-svc/v1/login/qr/{qr_token}. When the mobile client confirms the token with the code via POST /auth-svc/v1/login/qr/confirm, the server pushes the session tokens through this same stream and closes it. The stream closes with an `error` event if the token is rejected via POST /auth-svc/v1/login/qr/reject or isn't confirmed within 5 minutes.
 
 Events sent as SSE `event:`/`data:` frames, each shaped like a normal JSON:API response body for this API:
 
@@ -197,8 +210,8 @@ Events sent as SSE `event:`/`data:` frames, each shaped like a normal JSON:API r
     login endpoint. Sent once the QR token is confirmed; the stream closes
     right after.
 
-  - `error` — body shaped like the `Errors` schema. Sent if the token isn't
-    confirmed in time; the stream closes right after.
+  - `error` — body shaped like the `Errors` schema: 403 if the token was
+    rejected, 404 if it wasn't confirmed in time; the stream closes right after.
 
     @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
     @return ApiAuthSvcV1LoginQrGetRequest
@@ -295,3 +308,303 @@ func (a *QrAPIService) AuthSvcV1LoginQrGetExecute(r ApiAuthSvcV1LoginQrGetReques
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiAuthSvcV1LoginQrQrTokenGetRequest struct {
+	ctx        context.Context
+	ApiService *QrAPIService
+	qrToken    uuid.UUID
+}
+
+func (r ApiAuthSvcV1LoginQrQrTokenGetRequest) Execute() (*QRLogin, *http.Response, error) {
+	return r.ApiService.AuthSvcV1LoginQrQrTokenGetExecute(r)
+}
+
+/*
+AuthSvcV1LoginQrQrTokenGet Get QR login details
+
+Returns the device that opened the QR login — its IP address, User-Agent and when it asked — so the confirming app can show them before the user confirms or rejects. The verification code is not returned: the user has to read it off the requesting device.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param qrToken QR token scanned from the requesting device
+	@return ApiAuthSvcV1LoginQrQrTokenGetRequest
+*/
+func (a *QrAPIService) AuthSvcV1LoginQrQrTokenGet(ctx context.Context, qrToken uuid.UUID) ApiAuthSvcV1LoginQrQrTokenGetRequest {
+	return ApiAuthSvcV1LoginQrQrTokenGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		qrToken:    qrToken,
+	}
+}
+
+// Execute executes the request
+//
+//	@return QRLogin
+func (a *QrAPIService) AuthSvcV1LoginQrQrTokenGetExecute(r ApiAuthSvcV1LoginQrQrTokenGetRequest) (*QRLogin, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *QRLogin
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QrAPIService.AuthSvcV1LoginQrQrTokenGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/auth-svc/v1/login/qr/{qr_token}"
+	localVarPath = strings.Replace(localVarPath, "{"+"qr_token"+"}", url.PathEscape(parameterValueToString(r.qrToken, "qrToken")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiAuthSvcV1LoginQrRejectPostRequest struct {
+	ctx        context.Context
+	ApiService *QrAPIService
+	qRReject   *QRReject
+}
+
+func (r ApiAuthSvcV1LoginQrRejectPostRequest) QRReject(qRReject QRReject) ApiAuthSvcV1LoginQrRejectPostRequest {
+	r.qRReject = &qRReject
+	return r
+}
+
+func (r ApiAuthSvcV1LoginQrRejectPostRequest) Execute() (*http.Response, error) {
+	return r.ApiService.AuthSvcV1LoginQrRejectPostExecute(r)
+}
+
+/*
+AuthSvcV1LoginQrRejectPost Reject QR token
+
+Rejects a pending QR token, e.g. because the user does not recognise the requesting device. The desktop's SSE stream opened via GET /auth-svc/v1/login/qr gets an `error` event and closes.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiAuthSvcV1LoginQrRejectPostRequest
+*/
+func (a *QrAPIService) AuthSvcV1LoginQrRejectPost(ctx context.Context) ApiAuthSvcV1LoginQrRejectPostRequest {
+	return ApiAuthSvcV1LoginQrRejectPostRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+func (a *QrAPIService) AuthSvcV1LoginQrRejectPostExecute(r ApiAuthSvcV1LoginQrRejectPostRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QrAPIService.AuthSvcV1LoginQrRejectPost")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/auth-svc/v1/login/qr/reject"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.qRReject == nil {
+		return nil, reportError("qRReject is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.qRReject
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
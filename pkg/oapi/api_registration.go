@@ -39,7 +39,7 @@ func (r ApiAuthSvcV1RegistrationAdminPostRequest) Execute() (*User, *http.Respon
 /*
 AuthSvcV1RegistrationAdminPost Register a new admin user
 
-Registers a new user by an authenticated admin.
+Registers a new user by an authenticated admin, or by a netbill service whose client_credentials token carries the `users:create` scope.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiAuthSvcV1RegistrationAdminPostRequest
@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // SessionsAPIService SessionsAPI service
@@ -121,19 +122,199 @@ func (a *SessionsAPIService) AuthSvcV1MeLogoutPostExecute(r ApiAuthSvcV1MeLogout
 	return localVarHTTPResponse, nil
 }
 
-type ApiAuthSvcV1MeSessionsDeleteRequest struct {
+type ApiAuthSvcV1MeSecurityEventsGetRequest struct {
 	ctx        context.Context
 	ApiService *SessionsAPIService
+	page       *int32
+	size       *int32
+}
+
+// Page number (1-based).
+func (r ApiAuthSvcV1MeSecurityEventsGetRequest) Page(page int32) ApiAuthSvcV1MeSecurityEventsGetRequest {
+	r.page = &page
+	return r
+}
+
+// Max number of items per page (1-100).
+func (r ApiAuthSvcV1MeSecurityEventsGetRequest) Size(size int32) ApiAuthSvcV1MeSecurityEventsGetRequest {
+	r.size = &size
+	return r
+}
+
+func (r ApiAuthSvcV1MeSecurityEventsGetRequest) Execute() (*AuthEventsCollection, *http.Response, error) {
+	return r.ApiService.AuthSvcV1MeSecurityEventsGetExecute(r)
+}
+
+/*
+AuthSvcV1MeSecurityEventsGet Get my security events
+
+Returns the security audit log of the authenticated user, newest events first: logins and failed login attempts, refreshes, logouts, ended sessions and password changes.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiAuthSvcV1MeSecurityEventsGetRequest
+*/
+func (a *SessionsAPIService) AuthSvcV1MeSecurityEventsGet(ctx context.Context) ApiAuthSvcV1MeSecurityEventsGetRequest {
+	return ApiAuthSvcV1MeSecurityEventsGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return AuthEventsCollection
+func (a *SessionsAPIService) AuthSvcV1MeSecurityEventsGetExecute(r ApiAuthSvcV1MeSecurityEventsGetRequest) (*AuthEventsCollection, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *AuthEventsCollection
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionsAPIService.AuthSvcV1MeSecurityEventsGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/auth-svc/v1/me/security-events"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "form", "")
+	}
+	if r.size != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "size", r.size, "form", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 403 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiAuthSvcV1MeSessionsDeleteRequest struct {
+	ctx                  context.Context
+	ApiService           *SessionsAPIService
+	keepCurrent          *bool
+	filterLastUsedBefore *time.Time
+	filterCreatedBefore  *time.Time
+	filterId             *string
 }
 
-func (r ApiAuthSvcV1MeSessionsDeleteRequest) Execute() (*http.Response, error) {
+// Keep the session the request is made with.
+func (r ApiAuthSvcV1MeSessionsDeleteRequest) KeepCurrent(keepCurrent bool) ApiAuthSvcV1MeSessionsDeleteRequest {
+	r.keepCurrent = &keepCurrent
+	return r
+}
+
+// Only sessions last used before this time (RFC 3339).
+func (r ApiAuthSvcV1MeSessionsDeleteRequest) FilterLastUsedBefore(filterLastUsedBefore time.Time) ApiAuthSvcV1MeSessionsDeleteRequest {
+	r.filterLastUsedBefore = &filterLastUsedBefore
+	return r
+}
+
+// Only sessions created before this time (RFC 3339).
+func (r ApiAuthSvcV1MeSessionsDeleteRequest) FilterCreatedBefore(filterCreatedBefore time.Time) ApiAuthSvcV1MeSessionsDeleteRequest {
+	r.filterCreatedBefore = &filterCreatedBefore
+	return r
+}
+
+// Only the sessions with these ids, comma-separated UUIDs.
+func (r ApiAuthSvcV1MeSessionsDeleteRequest) FilterId(filterId string) ApiAuthSvcV1MeSessionsDeleteRequest {
+	r.filterId = &filterId
+	return r
+}
+
+func (r ApiAuthSvcV1MeSessionsDeleteRequest) Execute() (*SessionsRevoked, *http.Response, error) {
 	return r.ApiService.AuthSvcV1MeSessionsDeleteExecute(r)
 }
 
 /*
 AuthSvcV1MeSessionsDelete Delete my sessions
 
-Deletes all sessions of the authenticated user (logout from all devices).
+Deletes the active sessions of the authenticated user that match every filter given. Without filters deletes all of them, including the current one (logout from all devices); `keep_current=true` alone is "log out all other devices".
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiAuthSvcV1MeSessionsDeleteRequest
@@ -146,16 +327,19 @@ func (a *SessionsAPIService) AuthSvcV1MeSessionsDelete(ctx context.Context) ApiA
 }
 
 // Execute executes the request
-func (a *SessionsAPIService) AuthSvcV1MeSessionsDeleteExecute(r ApiAuthSvcV1MeSessionsDeleteRequest) (*http.Response, error) {
+//
+//	@return SessionsRevoked
+func (a *SessionsAPIService) AuthSvcV1MeSessionsDeleteExecute(r ApiAuthSvcV1MeSessionsDeleteRequest) (*SessionsRevoked, *http.Response, error) {
 	var (
-		localVarHTTPMethod = http.MethodDelete
-		localVarPostBody   interface{}
-		formFiles          []formFile
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *SessionsRevoked
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionsAPIService.AuthSvcV1MeSessionsDelete")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
 	localVarPath := localBasePath + "/auth-svc/v1/me/sessions"
@@ -164,6 +348,22 @@ func (a *SessionsAPIService) AuthSvcV1MeSessionsDeleteExecute(r ApiAuthSvcV1MeSe
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.keepCurrent != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "keep_current", r.keepCurrent, "form", "")
+	} else {
+		var defaultValue bool = false
+		parameterAddToHeaderOrQuery(localVarQueryParams, "keep_current", defaultValue, "form", "")
+		r.keepCurrent = &defaultValue
+	}
+	if r.filterLastUsedBefore != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "filter[last_used_before]", r.filterLastUsedBefore, "form", "")
+	}
+	if r.filterCreatedBefore != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "filter[created_before]", r.filterCreatedBefore, "form", "")
+	}
+	if r.filterId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "filter[id]", r.filterId, "form", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -183,19 +383,19 @@ func (a *SessionsAPIService) AuthSvcV1MeSessionsDeleteExecute(r ApiAuthSvcV1MeSe
 	}
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
-		return nil, err
+		return localVarReturnValue, nil, err
 	}
 
 	localVarHTTPResponse, err := a.client.callAPI(req)
 	if err != nil || localVarHTTPResponse == nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
 	if err != nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
@@ -203,31 +403,51 @@ func (a *SessionsAPIService) AuthSvcV1MeSessionsDeleteExecute(r ApiAuthSvcV1MeSe
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Errors
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
-				return localVarHTTPResponse, newErr
+				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
 			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
 			newErr.model = v
-			return localVarHTTPResponse, newErr
+			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Errors
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
-				return localVarHTTPResponse, newErr
+				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
 			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
 			newErr.model = v
 		}
-		return localVarHTTPResponse, newErr
+		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
-	return localVarHTTPResponse, nil
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
 type ApiAuthSvcV1MeSessionsGetRequest struct {
@@ -634,6 +854,164 @@ func (a *SessionsAPIService) AuthSvcV1MeSessionsSessionIdGetExecute(r ApiAuthSvc
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiAuthSvcV1MeSessionsSessionIdPatchRequest struct {
+	ctx           context.Context
+	ApiService    *SessionsAPIService
+	sessionId     uuid.UUID
+	updateSession *UpdateSession
+}
+
+func (r ApiAuthSvcV1MeSessionsSessionIdPatchRequest) UpdateSession(updateSession UpdateSession) ApiAuthSvcV1MeSessionsSessionIdPatchRequest {
+	r.updateSession = &updateSession
+	return r
+}
+
+func (r ApiAuthSvcV1MeSessionsSessionIdPatchRequest) Execute() (*UserSession, *http.Response, error) {
+	return r.ApiService.AuthSvcV1MeSessionsSessionIdPatchExecute(r)
+}
+
+/*
+AuthSvcV1MeSessionsSessionIdPatch Update my session
+
+Renames the device of a session of the authenticated user.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param sessionId Session ID
+	@return ApiAuthSvcV1MeSessionsSessionIdPatchRequest
+*/
+func (a *SessionsAPIService) AuthSvcV1MeSessionsSessionIdPatch(ctx context.Context, sessionId uuid.UUID) ApiAuthSvcV1MeSessionsSessionIdPatchRequest {
+	return ApiAuthSvcV1MeSessionsSessionIdPatchRequest{
+		ApiService: a,
+		ctx:        ctx,
+		sessionId:  sessionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return UserSession
+func (a *SessionsAPIService) AuthSvcV1MeSessionsSessionIdPatchExecute(r ApiAuthSvcV1MeSessionsSessionIdPatchRequest) (*UserSession, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPatch
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *UserSession
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionsAPIService.AuthSvcV1MeSessionsSessionIdPatch")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/auth-svc/v1/me/sessions/{session_id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"session_id"+"}", url.PathEscape(parameterValueToString(r.sessionId, "sessionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.updateSession == nil {
+		return localVarReturnValue, nil, reportError("updateSession is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.updateSession
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiAuthSvcV1RefreshPostRequest struct {
 	ctx            context.Context
 	ApiService     *SessionsAPIService
@@ -753,6 +1131,17 @@ func (a *SessionsAPIService) AuthSvcV1RefreshPostExecute(r ApiAuthSvcV1RefreshPo
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
+		if localVarHTTPResponse.StatusCode == 403 {
+			var v Errors
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 500 {
 			var v Errors
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
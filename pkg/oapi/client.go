@@ -48,8 +48,22 @@ type APIClient struct {
 
 	// API Services
 
+	AdminAPI *AdminAPIService
+
+	IdentitiesAPI *IdentitiesAPIService
+
+	KeysAPI *KeysAPIService
+
 	LoginAPI *LoginAPIService
 
+	MfaAPI *MfaAPIService
+
+	OauthClientsAPI *OauthClientsAPIService
+
+	OidcAPI *OidcAPIService
+
+	PasskeysAPI *PasskeysAPIService
+
 	QrAPI *QrAPIService
 
 	RegistrationAPI *RegistrationAPIService
@@ -75,7 +89,14 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
+	c.AdminAPI = (*AdminAPIService)(&c.common)
+	c.IdentitiesAPI = (*IdentitiesAPIService)(&c.common)
+	c.KeysAPI = (*KeysAPIService)(&c.common)
 	c.LoginAPI = (*LoginAPIService)(&c.common)
+	c.MfaAPI = (*MfaAPIService)(&c.common)
+	c.OauthClientsAPI = (*OauthClientsAPIService)(&c.common)
+	c.OidcAPI = (*OidcAPIService)(&c.common)
+	c.PasskeysAPI = (*PasskeysAPIService)(&c.common)
 	c.QrAPI = (*QrAPIService)(&c.common)
 	c.RegistrationAPI = (*RegistrationAPIService)(&c.common)
 	c.SessionsAPI = (*SessionsAPIService)(&c.common)
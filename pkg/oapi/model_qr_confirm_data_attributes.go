@@ -24,6 +24,8 @@ var _ MappedNullable = &QRConfirmDataAttributes{}
 type QRConfirmDataAttributes struct {
 	// The QR token received as the `qr_token` SSE event from GET /auth-svc/v1/login/qr.
 	QrToken uuid.UUID `json:"qr_token"`
+	// The verification code the requesting device shows next to the QR code, typed in by the user.
+	Code string `json:"code"`
 }
 
 type _QRConfirmDataAttributes QRConfirmDataAttributes
@@ -32,9 +34,10 @@ type _QRConfirmDataAttributes QRConfirmDataAttributes
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewQRConfirmDataAttributes(qrToken uuid.UUID) *QRConfirmDataAttributes {
+func NewQRConfirmDataAttributes(qrToken uuid.UUID, code string) *QRConfirmDataAttributes {
 	this := QRConfirmDataAttributes{}
 	this.QrToken = qrToken
+	this.Code = code
 	return &this
 }
 
@@ -70,6 +73,30 @@ func (o *QRConfirmDataAttributes) SetQrToken(v uuid.UUID) {
 	o.QrToken = v
 }
 
+// GetCode returns the Code field value
+func (o *QRConfirmDataAttributes) GetCode() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Code
+}
+
+// GetCodeOk returns a tuple with the Code field value
+// and a boolean to check if the value has been set.
+func (o *QRConfirmDataAttributes) GetCodeOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Code, true
+}
+
+// SetCode sets field value
+func (o *QRConfirmDataAttributes) SetCode(v string) {
+	o.Code = v
+}
+
 func (o QRConfirmDataAttributes) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -81,6 +108,7 @@ func (o QRConfirmDataAttributes) MarshalJSON() ([]byte, error) {
 func (o QRConfirmDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["qr_token"] = o.QrToken
+	toSerialize["code"] = o.Code
 	return toSerialize, nil
 }
 
@@ -90,6 +118,7 @@ func (o *QRConfirmDataAttributes) UnmarshalJSON(data []byte) (err error) {
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
 		"qr_token",
+		"code",
 	}
 
 	allProperties := make(map[string]interface{})
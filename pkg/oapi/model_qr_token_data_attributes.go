@@ -15,6 +15,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
+	"time"
 )
 
 // checks if the QRTokenDataAttributes type satisfies the MappedNullable interface at compile time
@@ -24,6 +25,10 @@ var _ MappedNullable = &QRTokenDataAttributes{}
 type QRTokenDataAttributes struct {
 	// Token to render as a QR code. Send it back via POST /auth-svc/v1/login/qr/confirm to complete the login.
 	QrToken uuid.UUID `json:"qr_token"`
+	// Verification code to display next to the QR code, not inside it. The confirming user has to type it in.
+	Code string `json:"code"`
+	// when the QR token expires unless confirmed
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 type _QRTokenDataAttributes QRTokenDataAttributes
@@ -32,9 +37,11 @@ type _QRTokenDataAttributes QRTokenDataAttributes
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewQRTokenDataAttributes(qrToken uuid.UUID) *QRTokenDataAttributes {
+func NewQRTokenDataAttributes(qrToken uuid.UUID, code string, expiresAt time.Time) *QRTokenDataAttributes {
 	this := QRTokenDataAttributes{}
 	this.QrToken = qrToken
+	this.Code = code
+	this.ExpiresAt = expiresAt
 	return &this
 }
 
@@ -70,6 +77,54 @@ func (o *QRTokenDataAttributes) SetQrToken(v uuid.UUID) {
 	o.QrToken = v
 }
 
+// GetCode returns the Code field value
+func (o *QRTokenDataAttributes) GetCode() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Code
+}
+
+// GetCodeOk returns a tuple with the Code field value
+// and a boolean to check if the value has been set.
+func (o *QRTokenDataAttributes) GetCodeOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Code, true
+}
+
+// SetCode sets field value
+func (o *QRTokenDataAttributes) SetCode(v string) {
+	o.Code = v
+}
+
+// GetExpiresAt returns the ExpiresAt field value
+func (o *QRTokenDataAttributes) GetExpiresAt() time.Time {
+	if o == nil {
+		var ret time.Time
+		return ret
+	}
+
+	return o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value
+// and a boolean to check if the value has been set.
+func (o *QRTokenDataAttributes) GetExpiresAtOk() (*time.Time, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.ExpiresAt, true
+}
+
+// SetExpiresAt sets field value
+func (o *QRTokenDataAttributes) SetExpiresAt(v time.Time) {
+	o.ExpiresAt = v
+}
+
 func (o QRTokenDataAttributes) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -81,6 +136,8 @@ func (o QRTokenDataAttributes) MarshalJSON() ([]byte, error) {
 func (o QRTokenDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["qr_token"] = o.QrToken
+	toSerialize["code"] = o.Code
+	toSerialize["expires_at"] = o.ExpiresAt
 	return toSerialize, nil
 }
 
@@ -90,6 +147,8 @@ func (o *QRTokenDataAttributes) UnmarshalJSON(data []byte) (err error) {
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
 		"qr_token",
+		"code",
+		"expires_at",
 	}
 
 	allProperties := make(map[string]interface{})
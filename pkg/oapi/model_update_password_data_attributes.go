@@ -21,8 +21,8 @@ var _ MappedNullable = &UpdatePasswordDataAttributes{}
 
 // UpdatePasswordDataAttributes struct for UpdatePasswordDataAttributes
 type UpdatePasswordDataAttributes struct {
-	// The user's current password.
-	OldPassword string `json:"old_password"`
+	// The user's current password. Omit it to set the first password of an account created through Google.
+	OldPassword *string `json:"old_password,omitempty"`
 	// The user's password.
 	NewPassword string `json:"new_password"`
 }
@@ -33,9 +33,8 @@ type _UpdatePasswordDataAttributes UpdatePasswordDataAttributes
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewUpdatePasswordDataAttributes(oldPassword string, newPassword string) *UpdatePasswordDataAttributes {
+func NewUpdatePasswordDataAttributes(newPassword string) *UpdatePasswordDataAttributes {
 	this := UpdatePasswordDataAttributes{}
-	this.OldPassword = oldPassword
 	this.NewPassword = newPassword
 	return &this
 }
@@ -48,28 +47,36 @@ func NewUpdatePasswordDataAttributesWithDefaults() *UpdatePasswordDataAttributes
 	return &this
 }
 
-// GetOldPassword returns the OldPassword field value
+// GetOldPassword returns the OldPassword field value if set, zero value otherwise.
 func (o *UpdatePasswordDataAttributes) GetOldPassword() string {
-	if o == nil {
+	if o == nil || IsNil(o.OldPassword) {
 		var ret string
 		return ret
 	}
-
-	return o.OldPassword
+	return *o.OldPassword
 }
 
-// GetOldPasswordOk returns a tuple with the OldPassword field value
+// GetOldPasswordOk returns a tuple with the OldPassword field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *UpdatePasswordDataAttributes) GetOldPasswordOk() (*string, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.OldPassword) {
 		return nil, false
 	}
-	return &o.OldPassword, true
+	return o.OldPassword, true
+}
+
+// HasOldPassword returns a boolean if a field has been set.
+func (o *UpdatePasswordDataAttributes) HasOldPassword() bool {
+	if o != nil && !IsNil(o.OldPassword) {
+		return true
+	}
+
+	return false
 }
 
-// SetOldPassword sets field value
+// SetOldPassword gets a reference to the given string and assigns it to the OldPassword field.
 func (o *UpdatePasswordDataAttributes) SetOldPassword(v string) {
-	o.OldPassword = v
+	o.OldPassword = &v
 }
 
 // GetNewPassword returns the NewPassword field value
@@ -106,7 +113,9 @@ func (o UpdatePasswordDataAttributes) MarshalJSON() ([]byte, error) {
 
 func (o UpdatePasswordDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
-	toSerialize["old_password"] = o.OldPassword
+	if !IsNil(o.OldPassword) {
+		toSerialize["old_password"] = o.OldPassword
+	}
 	toSerialize["new_password"] = o.NewPassword
 	return toSerialize, nil
 }
@@ -116,7 +125,6 @@ func (o *UpdatePasswordDataAttributes) UnmarshalJSON(data []byte) (err error) {
 	// by unmarshalling the object into a generic map with string keys and checking
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
-		"old_password",
 		"new_password",
 	}
 
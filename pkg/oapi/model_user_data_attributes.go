@@ -14,6 +14,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"github.com/google/uuid"
 	"time"
 )
 
@@ -38,6 +39,16 @@ type UserDataAttributes struct {
 	CreatedAt time.Time `json:"created_at"`
 	// The date and time when the user was last updated
 	UpdatedAt time.Time `json:"updated_at"`
+	// When the user was suspended. The suspension is in force until `suspended_until`, or until lifted if that is not set
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
+	// When the suspension ends by itself
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+	// Admin who suspended the user; only visible through the admin API
+	SuspendedBy *uuid.UUID `json:"suspended_by,omitempty"`
+	// Why the user was suspended; only visible through the admin API
+	SuspensionReason *string `json:"suspension_reason,omitempty"`
+	// When the user was soft-deleted; only visible through the admin API
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 type _UserDataAttributes UserDataAttributes
@@ -280,6 +291,166 @@ func (o *UserDataAttributes) SetUpdatedAt(v time.Time) {
 	o.UpdatedAt = v
 }
 
+// GetSuspendedAt returns the SuspendedAt field value if set, zero value otherwise.
+func (o *UserDataAttributes) GetSuspendedAt() time.Time {
+	if o == nil || IsNil(o.SuspendedAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.SuspendedAt
+}
+
+// GetSuspendedAtOk returns a tuple with the SuspendedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UserDataAttributes) GetSuspendedAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.SuspendedAt) {
+		return nil, false
+	}
+	return o.SuspendedAt, true
+}
+
+// HasSuspendedAt returns a boolean if a field has been set.
+func (o *UserDataAttributes) HasSuspendedAt() bool {
+	if o != nil && !IsNil(o.SuspendedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetSuspendedAt gets a reference to the given time.Time and assigns it to the SuspendedAt field.
+func (o *UserDataAttributes) SetSuspendedAt(v time.Time) {
+	o.SuspendedAt = &v
+}
+
+// GetSuspendedUntil returns the SuspendedUntil field value if set, zero value otherwise.
+func (o *UserDataAttributes) GetSuspendedUntil() time.Time {
+	if o == nil || IsNil(o.SuspendedUntil) {
+		var ret time.Time
+		return ret
+	}
+	return *o.SuspendedUntil
+}
+
+// GetSuspendedUntilOk returns a tuple with the SuspendedUntil field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UserDataAttributes) GetSuspendedUntilOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.SuspendedUntil) {
+		return nil, false
+	}
+	return o.SuspendedUntil, true
+}
+
+// HasSuspendedUntil returns a boolean if a field has been set.
+func (o *UserDataAttributes) HasSuspendedUntil() bool {
+	if o != nil && !IsNil(o.SuspendedUntil) {
+		return true
+	}
+
+	return false
+}
+
+// SetSuspendedUntil gets a reference to the given time.Time and assigns it to the SuspendedUntil field.
+func (o *UserDataAttributes) SetSuspendedUntil(v time.Time) {
+	o.SuspendedUntil = &v
+}
+
+// GetSuspendedBy returns the SuspendedBy field value if set, zero value otherwise.
+func (o *UserDataAttributes) GetSuspendedBy() uuid.UUID {
+	if o == nil || IsNil(o.SuspendedBy) {
+		var ret uuid.UUID
+		return ret
+	}
+	return *o.SuspendedBy
+}
+
+// GetSuspendedByOk returns a tuple with the SuspendedBy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UserDataAttributes) GetSuspendedByOk() (*uuid.UUID, bool) {
+	if o == nil || IsNil(o.SuspendedBy) {
+		return nil, false
+	}
+	return o.SuspendedBy, true
+}
+
+// HasSuspendedBy returns a boolean if a field has been set.
+func (o *UserDataAttributes) HasSuspendedBy() bool {
+	if o != nil && !IsNil(o.SuspendedBy) {
+		return true
+	}
+
+	return false
+}
+
+// SetSuspendedBy gets a reference to the given uuid.UUID and assigns it to the SuspendedBy field.
+func (o *UserDataAttributes) SetSuspendedBy(v uuid.UUID) {
+	o.SuspendedBy = &v
+}
+
+// GetSuspensionReason returns the SuspensionReason field value if set, zero value otherwise.
+func (o *UserDataAttributes) GetSuspensionReason() string {
+	if o == nil || IsNil(o.SuspensionReason) {
+		var ret string
+		return ret
+	}
+	return *o.SuspensionReason
+}
+
+// GetSuspensionReasonOk returns a tuple with the SuspensionReason field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UserDataAttributes) GetSuspensionReasonOk() (*string, bool) {
+	if o == nil || IsNil(o.SuspensionReason) {
+		return nil, false
+	}
+	return o.SuspensionReason, true
+}
+
+// HasSuspensionReason returns a boolean if a field has been set.
+func (o *UserDataAttributes) HasSuspensionReason() bool {
+	if o != nil && !IsNil(o.SuspensionReason) {
+		return true
+	}
+
+	return false
+}
+
+// SetSuspensionReason gets a reference to the given string and assigns it to the SuspensionReason field.
+func (o *UserDataAttributes) SetSuspensionReason(v string) {
+	o.SuspensionReason = &v
+}
+
+// GetDeletedAt returns the DeletedAt field value if set, zero value otherwise.
+func (o *UserDataAttributes) GetDeletedAt() time.Time {
+	if o == nil || IsNil(o.DeletedAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.DeletedAt
+}
+
+// GetDeletedAtOk returns a tuple with the DeletedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UserDataAttributes) GetDeletedAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.DeletedAt) {
+		return nil, false
+	}
+	return o.DeletedAt, true
+}
+
+// HasDeletedAt returns a boolean if a field has been set.
+func (o *UserDataAttributes) HasDeletedAt() bool {
+	if o != nil && !IsNil(o.DeletedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeletedAt gets a reference to the given time.Time and assigns it to the DeletedAt field.
+func (o *UserDataAttributes) SetDeletedAt(v time.Time) {
+	o.DeletedAt = &v
+}
+
 func (o UserDataAttributes) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -304,6 +475,21 @@ func (o UserDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize["version"] = o.Version
 	toSerialize["created_at"] = o.CreatedAt
 	toSerialize["updated_at"] = o.UpdatedAt
+	if !IsNil(o.SuspendedAt) {
+		toSerialize["suspended_at"] = o.SuspendedAt
+	}
+	if !IsNil(o.SuspendedUntil) {
+		toSerialize["suspended_until"] = o.SuspendedUntil
+	}
+	if !IsNil(o.SuspendedBy) {
+		toSerialize["suspended_by"] = o.SuspendedBy
+	}
+	if !IsNil(o.SuspensionReason) {
+		toSerialize["suspension_reason"] = o.SuspensionReason
+	}
+	if !IsNil(o.DeletedAt) {
+		toSerialize["deleted_at"] = o.DeletedAt
+	}
 	return toSerialize, nil
 }
 
@@ -25,6 +25,16 @@ var _ MappedNullable = &UserSessionAttributes{}
 type UserSessionAttributes struct {
 	// user id
 	UserId uuid.UUID `json:"user_id"`
+	// User-Agent of the client that last opened or refreshed the session
+	UserAgent *string `json:"user_agent,omitempty"`
+	// IP address of the client that last opened or refreshed the session
+	ClientIp *string `json:"client_ip,omitempty"`
+	// operating system family parsed from the user agent
+	Platform *string `json:"platform,omitempty"`
+	// browser family parsed from the user agent
+	Browser *string `json:"browser,omitempty"`
+	// device name set by the user
+	DeviceName *string `json:"device_name,omitempty"`
 	// session creation date
 	CreatedAt time.Time `json:"created_at"`
 	// The version number of the user record
@@ -85,6 +95,166 @@ func (o *UserSessionAttributes) SetUserId(v uuid.UUID) {
 	o.UserId = v
 }
 
+// GetUserAgent returns the UserAgent field value if set, zero value otherwise.
+func (o *UserSessionAttributes) GetUserAgent() string {
+	if o == nil || IsNil(o.UserAgent) {
+		var ret string
+		return ret
+	}
+	return *o.UserAgent
+}
+
+// GetUserAgentOk returns a tuple with the UserAgent field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UserSessionAttributes) GetUserAgentOk() (*string, bool) {
+	if o == nil || IsNil(o.UserAgent) {
+		return nil, false
+	}
+	return o.UserAgent, true
+}
+
+// HasUserAgent returns a boolean if a field has been set.
+func (o *UserSessionAttributes) HasUserAgent() bool {
+	if o != nil && !IsNil(o.UserAgent) {
+		return true
+	}
+
+	return false
+}
+
+// SetUserAgent gets a reference to the given string and assigns it to the UserAgent field.
+func (o *UserSessionAttributes) SetUserAgent(v string) {
+	o.UserAgent = &v
+}
+
+// GetClientIp returns the ClientIp field value if set, zero value otherwise.
+func (o *UserSessionAttributes) GetClientIp() string {
+	if o == nil || IsNil(o.ClientIp) {
+		var ret string
+		return ret
+	}
+	return *o.ClientIp
+}
+
+// GetClientIpOk returns a tuple with the ClientIp field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UserSessionAttributes) GetClientIpOk() (*string, bool) {
+	if o == nil || IsNil(o.ClientIp) {
+		return nil, false
+	}
+	return o.ClientIp, true
+}
+
+// HasClientIp returns a boolean if a field has been set.
+func (o *UserSessionAttributes) HasClientIp() bool {
+	if o != nil && !IsNil(o.ClientIp) {
+		return true
+	}
+
+	return false
+}
+
+// SetClientIp gets a reference to the given string and assigns it to the ClientIp field.
+func (o *UserSessionAttributes) SetClientIp(v string) {
+	o.ClientIp = &v
+}
+
+// GetPlatform returns the Platform field value if set, zero value otherwise.
+func (o *UserSessionAttributes) GetPlatform() string {
+	if o == nil || IsNil(o.Platform) {
+		var ret string
+		return ret
+	}
+	return *o.Platform
+}
+
+// GetPlatformOk returns a tuple with the Platform field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UserSessionAttributes) GetPlatformOk() (*string, bool) {
+	if o == nil || IsNil(o.Platform) {
+		return nil, false
+	}
+	return o.Platform, true
+}
+
+// HasPlatform returns a boolean if a field has been set.
+func (o *UserSessionAttributes) HasPlatform() bool {
+	if o != nil && !IsNil(o.Platform) {
+		return true
+	}
+
+	return false
+}
+
+// SetPlatform gets a reference to the given string and assigns it to the Platform field.
+func (o *UserSessionAttributes) SetPlatform(v string) {
+	o.Platform = &v
+}
+
+// GetBrowser returns the Browser field value if set, zero value otherwise.
+func (o *UserSessionAttributes) GetBrowser() string {
+	if o == nil || IsNil(o.Browser) {
+		var ret string
+		return ret
+	}
+	return *o.Browser
+}
+
+// GetBrowserOk returns a tuple with the Browser field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UserSessionAttributes) GetBrowserOk() (*string, bool) {
+	if o == nil || IsNil(o.Browser) {
+		return nil, false
+	}
+	return o.Browser, true
+}
+
+// HasBrowser returns a boolean if a field has been set.
+func (o *UserSessionAttributes) HasBrowser() bool {
+	if o != nil && !IsNil(o.Browser) {
+		return true
+	}
+
+	return false
+}
+
+// SetBrowser gets a reference to the given string and assigns it to the Browser field.
+func (o *UserSessionAttributes) SetBrowser(v string) {
+	o.Browser = &v
+}
+
+// GetDeviceName returns the DeviceName field value if set, zero value otherwise.
+func (o *UserSessionAttributes) GetDeviceName() string {
+	if o == nil || IsNil(o.DeviceName) {
+		var ret string
+		return ret
+	}
+	return *o.DeviceName
+}
+
+// GetDeviceNameOk returns a tuple with the DeviceName field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UserSessionAttributes) GetDeviceNameOk() (*string, bool) {
+	if o == nil || IsNil(o.DeviceName) {
+		return nil, false
+	}
+	return o.DeviceName, true
+}
+
+// HasDeviceName returns a boolean if a field has been set.
+func (o *UserSessionAttributes) HasDeviceName() bool {
+	if o != nil && !IsNil(o.DeviceName) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeviceName gets a reference to the given string and assigns it to the DeviceName field.
+func (o *UserSessionAttributes) SetDeviceName(v string) {
+	o.DeviceName = &v
+}
+
 // GetCreatedAt returns the CreatedAt field value
 func (o *UserSessionAttributes) GetCreatedAt() time.Time {
 	if o == nil {
@@ -224,6 +394,21 @@ func (o UserSessionAttributes) MarshalJSON() ([]byte, error) {
 func (o UserSessionAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["user_id"] = o.UserId
+	if !IsNil(o.UserAgent) {
+		toSerialize["user_agent"] = o.UserAgent
+	}
+	if !IsNil(o.ClientIp) {
+		toSerialize["client_ip"] = o.ClientIp
+	}
+	if !IsNil(o.Platform) {
+		toSerialize["platform"] = o.Platform
+	}
+	if !IsNil(o.Browser) {
+		toSerialize["browser"] = o.Browser
+	}
+	if !IsNil(o.DeviceName) {
+		toSerialize["device_name"] = o.DeviceName
+	}
 	toSerialize["created_at"] = o.CreatedAt
 	toSerialize["version"] = o.Version
 	toSerialize["last_used"] = o.LastUsed
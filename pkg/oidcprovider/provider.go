@@ -0,0 +1,215 @@
+// Package oidcprovider logs users in through external OpenID Connect
+// providers. It reads a provider's endpoints from its discovery document,
+// runs the authorization code flow against it and verifies the ID tokens it
+// issues locally, against the provider's published JWKS.
+package oidcprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// GoogleIssuer is Google's issuer. Its ID tokens also come with the
+	// bare "accounts.google.com", which is accepted for it.
+	GoogleIssuer     = "https://accounts.google.com"
+	googleIssuerBare = "accounts.google.com"
+
+	discoveryPath = "/.well-known/openid-configuration"
+)
+
+// DefaultScopes are requested when a provider is configured without any.
+var DefaultScopes = []string{"openid", "email", "profile"}
+
+// Config describes one provider we log users in with. Name is what routes
+// and linked identities refer to it by; DiscoveryURL defaults to the
+// issuer's well-known discovery document.
+type Config struct {
+	Name         string
+	Issuer       string
+	DiscoveryURL string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// Provider is a configured OpenID Connect provider. Its discovery document
+// is fetched on first use, so a provider that is down at start-up only
+// breaks its own logins.
+type Provider struct {
+	config     Config
+	httpClient *http.Client
+	verifier   *Verifier
+
+	mu        sync.Mutex
+	discovery *discovery
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+func New(cfg Config) (*Provider, error) {
+	if cfg.Name == "" {
+		return nil, errors.New("oidc provider name is empty")
+	}
+	if cfg.Issuer == "" {
+		return nil, fmt.Errorf("oidc provider %s: issuer is empty", cfg.Name)
+	}
+	if cfg.ClientID == "" {
+		return nil, fmt.Errorf("oidc provider %s: client id is empty", cfg.Name)
+	}
+
+	if cfg.DiscoveryURL == "" {
+		cfg.DiscoveryURL = strings.TrimSuffix(cfg.Issuer, "/") + discoveryPath
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = DefaultScopes
+	}
+
+	issuers := []string{cfg.Issuer}
+	if cfg.Issuer == GoogleIssuer {
+		issuers = append(issuers, googleIssuerBare)
+	}
+
+	p := &Provider{
+		config:     cfg,
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+	}
+	p.verifier = NewVerifier(issuers, cfg.ClientID, p.jwksURL, p.httpClient)
+
+	return p, nil
+}
+
+func (p *Provider) Name() string {
+	return p.config.Name
+}
+
+// AuthCodeURL is the provider's consent screen for one login attempt:
+// state and nonce come back on the callback and in the ID token, and the
+// PKCE challenge is derived from codeVerifier.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	cfg, err := p.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.AuthCodeURL(
+		state,
+		oauth2.SetAuthURLParam("nonce", nonce),
+		oauth2.S256ChallengeOption(codeVerifier),
+	), nil
+}
+
+// Exchange trades the authorization code from the callback for tokens and
+// returns the ID token among them, unverified.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (string, error) {
+	cfg, err := p.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	token, err := cfg.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return "", fmt.Errorf("exchange authorization code: %w", err)
+	}
+
+	idToken, _ := token.Extra("id_token").(string)
+	if idToken == "" {
+		return "", errors.New("token response has no id token")
+	}
+
+	return idToken, nil
+}
+
+// Verify checks an ID token issued to our client by this provider.
+func (p *Provider) Verify(ctx context.Context, idToken string) (Identity, error) {
+	return p.verifier.Verify(ctx, idToken)
+}
+
+// VerifyNonce is Verify for an ID token from our own authorization request.
+func (p *Provider) VerifyNonce(ctx context.Context, idToken, nonce string) (Identity, error) {
+	return p.verifier.VerifyNonce(ctx, idToken, nonce)
+}
+
+func (p *Provider) oauth2Config(ctx context.Context) (oauth2.Config, error) {
+	d, err := p.discover(ctx)
+	if err != nil {
+		return oauth2.Config{}, err
+	}
+
+	return oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Scopes:       p.config.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  d.AuthorizationEndpoint,
+			TokenURL: d.TokenEndpoint,
+		},
+	}, nil
+}
+
+func (p *Provider) jwksURL(ctx context.Context) (string, error) {
+	d, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return d.JWKSURI, nil
+}
+
+func (p *Provider) discover(ctx context.Context) (discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return *p.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.DiscoveryURL, nil)
+	if err != nil {
+		return discovery{}, fmt.Errorf("build %s discovery request: %w", p.config.Name, err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return discovery{}, fmt.Errorf("fetch %s discovery document: %w", p.config.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return discovery{}, fmt.Errorf("fetch %s discovery document: unexpected status %d",
+			p.config.Name, resp.StatusCode)
+	}
+
+	var d discovery
+	if err = json.NewDecoder(resp.Body).Decode(&d); err != nil {
+		return discovery{}, fmt.Errorf("decode %s discovery document: %w", p.config.Name, err)
+	}
+
+	// A document that names another issuer could hand us someone else's
+	// endpoints and keys.
+	if d.Issuer != p.config.Issuer {
+		return discovery{}, fmt.Errorf("%s discovery document is for issuer %q, want %q",
+			p.config.Name, d.Issuer, p.config.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return discovery{}, fmt.Errorf("%s discovery document lacks required endpoints", p.config.Name)
+	}
+
+	p.discovery = &d
+	return d, nil
+}
@@ -0,0 +1,391 @@
+package oidcprovider
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const testClientID = "test-client"
+
+// fakeIssuer is an OpenID Connect provider on httptest: a discovery
+// document, a JWKS with one key and a token endpoint that answers every
+// code with idToken.
+type fakeIssuer struct {
+	srv *httptest.Server
+
+	kid string
+	key crypto.Signer
+
+	idToken    string
+	tokenForm  url.Values
+	jwksServed atomic.Int32
+}
+
+func startFakeIssuer(t *testing.T, key crypto.Signer) *fakeIssuer {
+	t.Helper()
+
+	f := &fakeIssuer{kid: "kid-1", key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":                 f.srv.URL,
+			"authorization_endpoint": f.srv.URL + "/authorize",
+			"token_endpoint":         f.srv.URL + "/token",
+			"jwks_uri":               f.srv.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		f.jwksServed.Add(1)
+		writeJSON(w, map[string]any{"keys": []map[string]string{publicJWK(t, f.kid, f.key.Public())}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		f.tokenForm = r.PostForm
+		writeJSON(w, map[string]any{
+			"access_token": "provider-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     f.idToken,
+		})
+	})
+
+	f.srv = httptest.NewServer(mux)
+	t.Cleanup(f.srv.Close)
+
+	return f
+}
+
+func (f *fakeIssuer) provider(t *testing.T) *Provider {
+	t.Helper()
+
+	p, err := New(Config{
+		Name:         "fake",
+		Issuer:       f.srv.URL,
+		ClientID:     testClientID,
+		ClientSecret: "secret",
+		RedirectURL:  "https://auth.example.com/v1/login/fake/callback",
+	})
+	require.NoError(t, err)
+
+	return p
+}
+
+func (f *fakeIssuer) claims() claims {
+	now := time.Now()
+	return claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    f.srv.URL,
+			Subject:   "110169484474386276334",
+			Audience:  jwt.ClaimStrings{testClientID},
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+		Email:         "user@example.com",
+		EmailVerified: true,
+	}
+}
+
+func (f *fakeIssuer) sign(t *testing.T, c claims) string {
+	t.Helper()
+	return signToken(t, f.key, f.kid, c)
+}
+
+func signToken(t *testing.T, key crypto.Signer, kid string, c claims) string {
+	t.Helper()
+
+	method := jwt.SigningMethod(jwt.SigningMethodRS256)
+	if _, ok := key.(*ecdsa.PrivateKey); ok {
+		method = jwt.SigningMethodES256
+	}
+
+	token := jwt.NewWithClaims(method, c)
+	token.Header["kid"] = kid
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func publicJWK(t *testing.T, kid string, pub crypto.PublicKey) map[string]string {
+	t.Helper()
+
+	enc := base64.RawURLEncoding.EncodeToString
+
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   enc(pub.N.Bytes()),
+			"e":   enc(big.NewInt(int64(pub.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		return map[string]string{
+			"kty": "EC",
+			"kid": kid,
+			"crv": "P-256",
+			"x":   enc(pub.X.FillBytes(make([]byte, 32))),
+			"y":   enc(pub.Y.FillBytes(make([]byte, 32))),
+		}
+	default:
+		t.Fatalf("unsupported key %T", pub)
+		return nil
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func rsaKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func TestProvider_Verify_Success(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	identity, err := f.provider(t).Verify(t.Context(), f.sign(t, f.claims()))
+	require.NoError(t, err)
+	require.Equal(t, Identity{
+		Subject:       "110169484474386276334",
+		Email:         "user@example.com",
+		EmailVerified: true,
+	}, identity)
+}
+
+func TestProvider_Verify_ECKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	f := startFakeIssuer(t, key)
+
+	identity, err := f.provider(t).Verify(t.Context(), f.sign(t, f.claims()))
+	require.NoError(t, err)
+	require.Equal(t, "110169484474386276334", identity.Subject)
+}
+
+func TestProvider_Verify_EmailNotVerified(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	c := f.claims()
+	c.EmailVerified = false
+
+	identity, err := f.provider(t).Verify(t.Context(), f.sign(t, c))
+	require.NoError(t, err)
+	require.False(t, identity.EmailVerified)
+}
+
+func TestProvider_Verify_EmailVerifiedAsString(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	c := f.claims()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            c.Issuer,
+		"sub":            c.Subject,
+		"aud":            testClientID,
+		"exp":            c.ExpiresAt.Unix(),
+		"email":          c.Email,
+		"email_verified": "true",
+	})
+	token.Header["kid"] = f.kid
+	signed, err := token.SignedString(f.key)
+	require.NoError(t, err)
+
+	identity, err := f.provider(t).Verify(t.Context(), signed)
+	require.NoError(t, err)
+	require.True(t, identity.EmailVerified)
+}
+
+func TestProvider_Verify_NoSubject(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	c := f.claims()
+	c.Subject = ""
+
+	_, err := f.provider(t).Verify(t.Context(), f.sign(t, c))
+	require.Error(t, err)
+}
+
+func TestProvider_Verify_WrongAudience(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	c := f.claims()
+	c.Audience = jwt.ClaimStrings{"someone-else"}
+
+	_, err := f.provider(t).Verify(t.Context(), f.sign(t, c))
+	require.Error(t, err)
+}
+
+func TestProvider_Verify_WrongIssuer(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	c := f.claims()
+	c.Issuer = "https://evil.example.com"
+
+	_, err := f.provider(t).Verify(t.Context(), f.sign(t, c))
+	require.Error(t, err)
+}
+
+func TestProvider_Verify_Expired(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	c := f.claims()
+	c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+	_, err := f.provider(t).Verify(t.Context(), f.sign(t, c))
+	require.Error(t, err)
+}
+
+func TestProvider_Verify_UnknownKid(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+	p := f.provider(t)
+
+	// The JWKS only advertises the issuer's key; the token is signed with
+	// another one under a kid that isn't in the set.
+	token := signToken(t, rsaKey(t), "kid-unknown", f.claims())
+
+	_, err := p.Verify(t.Context(), token)
+	require.Error(t, err)
+
+	// Made-up kids right after a download do not fetch the set again.
+	_, err = p.Verify(t.Context(), token)
+	require.Error(t, err)
+	require.EqualValues(t, 1, f.jwksServed.Load())
+}
+
+func TestProvider_Verify_ForgedSignatureSameKid(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	// The token claims the issuer's kid but is signed with another key.
+	token := signToken(t, rsaKey(t), f.kid, f.claims())
+
+	_, err := f.provider(t).Verify(t.Context(), token)
+	require.Error(t, err)
+}
+
+func TestProvider_Verify_HS256Refused(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, f.claims())
+	token.Header["kid"] = f.kid
+	signed, err := token.SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	_, err = f.provider(t).Verify(t.Context(), signed)
+	require.Error(t, err)
+}
+
+func TestProvider_VerifyNonce(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+	p := f.provider(t)
+
+	c := f.claims()
+	c.Nonce = "n-0S6_WzA2Mj"
+	token := f.sign(t, c)
+
+	identity, err := p.VerifyNonce(t.Context(), token, "n-0S6_WzA2Mj")
+	require.NoError(t, err)
+	require.Equal(t, "user@example.com", identity.Email)
+
+	_, err = p.VerifyNonce(t.Context(), token, "another-login")
+	require.Error(t, err)
+
+	_, err = p.VerifyNonce(t.Context(), f.sign(t, f.claims()), "")
+	require.Error(t, err)
+}
+
+func TestProvider_AuthCodeURL(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	raw, err := f.provider(t).AuthCodeURL(t.Context(), "state-1", "nonce-1", "verifier-1")
+	require.NoError(t, err)
+
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	require.Equal(t, f.srv.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+
+	q := u.Query()
+	require.Equal(t, "code", q.Get("response_type"))
+	require.Equal(t, testClientID, q.Get("client_id"))
+	require.Equal(t, "state-1", q.Get("state"))
+	require.Equal(t, "nonce-1", q.Get("nonce"))
+	require.Equal(t, "openid email profile", q.Get("scope"))
+	require.Equal(t, "S256", q.Get("code_challenge_method"))
+	require.NotEmpty(t, q.Get("code_challenge"))
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+	f.idToken = f.sign(t, f.claims())
+
+	idToken, err := f.provider(t).Exchange(t.Context(), "code-1", "verifier-1")
+	require.NoError(t, err)
+	require.Equal(t, f.idToken, idToken)
+	require.Equal(t, "code-1", f.tokenForm.Get("code"))
+	require.Equal(t, "verifier-1", f.tokenForm.Get("code_verifier"))
+}
+
+func TestProvider_Exchange_NoIDToken(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	_, err := f.provider(t).Exchange(t.Context(), "code-1", "verifier-1")
+	require.Error(t, err)
+}
+
+func TestProvider_DiscoveryIssuerMismatch(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	p, err := New(Config{
+		Name:         "fake",
+		Issuer:       "https://issuer.example.com",
+		DiscoveryURL: f.srv.URL + discoveryPath,
+		ClientID:     testClientID,
+	})
+	require.NoError(t, err)
+
+	_, err = p.AuthCodeURL(t.Context(), "state-1", "nonce-1", "verifier-1")
+	require.Error(t, err)
+}
+
+func TestRegistry(t *testing.T) {
+	f := startFakeIssuer(t, rsaKey(t))
+
+	r, err := NewRegistry(Config{Name: "fake", Issuer: f.srv.URL, ClientID: testClientID})
+	require.NoError(t, err)
+
+	identity, err := r.Verify(t.Context(), "fake", f.sign(t, f.claims()))
+	require.NoError(t, err)
+	require.Equal(t, "110169484474386276334", identity.Subject)
+
+	_, err = r.Verify(t.Context(), "other", f.sign(t, f.claims()))
+	require.ErrorIs(t, err, ErrUnknownProvider)
+
+	_, err = NewRegistry(
+		Config{Name: "fake", Issuer: f.srv.URL, ClientID: testClientID},
+		Config{Name: "fake", Issuer: f.srv.URL, ClientID: testClientID},
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package oidcprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownProvider is returned for a provider name nobody configured.
+var ErrUnknownProvider = errors.New("unknown oidc provider")
+
+// Registry holds the configured providers by name.
+type Registry struct {
+	providers map[string]*Provider
+}
+
+func NewRegistry(configs ...Config) (*Registry, error) {
+	r := &Registry{providers: make(map[string]*Provider, len(configs))}
+
+	for _, cfg := range configs {
+		if _, ok := r.providers[cfg.Name]; ok {
+			return nil, fmt.Errorf("oidc provider %s is configured twice", cfg.Name)
+		}
+
+		p, err := New(cfg)
+		if err != nil {
+			return nil, err
+		}
+		r.providers[cfg.Name] = p
+	}
+
+	return r, nil
+}
+
+// Has reports whether a provider is configured under name.
+func (r *Registry) Has(name string) bool {
+	_, ok := r.providers[name]
+	return ok
+}
+
+func (r *Registry) Provider(name string) (*Provider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownProvider, name)
+	}
+	return p, nil
+}
+
+// AuthCodeURL is Provider.AuthCodeURL of the named provider.
+func (r *Registry) AuthCodeURL(ctx context.Context, provider, state, nonce, codeVerifier string) (string, error) {
+	p, err := r.Provider(provider)
+	if err != nil {
+		return "", err
+	}
+	return p.AuthCodeURL(ctx, state, nonce, codeVerifier)
+}
+
+// Exchange is Provider.Exchange of the named provider.
+func (r *Registry) Exchange(ctx context.Context, provider, code, codeVerifier string) (string, error) {
+	p, err := r.Provider(provider)
+	if err != nil {
+		return "", err
+	}
+	return p.Exchange(ctx, code, codeVerifier)
+}
+
+// Verify is Provider.Verify of the named provider.
+func (r *Registry) Verify(ctx context.Context, provider, idToken string) (Identity, error) {
+	p, err := r.Provider(provider)
+	if err != nil {
+		return Identity{}, err
+	}
+	return p.Verify(ctx, idToken)
+}
+
+// VerifyNonce is Provider.VerifyNonce of the named provider.
+func (r *Registry) VerifyNonce(ctx context.Context, provider, idToken, nonce string) (Identity, error) {
+	p, err := r.Provider(provider)
+	if err != nil {
+		return Identity{}, err
+	}
+	return p.VerifyNonce(ctx, idToken, nonce)
+}
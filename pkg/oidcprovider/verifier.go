@@ -0,0 +1,368 @@
+package oidcprovider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	keysTTL = time.Hour
+
+	// keysRefetchInterval bounds how often a token signed with a key we do
+	// not know makes us download the JWKS again, so a stream of made-up kids
+	// cannot turn into a stream of requests to the provider.
+	keysRefetchInterval = time.Minute
+)
+
+// signingMethods are the ID token algorithms we accept. Symmetric ones are
+// left out on purpose: the client secret is not a key anyone should verify
+// a login with.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+type claims struct {
+	jwt.RegisteredClaims
+	Email         string   `json:"email"`
+	EmailVerified flexBool `json:"email_verified"`
+	Nonce         string   `json:"nonce"`
+}
+
+// flexBool accepts both true and "true": some providers send
+// email_verified as a string.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	switch v := v.(type) {
+	case bool:
+		*b = flexBool(v)
+	case string:
+		*b = v == "true"
+	case nil:
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	return nil
+}
+
+// Identity is the provider account an ID token was issued for. Subject is
+// the provider's stable account ID; the email may change or be reassigned,
+// and is only worth trusting when EmailVerified is set.
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+// Verifier validates the ID tokens one issuer hands out to a single OAuth
+// client, checking signatures against the issuer's JWKS.
+type Verifier struct {
+	issuers    []string
+	audience   string
+	jwksURL    func(ctx context.Context) (string, error)
+	httpClient *http.Client
+
+	fetchMu sync.Mutex
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewVerifier builds a Verifier for tokens issued by any of issuers to
+// audience. jwksURL is resolved on every key download, which lets a
+// Provider take it from its discovery document.
+func NewVerifier(
+	issuers []string,
+	audience string,
+	jwksURL func(ctx context.Context) (string, error),
+	httpClient *http.Client,
+) *Verifier {
+	return &Verifier{
+		issuers:    issuers,
+		audience:   audience,
+		jwksURL:    jwksURL,
+		httpClient: httpClient,
+		keys:       make(map[string]crypto.PublicKey),
+	}
+}
+
+// Verify checks the token's signature, issuer, audience and expiry, and
+// returns the account it was issued for.
+func (v *Verifier) Verify(ctx context.Context, idToken string) (Identity, error) {
+	c, err := v.verify(ctx, idToken)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	return c.identity(), nil
+}
+
+// VerifyNonce is Verify for tokens obtained through our own authorization
+// request: the token must also carry the nonce that request was sent with,
+// so a token issued for another login cannot be replayed into this one.
+func (v *Verifier) VerifyNonce(ctx context.Context, idToken, nonce string) (Identity, error) {
+	c, err := v.verify(ctx, idToken)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(c.Nonce), []byte(nonce)) != 1 {
+		return Identity{}, errors.New("id token nonce does not match")
+	}
+
+	return c.identity(), nil
+}
+
+func (c claims) identity() Identity {
+	return Identity{
+		Subject:       c.Subject,
+		Email:         c.Email,
+		EmailVerified: bool(c.EmailVerified),
+	}
+}
+
+func (v *Verifier) verify(ctx context.Context, idToken string) (claims, error) {
+	var c claims
+
+	keyfunc := func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.publicKey(ctx, kid)
+	}
+
+	_, err := jwt.ParseWithClaims(idToken, &c, keyfunc,
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithAudience(v.audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return claims{}, fmt.Errorf("parse id token: %w", err)
+	}
+
+	if !slices.Contains(v.issuers, c.Issuer) {
+		return claims{}, fmt.Errorf("unexpected issuer %q", c.Issuer)
+	}
+
+	if c.Subject == "" {
+		return claims{}, errors.New("id token has no sub claim")
+	}
+
+	return c, nil
+}
+
+func (v *Verifier) publicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	if key, ok := v.cachedKey(kid); ok {
+		return key, nil
+	}
+
+	v.fetchMu.Lock()
+	defer v.fetchMu.Unlock()
+
+	// Someone else may have refreshed the cache while we were waiting for the lock.
+	if key, ok := v.cachedKey(kid); ok {
+		return key, nil
+	}
+
+	if v.fetchedRecently() {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	if err := v.fetchKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	key, ok := v.cachedKey(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// cachedKey looks kid up in the cached JWKS. A token without a kid is
+// accepted only while the set has a single key, which is how providers
+// that leave the header out publish theirs.
+func (v *Verifier) cachedKey(kid string) (crypto.PublicKey, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if time.Since(v.fetchedAt) >= keysTTL {
+		return nil, false
+	}
+
+	if kid == "" {
+		if len(v.keys) != 1 {
+			return nil, false
+		}
+		for _, key := range v.keys {
+			return key, true
+		}
+	}
+
+	key, ok := v.keys[kid]
+	return key, ok
+}
+
+func (v *Verifier) fetchedRecently() bool {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	return time.Since(v.fetchedAt) < keysRefetchInterval
+}
+
+func (v *Verifier) fetchKeys(ctx context.Context) error {
+	jwksURL, err := v.jwksURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURL, nil)
+	if err != nil {
+		return fmt.Errorf("build jwks request: %w", err)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetch jwks: unexpected status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("decode jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(body.Keys))
+	for _, k := range body.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		pub, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = pub
+	}
+	if len(keys) == 0 {
+		return errors.New("jwks response had no usable signing keys")
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.fetchedAt = time.Now()
+	v.mu.Unlock()
+
+	return nil
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		return rsaPublicKey(k.N, k.E)
+	case "EC":
+		return ecPublicKey(k.Crv, k.X, k.Y)
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func rsaPublicKey(nB64, eB64 string) (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(nB64)
+	if err != nil {
+		return nil, fmt.Errorf("decode modulus: %w", err)
+	}
+
+	eBytes, err := base64.RawURLEncoding.DecodeString(eB64)
+	if err != nil {
+		return nil, fmt.Errorf("decode exponent: %w", err)
+	}
+
+	e := 0
+	for _, b := range eBytes {
+		e = e<<8 + int(b)
+	}
+	if e == 0 {
+		return nil, errors.New("zero exponent")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nBytes),
+		E: e,
+	}, nil
+}
+
+func ecPublicKey(crv, xB64, yB64 string) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", crv)
+	}
+
+	xBytes, err := base64.RawURLEncoding.DecodeString(xB64)
+	if err != nil {
+		return nil, fmt.Errorf("decode x: %w", err)
+	}
+
+	yBytes, err := base64.RawURLEncoding.DecodeString(yB64)
+	if err != nil {
+		return nil, fmt.Errorf("decode y: %w", err)
+	}
+
+	pub := &ecdsa.PublicKey{
+		Curve: curve,
+		X:     new(big.Int).SetBytes(xBytes),
+		Y:     new(big.Int).SetBytes(yBytes),
+	}
+	// ECDH rejects points that are not on the curve.
+	if _, err = pub.ECDH(); err != nil {
+		return nil, fmt.Errorf("invalid ec key: %w", err)
+	}
+
+	return pub, nil
+}
@@ -29,17 +29,27 @@ const (
 // AuthService is an internal service used by other microservices to validate
 // incoming access tokens and retrieve the associated user and session.
 //
-// Authentication: pass the access token via metadata:
+// Authentication: the calling service passes its client_credentials token
+// (scope sessions:validate) via metadata and the user's access token in the
+// request:
 //
-//	authorization: Bearer <access_token>
+//	authorization: Bearer <service_token>
+//
+// With GRPC_LEGACY_VALIDATE_SESSION enabled, legacy callers without a service
+// token pass the user's access token via metadata instead and leave
+// access_token empty.
 type AuthServiceClient interface {
-	// ValidateSession parses the access token from metadata, verifies it against
-	// the database and returns the full user and session objects.
+	// ValidateSession verifies the user's access token against the database and
+	// returns the full user and session objects.
 	//
 	// Errors:
 	//
-	//	UNAUTHENTICATED     — token is missing, invalid, expired, or the session/user
-	//	                      was deleted after the token was issued
+	//	UNAUTHENTICATED     — the service token is missing, or the user's token is
+	//	                      invalid, expired, or its session/user was deleted after
+	//	                      it was issued
+	//	PERMISSION_DENIED   — the service token lacks the sessions:validate scope, or
+	//	                      the user is suspended
+	//	INVALID_ARGUMENT    — a service token was passed but access_token is empty
 	//	INTERNAL            — unexpected server error
 	ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...grpc.CallOption) (*ValidateSessionResponse, error)
 }
@@ -69,17 +79,27 @@ func (c *authServiceClient) ValidateSession(ctx context.Context, in *ValidateSes
 // AuthService is an internal service used by other microservices to validate
 // incoming access tokens and retrieve the associated user and session.
 //
-// Authentication: pass the access token via metadata:
+// Authentication: the calling service passes its client_credentials token
+// (scope sessions:validate) via metadata and the user's access token in the
+// request:
+//
+//	authorization: Bearer <service_token>
 //
-//	authorization: Bearer <access_token>
+// With GRPC_LEGACY_VALIDATE_SESSION enabled, legacy callers without a service
+// token pass the user's access token via metadata instead and leave
+// access_token empty.
 type AuthServiceServer interface {
-	// ValidateSession parses the access token from metadata, verifies it against
-	// the database and returns the full user and session objects.
+	// ValidateSession verifies the user's access token against the database and
+	// returns the full user and session objects.
 	//
 	// Errors:
 	//
-	//	UNAUTHENTICATED     — token is missing, invalid, expired, or the session/user
-	//	                      was deleted after the token was issued
+	//	UNAUTHENTICATED     — the service token is missing, or the user's token is
+	//	                      invalid, expired, or its session/user was deleted after
+	//	                      it was issued
+	//	PERMISSION_DENIED   — the service token lacks the sessions:validate scope, or
+	//	                      the user is suspended
+	//	INVALID_ARGUMENT    — a service token was passed but access_token is empty
 	//	INTERNAL            — unexpected server error
 	ValidateSession(context.Context, *ValidateSessionRequest) (*ValidateSessionResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
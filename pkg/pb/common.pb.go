@@ -34,9 +34,17 @@ type User struct {
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// Set when the user is soft-deleted.
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	// Set while the user is suspended by an admin.
+	SuspendedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=suspended_at,json=suspendedAt,proto3,oneof" json:"suspended_at,omitempty"`
+	// When the suspension ends by itself; unset if it lasts until lifted.
+	SuspendedUntil *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=suspended_until,json=suspendedUntil,proto3,oneof" json:"suspended_until,omitempty"`
+	// UUID of the admin who suspended the user. Only AdminService sets it.
+	SuspendedBy *string `protobuf:"bytes,10,opt,name=suspended_by,json=suspendedBy,proto3,oneof" json:"suspended_by,omitempty"`
+	// Why the user was suspended. Only AdminService sets it.
+	SuspensionReason *string `protobuf:"bytes,11,opt,name=suspension_reason,json=suspensionReason,proto3,oneof" json:"suspension_reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -111,6 +119,34 @@ func (x *User) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return nil
+}
+
+func (x *User) GetSuspendedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return nil
+}
+
+func (x *User) GetSuspendedBy() string {
+	if x != nil && x.SuspendedBy != nil {
+		return *x.SuspendedBy
+	}
+	return ""
+}
+
+func (x *User) GetSuspensionReason() string {
+	if x != nil && x.SuspensionReason != nil {
+		return *x.SuspensionReason
+	}
+	return ""
+}
+
 // UserEmail represents the primary email address of a user.
 type UserEmail struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -222,7 +258,17 @@ type Session struct {
 	// Timestamp of the most recent request made with this session's token.
 	LastUsed *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
 	// Set when the session is terminated (logout or explicit delete).
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	// User-Agent of the client that last opened or refreshed the session.
+	UserAgent *string `protobuf:"bytes,8,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`
+	// IP address of the client that last opened or refreshed the session.
+	ClientIp *string `protobuf:"bytes,9,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip,omitempty"`
+	// Operating system family parsed from user_agent, e.g. "macOS".
+	Platform *string `protobuf:"bytes,10,opt,name=platform,proto3,oneof" json:"platform,omitempty"`
+	// Browser family parsed from user_agent, e.g. "Safari".
+	Browser *string `protobuf:"bytes,11,opt,name=browser,proto3,oneof" json:"browser,omitempty"`
+	// Device name set by the user via UpdateMySession.
+	DeviceName    *string `protobuf:"bytes,12,opt,name=device_name,json=deviceName,proto3,oneof" json:"device_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -306,6 +352,41 @@ func (x *Session) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Session) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetClientIp() string {
+	if x != nil && x.ClientIp != nil {
+		return *x.ClientIp
+	}
+	return ""
+}
+
+func (x *Session) GetPlatform() string {
+	if x != nil && x.Platform != nil {
+		return *x.Platform
+	}
+	return ""
+}
+
+func (x *Session) GetBrowser() string {
+	if x != nil && x.Browser != nil {
+		return *x.Browser
+	}
+	return ""
+}
+
+func (x *Session) GetDeviceName() string {
+	if x != nil && x.DeviceName != nil {
+		return *x.DeviceName
+	}
+	return ""
+}
+
 // TokensPair holds the access and refresh tokens issued after a successful login or refresh.
 type TokensPair struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -370,6 +451,63 @@ func (x *TokensPair) GetRefreshToken() string {
 	return ""
 }
 
+// MfaChallenge is returned by a login method instead of a TokensPair when the
+// user has MFA enabled. Pass the token to SessionService.LoginByMfa together
+// with a code to finish the login.
+type MfaChallenge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Opaque single-use challenge token.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// After this moment the challenge is no longer accepted.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MfaChallenge) Reset() {
+	*x = MfaChallenge{}
+	mi := &file_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MfaChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MfaChallenge) ProtoMessage() {}
+
+func (x *MfaChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MfaChallenge.ProtoReflect.Descriptor instead.
+func (*MfaChallenge) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MfaChallenge) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *MfaChallenge) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 // Pagination controls page-based result slicing.
 type Pagination struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -383,7 +521,7 @@ type Pagination struct {
 
 func (x *Pagination) Reset() {
 	*x = Pagination{}
-	mi := &file_common_proto_msgTypes[4]
+	mi := &file_common_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +533,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[4]
+	mi := &file_common_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +546,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{4}
+	return file_common_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Pagination) GetPage() int32 {
@@ -442,7 +580,7 @@ type PageInfo struct {
 
 func (x *PageInfo) Reset() {
 	*x = PageInfo{}
-	mi := &file_common_proto_msgTypes[5]
+	mi := &file_common_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -454,7 +592,7 @@ func (x *PageInfo) String() string {
 func (*PageInfo) ProtoMessage() {}
 
 func (x *PageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[5]
+	mi := &file_common_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,7 +605,7 @@ func (x *PageInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
 func (*PageInfo) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{5}
+	return file_common_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PageInfo) GetTotal() int32 {
@@ -502,7 +640,7 @@ var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
 	"\n" +
-	"\fcommon.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x89\x02\n" +
+	"\fcommon.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbd\x04\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04role\x18\x03 \x01(\tR\x04role\x12\x18\n" +
@@ -512,8 +650,17 @@ const file_common_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
 	"\n" +
-	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdeletedAt\x88\x01\x01B\r\n" +
-	"\v_deleted_at\"\xb5\x02\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdeletedAt\x88\x01\x01\x12B\n" +
+	"\fsuspended_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vsuspendedAt\x88\x01\x01\x12H\n" +
+	"\x0fsuspended_until\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x0esuspendedUntil\x88\x01\x01\x12&\n" +
+	"\fsuspended_by\x18\n" +
+	" \x01(\tH\x03R\vsuspendedBy\x88\x01\x01\x120\n" +
+	"\x11suspension_reason\x18\v \x01(\tH\x04R\x10suspensionReason\x88\x01\x01B\r\n" +
+	"\v_deleted_atB\x0f\n" +
+	"\r_suspended_atB\x12\n" +
+	"\x10_suspended_untilB\x0f\n" +
+	"\r_suspended_byB\x14\n" +
+	"\x12_suspension_reason\"\xb5\x02\n" +
 	"\tUserEmail\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -525,7 +672,7 @@ const file_common_proto_rawDesc = "" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
 	"\n" +
 	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdeletedAt\x88\x01\x01B\r\n" +
-	"\v_deleted_at\"\xca\x02\n" +
+	"\v_deleted_at\"\xbc\x04\n" +
 	"\aSession\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
@@ -536,14 +683,33 @@ const file_common_proto_rawDesc = "" +
 	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x127\n" +
 	"\tlast_used\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\blastUsed\x12>\n" +
 	"\n" +
-	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdeletedAt\x88\x01\x01B\r\n" +
-	"\v_deleted_at\"s\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tdeletedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_agent\x18\b \x01(\tH\x01R\tuserAgent\x88\x01\x01\x12 \n" +
+	"\tclient_ip\x18\t \x01(\tH\x02R\bclientIp\x88\x01\x01\x12\x1f\n" +
+	"\bplatform\x18\n" +
+	" \x01(\tH\x03R\bplatform\x88\x01\x01\x12\x1d\n" +
+	"\abrowser\x18\v \x01(\tH\x04R\abrowser\x88\x01\x01\x12$\n" +
+	"\vdevice_name\x18\f \x01(\tH\x05R\n" +
+	"deviceName\x88\x01\x01B\r\n" +
+	"\v_deleted_atB\r\n" +
+	"\v_user_agentB\f\n" +
+	"\n" +
+	"_client_ipB\v\n" +
+	"\t_platformB\n" +
+	"\n" +
+	"\b_browserB\x0e\n" +
+	"\f_device_name\"s\n" +
 	"\n" +
 	"TokensPair\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
 	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\";\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\"_\n" +
+	"\fMfaChallenge\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\";\n" +
 	"\n" +
 	"Pagination\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
@@ -567,32 +733,36 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_common_proto_goTypes = []any{
 	(*User)(nil),                  // 0: auth.v1.User
 	(*UserEmail)(nil),             // 1: auth.v1.UserEmail
 	(*Session)(nil),               // 2: auth.v1.Session
 	(*TokensPair)(nil),            // 3: auth.v1.TokensPair
-	(*Pagination)(nil),            // 4: auth.v1.Pagination
-	(*PageInfo)(nil),              // 5: auth.v1.PageInfo
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*MfaChallenge)(nil),          // 4: auth.v1.MfaChallenge
+	(*Pagination)(nil),            // 5: auth.v1.Pagination
+	(*PageInfo)(nil),              // 6: auth.v1.PageInfo
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_common_proto_depIdxs = []int32{
-	6,  // 0: auth.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 1: auth.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 2: auth.v1.User.deleted_at:type_name -> google.protobuf.Timestamp
-	6,  // 3: auth.v1.UserEmail.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 4: auth.v1.UserEmail.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 5: auth.v1.UserEmail.deleted_at:type_name -> google.protobuf.Timestamp
-	6,  // 6: auth.v1.Session.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 7: auth.v1.Session.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 8: auth.v1.Session.last_used:type_name -> google.protobuf.Timestamp
-	6,  // 9: auth.v1.Session.deleted_at:type_name -> google.protobuf.Timestamp
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	7,  // 0: auth.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 1: auth.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 2: auth.v1.User.deleted_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: auth.v1.User.suspended_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: auth.v1.User.suspended_until:type_name -> google.protobuf.Timestamp
+	7,  // 5: auth.v1.UserEmail.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 6: auth.v1.UserEmail.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 7: auth.v1.UserEmail.deleted_at:type_name -> google.protobuf.Timestamp
+	7,  // 8: auth.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 9: auth.v1.Session.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 10: auth.v1.Session.last_used:type_name -> google.protobuf.Timestamp
+	7,  // 11: auth.v1.Session.deleted_at:type_name -> google.protobuf.Timestamp
+	7,  // 12: auth.v1.MfaChallenge.expires_at:type_name -> google.protobuf.Timestamp
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -609,7 +779,7 @@ func file_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -176,6 +177,60 @@ func (x *LoginByEmailRequest) GetPassword() string {
 	return ""
 }
 
+type LoginByOidcRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Provider name as configured in AUTH_OAUTH_PROVIDERS, e.g. "google".
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// ID token the provider issued to auth-svc's client.
+	IdToken       string `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginByOidcRequest) Reset() {
+	*x = LoginByOidcRequest{}
+	mi := &file_session_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginByOidcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginByOidcRequest) ProtoMessage() {}
+
+func (x *LoginByOidcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginByOidcRequest.ProtoReflect.Descriptor instead.
+func (*LoginByOidcRequest) Descriptor() ([]byte, []int) {
+	return file_session_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoginByOidcRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LoginByOidcRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
 type LoginByGoogleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Google ID token obtained from the Google OAuth2 flow.
@@ -186,7 +241,7 @@ type LoginByGoogleRequest struct {
 
 func (x *LoginByGoogleRequest) Reset() {
 	*x = LoginByGoogleRequest{}
-	mi := &file_session_proto_msgTypes[1]
+	mi := &file_session_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -198,7 +253,7 @@ func (x *LoginByGoogleRequest) String() string {
 func (*LoginByGoogleRequest) ProtoMessage() {}
 
 func (x *LoginByGoogleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_session_proto_msgTypes[1]
+	mi := &file_session_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,7 +266,7 @@ func (x *LoginByGoogleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginByGoogleRequest.ProtoReflect.Descriptor instead.
 func (*LoginByGoogleRequest) Descriptor() ([]byte, []int) {
-	return file_session_proto_rawDescGZIP(), []int{1}
+	return file_session_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *LoginByGoogleRequest) GetIdToken() string {
@@ -221,17 +276,73 @@ func (x *LoginByGoogleRequest) GetIdToken() string {
 	return ""
 }
 
-// LoginResponse is returned by all login and refresh methods.
+type LoginByMfaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token from LoginResponse.mfa_challenge.
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`
+	// TOTP code or recovery code.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginByMfaRequest) Reset() {
+	*x = LoginByMfaRequest{}
+	mi := &file_session_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginByMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginByMfaRequest) ProtoMessage() {}
+
+func (x *LoginByMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginByMfaRequest.ProtoReflect.Descriptor instead.
+func (*LoginByMfaRequest) Descriptor() ([]byte, []int) {
+	return file_session_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LoginByMfaRequest) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+func (x *LoginByMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// LoginResponse is returned by all login and refresh methods. Exactly one of
+// tokens and mfa_challenge is set; Refresh and LoginByMfa always set tokens.
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tokens        *TokensPair            `protobuf:"bytes,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	MfaChallenge  *MfaChallenge          `protobuf:"bytes,2,opt,name=mfa_challenge,json=mfaChallenge,proto3" json:"mfa_challenge,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_session_proto_msgTypes[2]
+	mi := &file_session_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -243,7 +354,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_session_proto_msgTypes[2]
+	mi := &file_session_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +367,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_session_proto_rawDescGZIP(), []int{2}
+	return file_session_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LoginResponse) GetTokens() *TokensPair {
@@ -266,6 +377,13 @@ func (x *LoginResponse) GetTokens() *TokensPair {
 	return nil
 }
 
+func (x *LoginResponse) GetMfaChallenge() *MfaChallenge {
+	if x != nil {
+		return x.MfaChallenge
+	}
+	return nil
+}
+
 type RefreshRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Refresh token from a previous LoginResponse or RefreshResponse.
@@ -276,7 +394,7 @@ type RefreshRequest struct {
 
 func (x *RefreshRequest) Reset() {
 	*x = RefreshRequest{}
-	mi := &file_session_proto_msgTypes[3]
+	mi := &file_session_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +406,7 @@ func (x *RefreshRequest) String() string {
 func (*RefreshRequest) ProtoMessage() {}
 
 func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_session_proto_msgTypes[3]
+	mi := &file_session_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +419,7 @@ func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
 func (*RefreshRequest) Descriptor() ([]byte, []int) {
-	return file_session_proto_rawDescGZIP(), []int{3}
+	return file_session_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RefreshRequest) GetRefreshToken() string {
@@ -321,7 +439,7 @@ type GetMySessionRequest struct {
 
 func (x *GetMySessionRequest) Reset() {
 	*x = GetMySessionRequest{}
-	mi := &file_session_proto_msgTypes[4]
+	mi := &file_session_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -333,7 +451,7 @@ func (x *GetMySessionRequest) String() string {
 func (*GetMySessionRequest) ProtoMessage() {}
 
 func (x *GetMySessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_session_proto_msgTypes[4]
+	mi := &file_session_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +464,7 @@ func (x *GetMySessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMySessionRequest.ProtoReflect.Descriptor instead.
 func (*GetMySessionRequest) Descriptor() ([]byte, []int) {
-	return file_session_proto_rawDescGZIP(), []int{4}
+	return file_session_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetMySessionRequest) GetSessionId() string {
@@ -365,7 +483,7 @@ type GetMySessionResponse struct {
 
 func (x *GetMySessionResponse) Reset() {
 	*x = GetMySessionResponse{}
-	mi := &file_session_proto_msgTypes[5]
+	mi := &file_session_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -377,7 +495,7 @@ func (x *GetMySessionResponse) String() string {
 func (*GetMySessionResponse) ProtoMessage() {}
 
 func (x *GetMySessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_session_proto_msgTypes[5]
+	mi := &file_session_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +508,7 @@ func (x *GetMySessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMySessionResponse.ProtoReflect.Descriptor instead.
 func (*GetMySessionResponse) Descriptor() ([]byte, []int) {
-	return file_session_proto_rawDescGZIP(), []int{5}
+	return file_session_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetMySessionResponse) GetSession() *Session {
@@ -414,7 +532,7 @@ type GetMySessionsRequest struct {
 
 func (x *GetMySessionsRequest) Reset() {
 	*x = GetMySessionsRequest{}
-	mi := &file_session_proto_msgTypes[6]
+	mi := &file_session_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -426,7 +544,7 @@ func (x *GetMySessionsRequest) String() string {
 func (*GetMySessionsRequest) ProtoMessage() {}
 
 func (x *GetMySessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_session_proto_msgTypes[6]
+	mi := &file_session_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,7 +557,7 @@ func (x *GetMySessionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMySessionsRequest.ProtoReflect.Descriptor instead.
 func (*GetMySessionsRequest) Descriptor() ([]byte, []int) {
-	return file_session_proto_rawDescGZIP(), []int{6}
+	return file_session_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetMySessionsRequest) GetPagination() *Pagination {
@@ -473,7 +591,7 @@ type GetMySessionsResponse struct {
 
 func (x *GetMySessionsResponse) Reset() {
 	*x = GetMySessionsResponse{}
-	mi := &file_session_proto_msgTypes[7]
+	mi := &file_session_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +603,7 @@ func (x *GetMySessionsResponse) String() string {
 func (*GetMySessionsResponse) ProtoMessage() {}
 
 func (x *GetMySessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_session_proto_msgTypes[7]
+	mi := &file_session_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +616,7 @@ func (x *GetMySessionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMySessionsResponse.ProtoReflect.Descriptor instead.
 func (*GetMySessionsResponse) Descriptor() ([]byte, []int) {
-	return file_session_proto_rawDescGZIP(), []int{7}
+	return file_session_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetMySessionsResponse) GetSessions() []*Session {
@@ -515,6 +633,104 @@ func (x *GetMySessionsResponse) GetPageInfo() *PageInfo {
 	return nil
 }
 
+type UpdateMySessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the target session.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// New device name, up to 64 characters. Empty clears the name.
+	DeviceName    string `protobuf:"bytes,2,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMySessionRequest) Reset() {
+	*x = UpdateMySessionRequest{}
+	mi := &file_session_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMySessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMySessionRequest) ProtoMessage() {}
+
+func (x *UpdateMySessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_session_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMySessionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMySessionRequest) Descriptor() ([]byte, []int) {
+	return file_session_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateMySessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UpdateMySessionRequest) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+type UpdateMySessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMySessionResponse) Reset() {
+	*x = UpdateMySessionResponse{}
+	mi := &file_session_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMySessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMySessionResponse) ProtoMessage() {}
+
+func (x *UpdateMySessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMySessionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMySessionResponse) Descriptor() ([]byte, []int) {
+	return file_session_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateMySessionResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
 type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -523,7 +739,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_session_proto_msgTypes[8]
+	mi := &file_session_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +751,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_session_proto_msgTypes[8]
+	mi := &file_session_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +764,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_session_proto_rawDescGZIP(), []int{8}
+	return file_session_proto_rawDescGZIP(), []int{12}
 }
 
 type DeleteMySessionRequest struct {
@@ -561,7 +777,7 @@ type DeleteMySessionRequest struct {
 
 func (x *DeleteMySessionRequest) Reset() {
 	*x = DeleteMySessionRequest{}
-	mi := &file_session_proto_msgTypes[9]
+	mi := &file_session_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +789,7 @@ func (x *DeleteMySessionRequest) String() string {
 func (*DeleteMySessionRequest) ProtoMessage() {}
 
 func (x *DeleteMySessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_session_proto_msgTypes[9]
+	mi := &file_session_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +802,7 @@ func (x *DeleteMySessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMySessionRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMySessionRequest) Descriptor() ([]byte, []int) {
-	return file_session_proto_rawDescGZIP(), []int{9}
+	return file_session_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteMySessionRequest) GetSessionId() string {
@@ -597,14 +813,22 @@ func (x *DeleteMySessionRequest) GetSessionId() string {
 }
 
 type DeleteMySessionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keep the calling session ("sign out everywhere else").
+	KeepCurrent bool `protobuf:"varint,1,opt,name=keep_current,json=keepCurrent,proto3" json:"keep_current,omitempty"`
+	// Only sessions last used before this time.
+	LastUsedBefore *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_used_before,json=lastUsedBefore,proto3,oneof" json:"last_used_before,omitempty"`
+	// Only sessions created before this time.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	// Only these sessions (UUIDs).
+	SessionIds    []string `protobuf:"bytes,4,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteMySessionsRequest) Reset() {
 	*x = DeleteMySessionsRequest{}
-	mi := &file_session_proto_msgTypes[10]
+	mi := &file_session_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -616,7 +840,7 @@ func (x *DeleteMySessionsRequest) String() string {
 func (*DeleteMySessionsRequest) ProtoMessage() {}
 
 func (x *DeleteMySessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_session_proto_msgTypes[10]
+	mi := &file_session_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,21 +853,101 @@ func (x *DeleteMySessionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMySessionsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMySessionsRequest) Descriptor() ([]byte, []int) {
-	return file_session_proto_rawDescGZIP(), []int{10}
+	return file_session_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteMySessionsRequest) GetKeepCurrent() bool {
+	if x != nil {
+		return x.KeepCurrent
+	}
+	return false
+}
+
+func (x *DeleteMySessionsRequest) GetLastUsedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedBefore
+	}
+	return nil
+}
+
+func (x *DeleteMySessionsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *DeleteMySessionsRequest) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+type DeleteMySessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of sessions terminated.
+	Revoked       int32 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMySessionsResponse) Reset() {
+	*x = DeleteMySessionsResponse{}
+	mi := &file_session_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMySessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMySessionsResponse) ProtoMessage() {}
+
+func (x *DeleteMySessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_session_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMySessionsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMySessionsResponse) Descriptor() ([]byte, []int) {
+	return file_session_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteMySessionsResponse) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
 }
 
 var File_session_proto protoreflect.FileDescriptor
 
 const file_session_proto_rawDesc = "" +
 	"\n" +
-	"\rsession.proto\x12\aauth.v1\x1a\fcommon.proto\x1a\x1bgoogle/protobuf/empty.proto\"G\n" +
+	"\rsession.proto\x12\aauth.v1\x1a\fcommon.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"G\n" +
 	"\x13LoginByEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"1\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"K\n" +
+	"\x12LoginByOidcRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x19\n" +
+	"\bid_token\x18\x02 \x01(\tR\aidToken\"1\n" +
 	"\x14LoginByGoogleRequest\x12\x19\n" +
-	"\bid_token\x18\x01 \x01(\tR\aidToken\"<\n" +
+	"\bid_token\x18\x01 \x01(\tR\aidToken\"P\n" +
+	"\x11LoginByMfaRequest\x12'\n" +
+	"\x0fchallenge_token\x18\x01 \x01(\tR\x0echallengeToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"x\n" +
 	"\rLoginResponse\x12+\n" +
-	"\x06tokens\x18\x01 \x01(\v2\x13.auth.v1.TokensPairR\x06tokens\"5\n" +
+	"\x06tokens\x18\x01 \x01(\v2\x13.auth.v1.TokensPairR\x06tokens\x12:\n" +
+	"\rmfa_challenge\x18\x02 \x01(\v2\x15.auth.v1.MfaChallengeR\fmfaChallenge\"5\n" +
 	"\x0eRefreshRequest\x12#\n" +
 	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"4\n" +
 	"\x13GetMySessionRequest\x12\x1d\n" +
@@ -659,28 +963,48 @@ const file_session_proto_rawDesc = "" +
 	"\x05order\x18\x03 \x01(\x0e2\x1d.auth.v1.SessionLastUsedOrderR\x05order\"u\n" +
 	"\x15GetMySessionsResponse\x12,\n" +
 	"\bsessions\x18\x01 \x03(\v2\x10.auth.v1.SessionR\bsessions\x12.\n" +
-	"\tpage_info\x18\x02 \x01(\v2\x11.auth.v1.PageInfoR\bpageInfo\"\x0f\n" +
+	"\tpage_info\x18\x02 \x01(\v2\x11.auth.v1.PageInfoR\bpageInfo\"X\n" +
+	"\x16UpdateMySessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vdevice_name\x18\x02 \x01(\tR\n" +
+	"deviceName\"E\n" +
+	"\x17UpdateMySessionResponse\x12*\n" +
+	"\asession\x18\x01 \x01(\v2\x10.auth.v1.SessionR\asession\"\x0f\n" +
 	"\rLogoutRequest\"7\n" +
 	"\x16DeleteMySessionRequest\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"\x19\n" +
-	"\x17DeleteMySessionsRequest*}\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x98\x02\n" +
+	"\x17DeleteMySessionsRequest\x12!\n" +
+	"\fkeep_current\x18\x01 \x01(\bR\vkeepCurrent\x12I\n" +
+	"\x10last_used_before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0elastUsedBefore\x88\x01\x01\x12F\n" +
+	"\x0ecreated_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\rcreatedBefore\x88\x01\x01\x12\x1f\n" +
+	"\vsession_ids\x18\x04 \x03(\tR\n" +
+	"sessionIdsB\x13\n" +
+	"\x11_last_used_beforeB\x11\n" +
+	"\x0f_created_before\"4\n" +
+	"\x18DeleteMySessionsResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x05R\arevoked*}\n" +
 	"\x14SessionDeletedFilter\x12\x1e\n" +
 	"\x1aSESSION_DELETED_FILTER_ALL\x10\x00\x12!\n" +
 	"\x1dSESSION_DELETED_FILTER_ACTIVE\x10\x01\x12\"\n" +
 	"\x1eSESSION_DELETED_FILTER_DELETED\x10\x02*Y\n" +
 	"\x14SessionLastUsedOrder\x12 \n" +
 	"\x1cSESSION_LAST_USED_ORDER_DESC\x10\x00\x12\x1f\n" +
-	"\x1bSESSION_LAST_USED_ORDER_ASC\x10\x012\xcb\x04\n" +
+	"\x1bSESSION_LAST_USED_ORDER_ASC\x10\x012\xb7\x06\n" +
 	"\x0eSessionService\x12D\n" +
-	"\fLoginByEmail\x12\x1c.auth.v1.LoginByEmailRequest\x1a\x16.auth.v1.LoginResponse\x12F\n" +
-	"\rLoginByGoogle\x12\x1d.auth.v1.LoginByGoogleRequest\x1a\x16.auth.v1.LoginResponse\x12:\n" +
+	"\fLoginByEmail\x12\x1c.auth.v1.LoginByEmailRequest\x1a\x16.auth.v1.LoginResponse\x12B\n" +
+	"\vLoginByOidc\x12\x1b.auth.v1.LoginByOidcRequest\x1a\x16.auth.v1.LoginResponse\x12K\n" +
+	"\rLoginByGoogle\x12\x1d.auth.v1.LoginByGoogleRequest\x1a\x16.auth.v1.LoginResponse\"\x03\x88\x02\x01\x12@\n" +
+	"\n" +
+	"LoginByMfa\x12\x1a.auth.v1.LoginByMfaRequest\x1a\x16.auth.v1.LoginResponse\x12:\n" +
 	"\aRefresh\x12\x17.auth.v1.RefreshRequest\x1a\x16.auth.v1.LoginResponse\x12K\n" +
 	"\fGetMySession\x12\x1c.auth.v1.GetMySessionRequest\x1a\x1d.auth.v1.GetMySessionResponse\x12N\n" +
-	"\rGetMySessions\x12\x1d.auth.v1.GetMySessionsRequest\x1a\x1e.auth.v1.GetMySessionsResponse\x128\n" +
+	"\rGetMySessions\x12\x1d.auth.v1.GetMySessionsRequest\x1a\x1e.auth.v1.GetMySessionsResponse\x12T\n" +
+	"\x0fUpdateMySession\x12\x1f.auth.v1.UpdateMySessionRequest\x1a .auth.v1.UpdateMySessionResponse\x128\n" +
 	"\x06Logout\x12\x16.auth.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
-	"\x0fDeleteMySession\x12\x1f.auth.v1.DeleteMySessionRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
-	"\x10DeleteMySessions\x12 .auth.v1.DeleteMySessionsRequest\x1a\x16.google.protobuf.EmptyB)Z'github.com/netbill/auth-svc/proto/pb;pbb\x06proto3"
+	"\x0fDeleteMySession\x12\x1f.auth.v1.DeleteMySessionRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\x10DeleteMySessions\x12 .auth.v1.DeleteMySessionsRequest\x1a!.auth.v1.DeleteMySessionsResponseB)Z'github.com/netbill/auth-svc/proto/pb;pbb\x06proto3"
 
 var (
 	file_session_proto_rawDescOnce sync.Once
@@ -695,56 +1019,73 @@ func file_session_proto_rawDescGZIP() []byte {
 }
 
 var file_session_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_session_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_session_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_session_proto_goTypes = []any{
-	(SessionDeletedFilter)(0),       // 0: auth.v1.SessionDeletedFilter
-	(SessionLastUsedOrder)(0),       // 1: auth.v1.SessionLastUsedOrder
-	(*LoginByEmailRequest)(nil),     // 2: auth.v1.LoginByEmailRequest
-	(*LoginByGoogleRequest)(nil),    // 3: auth.v1.LoginByGoogleRequest
-	(*LoginResponse)(nil),           // 4: auth.v1.LoginResponse
-	(*RefreshRequest)(nil),          // 5: auth.v1.RefreshRequest
-	(*GetMySessionRequest)(nil),     // 6: auth.v1.GetMySessionRequest
-	(*GetMySessionResponse)(nil),    // 7: auth.v1.GetMySessionResponse
-	(*GetMySessionsRequest)(nil),    // 8: auth.v1.GetMySessionsRequest
-	(*GetMySessionsResponse)(nil),   // 9: auth.v1.GetMySessionsResponse
-	(*LogoutRequest)(nil),           // 10: auth.v1.LogoutRequest
-	(*DeleteMySessionRequest)(nil),  // 11: auth.v1.DeleteMySessionRequest
-	(*DeleteMySessionsRequest)(nil), // 12: auth.v1.DeleteMySessionsRequest
-	(*TokensPair)(nil),              // 13: auth.v1.TokensPair
-	(*Session)(nil),                 // 14: auth.v1.Session
-	(*Pagination)(nil),              // 15: auth.v1.Pagination
-	(*PageInfo)(nil),                // 16: auth.v1.PageInfo
-	(*emptypb.Empty)(nil),           // 17: google.protobuf.Empty
+	(SessionDeletedFilter)(0),        // 0: auth.v1.SessionDeletedFilter
+	(SessionLastUsedOrder)(0),        // 1: auth.v1.SessionLastUsedOrder
+	(*LoginByEmailRequest)(nil),      // 2: auth.v1.LoginByEmailRequest
+	(*LoginByOidcRequest)(nil),       // 3: auth.v1.LoginByOidcRequest
+	(*LoginByGoogleRequest)(nil),     // 4: auth.v1.LoginByGoogleRequest
+	(*LoginByMfaRequest)(nil),        // 5: auth.v1.LoginByMfaRequest
+	(*LoginResponse)(nil),            // 6: auth.v1.LoginResponse
+	(*RefreshRequest)(nil),           // 7: auth.v1.RefreshRequest
+	(*GetMySessionRequest)(nil),      // 8: auth.v1.GetMySessionRequest
+	(*GetMySessionResponse)(nil),     // 9: auth.v1.GetMySessionResponse
+	(*GetMySessionsRequest)(nil),     // 10: auth.v1.GetMySessionsRequest
+	(*GetMySessionsResponse)(nil),    // 11: auth.v1.GetMySessionsResponse
+	(*UpdateMySessionRequest)(nil),   // 12: auth.v1.UpdateMySessionRequest
+	(*UpdateMySessionResponse)(nil),  // 13: auth.v1.UpdateMySessionResponse
+	(*LogoutRequest)(nil),            // 14: auth.v1.LogoutRequest
+	(*DeleteMySessionRequest)(nil),   // 15: auth.v1.DeleteMySessionRequest
+	(*DeleteMySessionsRequest)(nil),  // 16: auth.v1.DeleteMySessionsRequest
+	(*DeleteMySessionsResponse)(nil), // 17: auth.v1.DeleteMySessionsResponse
+	(*TokensPair)(nil),               // 18: auth.v1.TokensPair
+	(*MfaChallenge)(nil),             // 19: auth.v1.MfaChallenge
+	(*Session)(nil),                  // 20: auth.v1.Session
+	(*Pagination)(nil),               // 21: auth.v1.Pagination
+	(*PageInfo)(nil),                 // 22: auth.v1.PageInfo
+	(*timestamppb.Timestamp)(nil),    // 23: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 24: google.protobuf.Empty
 }
 var file_session_proto_depIdxs = []int32{
-	13, // 0: auth.v1.LoginResponse.tokens:type_name -> auth.v1.TokensPair
-	14, // 1: auth.v1.GetMySessionResponse.session:type_name -> auth.v1.Session
-	15, // 2: auth.v1.GetMySessionsRequest.pagination:type_name -> auth.v1.Pagination
-	0,  // 3: auth.v1.GetMySessionsRequest.filter:type_name -> auth.v1.SessionDeletedFilter
-	1,  // 4: auth.v1.GetMySessionsRequest.order:type_name -> auth.v1.SessionLastUsedOrder
-	14, // 5: auth.v1.GetMySessionsResponse.sessions:type_name -> auth.v1.Session
-	16, // 6: auth.v1.GetMySessionsResponse.page_info:type_name -> auth.v1.PageInfo
-	2,  // 7: auth.v1.SessionService.LoginByEmail:input_type -> auth.v1.LoginByEmailRequest
-	3,  // 8: auth.v1.SessionService.LoginByGoogle:input_type -> auth.v1.LoginByGoogleRequest
-	5,  // 9: auth.v1.SessionService.Refresh:input_type -> auth.v1.RefreshRequest
-	6,  // 10: auth.v1.SessionService.GetMySession:input_type -> auth.v1.GetMySessionRequest
-	8,  // 11: auth.v1.SessionService.GetMySessions:input_type -> auth.v1.GetMySessionsRequest
-	10, // 12: auth.v1.SessionService.Logout:input_type -> auth.v1.LogoutRequest
-	11, // 13: auth.v1.SessionService.DeleteMySession:input_type -> auth.v1.DeleteMySessionRequest
-	12, // 14: auth.v1.SessionService.DeleteMySessions:input_type -> auth.v1.DeleteMySessionsRequest
-	4,  // 15: auth.v1.SessionService.LoginByEmail:output_type -> auth.v1.LoginResponse
-	4,  // 16: auth.v1.SessionService.LoginByGoogle:output_type -> auth.v1.LoginResponse
-	4,  // 17: auth.v1.SessionService.Refresh:output_type -> auth.v1.LoginResponse
-	7,  // 18: auth.v1.SessionService.GetMySession:output_type -> auth.v1.GetMySessionResponse
-	9,  // 19: auth.v1.SessionService.GetMySessions:output_type -> auth.v1.GetMySessionsResponse
-	17, // 20: auth.v1.SessionService.Logout:output_type -> google.protobuf.Empty
-	17, // 21: auth.v1.SessionService.DeleteMySession:output_type -> google.protobuf.Empty
-	17, // 22: auth.v1.SessionService.DeleteMySessions:output_type -> google.protobuf.Empty
-	15, // [15:23] is the sub-list for method output_type
-	7,  // [7:15] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	18, // 0: auth.v1.LoginResponse.tokens:type_name -> auth.v1.TokensPair
+	19, // 1: auth.v1.LoginResponse.mfa_challenge:type_name -> auth.v1.MfaChallenge
+	20, // 2: auth.v1.GetMySessionResponse.session:type_name -> auth.v1.Session
+	21, // 3: auth.v1.GetMySessionsRequest.pagination:type_name -> auth.v1.Pagination
+	0,  // 4: auth.v1.GetMySessionsRequest.filter:type_name -> auth.v1.SessionDeletedFilter
+	1,  // 5: auth.v1.GetMySessionsRequest.order:type_name -> auth.v1.SessionLastUsedOrder
+	20, // 6: auth.v1.GetMySessionsResponse.sessions:type_name -> auth.v1.Session
+	22, // 7: auth.v1.GetMySessionsResponse.page_info:type_name -> auth.v1.PageInfo
+	20, // 8: auth.v1.UpdateMySessionResponse.session:type_name -> auth.v1.Session
+	23, // 9: auth.v1.DeleteMySessionsRequest.last_used_before:type_name -> google.protobuf.Timestamp
+	23, // 10: auth.v1.DeleteMySessionsRequest.created_before:type_name -> google.protobuf.Timestamp
+	2,  // 11: auth.v1.SessionService.LoginByEmail:input_type -> auth.v1.LoginByEmailRequest
+	3,  // 12: auth.v1.SessionService.LoginByOidc:input_type -> auth.v1.LoginByOidcRequest
+	4,  // 13: auth.v1.SessionService.LoginByGoogle:input_type -> auth.v1.LoginByGoogleRequest
+	5,  // 14: auth.v1.SessionService.LoginByMfa:input_type -> auth.v1.LoginByMfaRequest
+	7,  // 15: auth.v1.SessionService.Refresh:input_type -> auth.v1.RefreshRequest
+	8,  // 16: auth.v1.SessionService.GetMySession:input_type -> auth.v1.GetMySessionRequest
+	10, // 17: auth.v1.SessionService.GetMySessions:input_type -> auth.v1.GetMySessionsRequest
+	12, // 18: auth.v1.SessionService.UpdateMySession:input_type -> auth.v1.UpdateMySessionRequest
+	14, // 19: auth.v1.SessionService.Logout:input_type -> auth.v1.LogoutRequest
+	15, // 20: auth.v1.SessionService.DeleteMySession:input_type -> auth.v1.DeleteMySessionRequest
+	16, // 21: auth.v1.SessionService.DeleteMySessions:input_type -> auth.v1.DeleteMySessionsRequest
+	6,  // 22: auth.v1.SessionService.LoginByEmail:output_type -> auth.v1.LoginResponse
+	6,  // 23: auth.v1.SessionService.LoginByOidc:output_type -> auth.v1.LoginResponse
+	6,  // 24: auth.v1.SessionService.LoginByGoogle:output_type -> auth.v1.LoginResponse
+	6,  // 25: auth.v1.SessionService.LoginByMfa:output_type -> auth.v1.LoginResponse
+	6,  // 26: auth.v1.SessionService.Refresh:output_type -> auth.v1.LoginResponse
+	9,  // 27: auth.v1.SessionService.GetMySession:output_type -> auth.v1.GetMySessionResponse
+	11, // 28: auth.v1.SessionService.GetMySessions:output_type -> auth.v1.GetMySessionsResponse
+	13, // 29: auth.v1.SessionService.UpdateMySession:output_type -> auth.v1.UpdateMySessionResponse
+	24, // 30: auth.v1.SessionService.Logout:output_type -> google.protobuf.Empty
+	24, // 31: auth.v1.SessionService.DeleteMySession:output_type -> google.protobuf.Empty
+	17, // 32: auth.v1.SessionService.DeleteMySessions:output_type -> auth.v1.DeleteMySessionsResponse
+	22, // [22:33] is the sub-list for method output_type
+	11, // [11:22] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_session_proto_init() }
@@ -753,13 +1094,14 @@ func file_session_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_session_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_session_proto_rawDesc), len(file_session_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   11,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
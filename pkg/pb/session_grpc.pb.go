@@ -21,10 +21,13 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	SessionService_LoginByEmail_FullMethodName     = "/auth.v1.SessionService/LoginByEmail"
+	SessionService_LoginByOidc_FullMethodName      = "/auth.v1.SessionService/LoginByOidc"
 	SessionService_LoginByGoogle_FullMethodName    = "/auth.v1.SessionService/LoginByGoogle"
+	SessionService_LoginByMfa_FullMethodName       = "/auth.v1.SessionService/LoginByMfa"
 	SessionService_Refresh_FullMethodName          = "/auth.v1.SessionService/Refresh"
 	SessionService_GetMySession_FullMethodName     = "/auth.v1.SessionService/GetMySession"
 	SessionService_GetMySessions_FullMethodName    = "/auth.v1.SessionService/GetMySessions"
+	SessionService_UpdateMySession_FullMethodName  = "/auth.v1.SessionService/UpdateMySession"
 	SessionService_Logout_FullMethodName           = "/auth.v1.SessionService/Logout"
 	SessionService_DeleteMySession_FullMethodName  = "/auth.v1.SessionService/DeleteMySession"
 	SessionService_DeleteMySessions_FullMethodName = "/auth.v1.SessionService/DeleteMySessions"
@@ -42,25 +45,52 @@ const (
 //	authorization: Bearer <access_token>
 type SessionServiceClient interface {
 	// LoginByEmail authenticates a user by email and password.
-	// Returns a token pair on success.
+	// Returns a token pair on success, or an MFA challenge if the user has MFA
+	// enabled. Repeated failures lock the email and the client IP out for a
+	// growing period.
 	//
 	// Errors:
 	//
 	//	UNAUTHENTICATED     — email not found, user deleted, or password is incorrect
+	//	RESOURCE_EXHAUSTED  — too many failed attempts; google.rpc.RetryInfo holds the wait
+	//	PERMISSION_DENIED   — user is suspended
 	LoginByEmail(ctx context.Context, in *LoginByEmailRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	// LoginByGoogle authenticates a user via a Google ID token.
-	// The user must already exist with the email from the Google token.
+	// LoginByOidc authenticates a user via an ID token of a configured external
+	// OpenID Connect provider. The token must be issued to auth-svc's client at
+	// that provider; the user is the one the provider account is linked to.
+	// An unlinked account with a verified email nobody registered gets a new
+	// user if the provider allows sign-up.
+	// Users with MFA enabled get an MFA challenge instead of a token pair.
 	//
 	// Errors:
 	//
-	//	UNAUTHENTICATED     — user with this email not found or deleted
+	//	INVALID_ARGUMENT    — provider is not configured
+	//	UNAUTHENTICATED     — ID token is invalid, or no user for the provider account
+	//	FAILED_PRECONDITION — the account's email belongs to a user it is not linked to
+	//	PERMISSION_DENIED   — user is suspended
+	LoginByOidc(ctx context.Context, in *LoginByOidcRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Deprecated: Do not use.
+	// LoginByGoogle is LoginByOidc with provider "google".
 	LoginByGoogle(ctx context.Context, in *LoginByGoogleRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// LoginByMfa finishes a login answered with an MFA challenge. The code is
+	// either a TOTP code from the authenticator app or a recovery code; either
+	// is spent on success. A challenge accepts a limited number of wrong codes.
+	//
+	// Errors:
+	//
+	//	INVALID_ARGUMENT    — challenge_token or code is empty
+	//	UNAUTHENTICATED     — challenge is unknown, expired or used up, or the code is wrong
+	//	PERMISSION_DENIED   — user is suspended
+	LoginByMfa(ctx context.Context, in *LoginByMfaRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	// Refresh exchanges a valid refresh token for a new token pair.
-	// The old refresh token is invalidated after a successful call.
+	// The old refresh token is invalidated after a successful call. Presenting
+	// it again is treated as token theft: the session (or, depending on server
+	// configuration, every session of the user) is revoked.
 	//
 	// Errors:
 	//
-	//	UNAUTHENTICATED     — refresh token is expired, invalid, mismatched, or session not found
+	//	UNAUTHENTICATED     — refresh token is expired, invalid, mismatched, reused, or session not found
+	//	PERMISSION_DENIED   — user is suspended
 	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	// GetMySession returns a specific session belonging to the authenticated user.
 	//
@@ -76,6 +106,15 @@ type SessionServiceClient interface {
 	//
 	//	INTERNAL            — unexpected server error
 	GetMySessions(ctx context.Context, in *GetMySessionsRequest, opts ...grpc.CallOption) (*GetMySessionsResponse, error)
+	// UpdateMySession renames the device of a session belonging to the
+	// authenticated user. An empty device_name clears it.
+	//
+	// Errors:
+	//
+	//	INVALID_ARGUMENT    — session_id is not a valid UUID, or device_name is longer than 64 characters
+	//	NOT_FOUND           — session not found
+	//	UNAUTHENTICATED     — session is invalid or expired
+	UpdateMySession(ctx context.Context, in *UpdateMySessionRequest, opts ...grpc.CallOption) (*UpdateMySessionResponse, error)
 	// Logout terminates the current session.
 	// If the session is already terminated, the call succeeds without error.
 	//
@@ -91,12 +130,15 @@ type SessionServiceClient interface {
 	//	NOT_FOUND           — session not found
 	//	UNAUTHENTICATED     — session does not belong to this user
 	DeleteMySession(ctx context.Context, in *DeleteMySessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// DeleteMySessions terminates all sessions of the authenticated user.
+	// DeleteMySessions terminates the sessions of the authenticated user that
+	// match every filter set in the request; with none set, all of them,
+	// including the calling one. Returns how many sessions were terminated.
 	//
 	// Errors:
 	//
+	//	INVALID_ARGUMENT    — a session_ids entry is not a valid UUID
 	//	UNAUTHENTICATED     — session is invalid or expired
-	DeleteMySessions(ctx context.Context, in *DeleteMySessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteMySessions(ctx context.Context, in *DeleteMySessionsRequest, opts ...grpc.CallOption) (*DeleteMySessionsResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -117,6 +159,17 @@ func (c *sessionServiceClient) LoginByEmail(ctx context.Context, in *LoginByEmai
 	return out, nil
 }
 
+func (c *sessionServiceClient) LoginByOidc(ctx context.Context, in *LoginByOidcRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, SessionService_LoginByOidc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
 func (c *sessionServiceClient) LoginByGoogle(ctx context.Context, in *LoginByGoogleRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginResponse)
@@ -127,6 +180,16 @@ func (c *sessionServiceClient) LoginByGoogle(ctx context.Context, in *LoginByGoo
 	return out, nil
 }
 
+func (c *sessionServiceClient) LoginByMfa(ctx context.Context, in *LoginByMfaRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, SessionService_LoginByMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sessionServiceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginResponse)
@@ -157,6 +220,16 @@ func (c *sessionServiceClient) GetMySessions(ctx context.Context, in *GetMySessi
 	return out, nil
 }
 
+func (c *sessionServiceClient) UpdateMySession(ctx context.Context, in *UpdateMySessionRequest, opts ...grpc.CallOption) (*UpdateMySessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMySessionResponse)
+	err := c.cc.Invoke(ctx, SessionService_UpdateMySession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sessionServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -177,9 +250,9 @@ func (c *sessionServiceClient) DeleteMySession(ctx context.Context, in *DeleteMy
 	return out, nil
 }
 
-func (c *sessionServiceClient) DeleteMySessions(ctx context.Context, in *DeleteMySessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *sessionServiceClient) DeleteMySessions(ctx context.Context, in *DeleteMySessionsRequest, opts ...grpc.CallOption) (*DeleteMySessionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(DeleteMySessionsResponse)
 	err := c.cc.Invoke(ctx, SessionService_DeleteMySessions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -199,25 +272,52 @@ func (c *sessionServiceClient) DeleteMySessions(ctx context.Context, in *DeleteM
 //	authorization: Bearer <access_token>
 type SessionServiceServer interface {
 	// LoginByEmail authenticates a user by email and password.
-	// Returns a token pair on success.
+	// Returns a token pair on success, or an MFA challenge if the user has MFA
+	// enabled. Repeated failures lock the email and the client IP out for a
+	// growing period.
 	//
 	// Errors:
 	//
 	//	UNAUTHENTICATED     — email not found, user deleted, or password is incorrect
+	//	RESOURCE_EXHAUSTED  — too many failed attempts; google.rpc.RetryInfo holds the wait
+	//	PERMISSION_DENIED   — user is suspended
 	LoginByEmail(context.Context, *LoginByEmailRequest) (*LoginResponse, error)
-	// LoginByGoogle authenticates a user via a Google ID token.
-	// The user must already exist with the email from the Google token.
+	// LoginByOidc authenticates a user via an ID token of a configured external
+	// OpenID Connect provider. The token must be issued to auth-svc's client at
+	// that provider; the user is the one the provider account is linked to.
+	// An unlinked account with a verified email nobody registered gets a new
+	// user if the provider allows sign-up.
+	// Users with MFA enabled get an MFA challenge instead of a token pair.
 	//
 	// Errors:
 	//
-	//	UNAUTHENTICATED     — user with this email not found or deleted
+	//	INVALID_ARGUMENT    — provider is not configured
+	//	UNAUTHENTICATED     — ID token is invalid, or no user for the provider account
+	//	FAILED_PRECONDITION — the account's email belongs to a user it is not linked to
+	//	PERMISSION_DENIED   — user is suspended
+	LoginByOidc(context.Context, *LoginByOidcRequest) (*LoginResponse, error)
+	// Deprecated: Do not use.
+	// LoginByGoogle is LoginByOidc with provider "google".
 	LoginByGoogle(context.Context, *LoginByGoogleRequest) (*LoginResponse, error)
+	// LoginByMfa finishes a login answered with an MFA challenge. The code is
+	// either a TOTP code from the authenticator app or a recovery code; either
+	// is spent on success. A challenge accepts a limited number of wrong codes.
+	//
+	// Errors:
+	//
+	//	INVALID_ARGUMENT    — challenge_token or code is empty
+	//	UNAUTHENTICATED     — challenge is unknown, expired or used up, or the code is wrong
+	//	PERMISSION_DENIED   — user is suspended
+	LoginByMfa(context.Context, *LoginByMfaRequest) (*LoginResponse, error)
 	// Refresh exchanges a valid refresh token for a new token pair.
-	// The old refresh token is invalidated after a successful call.
+	// The old refresh token is invalidated after a successful call. Presenting
+	// it again is treated as token theft: the session (or, depending on server
+	// configuration, every session of the user) is revoked.
 	//
 	// Errors:
 	//
-	//	UNAUTHENTICATED     — refresh token is expired, invalid, mismatched, or session not found
+	//	UNAUTHENTICATED     — refresh token is expired, invalid, mismatched, reused, or session not found
+	//	PERMISSION_DENIED   — user is suspended
 	Refresh(context.Context, *RefreshRequest) (*LoginResponse, error)
 	// GetMySession returns a specific session belonging to the authenticated user.
 	//
@@ -233,6 +333,15 @@ type SessionServiceServer interface {
 	//
 	//	INTERNAL            — unexpected server error
 	GetMySessions(context.Context, *GetMySessionsRequest) (*GetMySessionsResponse, error)
+	// UpdateMySession renames the device of a session belonging to the
+	// authenticated user. An empty device_name clears it.
+	//
+	// Errors:
+	//
+	//	INVALID_ARGUMENT    — session_id is not a valid UUID, or device_name is longer than 64 characters
+	//	NOT_FOUND           — session not found
+	//	UNAUTHENTICATED     — session is invalid or expired
+	UpdateMySession(context.Context, *UpdateMySessionRequest) (*UpdateMySessionResponse, error)
 	// Logout terminates the current session.
 	// If the session is already terminated, the call succeeds without error.
 	//
@@ -248,12 +357,15 @@ type SessionServiceServer interface {
 	//	NOT_FOUND           — session not found
 	//	UNAUTHENTICATED     — session does not belong to this user
 	DeleteMySession(context.Context, *DeleteMySessionRequest) (*emptypb.Empty, error)
-	// DeleteMySessions terminates all sessions of the authenticated user.
+	// DeleteMySessions terminates the sessions of the authenticated user that
+	// match every filter set in the request; with none set, all of them,
+	// including the calling one. Returns how many sessions were terminated.
 	//
 	// Errors:
 	//
+	//	INVALID_ARGUMENT    — a session_ids entry is not a valid UUID
 	//	UNAUTHENTICATED     — session is invalid or expired
-	DeleteMySessions(context.Context, *DeleteMySessionsRequest) (*emptypb.Empty, error)
+	DeleteMySessions(context.Context, *DeleteMySessionsRequest) (*DeleteMySessionsResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -267,9 +379,15 @@ type UnimplementedSessionServiceServer struct{}
 func (UnimplementedSessionServiceServer) LoginByEmail(context.Context, *LoginByEmailRequest) (*LoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LoginByEmail not implemented")
 }
+func (UnimplementedSessionServiceServer) LoginByOidc(context.Context, *LoginByOidcRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LoginByOidc not implemented")
+}
 func (UnimplementedSessionServiceServer) LoginByGoogle(context.Context, *LoginByGoogleRequest) (*LoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LoginByGoogle not implemented")
 }
+func (UnimplementedSessionServiceServer) LoginByMfa(context.Context, *LoginByMfaRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LoginByMfa not implemented")
+}
 func (UnimplementedSessionServiceServer) Refresh(context.Context, *RefreshRequest) (*LoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Refresh not implemented")
 }
@@ -279,13 +397,16 @@ func (UnimplementedSessionServiceServer) GetMySession(context.Context, *GetMySes
 func (UnimplementedSessionServiceServer) GetMySessions(context.Context, *GetMySessionsRequest) (*GetMySessionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMySessions not implemented")
 }
+func (UnimplementedSessionServiceServer) UpdateMySession(context.Context, *UpdateMySessionRequest) (*UpdateMySessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMySession not implemented")
+}
 func (UnimplementedSessionServiceServer) Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method Logout not implemented")
 }
 func (UnimplementedSessionServiceServer) DeleteMySession(context.Context, *DeleteMySessionRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteMySession not implemented")
 }
-func (UnimplementedSessionServiceServer) DeleteMySessions(context.Context, *DeleteMySessionsRequest) (*emptypb.Empty, error) {
+func (UnimplementedSessionServiceServer) DeleteMySessions(context.Context, *DeleteMySessionsRequest) (*DeleteMySessionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteMySessions not implemented")
 }
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
@@ -327,6 +448,24 @@ func _SessionService_LoginByEmail_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_LoginByOidc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginByOidcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).LoginByOidc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_LoginByOidc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).LoginByOidc(ctx, req.(*LoginByOidcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SessionService_LoginByGoogle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoginByGoogleRequest)
 	if err := dec(in); err != nil {
@@ -345,6 +484,24 @@ func _SessionService_LoginByGoogle_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_LoginByMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginByMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).LoginByMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_LoginByMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).LoginByMfa(ctx, req.(*LoginByMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SessionService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefreshRequest)
 	if err := dec(in); err != nil {
@@ -399,6 +556,24 @@ func _SessionService_GetMySessions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_UpdateMySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UpdateMySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_UpdateMySession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UpdateMySession(ctx, req.(*UpdateMySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SessionService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LogoutRequest)
 	if err := dec(in); err != nil {
@@ -464,10 +639,18 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoginByEmail",
 			Handler:    _SessionService_LoginByEmail_Handler,
 		},
+		{
+			MethodName: "LoginByOidc",
+			Handler:    _SessionService_LoginByOidc_Handler,
+		},
 		{
 			MethodName: "LoginByGoogle",
 			Handler:    _SessionService_LoginByGoogle_Handler,
 		},
+		{
+			MethodName: "LoginByMfa",
+			Handler:    _SessionService_LoginByMfa_Handler,
+		},
 		{
 			MethodName: "Refresh",
 			Handler:    _SessionService_Refresh_Handler,
@@ -480,6 +663,10 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMySessions",
 			Handler:    _SessionService_GetMySessions_Handler,
 		},
+		{
+			MethodName: "UpdateMySession",
+			Handler:    _SessionService_UpdateMySession_Handler,
+		},
 		{
 			MethodName: "Logout",
 			Handler:    _SessionService_Logout_Handler,
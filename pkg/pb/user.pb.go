@@ -344,6 +344,105 @@ func (x *UpdatePasswordRequest) GetNewPassword() string {
 	return ""
 }
 
+type RequestPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Email address of the account to recover.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ConfirmPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token from the password reset link.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// New password. Same rules as CreateUserRequest.password.
+	NewPassword   string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
+	mi := &file_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConfirmPasswordResetRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
 type DeleteMyUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -352,7 +451,7 @@ type DeleteMyUserRequest struct {
 
 func (x *DeleteMyUserRequest) Reset() {
 	*x = DeleteMyUserRequest{}
-	mi := &file_user_proto_msgTypes[7]
+	mi := &file_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +463,7 @@ func (x *DeleteMyUserRequest) String() string {
 func (*DeleteMyUserRequest) ProtoMessage() {}
 
 func (x *DeleteMyUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[7]
+	mi := &file_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +476,7 @@ func (x *DeleteMyUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMyUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMyUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{7}
+	return file_user_proto_rawDescGZIP(), []int{9}
 }
 
 var File_user_proto protoreflect.FileDescriptor
@@ -400,15 +499,22 @@ const file_user_proto_rawDesc = "" +
 	"\x05email\x18\x01 \x01(\v2\x12.auth.v1.UserEmailR\x05email\"]\n" +
 	"\x15UpdatePasswordRequest\x12!\n" +
 	"\fold_password\x18\x01 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"V\n" +
+	"\x1bConfirmPasswordResetRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
 	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"\x15\n" +
-	"\x13DeleteMyUserRequest2\xef\x02\n" +
+	"\x13DeleteMyUserRequest2\x9b\x04\n" +
 	"\vUserService\x12E\n" +
 	"\n" +
 	"CreateUser\x12\x1a.auth.v1.CreateUserRequest\x1a\x1b.auth.v1.CreateUserResponse\x12B\n" +
 	"\tGetMyUser\x12\x19.auth.v1.GetMyUserRequest\x1a\x1a.auth.v1.GetMyUserResponse\x12E\n" +
 	"\n" +
 	"GetMyEmail\x12\x1a.auth.v1.GetMyEmailRequest\x1a\x1b.auth.v1.GetMyEmailResponse\x12H\n" +
-	"\x0eUpdatePassword\x12\x1e.auth.v1.UpdatePasswordRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\x0eUpdatePassword\x12\x1e.auth.v1.UpdatePasswordRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x14RequestPasswordReset\x12$.auth.v1.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x14ConfirmPasswordReset\x12$.auth.v1.ConfirmPasswordResetRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
 	"\fDeleteMyUser\x12\x1c.auth.v1.DeleteMyUserRequest\x1a\x16.google.protobuf.EmptyB)Z'github.com/netbill/auth-svc/proto/pb;pbb\x06proto3"
 
 var (
@@ -423,36 +529,42 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_user_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),     // 0: auth.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),    // 1: auth.v1.CreateUserResponse
-	(*GetMyUserRequest)(nil),      // 2: auth.v1.GetMyUserRequest
-	(*GetMyUserResponse)(nil),     // 3: auth.v1.GetMyUserResponse
-	(*GetMyEmailRequest)(nil),     // 4: auth.v1.GetMyEmailRequest
-	(*GetMyEmailResponse)(nil),    // 5: auth.v1.GetMyEmailResponse
-	(*UpdatePasswordRequest)(nil), // 6: auth.v1.UpdatePasswordRequest
-	(*DeleteMyUserRequest)(nil),   // 7: auth.v1.DeleteMyUserRequest
-	(*User)(nil),                  // 8: auth.v1.User
-	(*UserEmail)(nil),             // 9: auth.v1.UserEmail
-	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+	(*CreateUserRequest)(nil),           // 0: auth.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),          // 1: auth.v1.CreateUserResponse
+	(*GetMyUserRequest)(nil),            // 2: auth.v1.GetMyUserRequest
+	(*GetMyUserResponse)(nil),           // 3: auth.v1.GetMyUserResponse
+	(*GetMyEmailRequest)(nil),           // 4: auth.v1.GetMyEmailRequest
+	(*GetMyEmailResponse)(nil),          // 5: auth.v1.GetMyEmailResponse
+	(*UpdatePasswordRequest)(nil),       // 6: auth.v1.UpdatePasswordRequest
+	(*RequestPasswordResetRequest)(nil), // 7: auth.v1.RequestPasswordResetRequest
+	(*ConfirmPasswordResetRequest)(nil), // 8: auth.v1.ConfirmPasswordResetRequest
+	(*DeleteMyUserRequest)(nil),         // 9: auth.v1.DeleteMyUserRequest
+	(*User)(nil),                        // 10: auth.v1.User
+	(*UserEmail)(nil),                   // 11: auth.v1.UserEmail
+	(*emptypb.Empty)(nil),               // 12: google.protobuf.Empty
 }
 var file_user_proto_depIdxs = []int32{
-	8,  // 0: auth.v1.CreateUserResponse.user:type_name -> auth.v1.User
-	8,  // 1: auth.v1.GetMyUserResponse.user:type_name -> auth.v1.User
-	9,  // 2: auth.v1.GetMyEmailResponse.email:type_name -> auth.v1.UserEmail
+	10, // 0: auth.v1.CreateUserResponse.user:type_name -> auth.v1.User
+	10, // 1: auth.v1.GetMyUserResponse.user:type_name -> auth.v1.User
+	11, // 2: auth.v1.GetMyEmailResponse.email:type_name -> auth.v1.UserEmail
 	0,  // 3: auth.v1.UserService.CreateUser:input_type -> auth.v1.CreateUserRequest
 	2,  // 4: auth.v1.UserService.GetMyUser:input_type -> auth.v1.GetMyUserRequest
 	4,  // 5: auth.v1.UserService.GetMyEmail:input_type -> auth.v1.GetMyEmailRequest
 	6,  // 6: auth.v1.UserService.UpdatePassword:input_type -> auth.v1.UpdatePasswordRequest
-	7,  // 7: auth.v1.UserService.DeleteMyUser:input_type -> auth.v1.DeleteMyUserRequest
-	1,  // 8: auth.v1.UserService.CreateUser:output_type -> auth.v1.CreateUserResponse
-	3,  // 9: auth.v1.UserService.GetMyUser:output_type -> auth.v1.GetMyUserResponse
-	5,  // 10: auth.v1.UserService.GetMyEmail:output_type -> auth.v1.GetMyEmailResponse
-	10, // 11: auth.v1.UserService.UpdatePassword:output_type -> google.protobuf.Empty
-	10, // 12: auth.v1.UserService.DeleteMyUser:output_type -> google.protobuf.Empty
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
+	7,  // 7: auth.v1.UserService.RequestPasswordReset:input_type -> auth.v1.RequestPasswordResetRequest
+	8,  // 8: auth.v1.UserService.ConfirmPasswordReset:input_type -> auth.v1.ConfirmPasswordResetRequest
+	9,  // 9: auth.v1.UserService.DeleteMyUser:input_type -> auth.v1.DeleteMyUserRequest
+	1,  // 10: auth.v1.UserService.CreateUser:output_type -> auth.v1.CreateUserResponse
+	3,  // 11: auth.v1.UserService.GetMyUser:output_type -> auth.v1.GetMyUserResponse
+	5,  // 12: auth.v1.UserService.GetMyEmail:output_type -> auth.v1.GetMyEmailResponse
+	12, // 13: auth.v1.UserService.UpdatePassword:output_type -> google.protobuf.Empty
+	12, // 14: auth.v1.UserService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	12, // 15: auth.v1.UserService.ConfirmPasswordReset:output_type -> google.protobuf.Empty
+	12, // 16: auth.v1.UserService.DeleteMyUser:output_type -> google.protobuf.Empty
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
 	3,  // [3:3] is the sub-list for extension type_name
 	3,  // [3:3] is the sub-list for extension extendee
 	0,  // [0:3] is the sub-list for field type_name
@@ -470,7 +582,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,11 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_CreateUser_FullMethodName     = "/auth.v1.UserService/CreateUser"
-	UserService_GetMyUser_FullMethodName      = "/auth.v1.UserService/GetMyUser"
-	UserService_GetMyEmail_FullMethodName     = "/auth.v1.UserService/GetMyEmail"
-	UserService_UpdatePassword_FullMethodName = "/auth.v1.UserService/UpdatePassword"
-	UserService_DeleteMyUser_FullMethodName   = "/auth.v1.UserService/DeleteMyUser"
+	UserService_CreateUser_FullMethodName           = "/auth.v1.UserService/CreateUser"
+	UserService_GetMyUser_FullMethodName            = "/auth.v1.UserService/GetMyUser"
+	UserService_GetMyEmail_FullMethodName           = "/auth.v1.UserService/GetMyEmail"
+	UserService_UpdatePassword_FullMethodName       = "/auth.v1.UserService/UpdatePassword"
+	UserService_RequestPasswordReset_FullMethodName = "/auth.v1.UserService/RequestPasswordReset"
+	UserService_ConfirmPasswordReset_FullMethodName = "/auth.v1.UserService/ConfirmPasswordReset"
+	UserService_DeleteMyUser_FullMethodName         = "/auth.v1.UserService/DeleteMyUser"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -33,7 +35,8 @@ const (
 //
 // UserService manages users: registration, data, credentials.
 //
-// All methods except CreateUser require authentication via metadata:
+// All methods except CreateUser, RequestPasswordReset and
+// ConfirmPasswordReset require authentication via metadata:
 //
 //	authorization: Bearer <access_token>
 type UserServiceClient interface {
@@ -65,8 +68,24 @@ type UserServiceClient interface {
 	//	INVALID_ARGUMENT    — new password does not meet requirements
 	//	FAILED_PRECONDITION — password was changed too recently
 	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RequestPasswordReset mails a single-use password reset token to the
+	// given address. It succeeds whether or not the address belongs to a user.
+	//
+	// Errors:
+	//
+	//	INVALID_ARGUMENT    — email is empty
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ConfirmPasswordReset sets a new password using a token sent by
+	// RequestPasswordReset and revokes every session of the user.
+	//
+	// Errors:
+	//
+	//	INVALID_ARGUMENT    — token is invalid, expired or already used,
+	//	                      or new password does not meet requirements
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// DeleteMyUser soft-deletes the authenticated user along with all its
-	// sessions and email addresses. The operation is irreversible via the API.
+	// sessions and email addresses. Only an admin can undo it, with
+	// AdminService.RestoreUser.
 	//
 	// Errors:
 	//
@@ -122,6 +141,26 @@ func (c *userServiceClient) UpdatePassword(ctx context.Context, in *UpdatePasswo
 	return out, nil
 }
 
+func (c *userServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_ConfirmPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) DeleteMyUser(ctx context.Context, in *DeleteMyUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -138,7 +177,8 @@ func (c *userServiceClient) DeleteMyUser(ctx context.Context, in *DeleteMyUserRe
 //
 // UserService manages users: registration, data, credentials.
 //
-// All methods except CreateUser require authentication via metadata:
+// All methods except CreateUser, RequestPasswordReset and
+// ConfirmPasswordReset require authentication via metadata:
 //
 //	authorization: Bearer <access_token>
 type UserServiceServer interface {
@@ -170,8 +210,24 @@ type UserServiceServer interface {
 	//	INVALID_ARGUMENT    — new password does not meet requirements
 	//	FAILED_PRECONDITION — password was changed too recently
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*emptypb.Empty, error)
+	// RequestPasswordReset mails a single-use password reset token to the
+	// given address. It succeeds whether or not the address belongs to a user.
+	//
+	// Errors:
+	//
+	//	INVALID_ARGUMENT    — email is empty
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// ConfirmPasswordReset sets a new password using a token sent by
+	// RequestPasswordReset and revokes every session of the user.
+	//
+	// Errors:
+	//
+	//	INVALID_ARGUMENT    — token is invalid, expired or already used,
+	//	                      or new password does not meet requirements
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*emptypb.Empty, error)
 	// DeleteMyUser soft-deletes the authenticated user along with all its
-	// sessions and email addresses. The operation is irreversible via the API.
+	// sessions and email addresses. Only an admin can undo it, with
+	// AdminService.RestoreUser.
 	//
 	// Errors:
 	//
@@ -199,6 +255,12 @@ func (UnimplementedUserServiceServer) GetMyEmail(context.Context, *GetMyEmailReq
 func (UnimplementedUserServiceServer) UpdatePassword(context.Context, *UpdatePasswordRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdatePassword not implemented")
 }
+func (UnimplementedUserServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
 func (UnimplementedUserServiceServer) DeleteMyUser(context.Context, *DeleteMyUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteMyUser not implemented")
 }
@@ -295,6 +357,42 @@ func _UserService_UpdatePassword_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_DeleteMyUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteMyUserRequest)
 	if err := dec(in); err != nil {
@@ -336,6 +434,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _UserService_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _UserService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _UserService_ConfirmPasswordReset_Handler,
+		},
 		{
 			MethodName: "DeleteMyUser",
 			Handler:    _UserService_DeleteMyUser_Handler,
@@ -0,0 +1,60 @@
+package tokenmanager
+
+import (
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrNoSigningKey is returned when an ID token is requested but only the
+// HS256 secret is configured: relying parties verify ID tokens against the
+// JWKS, so they need an asymmetric key.
+var ErrNoSigningKey = errors.New("no active asymmetric signing key")
+
+// IDTokenClaims are the claims of an OpenID Connect ID token. Profile and
+// email claims are left empty when the client was not granted their scope.
+type IDTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce    string           `json:"nonce,omitempty"`
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
+
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Pseudonym         string `json:"pseudonym,omitempty"`
+
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+}
+
+// GenerateIDToken signs an ID token with the active access token key. The
+// caller sets every registered claim, the issuer included: it is the OpenID
+// Provider's issuer URL, not the one access tokens carry.
+func (m *Manager) GenerateIDToken(claims IDTokenClaims) (string, error) {
+	if m.active == nil {
+		return "", ErrNoSigningKey
+	}
+
+	method, err := signingMethod(m.active.Private)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = m.active.ID
+	return token.SignedString(m.active.Private)
+}
+
+// SigningAlgorithms lists the algorithms of the configured signing keys, as
+// advertised in the OpenID Provider metadata.
+func (m *Manager) SigningAlgorithms() []string {
+	seen := make(map[string]bool, len(m.cfg.AccessKeys))
+	algs := make([]string, 0, len(m.cfg.AccessKeys))
+	for _, k := range m.cfg.AccessKeys {
+		method, err := signingMethod(k.Private)
+		if err != nil || seen[method.Alg()] {
+			continue
+		}
+		seen[method.Alg()] = true
+		algs = append(algs, method.Alg())
+	}
+	return algs
+}
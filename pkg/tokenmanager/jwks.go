@@ -0,0 +1,63 @@
+package tokenmanager
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+)
+
+// JWK is the public half of a signing key as published in the JWKS
+// (RFC 7517). RSA keys fill N and E, Ed25519 keys Crv and X.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys other services verify access tokens with:
+// the active key and every retiring one, so tokens signed before a rotation
+// keep verifying until they expire.
+func (m *Manager) JWKS() JWKS {
+	set := JWKS{Keys: make([]JWK, 0, len(m.cfg.AccessKeys))}
+	for _, k := range m.cfg.AccessKeys {
+		set.Keys = append(set.Keys, publicJWK(k))
+	}
+	return set
+}
+
+func publicJWK(k Key) JWK {
+	switch pub := k.Private.Public().(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			Kid: k.ID,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}
+	case ed25519.PublicKey:
+		return JWK{
+			Kty: "OKP",
+			Kid: k.ID,
+			Use: "sig",
+			Alg: "EdDSA",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(pub),
+		}
+	default:
+		return JWK{Kid: k.ID}
+	}
+}
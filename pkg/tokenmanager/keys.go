@@ -0,0 +1,143 @@
+package tokenmanager
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// KeyState tells what an access token signing key is used for. The active
+// key signs new tokens; retiring keys only verify tokens signed before the
+// rotation, until those expire and the key can be removed.
+type KeyState string
+
+const (
+	KeyActive   KeyState = "active"
+	KeyRetiring KeyState = "retiring"
+)
+
+// Key is an asymmetric access token signing key. Its algorithm follows from
+// the key type: RS256 for RSA, EdDSA for Ed25519.
+type Key struct {
+	ID      string
+	State   KeyState
+	Private crypto.Signer
+}
+
+// KeyRef names a key file and the state to load it in.
+type KeyRef struct {
+	ID    string
+	State KeyState
+}
+
+// LoadKeys reads a PEM encoded private key (PKCS#8, or PKCS#1 for RSA) for
+// every ref from <dir>/<id>.pem. Exactly one of the refs must be active.
+func LoadKeys(dir string, refs []KeyRef) ([]Key, error) {
+	keys := make([]Key, 0, len(refs))
+	for _, ref := range refs {
+		data, err := os.ReadFile(filepath.Join(dir, ref.ID+".pem"))
+		if err != nil {
+			return nil, fmt.Errorf("read signing key %q: %w", ref.ID, err)
+		}
+
+		private, err := ParsePrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("signing key %q: %w", ref.ID, err)
+		}
+
+		keys = append(keys, Key{ID: ref.ID, State: ref.State, Private: private})
+	}
+
+	if err := validateKeys(keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// ParsePrivateKey decodes a PEM encoded RSA or Ed25519 private key.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("rsa key is %d bits, at least 2048 required", k.N.BitLen())
+		}
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+func validateKeys(keys []Key) error {
+	seen := make(map[string]bool, len(keys))
+	active := 0
+	for _, k := range keys {
+		if k.ID == "" {
+			return errors.New("signing key without id")
+		}
+		if seen[k.ID] {
+			return fmt.Errorf("duplicate signing key id %q", k.ID)
+		}
+		seen[k.ID] = true
+
+		switch k.State {
+		case KeyActive:
+			active++
+		case KeyRetiring:
+		default:
+			return fmt.Errorf("signing key %q has unknown state %q", k.ID, k.State)
+		}
+
+		if _, err := signingMethod(k.Private); err != nil {
+			return fmt.Errorf("signing key %q: %w", k.ID, err)
+		}
+	}
+
+	if len(keys) > 0 && active != 1 {
+		return fmt.Errorf("exactly one active signing key required, got %d", active)
+	}
+
+	return nil
+}
+
+func signingMethod(key crypto.Signer) (jwt.SigningMethod, error) {
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PrivateKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -15,9 +18,17 @@ import (
 type Config struct {
 	Issuer string
 
+	// AccessKeys sign access tokens when set, see LoadKeys. Without them
+	// access tokens are signed with AccessSecretKey (HS256). With both, the
+	// secret only verifies HS256 tokens issued before the switch.
+	AccessKeys      []Key
 	AccessSecretKey string
 	AccessTTL       time.Duration
 
+	// ServiceTTL is the lifetime of client_credentials tokens. They are
+	// signed like access tokens.
+	ServiceTTL time.Duration
+
 	RefreshSecretKey string
 	RefreshTTL       time.Duration
 	RefreshHashKey   string
@@ -25,10 +36,20 @@ type Config struct {
 
 type Manager struct {
 	cfg Config
+
+	keys   map[string]Key
+	active *Key
 }
 
 func New(cfg Config) *Manager {
-	return &Manager{cfg: cfg}
+	m := &Manager{cfg: cfg, keys: make(map[string]Key, len(cfg.AccessKeys))}
+	for i, k := range cfg.AccessKeys {
+		m.keys[k.ID] = k
+		if k.State == KeyActive && m.active == nil {
+			m.active = &cfg.AccessKeys[i]
+		}
+	}
+	return m
 }
 
 func (m *Manager) GenerateAccess(user models.User, sessionID uuid.UUID) (string, error) {
@@ -41,7 +62,29 @@ func (m *Manager) GenerateAccess(user models.User, sessionID uuid.UUID) (string,
 		Role:      user.Role,
 		SessionID: sessionID,
 	}
-	return claims.GenerateJWT(m.cfg.AccessSecretKey)
+
+	if err := claims.Validate(); err != nil {
+		return "", err
+	}
+
+	return m.signAccess(claims)
+}
+
+// signAccess signs claims with the active key, or with AccessSecretKey
+// (HS256, no kid) when no keys are configured.
+func (m *Manager) signAccess(claims jwt.Claims) (string, error) {
+	if m.active == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(m.cfg.AccessSecretKey))
+	}
+
+	method, err := signingMethod(m.active.Private)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = m.active.ID
+	return token.SignedString(m.active.Private)
 }
 
 func (m *Manager) GenerateRefresh(user models.User, sessionID uuid.UUID) (string, error) {
@@ -57,8 +100,46 @@ func (m *Manager) GenerateRefresh(user models.User, sessionID uuid.UUID) (string
 	return claims.GenerateJWT(m.cfg.RefreshSecretKey)
 }
 
-func (m *Manager) ParseUserAuthAccess(tokenStr string) (tokens.AccountAuthClaims, error) {
-	return tokens.ParseAccountJWT(tokenStr, m.cfg.AccessSecretKey)
+// ParseUserAuthAccess verifies an access token with the key its kid header
+// names, active or retiring. Tokens without a kid are HS256 tokens and are
+// accepted only while AccessSecretKey is configured. Tokens issued to an
+// OpenID Connect client carry an audience and are rejected, see
+// ParseClientAccess.
+func (m *Manager) ParseUserAuthAccess(tokenStr string) (claims tokens.AccountAuthClaims, err error) {
+	if _, err = jwt.ParseWithClaims(tokenStr, &claims, m.accessKey); err != nil {
+		return claims, err
+	}
+	if len(claims.Audience) > 0 {
+		return tokens.AccountAuthClaims{}, fmt.Errorf("%w: token was issued to %s",
+			jwt.ErrTokenInvalidAudience, strings.Join(claims.Audience, ", "))
+	}
+	return claims, nil
+}
+
+func (m *Manager) accessKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if token.Method != jwt.SigningMethodHS256 || m.cfg.AccessSecretKey == "" {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(m.cfg.AccessSecretKey), nil
+	}
+
+	key, ok := m.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown signing key %q", jwt.ErrTokenUnverifiable, kid)
+	}
+
+	method, err := signingMethod(key.Private)
+	if err != nil {
+		return nil, err
+	}
+	if token.Method.Alg() != method.Alg() {
+		return nil, errors.Join(jwt.ErrSignatureInvalid,
+			fmt.Errorf("key %q signs %s, token says %s", kid, method.Alg(), token.Method.Alg()))
+	}
+
+	return key.Private.Public(), nil
 }
 
 func (m *Manager) ParseUserAuthRefresh(tokenStr string) (tokens.AccountAuthClaims, error) {
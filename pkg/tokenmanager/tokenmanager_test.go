@@ -0,0 +1,308 @@
+package tokenmanager
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+var testUser = models.User{ID: uuid.New(), Role: "user"}
+
+func newRSAKey(t *testing.T, id string, state KeyState) Key {
+	t.Helper()
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return Key{ID: id, State: state, Private: private}
+}
+
+func newEd25519Key(t *testing.T, id string, state KeyState) Key {
+	t.Helper()
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return Key{ID: id, State: state, Private: private}
+}
+
+func newManager(keys ...Key) *Manager {
+	return New(Config{
+		Issuer:     "auth-svc",
+		AccessKeys: keys,
+		AccessTTL:  time.Hour,
+	})
+}
+
+func TestAccess_SignedWithActiveKey(t *testing.T) {
+	for _, key := range []Key{
+		newRSAKey(t, "rsa-1", KeyActive),
+		newEd25519Key(t, "ed-1", KeyActive),
+	} {
+		m := newManager(key)
+		sessionID := uuid.New()
+
+		token, err := m.GenerateAccess(testUser, sessionID)
+		require.NoError(t, err)
+
+		parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwt.RegisteredClaims{})
+		require.NoError(t, err)
+		require.Equal(t, key.ID, parsed.Header["kid"])
+
+		claims, err := m.ParseUserAuthAccess(token)
+		require.NoError(t, err)
+		require.Equal(t, testUser.ID, claims.GetAccountID())
+		require.Equal(t, sessionID, claims.SessionID)
+	}
+}
+
+func TestAccess_RetiringKeyStillVerifies(t *testing.T) {
+	old := newRSAKey(t, "2026-07", KeyActive)
+	token, err := newManager(old).GenerateAccess(testUser, uuid.New())
+	require.NoError(t, err)
+
+	// Rotation: a new key becomes active, the old one retires.
+	old.State = KeyRetiring
+	rotated := newManager(newEd25519Key(t, "2026-10", KeyActive), old)
+
+	_, err = rotated.ParseUserAuthAccess(token)
+	require.NoError(t, err)
+
+	fresh, err := rotated.GenerateAccess(testUser, uuid.New())
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(fresh, &jwt.RegisteredClaims{})
+	require.NoError(t, err)
+	require.Equal(t, "2026-10", parsed.Header["kid"])
+
+	// Once the key is removed its tokens stop verifying.
+	_, err = newManager(newEd25519Key(t, "2026-10", KeyActive)).ParseUserAuthAccess(token)
+	require.Error(t, err)
+}
+
+func TestAccess_Rejected(t *testing.T) {
+	key := newRSAKey(t, "rsa-1", KeyActive)
+	m := newManager(key)
+
+	t.Run("hs256 without secret", func(t *testing.T) {
+		hmacOnly := New(Config{AccessSecretKey: "secret", AccessTTL: time.Hour})
+		token, err := hmacOnly.GenerateAccess(testUser, uuid.New())
+		require.NoError(t, err)
+
+		_, err = m.ParseUserAuthAccess(token)
+		require.Error(t, err)
+	})
+
+	t.Run("algorithm confusion", func(t *testing.T) {
+		// An HS256 token keyed with the public key bytes must not pass as
+		// signed by the RSA key.
+		pub, err := x509.MarshalPKIXPublicKey(key.Private.Public())
+		require.NoError(t, err)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub":        testUser.ID.String(),
+			"role":       "user",
+			"session_id": uuid.New().String(),
+			"exp":        time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = key.ID
+		signed, err := token.SignedString(pub)
+		require.NoError(t, err)
+
+		_, err = m.ParseUserAuthAccess(signed)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown kid", func(t *testing.T) {
+		token, err := newManager(newRSAKey(t, "other", KeyActive)).GenerateAccess(testUser, uuid.New())
+		require.NoError(t, err)
+
+		_, err = m.ParseUserAuthAccess(token)
+		require.Error(t, err)
+	})
+}
+
+func TestAccess_HMACFallbackDuringMigration(t *testing.T) {
+	legacy := New(Config{AccessSecretKey: "secret", AccessTTL: time.Hour})
+	token, err := legacy.GenerateAccess(testUser, uuid.New())
+	require.NoError(t, err)
+
+	m := New(Config{
+		AccessKeys:      []Key{newRSAKey(t, "rsa-1", KeyActive)},
+		AccessSecretKey: "secret",
+		AccessTTL:       time.Hour,
+	})
+
+	_, err = m.ParseUserAuthAccess(token)
+	require.NoError(t, err)
+}
+
+func TestJWKS(t *testing.T) {
+	rsaKey := newRSAKey(t, "rsa-1", KeyRetiring)
+	edKey := newEd25519Key(t, "ed-1", KeyActive)
+
+	set := newManager(edKey, rsaKey).JWKS()
+	require.Len(t, set.Keys, 2)
+
+	ed := set.Keys[0]
+	require.Equal(t, "OKP", ed.Kty)
+	require.Equal(t, "EdDSA", ed.Alg)
+	require.Equal(t, "ed-1", ed.Kid)
+	x, err := base64.RawURLEncoding.DecodeString(ed.X)
+	require.NoError(t, err)
+	require.Equal(t, []byte(edKey.Private.Public().(ed25519.PublicKey)), x)
+
+	r := set.Keys[1]
+	require.Equal(t, "RSA", r.Kty)
+	require.Equal(t, "RS256", r.Alg)
+	require.Equal(t, "AQAB", r.E)
+	require.Empty(t, r.X)
+
+	require.Empty(t, New(Config{AccessSecretKey: "secret"}).JWKS().Keys)
+}
+
+func writeKey(t *testing.T, dir, id string, der []byte, blockType string) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, id+".pem"), data, 0o600))
+}
+
+func TestLoadKeys(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writeKey(t, dir, "rsa-1", x509.MarshalPKCS1PrivateKey(rsaKey), "RSA PRIVATE KEY")
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	writeKey(t, dir, "ed-1", der, "PRIVATE KEY")
+
+	keys, err := LoadKeys(dir, []KeyRef{
+		{ID: "ed-1", State: KeyActive},
+		{ID: "rsa-1", State: KeyRetiring},
+	})
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Equal(t, edKey, keys[0].Private)
+
+	_, err = LoadKeys(dir, []KeyRef{{ID: "ed-1", State: KeyRetiring}})
+	require.ErrorContains(t, err, "exactly one active")
+
+	_, err = LoadKeys(dir, []KeyRef{{ID: "ed-1", State: KeyActive}, {ID: "rsa-1", State: KeyActive}})
+	require.ErrorContains(t, err, "exactly one active")
+
+	_, err = LoadKeys(dir, []KeyRef{{ID: "ed-1", State: "paused"}})
+	require.ErrorContains(t, err, "unknown state")
+
+	_, err = LoadKeys(dir, []KeyRef{{ID: "missing", State: KeyActive}})
+	require.Error(t, err)
+
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	writeKey(t, dir, "small", x509.MarshalPKCS1PrivateKey(small), "RSA PRIVATE KEY")
+	_, err = LoadKeys(dir, []KeyRef{{ID: "small", State: KeyActive}})
+	require.ErrorContains(t, err, "2048")
+}
+
+func TestIDToken(t *testing.T) {
+	key := newEd25519Key(t, "ed-1", KeyActive)
+	m := newManager(key, newRSAKey(t, "rsa-1", KeyRetiring))
+
+	verified := true
+	signed, err := m.GenerateIDToken(IDTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "https://auth.netbill.local",
+			Subject:   testUser.ID.String(),
+			Audience:  jwt.ClaimStrings{"dashboard"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Nonce:             "n-0S6_WzA2Mj",
+		PreferredUsername: "alice",
+		EmailVerified:     &verified,
+	})
+	require.NoError(t, err)
+
+	var claims IDTokenClaims
+	token, err := jwt.ParseWithClaims(signed, &claims, func(*jwt.Token) (any, error) {
+		return key.Private.Public(), nil
+	}, jwt.WithAudience("dashboard"), jwt.WithIssuer("https://auth.netbill.local"))
+	require.NoError(t, err)
+	require.Equal(t, "ed-1", token.Header["kid"])
+	require.Equal(t, "n-0S6_WzA2Mj", claims.Nonce)
+	require.Equal(t, "alice", claims.PreferredUsername)
+	require.True(t, *claims.EmailVerified)
+
+	require.Equal(t, []string{"EdDSA", "RS256"}, m.SigningAlgorithms())
+
+	_, err = New(Config{AccessSecretKey: "secret"}).GenerateIDToken(IDTokenClaims{})
+	require.ErrorIs(t, err, ErrNoSigningKey)
+}
+
+func TestServiceAccess(t *testing.T) {
+	for _, m := range []*Manager{
+		newManager(newEd25519Key(t, "ed-1", KeyActive)),
+		New(Config{AccessSecretKey: "secret", AccessTTL: time.Hour, ServiceTTL: time.Hour}),
+	} {
+		m.cfg.ServiceTTL = time.Hour
+
+		token, err := m.GenerateServiceAccess("billing-svc", []string{"sessions:validate", "users:create"})
+		require.NoError(t, err)
+
+		claims, err := m.ParseServiceAccess(token)
+		require.NoError(t, err)
+		require.Equal(t, "billing-svc", claims.ClientID)
+		require.True(t, claims.HasScope("sessions:validate"))
+		require.False(t, claims.HasScope("openid"))
+
+		// Neither kind of token passes as the other.
+		_, err = m.ParseUserAuthAccess(token)
+		require.Error(t, err)
+
+		userToken, err := m.GenerateAccess(testUser, uuid.New())
+		require.NoError(t, err)
+		_, err = m.ParseServiceAccess(userToken)
+		require.Error(t, err)
+	}
+}
+
+func TestClientAccess(t *testing.T) {
+	for _, m := range []*Manager{
+		newManager(newEd25519Key(t, "ed-1", KeyActive)),
+		New(Config{AccessSecretKey: "secret", AccessTTL: time.Hour}),
+	} {
+		sessionID := uuid.New()
+
+		token, err := m.GenerateClientAccess(testUser, sessionID, "web-app", []string{"openid", "email"})
+		require.NoError(t, err)
+
+		claims, err := m.ParseClientAccess(token)
+		require.NoError(t, err)
+		require.Equal(t, "web-app", claims.ClientID)
+		require.Equal(t, jwt.ClaimStrings{"web-app"}, claims.Audience)
+		require.Equal(t, sessionID, claims.SessionID)
+		require.True(t, claims.HasScope("email"))
+		require.False(t, claims.HasScope("profile"))
+
+		// A client token is not a first-party or a service token, and a
+		// first-party token is not a client token.
+		_, err = m.ParseUserAuthAccess(token)
+		require.ErrorIs(t, err, jwt.ErrTokenInvalidAudience)
+		_, err = m.ParseServiceAccess(token)
+		require.Error(t, err)
+
+		userToken, err := m.GenerateAccess(testUser, sessionID)
+		require.NoError(t, err)
+		_, err = m.ParseClientAccess(userToken)
+		require.Error(t, err)
+	}
+}
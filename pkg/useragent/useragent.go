@@ -0,0 +1,69 @@
+// Package useragent extracts a human-readable platform and browser from a
+// User-Agent header. It is deliberately coarse: the result is only shown to
+// users in their session list, so recognising the common families is enough.
+package useragent
+
+import "strings"
+
+const Unknown = "Unknown"
+
+type rule struct {
+	marker string
+	name   string
+}
+
+// Order matters: several browsers and platforms carry the markers of the
+// ones they are based on (Edge and Opera say "Chrome", Chrome says
+// "Safari", Android says "Linux", iOS says "Mac OS X").
+var (
+	platforms = []rule{
+		{"android", "Android"},
+		{"iphone", "iOS"},
+		{"ipad", "iOS"},
+		{"ipod", "iOS"},
+		{"cros", "ChromeOS"},
+		{"windows", "Windows"},
+		{"mac os x", "macOS"},
+		{"macintosh", "macOS"},
+		{"linux", "Linux"},
+	}
+
+	browsers = []rule{
+		{"edg/", "Edge"},
+		{"edga/", "Edge"},
+		{"edgios/", "Edge"},
+		{"opr/", "Opera"},
+		{"yabrowser/", "Yandex Browser"},
+		{"samsungbrowser/", "Samsung Internet"},
+		{"firefox/", "Firefox"},
+		{"fxios/", "Firefox"},
+		{"crios/", "Chrome"},
+		{"chrome/", "Chrome"},
+		{"safari/", "Safari"},
+		{"grpc-", "gRPC"},
+		{"curl/", "curl"},
+		{"okhttp/", "OkHttp"},
+	}
+)
+
+// Parse returns the platform and browser families of ua, or Unknown for the
+// parts it cannot recognise. An empty ua yields empty strings.
+func Parse(ua string) (platform, browser string) {
+	if strings.TrimSpace(ua) == "" {
+		return "", ""
+	}
+
+	lower := strings.ToLower(ua)
+
+	return match(lower, platforms), match(lower, browsers)
+}
+
+func match(ua string, rules []rule) string {
+	for _, r := range rules {
+		if strings.Contains(ua, r.marker) {
+			return r.name
+		}
+	}
+
+	return Unknown
+}
@@ -0,0 +1,77 @@
+package useragent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		ua       string
+		platform string
+		browser  string
+	}{
+		{
+			name:     "chrome on windows",
+			ua:       "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+			platform: "Windows",
+			browser:  "Chrome",
+		},
+		{
+			name:     "edge on windows",
+			ua:       "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36 Edg/124.0.2478.51",
+			platform: "Windows",
+			browser:  "Edge",
+		},
+		{
+			name:     "safari on iphone",
+			ua:       "Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1",
+			platform: "iOS",
+			browser:  "Safari",
+		},
+		{
+			name:     "firefox on linux",
+			ua:       "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0",
+			platform: "Linux",
+			browser:  "Firefox",
+		},
+		{
+			name:     "chrome on android",
+			ua:       "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Mobile Safari/537.36",
+			platform: "Android",
+			browser:  "Chrome",
+		},
+		{
+			name:     "safari on mac",
+			ua:       "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4_1) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4.1 Safari/605.1.15",
+			platform: "macOS",
+			browser:  "Safari",
+		},
+		{
+			name:     "grpc client",
+			ua:       "grpc-go/1.80.0",
+			platform: Unknown,
+			browser:  "gRPC",
+		},
+		{
+			name:     "unrecognised",
+			ua:       "SomeBot/1.0",
+			platform: Unknown,
+			browser:  Unknown,
+		},
+		{
+			name: "empty",
+			ua:   "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			platform, browser := Parse(tc.ua)
+			require.Equal(t, tc.platform, platform)
+			require.Equal(t, tc.browser, browser)
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package username
 
 import (
+	"crypto/rand"
 	"fmt"
+	"math/big"
 	"regexp"
+	"strings"
+	"unicode"
 
 	"github.com/netbill/auth-svc/internal/errx"
 )
@@ -36,3 +40,36 @@ func (v *Validator) Validate(username string) error {
 
 	return nil
 }
+
+// generatedSuffixDigits is how many random digits FromEmail appends.
+const generatedSuffixDigits = 6
+
+// FromEmail derives a username for an account that was not asked to pick
+// one: the letters and digits of the email's local part, cut to fit, plus
+// random digits. The result passes Validate but may be taken; callers
+// check and retry with a fresh one.
+func FromEmail(email string) (string, error) {
+	local, _, _ := strings.Cut(email, "@")
+
+	var b strings.Builder
+	for _, r := range local {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	base := b.String()
+	if len(base) > MaxLength-generatedSuffixDigits {
+		base = base[:MaxLength-generatedSuffixDigits]
+	}
+	if base == "" {
+		base = "user"
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", fmt.Errorf("generate username suffix: %w", err)
+	}
+
+	return fmt.Sprintf("%s%0*d", base, generatedSuffixDigits, n.Int64()), nil
+}
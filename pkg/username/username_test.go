@@ -0,0 +1,27 @@
+package username
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromEmail(t *testing.T) {
+	v := NewValidator()
+
+	for _, email := range []string{
+		"John.Doe+news@example.com",
+		"a@example.com",
+		"._-@example.com",
+		strings.Repeat("x", 60) + "@example.com",
+	} {
+		name, err := FromEmail(email)
+		require.NoError(t, err)
+		require.NoError(t, v.Validate(name), name)
+	}
+
+	name, err := FromEmail("John.Doe+news@example.com")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(name, "johndoenews"), name)
+}
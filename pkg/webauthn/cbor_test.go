@@ -0,0 +1,57 @@
+package webauthn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCBOR(t *testing.T) {
+	// {"fmt": "none", 1: -7, "x": h'0102', "l": [true, null]}, then one spare byte.
+	data := []byte{
+		0xa4,
+		0x63, 'f', 'm', 't', 0x64, 'n', 'o', 'n', 'e',
+		0x01, 0x26,
+		0x61, 'x', 0x42, 0x01, 0x02,
+		0x61, 'l', 0x82, 0xf5, 0xf6,
+		0xff,
+	}
+
+	v, rest, err := decodeCBOR(data)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff}, rest)
+	require.Equal(t, map[any]any{
+		"fmt":    "none",
+		int64(1): int64(-7),
+		"x":      []byte{1, 2},
+		"l":      []any{true, nil},
+	}, v)
+}
+
+func TestDecodeCBOR_Malformed(t *testing.T) {
+	cases := map[string][]byte{
+		"empty":           {},
+		"truncated bytes": {0x45, 0x01},
+		"truncated map":   {0xa2, 0x01, 0x02},
+		"indefinite":      {0x9f, 0x01, 0xff},
+		"float":           {0xfb, 0, 0, 0, 0, 0, 0, 0, 0},
+		"duplicate key":   {0xa2, 0x01, 0x01, 0x01, 0x02},
+		"array key":       {0xa1, 0x80, 0x01},
+	}
+
+	for name, data := range cases {
+		_, _, err := decodeCBOR(data)
+		require.Error(t, err, name)
+	}
+}
+
+func TestDecodeCBOR_DepthLimit(t *testing.T) {
+	data := make([]byte, 0, maxCBORDepth+2)
+	for i := 0; i < maxCBORDepth+2; i++ {
+		data = append(data, 0x81)
+	}
+	data = append(data, 0x00)
+
+	_, _, err := decodeCBOR(data)
+	require.Error(t, err)
+}
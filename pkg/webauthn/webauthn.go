@@ -0,0 +1,351 @@
+// Package webauthn implements the relying party side of Web Authentication
+// (W3C WebAuthn Level 2) for passkeys: building the options handed to
+// navigator.credentials.create()/get() and verifying what the browser sends
+// back. Only "none" attestation is supported, which is what browsers send
+// when the relying party does not ask for attestation, and user
+// verification is always required, so a passkey is a complete login on its
+// own.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ErrVerification wraps every reason a ceremony response is rejected.
+var ErrVerification = errors.New("webauthn: verification failed")
+
+const challengeSize = 32
+
+// Authenticator data flags, WebAuthn section 6.1.
+const (
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttested     = 0x40
+)
+
+type Config struct {
+	// RPID is the relying party ID: the registrable domain credentials are
+	// scoped to, e.g. "netbill.io".
+	RPID string
+
+	// RPName is shown by the browser when a passkey is created.
+	RPName string
+
+	// Origins are the exact origins ceremonies may come from, e.g.
+	// "https://app.netbill.io".
+	Origins []string
+
+	// Timeout is the hint given to the browser for how long a ceremony may
+	// take.
+	Timeout time.Duration
+}
+
+type RelyingParty struct {
+	config Config
+	rpHash [32]byte
+}
+
+func New(cfg Config) (*RelyingParty, error) {
+	if cfg.RPID == "" {
+		return nil, errors.New("webauthn: empty relying party id")
+	}
+	if len(cfg.Origins) == 0 {
+		return nil, errors.New("webauthn: no allowed origins")
+	}
+	if cfg.RPName == "" {
+		cfg.RPName = cfg.RPID
+	}
+
+	return &RelyingParty{
+		config: cfg,
+		rpHash: sha256.Sum256([]byte(cfg.RPID)),
+	}, nil
+}
+
+// Credential is what has to be stored about a registered passkey to verify
+// logins with it later.
+type Credential struct {
+	ID        []byte
+	PublicKey []byte // COSE_Key
+	SignCount uint32
+	AAGUID    []byte
+}
+
+// BeginRegistration returns options for creating a discoverable credential
+// for user. exclude lists credential IDs the user already has, so the same
+// authenticator isn't registered twice.
+func (rp *RelyingParty) BeginRegistration(user UserEntity, exclude [][]byte) (CreationOptions, error) {
+	challenge, err := newChallenge()
+	if err != nil {
+		return CreationOptions{}, err
+	}
+
+	opts := CreationOptions{
+		Challenge: challenge,
+		RP:        RelyingPartyEntity{ID: rp.config.RPID, Name: rp.config.RPName},
+		User:      user,
+		PubKeyCredParams: []CredentialParameter{
+			{Type: credentialTypePublicKey, Alg: AlgES256},
+			{Type: credentialTypePublicKey, Alg: AlgEdDSA},
+			{Type: credentialTypePublicKey, Alg: AlgRS256},
+		},
+		Timeout: rp.config.Timeout.Milliseconds(),
+		AuthenticatorSelection: AuthenticatorSelection{
+			ResidentKey:        residentKeyRequired,
+			RequireResidentKey: true,
+			UserVerification:   userVerificationRequired,
+		},
+		Attestation: attestationNone,
+	}
+
+	for _, id := range exclude {
+		opts.ExcludeCredentials = append(opts.ExcludeCredentials, CredentialDescriptor{
+			Type: credentialTypePublicKey,
+			ID:   id,
+		})
+	}
+
+	return opts, nil
+}
+
+// BeginLogin returns options for a login with any passkey of the relying
+// party; the authenticator lets the user pick one.
+func (rp *RelyingParty) BeginLogin() (RequestOptions, error) {
+	challenge, err := newChallenge()
+	if err != nil {
+		return RequestOptions{}, err
+	}
+
+	return RequestOptions{
+		Challenge:        challenge,
+		Timeout:          rp.config.Timeout.Milliseconds(),
+		RPID:             rp.config.RPID,
+		UserVerification: userVerificationRequired,
+	}, nil
+}
+
+// FinishRegistration verifies the response to BeginRegistration options
+// that carried challenge, and returns the new credential.
+func (rp *RelyingParty) FinishRegistration(challenge []byte, cred RegistrationCredential) (Credential, error) {
+	if cred.Type != credentialTypePublicKey {
+		return Credential{}, verificationError("unexpected credential type %q", cred.Type)
+	}
+
+	if err := rp.verifyClientData(cred.Response.ClientDataJSON, "webauthn.create", challenge); err != nil {
+		return Credential{}, err
+	}
+
+	v, rest, err := decodeCBOR(cred.Response.AttestationObject)
+	if err != nil {
+		return Credential{}, verificationError("attestation object: %v", err)
+	}
+	if len(rest) != 0 {
+		return Credential{}, verificationError("attestation object: trailing data")
+	}
+
+	obj, ok := v.(map[any]any)
+	if !ok {
+		return Credential{}, verificationError("attestation object is not a map")
+	}
+
+	if format, _ := obj["fmt"].(string); format != attestationNone {
+		return Credential{}, verificationError("unsupported attestation format %q", format)
+	}
+
+	rawAuthData, _ := obj["authData"].([]byte)
+	authData, err := rp.parseAuthData(rawAuthData)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	if authData.flags&flagAttested == 0 {
+		return Credential{}, verificationError("authenticator data has no attested credential")
+	}
+
+	if len(cred.RawID) > 0 && !bytes.Equal(cred.RawID, authData.credentialID) {
+		return Credential{}, verificationError("credential id does not match authenticator data")
+	}
+
+	if _, err = parseCOSEKey(authData.publicKey); err != nil {
+		return Credential{}, verificationError("%v", err)
+	}
+
+	return Credential{
+		ID:        authData.credentialID,
+		PublicKey: authData.publicKey,
+		SignCount: authData.signCount,
+		AAGUID:    authData.aaguid,
+	}, nil
+}
+
+// FinishLogin verifies the response to BeginLogin options that carried
+// challenge against the stored credential, and returns the authenticator's
+// new signature counter.
+func (rp *RelyingParty) FinishLogin(challenge []byte, stored Credential, cred AssertionCredential) (uint32, error) {
+	if cred.Type != credentialTypePublicKey {
+		return 0, verificationError("unexpected credential type %q", cred.Type)
+	}
+
+	if !bytes.Equal(cred.RawID, stored.ID) {
+		return 0, verificationError("credential id does not match the stored credential")
+	}
+
+	if err := rp.verifyClientData(cred.Response.ClientDataJSON, "webauthn.get", challenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := rp.parseAuthData(cred.Response.AuthenticatorData)
+	if err != nil {
+		return 0, err
+	}
+
+	key, err := parseCOSEKey(stored.PublicKey)
+	if err != nil {
+		return 0, fmt.Errorf("webauthn: stored credential: %w", err)
+	}
+
+	clientDataHash := sha256.Sum256(cred.Response.ClientDataJSON)
+	signed := append(append([]byte(nil), cred.Response.AuthenticatorData...), clientDataHash[:]...)
+	if !key.verify(signed, cred.Response.Signature) {
+		return 0, verificationError("signature mismatch")
+	}
+
+	// Authenticators that keep a counter must move it forward on every use;
+	// a counter that didn't hints at a cloned authenticator. Both being zero
+	// means the authenticator doesn't count at all, which passkeys synced
+	// between devices commonly do.
+	if (authData.signCount != 0 || stored.SignCount != 0) && authData.signCount <= stored.SignCount {
+		return 0, verificationError("signature counter went from %d to %d", stored.SignCount, authData.signCount)
+	}
+
+	return authData.signCount, nil
+}
+
+// ClientChallenge returns the challenge a response was made for, so the
+// caller can look up the ceremony it belongs to before verifying it.
+func ClientChallenge(clientDataJSON []byte) ([]byte, error) {
+	var cd clientData
+	if err := json.Unmarshal(clientDataJSON, &cd); err != nil {
+		return nil, verificationError("client data: %v", err)
+	}
+
+	challenge, err := base64.RawURLEncoding.DecodeString(cd.Challenge)
+	if err != nil || len(challenge) == 0 {
+		return nil, verificationError("client data: malformed challenge")
+	}
+
+	return challenge, nil
+}
+
+func (rp *RelyingParty) verifyClientData(raw []byte, typ string, challenge []byte) error {
+	var cd clientData
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return verificationError("client data: %v", err)
+	}
+
+	if cd.Type != typ {
+		return verificationError("client data type is %q, want %q", cd.Type, typ)
+	}
+
+	got, err := base64.RawURLEncoding.DecodeString(cd.Challenge)
+	if err != nil || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return verificationError("client data challenge mismatch")
+	}
+
+	if !slices.Contains(rp.config.Origins, cd.Origin) {
+		return verificationError("origin %q is not allowed", cd.Origin)
+	}
+
+	if cd.CrossOrigin {
+		return verificationError("cross-origin ceremonies are not allowed")
+	}
+
+	return nil
+}
+
+type authenticatorData struct {
+	flags     byte
+	signCount uint32
+
+	aaguid       []byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+// parseAuthData parses authenticator data (WebAuthn section 6.1) and checks
+// the parts every ceremony has in common: the RP ID hash and the user
+// presence and verification flags.
+func (rp *RelyingParty) parseAuthData(raw []byte) (authenticatorData, error) {
+	const headerSize = 32 + 1 + 4
+
+	if len(raw) < headerSize {
+		return authenticatorData{}, verificationError("authenticator data too short")
+	}
+
+	if subtle.ConstantTimeCompare(raw[:32], rp.rpHash[:]) != 1 {
+		return authenticatorData{}, verificationError("rp id hash mismatch")
+	}
+
+	ad := authenticatorData{
+		flags:     raw[32],
+		signCount: binary.BigEndian.Uint32(raw[33:37]),
+	}
+
+	if ad.flags&flagUserPresent == 0 {
+		return authenticatorData{}, verificationError("user was not present")
+	}
+	if ad.flags&flagUserVerified == 0 {
+		return authenticatorData{}, verificationError("user was not verified")
+	}
+
+	if ad.flags&flagAttested == 0 {
+		return ad, nil
+	}
+
+	rest := raw[headerSize:]
+	if len(rest) < 18 {
+		return authenticatorData{}, verificationError("attested credential data too short")
+	}
+
+	ad.aaguid = append([]byte(nil), rest[:16]...)
+	idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+
+	if idLen == 0 || len(rest) < idLen {
+		return authenticatorData{}, verificationError("malformed credential id")
+	}
+	ad.credentialID = append([]byte(nil), rest[:idLen]...)
+	rest = rest[idLen:]
+
+	// The COSE key is the only self-delimiting part; whatever follows it
+	// belongs to extensions, which aren't used.
+	_, after, err := decodeCBOR(rest)
+	if err != nil {
+		return authenticatorData{}, verificationError("credential public key: %v", err)
+	}
+	ad.publicKey = append([]byte(nil), rest[:len(rest)-len(after)]...)
+
+	return ad, nil
+}
+
+func newChallenge() (Bytes, error) {
+	b := make([]byte, challengeSize)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("webauthn: generate challenge: %w", err)
+	}
+	return b, nil
+}
+
+func verificationError(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrVerification, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,176 @@
+package webauthn_test
+
+import (
+	"testing"
+
+	"github.com/netbill/auth-svc/pkg/webauthn"
+	"github.com/netbill/auth-svc/pkg/webauthn/webauthntest"
+	"github.com/stretchr/testify/require"
+)
+
+const testOrigin = "https://app.netbill.test"
+
+func newRP(t *testing.T) *webauthn.RelyingParty {
+	t.Helper()
+	rp, err := webauthn.New(webauthn.Config{
+		RPID:    "netbill.test",
+		RPName:  "netbill",
+		Origins: []string{testOrigin},
+	})
+	require.NoError(t, err)
+	return rp
+}
+
+func register(t *testing.T, rp *webauthn.RelyingParty, a *webauthntest.Authenticator) webauthn.Credential {
+	t.Helper()
+
+	opts, err := rp.BeginRegistration(webauthn.UserEntity{ID: []byte("user-1"), Name: "alice", DisplayName: "alice"}, nil)
+	require.NoError(t, err)
+
+	resp, err := a.Register(opts)
+	require.NoError(t, err)
+
+	cred, err := rp.FinishRegistration(opts.Challenge, resp)
+	require.NoError(t, err)
+	return cred
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	rp := newRP(t)
+	a := webauthntest.New(testOrigin)
+
+	cred := register(t, rp, a)
+	require.NotEmpty(t, cred.ID)
+	require.NotEmpty(t, cred.PublicKey)
+
+	opts, err := rp.BeginLogin()
+	require.NoError(t, err)
+
+	resp, err := a.Login(opts)
+	require.NoError(t, err)
+
+	challenge, err := webauthn.ClientChallenge(resp.Response.ClientDataJSON)
+	require.NoError(t, err)
+	require.Equal(t, []byte(opts.Challenge), challenge)
+
+	count, err := rp.FinishLogin(opts.Challenge, cred, resp)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), count)
+}
+
+func TestFinishRegistration_Rejects(t *testing.T) {
+	rp := newRP(t)
+
+	cases := map[string]func(a *webauthntest.Authenticator){
+		"wrong origin": func(a *webauthntest.Authenticator) { a.Origin = "https://evil.test" },
+		"wrong rp id":  func(a *webauthntest.Authenticator) { a.RPID = "evil.test" },
+		"no uv":        func(a *webauthntest.Authenticator) { a.UserVerified = false },
+	}
+
+	for name, tweak := range cases {
+		t.Run(name, func(t *testing.T) {
+			a := webauthntest.New(testOrigin)
+			tweak(a)
+
+			opts, err := rp.BeginRegistration(webauthn.UserEntity{ID: []byte("user-1"), Name: "alice"}, nil)
+			require.NoError(t, err)
+			resp, err := a.Register(opts)
+			require.NoError(t, err)
+
+			_, err = rp.FinishRegistration(opts.Challenge, resp)
+			require.ErrorIs(t, err, webauthn.ErrVerification)
+		})
+	}
+}
+
+func TestFinishRegistration_WrongChallenge(t *testing.T) {
+	rp := newRP(t)
+	a := webauthntest.New(testOrigin)
+
+	opts, err := rp.BeginRegistration(webauthn.UserEntity{ID: []byte("user-1"), Name: "alice"}, nil)
+	require.NoError(t, err)
+	resp, err := a.Register(opts)
+	require.NoError(t, err)
+
+	_, err = rp.FinishRegistration([]byte("another challenge"), resp)
+	require.ErrorIs(t, err, webauthn.ErrVerification)
+}
+
+func TestFinishLogin_TamperedSignature(t *testing.T) {
+	rp := newRP(t)
+	a := webauthntest.New(testOrigin)
+	cred := register(t, rp, a)
+
+	opts, err := rp.BeginLogin()
+	require.NoError(t, err)
+	resp, err := a.Login(opts)
+	require.NoError(t, err)
+
+	resp.Response.Signature[len(resp.Response.Signature)-1] ^= 0xff
+
+	_, err = rp.FinishLogin(opts.Challenge, cred, resp)
+	require.ErrorIs(t, err, webauthn.ErrVerification)
+}
+
+func TestFinishLogin_CounterMustIncrease(t *testing.T) {
+	rp := newRP(t)
+	a := webauthntest.New(testOrigin)
+	cred := register(t, rp, a)
+
+	opts, err := rp.BeginLogin()
+	require.NoError(t, err)
+	resp, err := a.Login(opts)
+	require.NoError(t, err)
+
+	cred.SignCount = 5
+	_, err = rp.FinishLogin(opts.Challenge, cred, resp)
+	require.ErrorIs(t, err, webauthn.ErrVerification)
+}
+
+func TestFinishLogin_NonCountingAuthenticator(t *testing.T) {
+	rp := newRP(t)
+	a := webauthntest.New(testOrigin)
+	a.Counting = false
+	cred := register(t, rp, a)
+
+	for range 2 {
+		opts, err := rp.BeginLogin()
+		require.NoError(t, err)
+		resp, err := a.Login(opts)
+		require.NoError(t, err)
+
+		count, err := rp.FinishLogin(opts.Challenge, cred, resp)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	}
+}
+
+func TestFinishLogin_OtherCredential(t *testing.T) {
+	rp := newRP(t)
+	cred := register(t, rp, webauthntest.New(testOrigin))
+
+	other := webauthntest.New(testOrigin)
+	register(t, rp, other)
+
+	opts, err := rp.BeginLogin()
+	require.NoError(t, err)
+	resp, err := other.Login(opts)
+	require.NoError(t, err)
+
+	_, err = rp.FinishLogin(opts.Challenge, cred, resp)
+	require.ErrorIs(t, err, webauthn.ErrVerification)
+}
+
+func TestBytes_JSON(t *testing.T) {
+	var b webauthn.Bytes
+	require.NoError(t, b.UnmarshalJSON([]byte(`"AQID"`)))
+	require.Equal(t, webauthn.Bytes{1, 2, 3}, b)
+
+	// Padded base64url is accepted too.
+	require.NoError(t, b.UnmarshalJSON([]byte(`"AQI="`)))
+	require.Equal(t, webauthn.Bytes{1, 2}, b)
+
+	out, err := webauthn.Bytes{0xfb, 0xff}.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `"-_8"`, string(out))
+}
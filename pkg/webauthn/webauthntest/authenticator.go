@@ -0,0 +1,207 @@
+// Package webauthntest provides a software authenticator that answers
+// webauthn ceremonies the way a browser and a platform authenticator would
+// together, for use in tests.
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/netbill/auth-svc/pkg/webauthn"
+)
+
+// Authenticator holds ES256 passkeys in memory. The exported fields can be
+// changed between ceremonies to produce responses a relying party must
+// reject.
+type Authenticator struct {
+	// Origin is reported in client data.
+	Origin string
+
+	// RPID overrides the relying party ID taken from the options when set.
+	RPID string
+
+	// UserVerified controls the UV flag; New sets it.
+	UserVerified bool
+
+	// Counting makes the authenticator keep a signature counter. Passkeys
+	// synced between devices usually report zero instead.
+	Counting bool
+
+	mu    sync.Mutex
+	creds []*credential
+}
+
+type credential struct {
+	id         []byte
+	rpID       string
+	userHandle []byte
+	key        *ecdsa.PrivateKey
+	counter    uint32
+}
+
+func New(origin string) *Authenticator {
+	return &Authenticator{Origin: origin, UserVerified: true, Counting: true}
+}
+
+// Register creates a passkey as navigator.credentials.create() would.
+func (a *Authenticator) Register(opts webauthn.CreationOptions) (webauthn.RegistrationCredential, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	rpID := a.rpID(opts.RP.ID)
+
+	for _, ex := range opts.ExcludeCredentials {
+		for _, c := range a.creds {
+			if c.rpID == rpID && string(c.id) == string(ex.ID) {
+				return webauthn.RegistrationCredential{}, errors.New("webauthntest: credential already registered")
+			}
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return webauthn.RegistrationCredential{}, err
+	}
+
+	id := make([]byte, 16)
+	if _, err = rand.Read(id); err != nil {
+		return webauthn.RegistrationCredential{}, err
+	}
+
+	c := &credential{id: id, rpID: rpID, userHandle: opts.User.ID, key: key}
+	a.creds = append(a.creds, c)
+
+	authData := a.authData(c, true)
+	attestation := encode(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+
+	return webauthn.RegistrationCredential{
+		ID:    base64.RawURLEncoding.EncodeToString(id),
+		RawID: id,
+		Type:  "public-key",
+		Response: webauthn.AttestationResponse{
+			ClientDataJSON:    a.clientData("webauthn.create", opts.Challenge),
+			AttestationObject: attestation,
+			Transports:        []string{"internal"},
+		},
+	}, nil
+}
+
+// Login signs in with the most recently created passkey for the relying
+// party that the options allow, as navigator.credentials.get() would.
+func (a *Authenticator) Login(opts webauthn.RequestOptions) (webauthn.AssertionCredential, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	rpID := a.rpID(opts.RPID)
+
+	var c *credential
+	for i := len(a.creds) - 1; i >= 0 && c == nil; i-- {
+		if a.creds[i].rpID != rpID {
+			continue
+		}
+		if len(opts.AllowCredentials) == 0 {
+			c = a.creds[i]
+		}
+		for _, allowed := range opts.AllowCredentials {
+			if string(allowed.ID) == string(a.creds[i].id) {
+				c = a.creds[i]
+			}
+		}
+	}
+	if c == nil {
+		return webauthn.AssertionCredential{}, errors.New("webauthntest: no credential for relying party")
+	}
+
+	if a.Counting {
+		c.counter++
+	}
+
+	authData := a.authData(c, false)
+	clientData := a.clientData("webauthn.get", opts.Challenge)
+
+	hash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), hash[:]...))
+
+	sig, err := ecdsa.SignASN1(rand.Reader, c.key, digest[:])
+	if err != nil {
+		return webauthn.AssertionCredential{}, err
+	}
+
+	return webauthn.AssertionCredential{
+		ID:    base64.RawURLEncoding.EncodeToString(c.id),
+		RawID: c.id,
+		Type:  "public-key",
+		Response: webauthn.AssertionResponse{
+			ClientDataJSON:    clientData,
+			AuthenticatorData: authData,
+			Signature:         sig,
+			UserHandle:        c.userHandle,
+		},
+	}, nil
+}
+
+func (a *Authenticator) rpID(fromOptions string) string {
+	if a.RPID != "" {
+		return a.RPID
+	}
+	return fromOptions
+}
+
+func (a *Authenticator) clientData(typ string, challenge []byte) []byte {
+	data, _ := json.Marshal(map[string]any{
+		"type":        typ,
+		"challenge":   base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+	return data
+}
+
+func (a *Authenticator) authData(c *credential, attested bool) []byte {
+	rpHash := sha256.Sum256([]byte(c.rpID))
+
+	flags := byte(0x01) // UP
+	if a.UserVerified {
+		flags |= 0x04
+	}
+	if attested {
+		flags |= 0x40
+	}
+
+	out := append([]byte(nil), rpHash[:]...)
+	out = append(out, flags)
+	out = binary.BigEndian.AppendUint32(out, c.counter)
+
+	if !attested {
+		return out
+	}
+
+	out = append(out, make([]byte, 16)...) // AAGUID
+	out = binary.BigEndian.AppendUint16(out, uint16(len(c.id)))
+	out = append(out, c.id...)
+
+	pub, err := c.key.PublicKey.Bytes()
+	if err != nil {
+		panic(fmt.Sprintf("webauthntest: encode public key: %v", err))
+	}
+
+	return append(out, encode(map[int]any{
+		1:  2,  // kty: EC2
+		3:  -7, // alg: ES256
+		-1: 1,  // crv: P-256
+		-2: pub[1:33],
+		-3: pub[33:],
+	})...)
+}
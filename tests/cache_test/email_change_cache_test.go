@@ -0,0 +1,43 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailChangeCache_SetAndConsume(t *testing.T) {
+	setupCacheTest(t)
+	cache := newEmailChangeCache(t)
+	ctx := context.Background()
+
+	v := models.EmailChange{
+		UserID:   testutil.RandomUUID(),
+		OldEmail: "alice@example.com",
+		NewEmail: "alice.new@example.com",
+	}
+
+	err := cache.Set(ctx, "hash", v)
+	require.NoError(t, err)
+
+	got, err := cache.Consume(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, v, got)
+
+	_, err = cache.Consume(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorEmailChangeTokenInvalid)
+}
+
+func TestEmailChangeCache_Consume_Miss(t *testing.T) {
+	setupCacheTest(t)
+	cache := newEmailChangeCache(t)
+	ctx := context.Background()
+
+	_, err := cache.Consume(ctx, "unknown")
+	assert.ErrorIs(t, err, errx.ErrorEmailChangeTokenInvalid)
+}
@@ -0,0 +1,57 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailVerificationCache_SetAndConsume(t *testing.T) {
+	setupCacheTest(t)
+	cache := newEmailVerificationCache(t)
+	ctx := context.Background()
+
+	v := models.EmailVerification{
+		UserID: testutil.RandomUUID(),
+		Email:  "alice@example.com",
+	}
+
+	err := cache.Set(ctx, "hash", v)
+	require.NoError(t, err)
+
+	got, err := cache.Consume(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, v, got)
+}
+
+func TestEmailVerificationCache_ConsumeIsSingleUse(t *testing.T) {
+	setupCacheTest(t)
+	cache := newEmailVerificationCache(t)
+	ctx := context.Background()
+
+	err := cache.Set(ctx, "hash", models.EmailVerification{
+		UserID: testutil.RandomUUID(),
+		Email:  "bob@example.com",
+	})
+	require.NoError(t, err)
+
+	_, err = cache.Consume(ctx, "hash")
+	require.NoError(t, err)
+
+	_, err = cache.Consume(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorEmailVerificationTokenInvalid)
+}
+
+func TestEmailVerificationCache_Consume_Miss(t *testing.T) {
+	setupCacheTest(t)
+	cache := newEmailVerificationCache(t)
+	ctx := context.Background()
+
+	_, err := cache.Consume(ctx, "unknown")
+	assert.ErrorIs(t, err, errx.ErrorEmailVerificationTokenInvalid)
+}
@@ -0,0 +1,79 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginAttemptCache_AddFailure_Counts(t *testing.T) {
+	setupCacheTest(t)
+	cache := newLoginAttemptCache(t)
+	ctx := context.Background()
+
+	for i := 1; i <= 3; i++ {
+		n, err := cache.AddFailure(ctx, "ip:203.0.113.7", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, i, n)
+	}
+
+	n, err := cache.AddFailure(ctx, "ip:198.51.100.1", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestLoginAttemptCache_AddFailure_WindowSlides(t *testing.T) {
+	setupCacheTest(t)
+	cache := newLoginAttemptCache(t)
+	ctx := context.Background()
+
+	_, err := cache.AddFailure(ctx, "ip:203.0.113.7", 200*time.Millisecond)
+	require.NoError(t, err)
+
+	time.Sleep(300 * time.Millisecond)
+
+	n, err := cache.AddFailure(ctx, "ip:203.0.113.7", 200*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestLoginAttemptCache_Lock(t *testing.T) {
+	setupCacheTest(t)
+	cache := newLoginAttemptCache(t)
+	ctx := context.Background()
+
+	d, err := cache.LockedFor(ctx, "email:hash")
+	require.NoError(t, err)
+	assert.Zero(t, d)
+
+	require.NoError(t, cache.Lock(ctx, "email:hash", time.Minute))
+
+	d, err = cache.LockedFor(ctx, "email:hash")
+	require.NoError(t, err)
+	assert.Greater(t, d, 50*time.Second)
+	assert.LessOrEqual(t, d, time.Minute)
+}
+
+func TestLoginAttemptCache_Reset(t *testing.T) {
+	setupCacheTest(t)
+	cache := newLoginAttemptCache(t)
+	ctx := context.Background()
+
+	_, err := cache.AddFailure(ctx, "email:hash", time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, cache.Lock(ctx, "email:hash", time.Minute))
+
+	require.NoError(t, cache.Reset(ctx, "email:hash"))
+
+	n, err := cache.AddFailure(ctx, "email:hash", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	// Reset forgives failures but doesn't lift a lockout already in place.
+	d, err := cache.LockedFor(ctx, "email:hash")
+	require.NoError(t, err)
+	assert.Positive(t, d)
+}
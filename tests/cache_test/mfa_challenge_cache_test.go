@@ -0,0 +1,87 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMFAChallengeCache_CreateAndGet(t *testing.T) {
+	setupCacheTest(t)
+	cache := newMFAChallengeCache(t)
+	ctx := context.Background()
+
+	userID := testutil.RandomUUID()
+
+	err := cache.Create(ctx, "hash", userID, "email:subject", time.Minute)
+	require.NoError(t, err)
+
+	got, loginSubject, err := cache.Get(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, userID, got)
+	assert.Equal(t, "email:subject", loginSubject)
+}
+
+func TestMFAChallengeCache_Get_Miss(t *testing.T) {
+	setupCacheTest(t)
+	cache := newMFAChallengeCache(t)
+
+	_, _, err := cache.Get(context.Background(), "unknown")
+	assert.ErrorIs(t, err, errx.ErrorMFAChallengeInvalid)
+}
+
+func TestMFAChallengeCache_AddFailure(t *testing.T) {
+	setupCacheTest(t)
+	cache := newMFAChallengeCache(t)
+	ctx := context.Background()
+
+	err := cache.Create(ctx, "hash", testutil.RandomUUID(), "", time.Minute)
+	require.NoError(t, err)
+
+	n, err := cache.AddFailure(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	n, err = cache.AddFailure(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestMFAChallengeCache_AddFailure_Miss(t *testing.T) {
+	rc := setupCacheTest(t)
+	cache := newMFAChallengeCache(t)
+	ctx := context.Background()
+
+	_, err := cache.AddFailure(ctx, "unknown")
+	assert.ErrorIs(t, err, errx.ErrorMFAChallengeInvalid)
+
+	// The failure must not resurrect the challenge without a TTL.
+	exists, err := rc.Exists(ctx, "mfa:challenge:unknown").Result()
+	require.NoError(t, err)
+	assert.Zero(t, exists)
+}
+
+func TestMFAChallengeCache_DeleteIsSingleUse(t *testing.T) {
+	setupCacheTest(t)
+	cache := newMFAChallengeCache(t)
+	ctx := context.Background()
+
+	err := cache.Create(ctx, "hash", testutil.RandomUUID(), "", time.Minute)
+	require.NoError(t, err)
+
+	existed, err := cache.Delete(ctx, "hash")
+	require.NoError(t, err)
+	assert.True(t, existed)
+
+	existed, err = cache.Delete(ctx, "hash")
+	require.NoError(t, err)
+	assert.False(t, existed)
+
+	_, _, err = cache.Get(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorMFAChallengeInvalid)
+}
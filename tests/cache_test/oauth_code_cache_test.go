@@ -0,0 +1,54 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthCodeCache_SetAndConsume(t *testing.T) {
+	setupCacheTest(t)
+	cache := newOAuthCodeCache(t)
+	ctx := context.Background()
+
+	authz := models.OAuthAuthorization{
+		ClientID:      "dashboard",
+		RedirectURI:   "https://dashboard.netbill.local/callback",
+		UserID:        testutil.RandomUUID(),
+		Scopes:        []string{"openid", "profile"},
+		Nonce:         "nonce",
+		CodeChallenge: "challenge",
+		AuthTime:      time.Now().UTC().Truncate(time.Second),
+	}
+
+	err := cache.Set(ctx, "hash", authz, time.Minute)
+	require.NoError(t, err)
+
+	got, err := cache.Consume(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, authz, got)
+
+	// A code is exchanged once.
+	_, err = cache.Consume(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorOAuthGrantInvalid)
+}
+
+func TestOAuthCodeCache_Expired(t *testing.T) {
+	setupCacheTest(t)
+	cache := newOAuthCodeCache(t)
+	ctx := context.Background()
+
+	err := cache.Set(ctx, "hash", models.OAuthAuthorization{ClientID: "dashboard"}, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	time.Sleep(300 * time.Millisecond)
+
+	_, err = cache.Consume(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorOAuthGrantInvalid)
+}
@@ -0,0 +1,70 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthStateCache_SetAndConsume(t *testing.T) {
+	setupCacheTest(t)
+	cache := newOAuthStateCache(t)
+	ctx := context.Background()
+
+	state := models.OAuthLoginState{
+		Nonce:        "nonce",
+		CodeVerifier: "verifier",
+		RedirectURI:  "https://app.netbill.local/after-login",
+	}
+
+	err := cache.Set(ctx, "hash", state, time.Minute)
+	require.NoError(t, err)
+
+	got, err := cache.Consume(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, state, got)
+
+	// A callback is completed once.
+	_, err = cache.Consume(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorOAuthStateInvalid)
+}
+
+func TestOAuthStateCache_Expired(t *testing.T) {
+	setupCacheTest(t)
+	cache := newOAuthStateCache(t)
+	ctx := context.Background()
+
+	err := cache.Set(ctx, "hash", models.OAuthLoginState{Nonce: "nonce"}, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	time.Sleep(300 * time.Millisecond)
+
+	_, err = cache.Consume(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorOAuthStateInvalid)
+}
+
+func TestOAuthStateCache_GetKeepsState(t *testing.T) {
+	setupCacheTest(t)
+	cache := newOAuthStateCache(t)
+	ctx := context.Background()
+
+	state := models.OAuthLoginState{Nonce: "nonce", BindingHash: "binding-hash"}
+	require.NoError(t, cache.Set(ctx, "hash", state, time.Minute))
+
+	got, err := cache.Get(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, state, got)
+
+	// Checking the state does not use it up.
+	got, err = cache.Consume(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, state, got)
+
+	_, err = cache.Get(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorOAuthStateInvalid)
+}
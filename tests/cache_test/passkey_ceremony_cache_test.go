@@ -0,0 +1,57 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasskeyCeremonyCache_SetAndConsume(t *testing.T) {
+	setupCacheTest(t)
+	cache := newPasskeyCeremonyCache(t)
+	ctx := context.Background()
+
+	ceremony := models.PasskeyCeremony{
+		Kind:   models.PasskeyCeremonyRegistration,
+		UserID: testutil.RandomUUID(),
+	}
+
+	err := cache.Set(ctx, "hash", ceremony, time.Minute)
+	require.NoError(t, err)
+
+	got, err := cache.Consume(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, ceremony, got)
+
+	// A ceremony is answered once.
+	_, err = cache.Consume(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorPasskeyChallengeInvalid)
+}
+
+func TestPasskeyCeremonyCache_Consume_Miss(t *testing.T) {
+	setupCacheTest(t)
+	cache := newPasskeyCeremonyCache(t)
+
+	_, err := cache.Consume(context.Background(), "unknown")
+	assert.ErrorIs(t, err, errx.ErrorPasskeyChallengeInvalid)
+}
+
+func TestPasskeyCeremonyCache_Expired(t *testing.T) {
+	setupCacheTest(t)
+	cache := newPasskeyCeremonyCache(t)
+	ctx := context.Background()
+
+	err := cache.Set(ctx, "hash", models.PasskeyCeremony{Kind: models.PasskeyCeremonyLogin}, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	time.Sleep(300 * time.Millisecond)
+
+	_, err = cache.Consume(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorPasskeyChallengeInvalid)
+}
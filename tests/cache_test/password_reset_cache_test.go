@@ -0,0 +1,51 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordResetCache_SetAndConsume(t *testing.T) {
+	setupCacheTest(t)
+	cache := newPasswordResetCache(t)
+	ctx := context.Background()
+
+	v := models.PasswordReset{UserID: testutil.RandomUUID()}
+
+	err := cache.Set(ctx, "hash", v)
+	require.NoError(t, err)
+
+	got, err := cache.Consume(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, v, got)
+}
+
+func TestPasswordResetCache_ConsumeIsSingleUse(t *testing.T) {
+	setupCacheTest(t)
+	cache := newPasswordResetCache(t)
+	ctx := context.Background()
+
+	err := cache.Set(ctx, "hash", models.PasswordReset{UserID: testutil.RandomUUID()})
+	require.NoError(t, err)
+
+	_, err = cache.Consume(ctx, "hash")
+	require.NoError(t, err)
+
+	_, err = cache.Consume(ctx, "hash")
+	assert.ErrorIs(t, err, errx.ErrorPasswordResetTokenInvalid)
+}
+
+func TestPasswordResetCache_Consume_Miss(t *testing.T) {
+	setupCacheTest(t)
+	cache := newPasswordResetCache(t)
+	ctx := context.Background()
+
+	_, err := cache.Consume(ctx, "unknown")
+	assert.ErrorIs(t, err, errx.ErrorPasswordResetTokenInvalid)
+}
@@ -0,0 +1,98 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newQRLogin() models.QRLogin {
+	now := time.Now().UTC().Truncate(time.Second)
+	return models.QRLogin{
+		Token:     "qr-token",
+		Status:    models.QRLoginPending,
+		Code:      "042917",
+		Client:    models.SessionClient{IP: "203.0.113.7", UserAgent: "curl/8.0", Platform: "Linux", Browser: "curl"},
+		CreatedAt: now,
+		ExpiresAt: now.Add(5 * time.Minute),
+	}
+}
+
+func TestQRCache_CreateAndGet(t *testing.T) {
+	setupCacheTest(t)
+	cache := newQRCache(t)
+	ctx := context.Background()
+
+	login := newQRLogin()
+	require.NoError(t, cache.Create(ctx, login, time.Minute))
+
+	got, err := cache.Get(ctx, login.Token)
+	require.NoError(t, err)
+	assert.Equal(t, login, got)
+}
+
+func TestQRCache_Get_Miss(t *testing.T) {
+	setupCacheTest(t)
+	cache := newQRCache(t)
+
+	_, err := cache.Get(context.Background(), "unknown")
+	assert.ErrorIs(t, err, errx.ErrorQRTokenNotFound)
+}
+
+func TestQRCache_Resolve(t *testing.T) {
+	setupCacheTest(t)
+	cache := newQRCache(t)
+	ctx := context.Background()
+
+	login := newQRLogin()
+	require.NoError(t, cache.Create(ctx, login, time.Minute))
+
+	resolved, err := cache.Resolve(ctx, login.Token, models.QRLoginRejected, 30*time.Second)
+	require.NoError(t, err)
+	assert.True(t, resolved)
+
+	// Only the first resolution wins.
+	resolved, err = cache.Resolve(ctx, login.Token, models.QRLoginConfirmed, 30*time.Second)
+	require.NoError(t, err)
+	assert.False(t, resolved)
+
+	got, err := cache.Get(ctx, login.Token)
+	require.NoError(t, err)
+	assert.Equal(t, models.QRLoginRejected, got.Status)
+}
+
+func TestQRCache_Resolve_Miss(t *testing.T) {
+	setupCacheTest(t)
+	cache := newQRCache(t)
+
+	_, err := cache.Resolve(context.Background(), "unknown", models.QRLoginConfirmed, time.Minute)
+	assert.ErrorIs(t, err, errx.ErrorQRTokenNotFound)
+}
+
+func TestQRCache_AddFailure(t *testing.T) {
+	setupCacheTest(t)
+	cache := newQRCache(t)
+	ctx := context.Background()
+
+	login := newQRLogin()
+	require.NoError(t, cache.Create(ctx, login, time.Minute))
+
+	for want := 1; want <= 3; want++ {
+		n, err := cache.AddFailure(ctx, login.Token)
+		require.NoError(t, err)
+		assert.Equal(t, want, n)
+	}
+}
+
+func TestQRCache_AddFailure_Miss(t *testing.T) {
+	setupCacheTest(t)
+	cache := newQRCache(t)
+
+	_, err := cache.AddFailure(context.Background(), "unknown")
+	assert.ErrorIs(t, err, errx.ErrorQRTokenNotFound)
+}
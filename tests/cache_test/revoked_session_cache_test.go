@@ -0,0 +1,46 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokedSessionCache_AddAndContains(t *testing.T) {
+	setupCacheTest(t)
+	cache := newRevokedSessionCache(t)
+	ctx := context.Background()
+
+	revoked, other := uuid.New(), uuid.New()
+	until := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+
+	require.NoError(t, cache.Add(ctx, map[uuid.UUID]time.Time{revoked: until}))
+
+	got, ok, err := cache.Contains(ctx, revoked)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, until.Equal(got))
+
+	_, ok, err = cache.Contains(ctx, other)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestRevokedSessionCache_EntryRunsOut(t *testing.T) {
+	setupCacheTest(t)
+	cache := newRevokedSessionCache(t)
+	ctx := context.Background()
+
+	id := uuid.New()
+	require.NoError(t, cache.Add(ctx, map[uuid.UUID]time.Time{id: time.Now().Add(100 * time.Millisecond)}))
+
+	time.Sleep(200 * time.Millisecond)
+
+	_, ok, err := cache.Contains(ctx, id)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -82,8 +82,68 @@ func newPasswordCache(t *testing.T) *chache.PasswordCache {
 	return chache.NewPasswordCache(testRedis, testCacheTTL, noop, testLog)
 }
 
+func newEmailVerificationCache(t *testing.T) *chache.EmailVerificationCache {
+	t.Helper()
+	require.NotNil(t, testRedis)
+	return chache.NewEmailVerificationCache(testRedis, testCacheTTL)
+}
+
+func newEmailChangeCache(t *testing.T) *chache.EmailChangeCache {
+	t.Helper()
+	require.NotNil(t, testRedis)
+	return chache.NewEmailChangeCache(testRedis, testCacheTTL)
+}
+
+func newPasswordResetCache(t *testing.T) *chache.PasswordResetCache {
+	t.Helper()
+	require.NotNil(t, testRedis)
+	return chache.NewPasswordResetCache(testRedis, testCacheTTL)
+}
+
 func newSessionCache(t *testing.T) *chache.SessionCache {
 	t.Helper()
 	require.NotNil(t, testRedis)
 	return chache.NewSessionCache(testRedis, testCacheTTL, noop, testLog)
 }
+
+func newLoginAttemptCache(t *testing.T) *chache.LoginAttemptCache {
+	t.Helper()
+	require.NotNil(t, testRedis)
+	return chache.NewLoginAttemptCache(testRedis, testLog)
+}
+
+func newRevokedSessionCache(t *testing.T) *chache.RevokedSessionCache {
+	t.Helper()
+	require.NotNil(t, testRedis)
+	return chache.NewRevokedSessionCache(testRedis, testLog)
+}
+
+func newMFAChallengeCache(t *testing.T) *chache.MFAChallengeCache {
+	t.Helper()
+	require.NotNil(t, testRedis)
+	return chache.NewMFAChallengeCache(testRedis)
+}
+
+func newPasskeyCeremonyCache(t *testing.T) *chache.PasskeyCeremonyCache {
+	t.Helper()
+	require.NotNil(t, testRedis)
+	return chache.NewPasskeyCeremonyCache(testRedis)
+}
+
+func newOAuthCodeCache(t *testing.T) *chache.OAuthCodeCache {
+	t.Helper()
+	require.NotNil(t, testRedis)
+	return chache.NewOAuthCodeCache(testRedis)
+}
+
+func newOAuthStateCache(t *testing.T) *chache.OAuthStateCache {
+	t.Helper()
+	require.NotNil(t, testRedis)
+	return chache.NewOAuthStateCache(testRedis)
+}
+
+func newQRCache(t *testing.T) *chache.QRCache {
+	t.Helper()
+	require.NotNil(t, testRedis)
+	return chache.NewQRCache(testRedis)
+}
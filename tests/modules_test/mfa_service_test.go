@@ -0,0 +1,89 @@
+package modules_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/pkg/totp"
+	"github.com/netbill/auth-svc/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMFAService_EnrollConfirmAndLogin(t *testing.T) {
+	db, rc := setup(t)
+	userSvc, sessionSvc := newServices(t, db, rc)
+	mfaSvc := newMFAService(t, db, rc)
+	ctx := context.Background()
+
+	acc, tokens, email := registerAndLogin(t, userSvc, sessionSvc)
+	actor := models.UserActor{ID: acc.ID, SessionID: tokens.SessionID, Role: acc.Role}
+
+	enrollment, err := mfaSvc.EnrollTOTP(ctx, actor)
+	require.NoError(t, err)
+	assert.Contains(t, enrollment.URI, enrollment.Secret)
+
+	// Still off until confirmed.
+	res, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword, models.SessionClient{})
+	require.NoError(t, err)
+	assert.Nil(t, res.Challenge)
+
+	code, err := totp.Code(enrollment.Secret, time.Now())
+	require.NoError(t, err)
+
+	recovery, err := mfaSvc.ConfirmTOTP(ctx, actor, code)
+	require.NoError(t, err)
+	require.Len(t, recovery, 10)
+
+	status, err := mfaSvc.GetMyStatus(ctx, actor)
+	require.NoError(t, err)
+	assert.True(t, status.Enabled)
+	assert.Equal(t, 10, status.RecoveryCodesRemaining)
+
+	res, err = sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword, models.SessionClient{})
+	require.NoError(t, err)
+	require.NotNil(t, res.Challenge)
+	assert.Empty(t, res.Tokens.Access)
+
+	// The code used for confirmation can't be replayed.
+	_, err = sessionSvc.LoginByMFA(ctx, res.Challenge.Token, code, models.SessionClient{})
+	assert.ErrorIs(t, err, errx.ErrorMFACodeInvalid)
+
+	pair, err := sessionSvc.LoginByMFA(ctx, res.Challenge.Token, recovery[0], models.SessionClient{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, pair.Access)
+
+	// The challenge is single use.
+	_, err = sessionSvc.LoginByMFA(ctx, res.Challenge.Token, recovery[1], models.SessionClient{})
+	assert.ErrorIs(t, err, errx.ErrorMFAChallengeInvalid)
+}
+
+func TestMFAService_Disable_WrongPassword(t *testing.T) {
+	db, rc := setup(t)
+	userSvc, sessionSvc := newServices(t, db, rc)
+	mfaSvc := newMFAService(t, db, rc)
+	ctx := context.Background()
+
+	acc, tokens, _ := registerAndLogin(t, userSvc, sessionSvc)
+	actor := models.UserActor{ID: acc.ID, SessionID: tokens.SessionID, Role: acc.Role}
+
+	enrollment, err := mfaSvc.EnrollTOTP(ctx, actor)
+	require.NoError(t, err)
+	code, err := totp.Code(enrollment.Secret, time.Now())
+	require.NoError(t, err)
+	_, err = mfaSvc.ConfirmTOTP(ctx, actor, code)
+	require.NoError(t, err)
+
+	err = mfaSvc.DisableTOTP(ctx, actor, "Wrong@pass1", "")
+	require.Error(t, err)
+
+	err = mfaSvc.DisableTOTP(ctx, actor, testutil.TestPassword, "")
+	require.NoError(t, err)
+
+	status, err := mfaSvc.GetMyStatus(ctx, actor)
+	require.NoError(t, err)
+	assert.False(t, status.Enabled)
+}
@@ -0,0 +1,107 @@
+package modules_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/passkey"
+	"github.com/netbill/auth-svc/pkg/webauthn/webauthntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasskeyService_RegisterAndLogin(t *testing.T) {
+	db, rc := setup(t)
+	userSvc, sessionSvc := newServices(t, db, rc)
+	passkeySvc := newPasskeyService(t, db, rc)
+	authn := webauthntest.New(testPasskeyOrigin)
+	ctx := context.Background()
+
+	acc, tokens, _ := registerAndLogin(t, userSvc, sessionSvc)
+	actor := models.UserActor{ID: acc.ID, SessionID: tokens.SessionID, Role: acc.Role}
+
+	creation, err := passkeySvc.BeginRegistration(ctx, actor)
+	require.NoError(t, err)
+
+	created, err := authn.Register(creation)
+	require.NoError(t, err)
+
+	pk, err := passkeySvc.FinishRegistration(ctx, actor, "", created)
+	require.NoError(t, err)
+	assert.Equal(t, passkey.DefaultName, pk.Name)
+	assert.Equal(t, []string{"internal"}, pk.Transports)
+
+	// The registration ceremony is single use.
+	_, err = passkeySvc.FinishRegistration(ctx, actor, "", created)
+	assert.ErrorIs(t, err, errx.ErrorPasskeyChallengeInvalid)
+
+	// The authenticator refuses to register the same user twice.
+	creation, err = passkeySvc.BeginRegistration(ctx, actor)
+	require.NoError(t, err)
+	require.Len(t, creation.ExcludeCredentials, 1)
+	_, err = authn.Register(creation)
+	assert.Error(t, err)
+
+	request, err := sessionSvc.BeginPasskeyLogin(ctx)
+	require.NoError(t, err)
+
+	assertion, err := authn.Login(request)
+	require.NoError(t, err)
+
+	pair, err := sessionSvc.LoginByPasskey(ctx, assertion, models.SessionClient{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, pair.Access)
+	assert.NotEqual(t, tokens.SessionID, pair.SessionID)
+
+	// Replaying the assertion fails: its challenge has been spent.
+	_, err = sessionSvc.LoginByPasskey(ctx, assertion, models.SessionClient{})
+	assert.ErrorIs(t, err, errx.ErrorPasskeyChallengeInvalid)
+
+	list, err := passkeySvc.ListMyPasskeys(ctx, actor)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.NotNil(t, list[0].LastUsedAt)
+	assert.Equal(t, uint32(1), list[0].SignCount)
+}
+
+func TestPasskeyService_RenameAndDelete(t *testing.T) {
+	db, rc := setup(t)
+	userSvc, sessionSvc := newServices(t, db, rc)
+	passkeySvc := newPasskeyService(t, db, rc)
+	authn := webauthntest.New(testPasskeyOrigin)
+	ctx := context.Background()
+
+	acc, tokens, _ := registerAndLogin(t, userSvc, sessionSvc)
+	actor := models.UserActor{ID: acc.ID, SessionID: tokens.SessionID, Role: acc.Role}
+
+	creation, err := passkeySvc.BeginRegistration(ctx, actor)
+	require.NoError(t, err)
+	created, err := authn.Register(creation)
+	require.NoError(t, err)
+	pk, err := passkeySvc.FinishRegistration(ctx, actor, "Laptop", created)
+	require.NoError(t, err)
+
+	// Another user can neither rename nor delete it.
+	other, otherTokens, _ := registerAndLogin(t, userSvc, sessionSvc)
+	otherActor := models.UserActor{ID: other.ID, SessionID: otherTokens.SessionID, Role: other.Role}
+	_, err = passkeySvc.RenameMyPasskey(ctx, otherActor, pk.ID, "Mine now")
+	assert.ErrorIs(t, err, errx.ErrorPasskeyNotFound)
+	err = passkeySvc.DeleteMyPasskey(ctx, otherActor, pk.ID)
+	assert.ErrorIs(t, err, errx.ErrorPasskeyNotFound)
+
+	renamed, err := passkeySvc.RenameMyPasskey(ctx, actor, pk.ID, "Phone")
+	require.NoError(t, err)
+	assert.Equal(t, "Phone", renamed.Name)
+
+	require.NoError(t, passkeySvc.DeleteMyPasskey(ctx, actor, pk.ID))
+
+	// A deleted passkey no longer logs in.
+	request, err := sessionSvc.BeginPasskeyLogin(ctx)
+	require.NoError(t, err)
+	assertion, err := authn.Login(request)
+	require.NoError(t, err)
+	_, err = sessionSvc.LoginByPasskey(ctx, assertion, models.SessionClient{})
+	assert.ErrorIs(t, err, errx.ErrorPasskeyNotFound)
+}
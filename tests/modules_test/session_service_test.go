@@ -8,7 +8,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/errx"
 	"github.com/netbill/auth-svc/internal/models"
+	authmodule "github.com/netbill/auth-svc/internal/modules/auth"
+	"github.com/netbill/auth-svc/internal/modules/session"
 	"github.com/netbill/auth-svc/internal/modules/user"
+	"github.com/netbill/auth-svc/internal/repo/pg"
 	"github.com/netbill/auth-svc/tests/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,7 +21,7 @@ func registerAndLogin(
 	t *testing.T,
 	userSvc *user.Service,
 	sessionSvc interface {
-		LoginByEmail(ctx context.Context, email, password string) (models.TokensPair, error)
+		LoginByEmail(ctx context.Context, email, password string, client models.SessionClient) (models.LoginResult, error)
 	},
 ) (models.User, models.TokensPair, string) {
 	t.Helper()
@@ -33,10 +36,10 @@ func registerAndLogin(
 	})
 	require.NoError(t, err)
 
-	tokens, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword)
+	res, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword, models.SessionClient{})
 	require.NoError(t, err)
 
-	return acc, tokens, email
+	return acc, res.Tokens, email
 }
 
 func TestSessionService_LoginByEmail(t *testing.T) {
@@ -53,8 +56,9 @@ func TestSessionService_LoginByEmail(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	tokens, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword)
+	res, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword, models.SessionClient{})
 	require.NoError(t, err)
+	tokens := res.Tokens
 	assert.NotEmpty(t, tokens.Access)
 	assert.NotEmpty(t, tokens.Refresh)
 	assert.NotEqual(t, uuid.Nil, tokens.SessionID)
@@ -74,7 +78,7 @@ func TestSessionService_LoginByEmail_WrongPassword(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	_, err = sessionSvc.LoginByEmail(ctx, email, "Wrong@pass1")
+	_, err = sessionSvc.LoginByEmail(ctx, email, "Wrong@pass1", models.SessionClient{})
 	assert.Error(t, err)
 }
 
@@ -82,7 +86,7 @@ func TestSessionService_LoginByEmail_NotFound(t *testing.T) {
 	db, rc := setup(t)
 	_, sessionSvc := newServices(t, db, rc)
 
-	_, err := sessionSvc.LoginByEmail(context.Background(), "nobody@example.com", testutil.TestPassword)
+	_, err := sessionSvc.LoginByEmail(context.Background(), "nobody@example.com", testutil.TestPassword, models.SessionClient{})
 	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
 }
 
@@ -147,6 +151,33 @@ func TestSessionService_Logout(t *testing.T) {
 	assert.NotNil(t, session.DeletedAt)
 }
 
+func TestSessionService_Logout_InvalidatesSession(t *testing.T) {
+	db, rc := setup(t)
+	userSvc, sessionSvc := newServices(t, db, rc)
+	ctx := context.Background()
+
+	authSvc := authmodule.New(authmodule.ServiceDeps{
+		UserRepo:    pg.NewUserRepo(db),
+		SessionRepo: pg.NewSessionRepo(db),
+	})
+
+	acc, tokens, _ := registerAndLogin(t, userSvc, sessionSvc)
+
+	actor := models.UserActor{
+		ID:        acc.ID,
+		SessionID: tokens.SessionID,
+		Role:      acc.Role,
+	}
+
+	_, _, err := authSvc.ValidateSession(ctx, actor)
+	require.NoError(t, err)
+
+	require.NoError(t, sessionSvc.Logout(ctx, actor))
+
+	_, _, err = authSvc.ValidateSession(ctx, actor)
+	assert.ErrorIs(t, err, errx.ErrorUserInvalidSession)
+}
+
 func TestSessionService_Refresh(t *testing.T) {
 	db, rc := setup(t)
 	userSvc, sessionSvc := newServices(t, db, rc)
@@ -157,15 +188,18 @@ func TestSessionService_Refresh(t *testing.T) {
 	// Wait >1s so the new refresh token gets a different ExpiresAt (second-precision JWT)
 	time.Sleep(1100 * time.Millisecond)
 
-	newTokens, err := sessionSvc.Refresh(ctx, tokens.Refresh)
+	newTokens, err := sessionSvc.Refresh(ctx, tokens.Refresh, models.SessionClient{})
 	require.NoError(t, err)
 	assert.NotEmpty(t, newTokens.Access)
 	assert.NotEmpty(t, newTokens.Refresh)
 	assert.Equal(t, tokens.SessionID, newTokens.SessionID)
 
-	// Old refresh token should no longer work
-	_, err = sessionSvc.Refresh(ctx, tokens.Refresh)
-	assert.ErrorIs(t, err, errx.ErrorSessionTokenMismatch)
+	// Replaying the rotated-out token is treated as theft and ends the session
+	_, err = sessionSvc.Refresh(ctx, tokens.Refresh, models.SessionClient{})
+	assert.ErrorIs(t, err, errx.ErrorSessionTokenReused)
+
+	_, err = sessionSvc.Refresh(ctx, newTokens.Refresh, models.SessionClient{})
+	assert.ErrorIs(t, err, errx.ErrorSessionNotFound)
 }
 
 func TestSessionService_DeleteMySession(t *testing.T) {
@@ -176,8 +210,9 @@ func TestSessionService_DeleteMySession(t *testing.T) {
 	acc, tokens, email := registerAndLogin(t, userSvc, sessionSvc)
 
 	// Login again as acc to get a second session
-	tokens2, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword)
+	res2, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword, models.SessionClient{})
 	require.NoError(t, err)
+	tokens2 := res2.Tokens
 
 	actor := models.UserActor{
 		ID:        acc.ID,
@@ -208,10 +243,12 @@ func TestSessionService_DeleteMySessions(t *testing.T) {
 	acc, tokens, email := registerAndLogin(t, userSvc, sessionSvc)
 
 	// Create additional sessions
-	tokens2, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword)
+	res2, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword, models.SessionClient{})
 	require.NoError(t, err)
-	tokens3, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword)
+	tokens2 := res2.Tokens
+	res3, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword, models.SessionClient{})
 	require.NoError(t, err)
+	tokens3 := res3.Tokens
 
 	// Use the first session as the actor for the DeleteMySessions call
 	actor := models.UserActor{
@@ -220,7 +257,7 @@ func TestSessionService_DeleteMySessions(t *testing.T) {
 		Role:      acc.Role,
 	}
 
-	err = sessionSvc.DeleteMySessions(ctx, actor)
+	_, err = sessionSvc.DeleteMySessions(ctx, actor, session.DeleteSessionsParams{})
 	require.NoError(t, err)
 	time.Sleep(100 * time.Millisecond)
 
@@ -235,3 +272,110 @@ func TestSessionService_DeleteMySessions(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, session.DeletedAt)
 }
+
+func googleIdentity(email string) models.ExternalIdentity {
+	return models.ExternalIdentity{
+		Provider:      models.IdentityProviderGoogle,
+		Subject:       uuid.NewString(),
+		Email:         email,
+		EmailVerified: true,
+	}
+}
+
+func TestSessionService_LoginByOIDC_SignUp(t *testing.T) {
+	db, rc := setup(t)
+	userSvc, sessionSvc := newServices(t, db, rc)
+	ctx := context.Background()
+
+	email := testutil.UniqueEmail()
+	google := googleIdentity(email)
+
+	first, err := sessionSvc.LoginByOIDC(ctx, google, models.SessionClient{})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.Tokens.Access)
+
+	userEmail, err := pg.NewEmailRepo(db).GetByEmail(ctx, email)
+	require.NoError(t, err)
+	assert.True(t, userEmail.Verified)
+
+	actor := models.UserActor{ID: userEmail.UserID, SessionID: first.Tokens.SessionID}
+
+	// The second login finds the account the first one created, even after
+	// the email changed at Google.
+	google.Email = testutil.UniqueEmail()
+	second, err := sessionSvc.LoginByOIDC(ctx, google, models.SessionClient{})
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Tokens.SessionID, second.Tokens.SessionID)
+
+	identities, err := userSvc.ListMyIdentities(ctx, actor)
+	require.NoError(t, err)
+	require.Len(t, identities, 1)
+	assert.Equal(t, google.Subject, identities[0].Subject)
+	assert.Equal(t, google.Email, identities[0].Email)
+
+	// No password until the user sets one, without an old password.
+	_, err = sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword, models.SessionClient{})
+	assert.ErrorIs(t, err, errx.ErrorPasswordInvalid)
+
+	require.NoError(t, userSvc.UpdatePassword(ctx, actor, "", testutil.TestPassword))
+
+	_, err = sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword, models.SessionClient{})
+	require.NoError(t, err)
+}
+
+func TestSessionService_LoginByOIDC_UnlinkedEmail(t *testing.T) {
+	db, rc := setup(t)
+	userSvc, sessionSvc := newServices(t, db, rc)
+	ctx := context.Background()
+
+	acc, tokens, email := registerAndLogin(t, userSvc, sessionSvc)
+	actor := models.UserActor{ID: acc.ID, SessionID: tokens.SessionID}
+	google := googleIdentity(email)
+
+	// A Google account carrying the email is not enough to get in.
+	_, err := sessionSvc.LoginByOIDC(ctx, google, models.SessionClient{})
+	assert.ErrorIs(t, err, errx.ErrorIdentityNotLinked)
+
+	linked, err := userSvc.LinkIdentity(ctx, acc.ID, google)
+	require.NoError(t, err)
+
+	res, err := sessionSvc.LoginByOIDC(ctx, google, models.SessionClient{})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Tokens.Access)
+
+	// Linked to someone already.
+	other, _, _ := registerAndLogin(t, userSvc, sessionSvc)
+	_, err = userSvc.LinkIdentity(ctx, other.ID, google)
+	assert.ErrorIs(t, err, errx.ErrorIdentityAlreadyLinked)
+
+	// The password is left, so the identity can go.
+	require.NoError(t, userSvc.UnlinkMyIdentity(ctx, actor, linked.ID))
+
+	_, err = sessionSvc.LoginByOIDC(ctx, google, models.SessionClient{})
+	assert.ErrorIs(t, err, errx.ErrorIdentityNotLinked)
+}
+
+func TestSessionService_UnlinkLastCredential(t *testing.T) {
+	db, rc := setup(t)
+	userSvc, sessionSvc := newServices(t, db, rc)
+	ctx := context.Background()
+
+	google := googleIdentity(testutil.UniqueEmail())
+	res, err := sessionSvc.LoginByOIDC(ctx, google, models.SessionClient{})
+	require.NoError(t, err)
+
+	userEmail, err := pg.NewEmailRepo(db).GetByEmail(ctx, google.Email)
+	require.NoError(t, err)
+	actor := models.UserActor{ID: userEmail.UserID, SessionID: res.Tokens.SessionID}
+
+	identities, err := userSvc.ListMyIdentities(ctx, actor)
+	require.NoError(t, err)
+	require.Len(t, identities, 1)
+
+	err = userSvc.UnlinkMyIdentity(ctx, actor, identities[0].ID)
+	assert.ErrorIs(t, err, errx.ErrorLastCredential)
+
+	identities, err = userSvc.ListMyIdentities(ctx, actor)
+	require.NoError(t, err)
+	assert.Len(t, identities, 1)
+}
@@ -5,17 +5,25 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/netbill/auth-svc/internal/mail"
 	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/audit"
 	authmodule "github.com/netbill/auth-svc/internal/modules/auth"
+	"github.com/netbill/auth-svc/internal/modules/mfa"
+	"github.com/netbill/auth-svc/internal/modules/passkey"
 	"github.com/netbill/auth-svc/internal/modules/session"
 	"github.com/netbill/auth-svc/internal/modules/user"
 	"github.com/netbill/auth-svc/internal/repo/chache"
 	"github.com/netbill/auth-svc/internal/repo/pg"
+	"github.com/netbill/auth-svc/internal/revocation"
+	"github.com/netbill/auth-svc/pkg/cryptobox"
 	pkglog "github.com/netbill/auth-svc/pkg/log"
 	"github.com/netbill/auth-svc/pkg/passmanager"
 	"github.com/netbill/auth-svc/pkg/tokenmanager"
 	"github.com/netbill/auth-svc/pkg/username"
+	"github.com/netbill/auth-svc/pkg/webauthn"
 	"github.com/netbill/auth-svc/tests/testutil"
 	"github.com/netbill/pgdbx"
 	"github.com/redis/go-redis/v9"
@@ -35,6 +43,11 @@ func (n *noopMetrics) EmailCacheOp(_ context.Context, _ *error)    {}
 func (n *noopMetrics) PasswordCacheOp(_ context.Context, _ *error) {}
 func (n *noopMetrics) SessionCacheOp(_ context.Context, _ *error)  {}
 
+func (n *noopMetrics) RecordSessionTokenReuse(_ context.Context, _ string)            {}
+func (n *noopMetrics) RecordLoginLockout(_ context.Context, _ string)                 {}
+func (n *noopMetrics) RecordSessionsEndedByPolicy(_ context.Context, _ string, _ int) {}
+func (n *noopMetrics) RecordSessionRevocationFailure(_ context.Context, _ int)        {}
+
 var noop = &noopMetrics{}
 
 func TestMain(m *testing.M) {
@@ -79,17 +92,32 @@ func newServices(t *testing.T, db *pgdbx.DB, rc *redis.Client) (*user.Service, *
 	emailRepo := pg.NewEmailRepo(db)
 	passwordRepo := pg.NewPasswordRepo(db)
 	sessionRepo := pg.NewSessionRepo(db)
+	identityRepo := pg.NewIdentityRepo(db)
 
 	userCache := chache.NewUserCache(rc, cacheTTL, noop, testLog)
 	emailCache := chache.NewEmailCache(rc, cacheTTL, noop, testLog)
 	passwordCache := chache.NewPasswordCache(rc, cacheTTL, noop, testLog)
 	sessionCache := chache.NewSessionCache(rc, cacheTTL, noop, testLog)
+	revokedSessions := revocation.New(
+		chache.NewRevokedSessionCache(rc, testLog),
+		revocation.Config{TokenTTL: testCfg.Auth.Tokens.UserAccess.TTL, LocalTTL: time.Second},
+		noop,
+		testLog,
+	)
 
 	authSvc := authmodule.New(authmodule.ServiceDeps{
 		UserRepo:    userRepo,
 		SessionRepo: sessionRepo,
 	})
 
+	auditSvc := audit.New(audit.ServiceDeps{
+		Auth:      authSvc,
+		EventRepo: pg.NewAuthEventRepo(db),
+		Locks:     pg.NewLocker(db),
+		Tx:        db,
+		Log:       testLog,
+	})
+
 	passMgr := passmanager.New(testCfg.Auth.PassBcryptCost)
 
 	tokenMgr := tokenmanager.New(tokenmanager.Config{
@@ -102,38 +130,109 @@ func newServices(t *testing.T, db *pgdbx.DB, rc *redis.Client) (*user.Service, *
 	})
 
 	userSvc := user.New(user.ServiceDeps{
-		Auth:          authSvc,
-		UserRepo:      userRepo,
-		EmailRepo:     emailRepo,
-		PasswordRepo:  passwordRepo,
-		SessionRepo:   sessionRepo,
-		Tx:            db,
-		UserCache:     userCache,
-		EmailCache:    emailCache,
-		PasswordCache: passwordCache,
-		SessionsCache: sessionCache,
-		PassManager:   passMgr,
-		Messenger:     &noopMessenger{},
-		Username:      username.NewValidator(),
+		Auth:               authSvc,
+		UserRepo:           userRepo,
+		EmailRepo:          emailRepo,
+		PasswordRepo:       passwordRepo,
+		SessionRepo:        sessionRepo,
+		IdentityRepo:       identityRepo,
+		Tx:                 db,
+		UserCache:          userCache,
+		EmailCache:         emailCache,
+		PasswordCache:      passwordCache,
+		SessionsCache:      sessionCache,
+		RevokedSessions:    revokedSessions,
+		EmailVerifications: chache.NewEmailVerificationCache(rc, cacheTTL),
+		EmailChanges:       chache.NewEmailChangeCache(rc, cacheTTL),
+		PasswordResets:     chache.NewPasswordResetCache(rc, cacheTTL),
+		PassManager:        passMgr,
+		Messenger:          &noopMessenger{},
+		Mailer:             mail.New(mail.NewLogTransport(testLog), mail.Config{}),
+		Username:           username.NewValidator(),
+		AuditLog:           auditSvc,
+		Log:                testLog,
 	})
 
 	sessionSvc := session.New(session.ServiceDeps{
-		Auth:          authSvc,
-		UserRepo:      userRepo,
-		EmailRepo:     emailRepo,
-		PasswordRepo:  passwordRepo,
-		SessionRepo:   sessionRepo,
-		Tx:            db,
-		PasswordCache: passwordCache,
-		UserCache:     userCache,
-		SessionsCache: sessionCache,
-		PassManager:   passMgr,
-		TokenManager:  tokenMgr,
+		Config:          session.Config{OAuthSignUpProviders: []string{models.IdentityProviderGoogle}},
+		Auth:            authSvc,
+		Users:           userSvc,
+		UserRepo:        userRepo,
+		EmailRepo:       emailRepo,
+		PasswordRepo:    passwordRepo,
+		SessionRepo:     sessionRepo,
+		IdentityRepo:    identityRepo,
+		Tx:              db,
+		PasswordCache:   passwordCache,
+		UserCache:       userCache,
+		SessionsCache:   sessionCache,
+		RevokedSessions: revokedSessions,
+		PassManager:     passMgr,
+		TokenManager:    tokenMgr,
+		Messenger:       &noopMessenger{},
+		Metrics:         noop,
+		MFA:             newMFAService(t, db, rc),
+		MFAChallenges:   chache.NewMFAChallengeCache(rc),
+		Passkeys:        newPasskeyService(t, db, rc),
+		AuditLog:        auditSvc,
 	})
 
 	return userSvc, sessionSvc
 }
 
+func newMFAService(t *testing.T, db *pgdbx.DB, rc *redis.Client) *mfa.Service {
+	t.Helper()
+
+	box, err := cryptobox.New("test-mfa-encryption-key")
+	if err != nil {
+		t.Fatalf("init mfa box: %v", err)
+	}
+
+	return mfa.New(mfa.ServiceDeps{
+		Config: mfa.Config{Issuer: "netbill-test"},
+		Auth: authmodule.New(authmodule.ServiceDeps{
+			UserRepo:    pg.NewUserRepo(db),
+			SessionRepo: pg.NewSessionRepo(db),
+		}),
+		MFARepo:       pg.NewMFARepo(db),
+		PasswordRepo:  pg.NewPasswordRepo(db),
+		Tx:            db,
+		PasswordCache: chache.NewPasswordCache(rc, cacheTTL, noop, testLog),
+		PassManager:   passmanager.New(testCfg.Auth.PassBcryptCost),
+		Box:           box,
+	})
+}
+
+// testPasskeyOrigin is the origin the software authenticator reports and
+// the relying party of newPasskeyService accepts.
+const testPasskeyOrigin = "http://localhost:3000"
+
+func newPasskeyService(t *testing.T, db *pgdbx.DB, rc *redis.Client) *passkey.Service {
+	t.Helper()
+
+	rp, err := webauthn.New(webauthn.Config{
+		RPID:    "localhost",
+		RPName:  "netbill-test",
+		Origins: []string{testPasskeyOrigin},
+		Timeout: passkey.CeremonyTTL,
+	})
+	if err != nil {
+		t.Fatalf("init webauthn: %v", err)
+	}
+
+	return passkey.New(passkey.ServiceDeps{
+		Auth: authmodule.New(authmodule.ServiceDeps{
+			UserRepo:    pg.NewUserRepo(db),
+			SessionRepo: pg.NewSessionRepo(db),
+		}),
+		PasskeyRepo:  pg.NewPasskeyRepo(db),
+		Credentials:  pg.NewIdentityRepo(db),
+		Ceremonies:   chache.NewPasskeyCeremonyCache(rc),
+		Tx:           db,
+		RelyingParty: rp,
+	})
+}
+
 type noopMessenger struct{}
 
 func (n *noopMessenger) WriteUserCreated(_ context.Context, _ models.User, _ models.UserEmail) error {
@@ -147,3 +246,23 @@ func (n *noopMessenger) WriteUserDeleted(_ context.Context, _ models.User, _ mod
 func (n *noopMessenger) WriteUserUpdated(_ context.Context, _ models.User) error {
 	return nil
 }
+
+func (n *noopMessenger) WriteUserEmailUpdated(_ context.Context, _ models.UserEmail) error {
+	return nil
+}
+
+func (n *noopMessenger) WriteSessionTokenReused(_ context.Context, _, _ uuid.UUID, _ []uuid.UUID) error {
+	return nil
+}
+
+func (n *noopMessenger) WriteSessionCreated(_ context.Context, _ models.Session) error {
+	return nil
+}
+
+func (n *noopMessenger) WriteSessionRefreshed(_ context.Context, _ models.Session) error {
+	return nil
+}
+
+func (n *noopMessenger) WriteSessionsRevoked(_ context.Context, _ uuid.UUID, _ []uuid.UUID, _ string) error {
+	return nil
+}
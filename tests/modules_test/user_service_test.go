@@ -101,8 +101,9 @@ func TestUserService_UpdatePassword(t *testing.T) {
 	require.NoError(t, err)
 
 	// Login to get a valid session
-	tokens, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword)
+	res, err := sessionSvc.LoginByEmail(ctx, email, testutil.TestPassword, models.SessionClient{})
 	require.NoError(t, err)
+	tokens := res.Tokens
 
 	actor := models.UserActor{
 		ID:        created.ID,
@@ -115,6 +116,6 @@ func TestUserService_UpdatePassword(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify new password works for login
-	_, err = sessionSvc.LoginByEmail(ctx, email, newPass)
+	_, err = sessionSvc.LoginByEmail(ctx, email, newPass, models.SessionClient{})
 	require.NoError(t, err)
 }
@@ -0,0 +1,124 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/audit"
+	"github.com/netbill/auth-svc/internal/repo/pg"
+	"github.com/netbill/pgdbx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAuthEventRepo(t *testing.T) *pg.AuthEventRepo {
+	t.Helper()
+	return pg.NewAuthEventRepo(pgdbx.NewDB(setupDB(t)))
+}
+
+func TestAuthEventRepo_CreateAndFilter(t *testing.T) {
+	repo := newAuthEventRepo(t)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	sessionID := uuid.New()
+	reason := "password_invalid"
+
+	require.NoError(t, repo.Create(ctx, models.AuthEvent{
+		UserID:    &userID,
+		Type:      models.AuthEventLoginEmail,
+		Outcome:   models.AuthEventFailure,
+		Reason:    &reason,
+		IP:        "203.0.113.7",
+		UserAgent: "curl/8.0",
+	}))
+	require.NoError(t, repo.Create(ctx, models.AuthEvent{
+		UserID:    &userID,
+		SessionID: &sessionID,
+		Type:      models.AuthEventLoginEmail,
+		Outcome:   models.AuthEventSuccess,
+	}))
+	require.NoError(t, repo.Create(ctx, models.AuthEvent{
+		Type:    models.AuthEventLoginEmail,
+		Outcome: models.AuthEventFailure,
+	}))
+
+	page, err := repo.Filter(ctx, audit.FilterParams{UserID: &userID}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), page.Total)
+	require.Len(t, page.Data, 2)
+
+	success, failure := page.Data[0], page.Data[1]
+	if success.Outcome != models.AuthEventSuccess {
+		success, failure = failure, success
+	}
+	require.NotNil(t, success.SessionID)
+	assert.Equal(t, sessionID, *success.SessionID)
+	assert.Empty(t, success.IP)
+	assert.Nil(t, success.Reason)
+
+	require.NotNil(t, failure.Reason)
+	assert.Equal(t, reason, *failure.Reason)
+	assert.Equal(t, "203.0.113.7", failure.IP)
+	assert.Equal(t, "curl/8.0", failure.UserAgent)
+
+	outcome := models.AuthEventFailure
+	page, err = repo.Filter(ctx, audit.FilterParams{Outcome: &outcome}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), page.Total)
+
+	ip := "203.0.113.7"
+	page, err = repo.Filter(ctx, audit.FilterParams{IP: &ip}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), page.Total)
+}
+
+func TestAuthEventRepo_FilterByTime(t *testing.T) {
+	repo := newAuthEventRepo(t)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Create(ctx, models.AuthEvent{Type: models.AuthEventLogout, Outcome: models.AuthEventSuccess}))
+
+	future := time.Now().Add(time.Hour)
+	page, err := repo.Filter(ctx, audit.FilterParams{Since: &future}, 10, 0)
+	require.NoError(t, err)
+	assert.Zero(t, page.Total)
+
+	page, err = repo.Filter(ctx, audit.FilterParams{Until: &future}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), page.Total)
+}
+
+func TestAuthEventRepo_IsAppendOnly(t *testing.T) {
+	repo := newAuthEventRepo(t)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Create(ctx, models.AuthEvent{Type: models.AuthEventLogout, Outcome: models.AuthEventSuccess}))
+
+	_, err := testPool.Exec(ctx, `UPDATE auth_events SET outcome = 'failure'`)
+	require.Error(t, err)
+}
+
+func TestAuthEventRepo_DeleteBefore(t *testing.T) {
+	repo := newAuthEventRepo(t)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, repo.Create(ctx, models.AuthEvent{Type: models.AuthEventLogout, Outcome: models.AuthEventSuccess}))
+	}
+
+	n, err := repo.DeleteBefore(ctx, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	n, err = repo.DeleteBefore(ctx, time.Now().Add(time.Minute), 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	n, err = repo.DeleteBefore(ctx, time.Now().Add(time.Minute), 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+}
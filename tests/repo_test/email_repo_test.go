@@ -121,3 +121,55 @@ func TestEmailRepo_GetByEmail_NotFound(t *testing.T) {
 	_, err := emailRepo.GetByEmail(context.Background(), "nobody@example.com")
 	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
 }
+
+func TestEmailRepo_UpdateEmail(t *testing.T) {
+	accRepo, emailRepo := newEmailRepo(t)
+	ctx := context.Background()
+
+	acc, err := accRepo.Create(ctx, user.RegistrationParams{Role: "user", Username: testutil.UniqueUsername()})
+	require.NoError(t, err)
+
+	created, err := emailRepo.Create(ctx, models.UserEmail{UserID: acc.ID, Email: "erin@example.com"})
+	require.NoError(t, err)
+
+	got, err := emailRepo.UpdateEmail(ctx, acc.ID, "erin@example.com", "erin.new@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "erin.new@example.com", got.Email)
+	assert.True(t, got.Verified)
+	assert.Equal(t, created.Version+1, got.Version)
+
+	_, err = emailRepo.GetByEmail(ctx, "erin@example.com")
+	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
+}
+
+func TestEmailRepo_UpdateEmail_StaleOldEmail(t *testing.T) {
+	accRepo, emailRepo := newEmailRepo(t)
+	ctx := context.Background()
+
+	acc, err := accRepo.Create(ctx, user.RegistrationParams{Role: "user", Username: testutil.UniqueUsername()})
+	require.NoError(t, err)
+
+	_, err = emailRepo.Create(ctx, models.UserEmail{UserID: acc.ID, Email: "frank@example.com"})
+	require.NoError(t, err)
+
+	_, err = emailRepo.UpdateEmail(ctx, acc.ID, "frank.old@example.com", "frank.new@example.com")
+	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
+}
+
+func TestEmailRepo_UpdateEmail_Taken(t *testing.T) {
+	accRepo, emailRepo := newEmailRepo(t)
+	ctx := context.Background()
+
+	acc1, err := accRepo.Create(ctx, user.RegistrationParams{Role: "user", Username: testutil.UniqueUsername()})
+	require.NoError(t, err)
+	acc2, err := accRepo.Create(ctx, user.RegistrationParams{Role: "user", Username: testutil.UniqueUsername()})
+	require.NoError(t, err)
+
+	_, err = emailRepo.Create(ctx, models.UserEmail{UserID: acc1.ID, Email: "grace@example.com"})
+	require.NoError(t, err)
+	_, err = emailRepo.Create(ctx, models.UserEmail{UserID: acc2.ID, Email: "heidi@example.com"})
+	require.NoError(t, err)
+
+	_, err = emailRepo.UpdateEmail(ctx, acc2.ID, "heidi@example.com", "grace@example.com")
+	assert.ErrorIs(t, err, errx.ErrorEmailAlreadyExist)
+}
@@ -0,0 +1,108 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/repo/pg"
+	"github.com/netbill/pgdbx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newIdentityRepo(t *testing.T) (*pgdbx.DB, *pg.UserRepo, *pg.IdentityRepo) {
+	t.Helper()
+	db := pgdbx.NewDB(setupDB(t))
+	return db, pg.NewUserRepo(db), pg.NewIdentityRepo(db)
+}
+
+func testIdentity(userID uuid.UUID, subject string) models.UserIdentity {
+	return models.UserIdentity{
+		UserID:   userID,
+		Provider: models.IdentityProviderGoogle,
+		Subject:  subject,
+		Email:    subject + "@gmail.com",
+	}
+}
+
+func TestIdentityRepo_CreateAndGet(t *testing.T) {
+	_, accRepo, identityRepo := newIdentityRepo(t)
+	ctx := context.Background()
+
+	acc := createUserForPasskey(t, accRepo)
+
+	created, err := identityRepo.Create(ctx, testIdentity(acc.ID, "sub-1"))
+	require.NoError(t, err)
+	assert.Equal(t, acc.ID, created.UserID)
+	assert.Nil(t, created.LastUsedAt)
+
+	got, err := identityRepo.GetByProviderSubject(ctx, models.IdentityProviderGoogle, "sub-1")
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+
+	// The same Google account cannot be linked twice, not even to another user.
+	other := createUserForPasskey(t, accRepo)
+	_, err = identityRepo.Create(ctx, testIdentity(other.ID, "sub-1"))
+	assert.ErrorIs(t, err, errx.ErrorIdentityAlreadyLinked)
+
+	_, err = identityRepo.GetByProviderSubject(ctx, "github", "sub-1")
+	assert.ErrorIs(t, err, errx.ErrorIdentityNotFound)
+
+	require.NoError(t, identityRepo.RecordUse(ctx, created.ID, "renamed@gmail.com"))
+	got, err = identityRepo.GetByProviderSubject(ctx, models.IdentityProviderGoogle, "sub-1")
+	require.NoError(t, err)
+	assert.Equal(t, "renamed@gmail.com", got.Email)
+	assert.NotNil(t, got.LastUsedAt)
+}
+
+func TestIdentityRepo_ListAndDelete(t *testing.T) {
+	_, accRepo, identityRepo := newIdentityRepo(t)
+	ctx := context.Background()
+
+	acc := createUserForPasskey(t, accRepo)
+	other := createUserForPasskey(t, accRepo)
+
+	first, err := identityRepo.Create(ctx, testIdentity(acc.ID, "sub-1"))
+	require.NoError(t, err)
+	_, err = identityRepo.Create(ctx, testIdentity(acc.ID, "sub-2"))
+	require.NoError(t, err)
+
+	list, err := identityRepo.ListForUser(ctx, acc.ID)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	assert.ErrorIs(t, identityRepo.Delete(ctx, other.ID, first.ID), errx.ErrorIdentityNotFound)
+	require.NoError(t, identityRepo.Delete(ctx, acc.ID, first.ID))
+
+	list, err = identityRepo.ListForUser(ctx, acc.ID)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+}
+
+func TestIdentityRepo_CountCredentials(t *testing.T) {
+	db, accRepo, identityRepo := newIdentityRepo(t)
+	ctx := context.Background()
+
+	acc := createUserForPasskey(t, accRepo)
+
+	n, err := identityRepo.CountCredentials(ctx, acc.ID)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	_, err = pg.NewPasswordRepo(db).Create(ctx, models.UserPassword{UserID: acc.ID, Hash: "hash"})
+	require.NoError(t, err)
+	_, err = pg.NewPasskeyRepo(db).Create(ctx, testPasskey(acc.ID, "cred-1"))
+	require.NoError(t, err)
+	_, err = identityRepo.Create(ctx, testIdentity(acc.ID, "sub-1"))
+	require.NoError(t, err)
+
+	n, err = identityRepo.CountCredentials(ctx, acc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	_, err = identityRepo.CountCredentials(ctx, uuid.New())
+	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
+}
@@ -0,0 +1,143 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/user"
+	"github.com/netbill/auth-svc/internal/repo/pg"
+	"github.com/netbill/auth-svc/tests/testutil"
+	"github.com/netbill/pgdbx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMFARepo(t *testing.T) (*pg.UserRepo, *pg.MFARepo) {
+	t.Helper()
+	pool := setupDB(t)
+	db := pgdbx.NewDB(pool)
+	return pg.NewUserRepo(db), pg.NewMFARepo(db)
+}
+
+func createUserForMFA(t *testing.T, accRepo *pg.UserRepo) models.User {
+	t.Helper()
+	acc, err := accRepo.Create(context.Background(), user.RegistrationParams{
+		Role:     "user",
+		Username: testutil.UniqueUsername(),
+	})
+	require.NoError(t, err)
+	return acc
+}
+
+func TestMFARepo_GetTOTP_NotFound(t *testing.T) {
+	_, mfaRepo := newMFARepo(t)
+
+	_, err := mfaRepo.GetTOTP(context.Background(), testutil.RandomUUID())
+	assert.ErrorIs(t, err, errx.ErrorMFANotEnabled)
+}
+
+func TestMFARepo_UpsertAndConfirmTOTP(t *testing.T) {
+	accRepo, mfaRepo := newMFARepo(t)
+	ctx := context.Background()
+
+	acc := createUserForMFA(t, accRepo)
+
+	created, err := mfaRepo.UpsertTOTP(ctx, acc.ID, []byte("first"))
+	require.NoError(t, err)
+	assert.False(t, created.Enabled())
+
+	// A pending enrollment is replaced.
+	replaced, err := mfaRepo.UpsertTOTP(ctx, acc.ID, []byte("second"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("second"), replaced.Secret)
+
+	confirmed, err := mfaRepo.ConfirmTOTP(ctx, acc.ID, 100)
+	require.NoError(t, err)
+	assert.True(t, confirmed.Enabled())
+	require.NotNil(t, confirmed.LastUsedStep)
+	assert.Equal(t, int64(100), *confirmed.LastUsedStep)
+
+	// A confirmed one is not.
+	_, err = mfaRepo.UpsertTOTP(ctx, acc.ID, []byte("third"))
+	assert.ErrorIs(t, err, errx.ErrorMFAAlreadyEnabled)
+
+	got, err := mfaRepo.GetTOTP(ctx, acc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("second"), got.Secret)
+}
+
+func TestMFARepo_UseTOTPStep_RejectsReplay(t *testing.T) {
+	accRepo, mfaRepo := newMFARepo(t)
+	ctx := context.Background()
+
+	acc := createUserForMFA(t, accRepo)
+
+	_, err := mfaRepo.UpsertTOTP(ctx, acc.ID, []byte("secret"))
+	require.NoError(t, err)
+	_, err = mfaRepo.ConfirmTOTP(ctx, acc.ID, 100)
+	require.NoError(t, err)
+
+	err = mfaRepo.UseTOTPStep(ctx, acc.ID, 100)
+	assert.ErrorIs(t, err, errx.ErrorMFACodeInvalid)
+
+	err = mfaRepo.UseTOTPStep(ctx, acc.ID, 101)
+	require.NoError(t, err)
+
+	err = mfaRepo.UseTOTPStep(ctx, acc.ID, 101)
+	assert.ErrorIs(t, err, errx.ErrorMFACodeInvalid)
+}
+
+func TestMFARepo_RecoveryCodes(t *testing.T) {
+	accRepo, mfaRepo := newMFARepo(t)
+	ctx := context.Background()
+
+	acc := createUserForMFA(t, accRepo)
+
+	err := mfaRepo.ReplaceRecoveryCodes(ctx, acc.ID, []string{"a", "b", "c"})
+	require.NoError(t, err)
+
+	n, err := mfaRepo.CountRecoveryCodes(ctx, acc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	err = mfaRepo.UseRecoveryCode(ctx, acc.ID, "b")
+	require.NoError(t, err)
+
+	err = mfaRepo.UseRecoveryCode(ctx, acc.ID, "b")
+	assert.ErrorIs(t, err, errx.ErrorMFACodeInvalid)
+
+	n, err = mfaRepo.CountRecoveryCodes(ctx, acc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	// Replacing drops used codes as well.
+	err = mfaRepo.ReplaceRecoveryCodes(ctx, acc.ID, []string{"b", "d"})
+	require.NoError(t, err)
+
+	err = mfaRepo.UseRecoveryCode(ctx, acc.ID, "b")
+	require.NoError(t, err)
+
+	err = mfaRepo.UseRecoveryCode(ctx, acc.ID, "a")
+	assert.ErrorIs(t, err, errx.ErrorMFACodeInvalid)
+}
+
+func TestMFARepo_DeleteTOTP(t *testing.T) {
+	accRepo, mfaRepo := newMFARepo(t)
+	ctx := context.Background()
+
+	acc := createUserForMFA(t, accRepo)
+
+	err := mfaRepo.DeleteTOTP(ctx, acc.ID)
+	assert.ErrorIs(t, err, errx.ErrorMFANotEnabled)
+
+	_, err = mfaRepo.UpsertTOTP(ctx, acc.ID, []byte("secret"))
+	require.NoError(t, err)
+
+	err = mfaRepo.DeleteTOTP(ctx, acc.ID)
+	require.NoError(t, err)
+
+	_, err = mfaRepo.GetTOTP(ctx, acc.ID)
+	assert.ErrorIs(t, err, errx.ErrorMFANotEnabled)
+}
@@ -0,0 +1,85 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/oauthclient"
+	"github.com/netbill/auth-svc/internal/repo/pg"
+	"github.com/netbill/pgdbx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOAuthClientRepo(t *testing.T) *pg.OAuthClientRepo {
+	t.Helper()
+	return pg.NewOAuthClientRepo(pgdbx.NewDB(setupDB(t)))
+}
+
+func TestOAuthClientRepo_CreateAndGet(t *testing.T) {
+	repo := newOAuthClientRepo(t)
+	ctx := context.Background()
+
+	hash := "secret-hash"
+	created, err := repo.Create(ctx, models.OAuthClient{
+		ID:         "billing-svc",
+		Name:       "Billing",
+		SecretHash: &hash,
+		Scopes:     []string{models.ScopeSessionsValidate},
+	})
+	require.NoError(t, err)
+	assert.True(t, created.Confidential())
+	assert.Empty(t, created.RedirectURIs)
+
+	got, err := repo.GetByID(ctx, "billing-svc")
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+
+	_, err = repo.Create(ctx, models.OAuthClient{ID: "billing-svc", Name: "Other"})
+	assert.ErrorIs(t, err, errx.ErrorOAuthClientAlreadyExists)
+
+	_, err = repo.GetByID(ctx, "missing")
+	assert.ErrorIs(t, err, errx.ErrorOAuthClientNotFound)
+}
+
+func TestOAuthClientRepo_Update(t *testing.T) {
+	repo := newOAuthClientRepo(t)
+	ctx := context.Background()
+
+	_, err := repo.Create(ctx, models.OAuthClient{
+		ID:           "dashboard",
+		Name:         "Dashboard",
+		Scopes:       []string{"openid"},
+		RedirectURIs: []string{"https://dashboard.netbill.local/callback"},
+	})
+	require.NoError(t, err)
+
+	scopes := []string{"openid", "email"}
+	updated, err := repo.Update(ctx, "dashboard", oauthclient.UpdateParams{Scopes: &scopes})
+	require.NoError(t, err)
+	assert.Equal(t, "Dashboard", updated.Name)
+	assert.Equal(t, scopes, updated.Scopes)
+	assert.Equal(t, []string{"https://dashboard.netbill.local/callback"}, updated.RedirectURIs)
+
+	rotated, err := repo.UpdateSecretHash(ctx, "dashboard", "new-hash")
+	require.NoError(t, err)
+	require.NotNil(t, rotated.SecretHash)
+	assert.Equal(t, "new-hash", *rotated.SecretHash)
+
+	list, err := repo.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+}
+
+func TestOAuthClientRepo_Delete(t *testing.T) {
+	repo := newOAuthClientRepo(t)
+	ctx := context.Background()
+
+	_, err := repo.Create(ctx, models.OAuthClient{ID: "widget", Name: "Widget"})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Delete(ctx, "widget"))
+	assert.ErrorIs(t, repo.Delete(ctx, "widget"), errx.ErrorOAuthClientNotFound)
+}
@@ -0,0 +1,141 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/repo/pg"
+	"github.com/netbill/pgdbx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOutboxRepo(t *testing.T) *pg.OutboxRepo {
+	t.Helper()
+	return pg.NewOutboxRepo(pgdbx.NewDB(setupDB(t)), "auth-svc-test")
+}
+
+func TestOutboxRepo_ClaimPending_OnePerKeyInOrder(t *testing.T) {
+	repo := newOutboxRepo(t)
+	ctx := context.Background()
+
+	userA, userB := uuid.New(), uuid.New()
+	require.NoError(t, repo.WriteSessionCreated(ctx, models.Session{ID: uuid.New(), UserID: userA}))
+	require.NoError(t, repo.WriteSessionsRevoked(ctx, userA, []uuid.UUID{uuid.New()}, models.SessionRevokedLogout))
+	require.NoError(t, repo.WriteSessionCreated(ctx, models.Session{ID: uuid.New(), UserID: userB}))
+
+	claimed, err := repo.ClaimPending(ctx, 10, time.Now())
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	assert.Equal(t, userA.String(), claimed[0].Key)
+	assert.Equal(t, "session_created", claimed[0].Type)
+	assert.Equal(t, userB.String(), claimed[1].Key)
+	assert.Equal(t, "auth-svc-test", claimed[0].Producer)
+	assert.Equal(t, int32(1), claimed[0].Version)
+
+	require.NoError(t, repo.MarkPublished(ctx, []uuid.UUID{claimed[0].ID, claimed[1].ID}))
+
+	claimed, err = repo.ClaimPending(ctx, 10, time.Now())
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "sessions_revoked", claimed[0].Type)
+}
+
+func TestOutboxRepo_MarkFailed_HoldsBackKey(t *testing.T) {
+	repo := newOutboxRepo(t)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	require.NoError(t, repo.WriteSessionCreated(ctx, models.Session{ID: uuid.New(), UserID: userID}))
+	require.NoError(t, repo.WriteSessionsRevoked(ctx, userID, []uuid.UUID{uuid.New()}, models.SessionRevokedLogout))
+
+	claimed, err := repo.ClaimPending(ctx, 10, time.Now())
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+
+	require.NoError(t, repo.MarkFailed(ctx, claimed[0].ID, time.Now().Add(time.Hour), "broker unavailable"))
+
+	// The failed event waits out its backoff and the one after it waits too.
+	claimed, err = repo.ClaimPending(ctx, 10, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+}
+
+func TestOutboxRepo_MarkFailed_CountsAttempts(t *testing.T) {
+	repo := newOutboxRepo(t)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	require.NoError(t, repo.WriteSessionCreated(ctx, models.Session{ID: uuid.New(), UserID: userID}))
+
+	claimed, err := repo.ClaimPending(ctx, 10, time.Now())
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.NoError(t, repo.MarkFailed(ctx, claimed[0].ID, time.Now().Add(-time.Second), "broker unavailable"))
+
+	claimed, err = repo.ClaimPending(ctx, 10, time.Now())
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, int32(1), claimed[0].Attempts)
+}
+
+func TestOutboxRepo_DeletePublishedBefore(t *testing.T) {
+	repo := newOutboxRepo(t)
+	ctx := context.Background()
+
+	require.NoError(t, repo.WriteSessionCreated(ctx, models.Session{ID: uuid.New(), UserID: uuid.New()}))
+	require.NoError(t, repo.WriteSessionCreated(ctx, models.Session{ID: uuid.New(), UserID: uuid.New()}))
+
+	claimed, err := repo.ClaimPending(ctx, 10, time.Now())
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	require.NoError(t, repo.MarkPublished(ctx, []uuid.UUID{claimed[0].ID}))
+
+	n, err := repo.DeletePublishedBefore(ctx, time.Now().Add(time.Minute), 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	// The unpublished event is never pruned.
+	claimed, err = repo.ClaimPending(ctx, 10, time.Now())
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+}
+
+func TestOutboxRepo_ClaimPending_LeasesEvents(t *testing.T) {
+	repo := newOutboxRepo(t)
+	ctx := context.Background()
+
+	require.NoError(t, repo.WriteSessionCreated(ctx, models.Session{ID: uuid.New(), UserID: uuid.New()}))
+
+	claimed, err := repo.ClaimPending(ctx, 10, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+
+	// Another relay polling meanwhile does not get the leased event.
+	claimed, err = repo.ClaimPending(ctx, 10, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+}
+
+func TestOutboxRepo_MarkParked_ReleasesKey(t *testing.T) {
+	repo := newOutboxRepo(t)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	require.NoError(t, repo.WriteSessionCreated(ctx, models.Session{ID: uuid.New(), UserID: userID}))
+	require.NoError(t, repo.WriteSessionsRevoked(ctx, userID, []uuid.UUID{uuid.New()}, models.SessionRevokedLogout))
+
+	claimed, err := repo.ClaimPending(ctx, 10, time.Now())
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.NoError(t, repo.MarkParked(ctx, claimed[0].ID, "message too large"))
+
+	// The parked event is not retried and the one after it goes out.
+	claimed, err = repo.ClaimPending(ctx, 10, time.Now())
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "sessions_revoked", claimed[0].Type)
+}
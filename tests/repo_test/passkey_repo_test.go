@@ -0,0 +1,151 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
+	"github.com/netbill/auth-svc/internal/modules/user"
+	"github.com/netbill/auth-svc/internal/repo/pg"
+	"github.com/netbill/auth-svc/tests/testutil"
+	"github.com/netbill/pgdbx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPasskeyRepo(t *testing.T) (*pg.UserRepo, *pg.PasskeyRepo) {
+	t.Helper()
+	pool := setupDB(t)
+	db := pgdbx.NewDB(pool)
+	return pg.NewUserRepo(db), pg.NewPasskeyRepo(db)
+}
+
+func createUserForPasskey(t *testing.T, accRepo *pg.UserRepo) models.User {
+	t.Helper()
+	acc, err := accRepo.Create(context.Background(), user.RegistrationParams{
+		Role:     "user",
+		Username: testutil.UniqueUsername(),
+	})
+	require.NoError(t, err)
+	return acc
+}
+
+func testPasskey(userID uuid.UUID, credentialID string) models.Passkey {
+	return models.Passkey{
+		UserID:       userID,
+		CredentialID: []byte(credentialID),
+		PublicKey:    []byte("cose-key"),
+		SignCount:    3,
+		AAGUID:       make([]byte, 16),
+		Name:         "Laptop",
+	}
+}
+
+func TestPasskeyRepo_CreateAndGet(t *testing.T) {
+	accRepo, passkeyRepo := newPasskeyRepo(t)
+	ctx := context.Background()
+
+	acc := createUserForPasskey(t, accRepo)
+
+	created, err := passkeyRepo.Create(ctx, testPasskey(acc.ID, "cred-1"))
+	require.NoError(t, err)
+	assert.Equal(t, acc.ID, created.UserID)
+	assert.Equal(t, uint32(3), created.SignCount)
+	assert.Empty(t, created.Transports)
+	assert.Nil(t, created.LastUsedAt)
+
+	got, err := passkeyRepo.GetByCredentialID(ctx, []byte("cred-1"))
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+	assert.Equal(t, []byte("cose-key"), got.PublicKey)
+
+	_, err = passkeyRepo.GetByCredentialID(ctx, []byte("unknown"))
+	assert.ErrorIs(t, err, errx.ErrorPasskeyNotFound)
+}
+
+func TestPasskeyRepo_Create_DuplicateCredential(t *testing.T) {
+	accRepo, passkeyRepo := newPasskeyRepo(t)
+	ctx := context.Background()
+
+	first := createUserForPasskey(t, accRepo)
+	second := createUserForPasskey(t, accRepo)
+
+	_, err := passkeyRepo.Create(ctx, testPasskey(first.ID, "cred-1"))
+	require.NoError(t, err)
+
+	_, err = passkeyRepo.Create(ctx, testPasskey(second.ID, "cred-1"))
+	assert.ErrorIs(t, err, errx.ErrorPasskeyAlreadyExists)
+}
+
+func TestPasskeyRepo_ListForUser(t *testing.T) {
+	accRepo, passkeyRepo := newPasskeyRepo(t)
+	ctx := context.Background()
+
+	acc := createUserForPasskey(t, accRepo)
+	other := createUserForPasskey(t, accRepo)
+
+	list, err := passkeyRepo.ListForUser(ctx, acc.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, list)
+	assert.Empty(t, list)
+
+	_, err = passkeyRepo.Create(ctx, testPasskey(acc.ID, "cred-1"))
+	require.NoError(t, err)
+	_, err = passkeyRepo.Create(ctx, testPasskey(acc.ID, "cred-2"))
+	require.NoError(t, err)
+	_, err = passkeyRepo.Create(ctx, testPasskey(other.ID, "cred-3"))
+	require.NoError(t, err)
+
+	list, err = passkeyRepo.ListForUser(ctx, acc.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, []byte("cred-1"), list[0].CredentialID)
+}
+
+func TestPasskeyRepo_RenameAndDelete_OwnerOnly(t *testing.T) {
+	accRepo, passkeyRepo := newPasskeyRepo(t)
+	ctx := context.Background()
+
+	acc := createUserForPasskey(t, accRepo)
+	other := createUserForPasskey(t, accRepo)
+
+	created, err := passkeyRepo.Create(ctx, testPasskey(acc.ID, "cred-1"))
+	require.NoError(t, err)
+
+	_, err = passkeyRepo.Rename(ctx, other.ID, created.ID, "Stolen")
+	assert.ErrorIs(t, err, errx.ErrorPasskeyNotFound)
+
+	renamed, err := passkeyRepo.Rename(ctx, acc.ID, created.ID, "Phone")
+	require.NoError(t, err)
+	assert.Equal(t, "Phone", renamed.Name)
+
+	err = passkeyRepo.Delete(ctx, other.ID, created.ID)
+	assert.ErrorIs(t, err, errx.ErrorPasskeyNotFound)
+
+	require.NoError(t, passkeyRepo.Delete(ctx, acc.ID, created.ID))
+
+	err = passkeyRepo.Delete(ctx, acc.ID, created.ID)
+	assert.ErrorIs(t, err, errx.ErrorPasskeyNotFound)
+}
+
+func TestPasskeyRepo_RecordUse(t *testing.T) {
+	accRepo, passkeyRepo := newPasskeyRepo(t)
+	ctx := context.Background()
+
+	acc := createUserForPasskey(t, accRepo)
+
+	created, err := passkeyRepo.Create(ctx, testPasskey(acc.ID, "cred-1"))
+	require.NoError(t, err)
+
+	require.NoError(t, passkeyRepo.RecordUse(ctx, created.ID, 7))
+
+	got, err := passkeyRepo.GetByCredentialID(ctx, []byte("cred-1"))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(7), got.SignCount)
+	assert.NotNil(t, got.LastUsedAt)
+
+	err = passkeyRepo.RecordUse(ctx, testutil.RandomUUID(), 1)
+	assert.ErrorIs(t, err, errx.ErrorPasskeyNotFound)
+}
@@ -69,7 +69,7 @@ func TestPasswordRepo_GetByID_NotFound(t *testing.T) {
 	_, passRepo := newPasswordRepo(t)
 
 	_, err := passRepo.GetByID(context.Background(), testutil.RandomUUID())
-	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
+	assert.ErrorIs(t, err, errx.ErrorPasswordNotSet)
 }
 
 func TestPasswordRepo_UpdatePassword(t *testing.T) {
@@ -116,7 +116,7 @@ func TestPasswordRepo_UpdatePassword_NotFound(t *testing.T) {
 	_, passRepo := newPasswordRepo(t)
 
 	_, err := passRepo.UpdatePassword(context.Background(), testutil.RandomUUID(), "hash")
-	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
+	assert.ErrorIs(t, err, errx.ErrorPasswordNotSet)
 }
 
 func TestPasswordRepo_Delete(t *testing.T) {
@@ -136,7 +136,7 @@ func TestPasswordRepo_Delete(t *testing.T) {
 
 	// After soft-delete, GetByID filters deleted_at IS NULL → not found
 	_, err = passRepo.GetByID(ctx, acc.ID)
-	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
+	assert.ErrorIs(t, err, errx.ErrorPasswordNotSet)
 }
 
 func TestPasswordRepo_Delete_NotFound(t *testing.T) {
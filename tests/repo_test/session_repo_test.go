@@ -3,9 +3,11 @@ package repo_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/models"
 	"github.com/netbill/auth-svc/internal/modules/session"
 	"github.com/netbill/auth-svc/internal/modules/user"
 	"github.com/netbill/auth-svc/internal/repo/pg"
@@ -39,7 +41,7 @@ func TestSessionRepo_Create(t *testing.T) {
 	userID := createUserForSession(t, accRepo)
 	sessionID := uuid.New()
 
-	sess, err := sessRepo.Create(ctx, sessionID, userID, "hashtoken")
+	sess, err := sessRepo.Create(ctx, sessionID, userID, "hashtoken", models.SessionClient{}, nil)
 	require.NoError(t, err)
 	assert.Equal(t, sessionID, sess.ID)
 	assert.Equal(t, userID, sess.UserID)
@@ -53,7 +55,7 @@ func TestSessionRepo_GetByID(t *testing.T) {
 	userID := createUserForSession(t, accRepo)
 	sessionID := uuid.New()
 
-	_, err := sessRepo.Create(ctx, sessionID, userID, "hashtoken")
+	_, err := sessRepo.Create(ctx, sessionID, userID, "hashtoken", models.SessionClient{}, nil)
 	require.NoError(t, err)
 
 	got, err := sessRepo.GetByID(ctx, sessionID)
@@ -76,7 +78,7 @@ func TestSessionRepo_GetForUser(t *testing.T) {
 	userID := createUserForSession(t, accRepo)
 	sessionID := uuid.New()
 
-	_, err := sessRepo.Create(ctx, sessionID, userID, "hashtoken")
+	_, err := sessRepo.Create(ctx, sessionID, userID, "hashtoken", models.SessionClient{}, nil)
 	require.NoError(t, err)
 
 	got, err := sessRepo.GetForUser(ctx, userID, sessionID)
@@ -92,7 +94,7 @@ func TestSessionRepo_GetForUser_WrongUser(t *testing.T) {
 	acc2 := createUserForSession(t, accRepo)
 	sessionID := uuid.New()
 
-	_, err := sessRepo.Create(ctx, sessionID, acc1, "hashtoken")
+	_, err := sessRepo.Create(ctx, sessionID, acc1, "hashtoken", models.SessionClient{}, nil)
 	require.NoError(t, err)
 
 	// Try to get acc1's session as acc2
@@ -108,7 +110,7 @@ func TestSessionRepo_GetListForUser(t *testing.T) {
 
 	// Create 3 sessions
 	for i := 0; i < 3; i++ {
-		_, err := sessRepo.Create(ctx, uuid.New(), userID, uuid.New().String())
+		_, err := sessRepo.Create(ctx, uuid.New(), userID, uuid.New().String(), models.SessionClient{}, nil)
 		require.NoError(t, err)
 	}
 
@@ -128,13 +130,13 @@ func TestSessionRepo_GetListForUser_FilterDeleted(t *testing.T) {
 
 	userID := createUserForSession(t, accRepo)
 
-	s1, err := sessRepo.Create(ctx, uuid.New(), userID, uuid.New().String())
+	s1, err := sessRepo.Create(ctx, uuid.New(), userID, uuid.New().String(), models.SessionClient{}, nil)
 	require.NoError(t, err)
-	_, err = sessRepo.Create(ctx, uuid.New(), userID, uuid.New().String())
+	_, err = sessRepo.Create(ctx, uuid.New(), userID, uuid.New().String(), models.SessionClient{}, nil)
 	require.NoError(t, err)
 
 	// Delete one session
-	err = sessRepo.Delete(ctx, s1.ID)
+	_, err = sessRepo.Delete(ctx, s1.ID)
 	require.NoError(t, err)
 
 	// Filter active only
@@ -169,7 +171,7 @@ func TestSessionRepo_GetListForUser_Pagination(t *testing.T) {
 	userID := createUserForSession(t, accRepo)
 
 	for i := 0; i < 5; i++ {
-		_, err := sessRepo.Create(ctx, uuid.New(), userID, uuid.New().String())
+		_, err := sessRepo.Create(ctx, uuid.New(), userID, uuid.New().String(), models.SessionClient{}, nil)
 		require.NoError(t, err)
 	}
 
@@ -196,12 +198,32 @@ func TestSessionRepo_GetToken(t *testing.T) {
 	userID := createUserForSession(t, accRepo)
 	sessionID := uuid.New()
 
-	_, err := sessRepo.Create(ctx, sessionID, userID, "myhash")
+	_, err := sessRepo.Create(ctx, sessionID, userID, "myhash", models.SessionClient{}, nil)
 	require.NoError(t, err)
 
-	hash, err := sessRepo.GetToken(ctx, sessionID)
+	token, err := sessRepo.GetToken(ctx, sessionID)
 	require.NoError(t, err)
-	assert.Equal(t, "myhash", hash)
+	assert.Equal(t, userID, token.UserID)
+	assert.Equal(t, "myhash", token.Hash)
+	assert.Nil(t, token.PreviousHash)
+	assert.Nil(t, token.Grant)
+}
+
+func TestSessionRepo_GetToken_OAuthGrant(t *testing.T) {
+	accRepo, sessRepo := newSessionRepos(t)
+	ctx := context.Background()
+
+	userID := createUserForSession(t, accRepo)
+	sessionID := uuid.New()
+
+	grant := &models.OAuthGrant{ClientID: "web-app", Scopes: []string{"openid", "email"}}
+	_, err := sessRepo.Create(ctx, sessionID, userID, "myhash", models.SessionClient{}, grant)
+	require.NoError(t, err)
+
+	token, err := sessRepo.GetToken(ctx, sessionID)
+	require.NoError(t, err)
+	require.NotNil(t, token.Grant)
+	assert.Equal(t, *grant, *token.Grant)
 }
 
 func TestSessionRepo_UpdateToken(t *testing.T) {
@@ -211,16 +233,99 @@ func TestSessionRepo_UpdateToken(t *testing.T) {
 	userID := createUserForSession(t, accRepo)
 	sessionID := uuid.New()
 
-	_, err := sessRepo.Create(ctx, sessionID, userID, "oldhash")
+	_, err := sessRepo.Create(ctx, sessionID, userID, "oldhash", models.SessionClient{}, nil)
 	require.NoError(t, err)
 
-	updated, err := sessRepo.UpdateToken(ctx, sessionID, "newhash")
+	updated, err := sessRepo.UpdateToken(ctx, sessionID, "oldhash", "newhash", models.SessionClient{})
 	require.NoError(t, err)
 	assert.Equal(t, sessionID, updated.ID)
 
-	hash, err := sessRepo.GetToken(ctx, sessionID)
+	token, err := sessRepo.GetToken(ctx, sessionID)
 	require.NoError(t, err)
-	assert.Equal(t, "newhash", hash)
+	assert.Equal(t, "newhash", token.Hash)
+	require.NotNil(t, token.PreviousHash)
+	assert.Equal(t, "oldhash", *token.PreviousHash)
+}
+
+func TestSessionRepo_UpdateToken_AlreadyRotated(t *testing.T) {
+	accRepo, sessRepo := newSessionRepos(t)
+	ctx := context.Background()
+
+	userID := createUserForSession(t, accRepo)
+	sessionID := uuid.New()
+
+	_, err := sessRepo.Create(ctx, sessionID, userID, "oldhash", models.SessionClient{}, nil)
+	require.NoError(t, err)
+
+	_, err = sessRepo.UpdateToken(ctx, sessionID, "oldhash", "newhash", models.SessionClient{})
+	require.NoError(t, err)
+
+	// A second rotation of the same token loses and leaves the first one's
+	// hash in place, so the service can tell the token was reused.
+	_, err = sessRepo.UpdateToken(ctx, sessionID, "oldhash", "otherhash", models.SessionClient{})
+	assert.ErrorIs(t, err, errx.ErrorSessionTokenMismatch)
+
+	token, err := sessRepo.GetToken(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, "newhash", token.Hash)
+	require.NotNil(t, token.PreviousHash)
+	assert.Equal(t, "oldhash", *token.PreviousHash)
+}
+
+func TestSessionRepo_ClientMetadata(t *testing.T) {
+	accRepo, sessRepo := newSessionRepos(t)
+	ctx := context.Background()
+
+	userID := createUserForSession(t, accRepo)
+	sessionID := uuid.New()
+
+	sess, err := sessRepo.Create(ctx, sessionID, userID, "hash", models.SessionClient{
+		UserAgent: "Mozilla/5.0 (Macintosh)",
+		IP:        "203.0.113.7",
+		Platform:  "macOS",
+		Browser:   "Safari",
+	}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, sess.ClientIP)
+	assert.Equal(t, "203.0.113.7", *sess.ClientIP)
+	require.NotNil(t, sess.Platform)
+	assert.Equal(t, "macOS", *sess.Platform)
+	assert.Nil(t, sess.DeviceName)
+
+	// Refreshing from a client that sends nothing keeps the recorded details.
+	updated, err := sessRepo.UpdateToken(ctx, sessionID, "hash", "newhash", models.SessionClient{})
+	require.NoError(t, err)
+	require.NotNil(t, updated.Browser)
+	assert.Equal(t, "Safari", *updated.Browser)
+
+	updated, err = sessRepo.UpdateToken(ctx, sessionID, "newhash", "newerhash", models.SessionClient{IP: "198.51.100.1"})
+	require.NoError(t, err)
+	require.NotNil(t, updated.ClientIP)
+	assert.Equal(t, "198.51.100.1", *updated.ClientIP)
+}
+
+func TestSessionRepo_UpdateDeviceName(t *testing.T) {
+	accRepo, sessRepo := newSessionRepos(t)
+	ctx := context.Background()
+
+	userID := createUserForSession(t, accRepo)
+	otherID := createUserForSession(t, accRepo)
+	sessionID := uuid.New()
+
+	_, err := sessRepo.Create(ctx, sessionID, userID, "hash", models.SessionClient{}, nil)
+	require.NoError(t, err)
+
+	updated, err := sessRepo.UpdateDeviceName(ctx, userID, sessionID, "Work laptop")
+	require.NoError(t, err)
+	require.NotNil(t, updated.DeviceName)
+	assert.Equal(t, "Work laptop", *updated.DeviceName)
+
+	cleared, err := sessRepo.UpdateDeviceName(ctx, userID, sessionID, "")
+	require.NoError(t, err)
+	assert.Nil(t, cleared.DeviceName)
+
+	_, err = sessRepo.UpdateDeviceName(ctx, otherID, sessionID, "Not mine")
+	assert.ErrorIs(t, err, errx.ErrorSessionNotFound)
 }
 
 func TestSessionRepo_Delete(t *testing.T) {
@@ -230,11 +335,13 @@ func TestSessionRepo_Delete(t *testing.T) {
 	userID := createUserForSession(t, accRepo)
 	sessionID := uuid.New()
 
-	_, err := sessRepo.Create(ctx, sessionID, userID, "hash")
+	created, err := sessRepo.Create(ctx, sessionID, userID, "hash", models.SessionClient{}, nil)
 	require.NoError(t, err)
 
-	err = sessRepo.Delete(ctx, sessionID)
+	ended, err := sessRepo.Delete(ctx, sessionID)
 	require.NoError(t, err)
+	assert.Equal(t, sessionID, ended.ID)
+	assert.True(t, created.LastUsed.Equal(ended.LastUsed))
 
 	// Session still accessible by GetByID (no deleted_at filter there)
 	got, err := sessRepo.GetByID(ctx, sessionID)
@@ -245,7 +352,7 @@ func TestSessionRepo_Delete(t *testing.T) {
 func TestSessionRepo_Delete_NotFound(t *testing.T) {
 	_, sessRepo := newSessionRepos(t)
 
-	err := sessRepo.Delete(context.Background(), uuid.New())
+	_, err := sessRepo.Delete(context.Background(), uuid.New())
 	assert.ErrorIs(t, err, errx.ErrorSessionNotFound)
 }
 
@@ -256,11 +363,12 @@ func TestSessionRepo_DeleteOneForUser(t *testing.T) {
 	userID := createUserForSession(t, accRepo)
 	sessionID := uuid.New()
 
-	_, err := sessRepo.Create(ctx, sessionID, userID, "hash")
+	_, err := sessRepo.Create(ctx, sessionID, userID, "hash", models.SessionClient{}, nil)
 	require.NoError(t, err)
 
-	err = sessRepo.DeleteOneForUser(ctx, userID, sessionID)
+	ended, err := sessRepo.DeleteOneForUser(ctx, userID, sessionID)
 	require.NoError(t, err)
+	assert.Equal(t, sessionID, ended.ID)
 
 	got, err := sessRepo.GetByID(ctx, sessionID)
 	require.NoError(t, err)
@@ -275,10 +383,10 @@ func TestSessionRepo_DeleteOneForUser_WrongUser(t *testing.T) {
 	acc2 := createUserForSession(t, accRepo)
 	sessionID := uuid.New()
 
-	_, err := sessRepo.Create(ctx, sessionID, acc1, "hash")
+	_, err := sessRepo.Create(ctx, sessionID, acc1, "hash", models.SessionClient{}, nil)
 	require.NoError(t, err)
 
-	err = sessRepo.DeleteOneForUser(ctx, acc2, sessionID)
+	_, err = sessRepo.DeleteOneForUser(ctx, acc2, sessionID)
 	assert.ErrorIs(t, err, errx.ErrorSessionNotFound)
 }
 
@@ -289,13 +397,13 @@ func TestSessionRepo_DeleteManyForUser(t *testing.T) {
 	userID := createUserForSession(t, accRepo)
 
 	for i := 0; i < 3; i++ {
-		_, err := sessRepo.Create(ctx, uuid.New(), userID, uuid.New().String())
+		_, err := sessRepo.Create(ctx, uuid.New(), userID, uuid.New().String(), models.SessionClient{}, nil)
 		require.NoError(t, err)
 	}
 
-	ids, err := sessRepo.DeleteManyForUser(ctx, userID)
+	ended, err := sessRepo.DeleteManyForUser(ctx, userID)
 	require.NoError(t, err)
-	assert.Len(t, ids, 3)
+	assert.Len(t, ended, 3)
 
 	// All should be deleted now
 	page, err := sessRepo.GetListForUser(ctx, userID,
@@ -305,3 +413,188 @@ func TestSessionRepo_DeleteManyForUser(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, uint(0), page.Total)
 }
+
+func TestSessionRepo_DeleteFilteredForUser(t *testing.T) {
+	accRepo, sessRepo := newSessionRepos(t)
+	ctx := context.Background()
+
+	userID := createUserForSession(t, accRepo)
+
+	ids := make([]uuid.UUID, 3)
+	for i := range ids {
+		ids[i] = uuid.New()
+		_, err := sessRepo.Create(ctx, ids[i], userID, uuid.New().String(), models.SessionClient{}, nil)
+		require.NoError(t, err)
+	}
+
+	// Only the listed sessions go, and never the current one.
+	deleted, err := sessRepo.DeleteFilteredForUser(ctx, userID, ids[0], session.DeleteSessionsParams{
+		KeepCurrent: true,
+		SessionIDs:  []uuid.UUID{ids[0], ids[1]},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{ids[1]}, models.EndedSessionIDs(deleted))
+
+	// Nothing was used before an hour ago.
+	hourAgo := time.Now().Add(-time.Hour)
+	deleted, err = sessRepo.DeleteFilteredForUser(ctx, userID, ids[0], session.DeleteSessionsParams{
+		KeepCurrent:    true,
+		LastUsedBefore: &hourAgo,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+
+	deleted, err = sessRepo.DeleteFilteredForUser(ctx, userID, ids[0], session.DeleteSessionsParams{
+		KeepCurrent: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{ids[2]}, models.EndedSessionIDs(deleted))
+
+	current, err := sessRepo.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Nil(t, current.DeletedAt)
+}
+
+func TestSessionRepo_End(t *testing.T) {
+	accRepo, sessRepo := newSessionRepos(t)
+	ctx := context.Background()
+
+	userID := createUserForSession(t, accRepo)
+	sessionID := uuid.New()
+
+	_, err := sessRepo.Create(ctx, sessionID, userID, "hash", models.SessionClient{}, nil)
+	require.NoError(t, err)
+
+	err = sessRepo.End(ctx, sessionID, models.SessionRevokedIdleTimeout)
+	require.NoError(t, err)
+
+	got, err := sessRepo.GetByID(ctx, sessionID)
+	require.NoError(t, err)
+	assert.NotNil(t, got.DeletedAt)
+
+	// The token stays readable so a refresh can tell why it stopped working.
+	token, err := sessRepo.GetToken(ctx, sessionID)
+	require.NoError(t, err)
+	require.NotNil(t, token.EndReason)
+	assert.Equal(t, models.SessionRevokedIdleTimeout, *token.EndReason)
+
+	err = sessRepo.End(ctx, sessionID, models.SessionRevokedIdleTimeout)
+	assert.ErrorIs(t, err, errx.ErrorSessionNotFound)
+}
+
+func TestSessionRepo_GetToken_Deleted(t *testing.T) {
+	accRepo, sessRepo := newSessionRepos(t)
+	ctx := context.Background()
+
+	userID := createUserForSession(t, accRepo)
+	sessionID := uuid.New()
+
+	_, err := sessRepo.Create(ctx, sessionID, userID, "hash", models.SessionClient{}, nil)
+	require.NoError(t, err)
+	_, err = sessRepo.Delete(ctx, sessionID)
+	require.NoError(t, err)
+
+	_, err = sessRepo.GetToken(ctx, sessionID)
+	assert.ErrorIs(t, err, errx.ErrorSessionNotFound)
+}
+
+func TestSessionRepo_EvictLeastRecentlyUsed(t *testing.T) {
+	accRepo, sessRepo := newSessionRepos(t)
+	ctx := context.Background()
+
+	userID := createUserForSession(t, accRepo)
+
+	ids := make([]uuid.UUID, 3)
+	hashes := make([]string, len(ids))
+	for i := range ids {
+		ids[i] = uuid.New()
+		hashes[i] = uuid.New().String()
+		_, err := sessRepo.Create(ctx, ids[i], userID, hashes[i], models.SessionClient{}, nil)
+		require.NoError(t, err)
+	}
+
+	// The oldest session becomes the most recently used one.
+	_, err := sessRepo.UpdateToken(ctx, ids[0], hashes[0], uuid.New().String(), models.SessionClient{})
+	require.NoError(t, err)
+
+	evicted, err := sessRepo.EvictLeastRecentlyUsed(ctx, userID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{ids[1]}, models.EndedSessionIDs(evicted))
+
+	token, err := sessRepo.GetToken(ctx, ids[1])
+	require.NoError(t, err)
+	require.NotNil(t, token.EndReason)
+	assert.Equal(t, models.SessionRevokedEvicted, *token.EndReason)
+
+	evicted, err = sessRepo.EvictLeastRecentlyUsed(ctx, userID, 2)
+	require.NoError(t, err)
+	assert.Empty(t, evicted)
+}
+
+func TestSessionRepo_PurgeSessions(t *testing.T) {
+	accRepo, sessRepo := newSessionRepos(t)
+	ctx := context.Background()
+
+	userID := createUserForSession(t, accRepo)
+
+	deleted, live := uuid.New(), uuid.New()
+	for _, id := range []uuid.UUID{deleted, live} {
+		_, err := sessRepo.Create(ctx, id, userID, uuid.New().String(), models.SessionClient{}, nil)
+		require.NoError(t, err)
+	}
+	_, err := sessRepo.Delete(ctx, deleted)
+	require.NoError(t, err)
+
+	// Neither window is over yet.
+	past := time.Now().Add(-time.Hour)
+	purged, err := sessRepo.PurgeSessions(ctx, past, past, 100)
+	require.NoError(t, err)
+	assert.Empty(t, purged)
+
+	// Only the soft-deleted session is past its window.
+	purged, err = sessRepo.PurgeSessions(ctx, time.Now().Add(time.Minute), past, 100)
+	require.NoError(t, err)
+	require.Len(t, purged, 1)
+	assert.Equal(t, deleted, purged[0].ID)
+	assert.Equal(t, userID, purged[0].UserID)
+	assert.False(t, purged[0].Idle)
+
+	_, err = sessRepo.GetByID(ctx, deleted)
+	assert.ErrorIs(t, err, errx.ErrorSessionNotFound)
+
+	purged, err = sessRepo.PurgeSessions(ctx, past, time.Now().Add(time.Minute), 100)
+	require.NoError(t, err)
+	require.Len(t, purged, 1)
+	assert.Equal(t, live, purged[0].ID)
+	assert.True(t, purged[0].Idle)
+	assert.False(t, purged[0].LastUsed.IsZero())
+
+	_, err = sessRepo.GetByID(ctx, live)
+	assert.ErrorIs(t, err, errx.ErrorSessionNotFound)
+}
+
+func TestLocker_TryXactLock(t *testing.T) {
+	db := pgdbx.NewDB(setupDB(t))
+	locker := pg.NewLocker(db)
+	ctx := context.Background()
+
+	const key = 4242
+
+	err := db.Transaction(ctx, func(ctx context.Context) error {
+		locked, err := locker.TryXactLock(ctx, key)
+		require.NoError(t, err)
+		assert.True(t, locked)
+
+		// Another connection can't take it while the transaction lasts.
+		locked, err = locker.TryXactLock(context.Background(), key)
+		require.NoError(t, err)
+		assert.False(t, locked)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	locked, err := locker.TryXactLock(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, locked)
+}
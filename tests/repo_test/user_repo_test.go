@@ -3,8 +3,11 @@ package repo_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/errx"
+	"github.com/netbill/auth-svc/internal/modules/admin"
 	"github.com/netbill/auth-svc/internal/modules/user"
 	"github.com/netbill/auth-svc/internal/repo/pg"
 	"github.com/netbill/auth-svc/tests/testutil"
@@ -88,3 +91,73 @@ func TestUserRepo_Delete_NotFound(t *testing.T) {
 	_, err := repo.Delete(context.Background(), testutil.RandomUUID())
 	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
 }
+
+func TestUserRepo_UpdateRole(t *testing.T) {
+	repo := newUserRepo(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, user.RegistrationParams{Role: "user", Username: testutil.UniqueUsername()})
+	require.NoError(t, err)
+
+	updated, err := repo.UpdateRole(ctx, created.ID, "moderator")
+	require.NoError(t, err)
+	assert.Equal(t, "moderator", updated.Role)
+	assert.Equal(t, created.Version+1, updated.Version)
+}
+
+func TestUserRepo_SuspendUnsuspend(t *testing.T) {
+	repo := newUserRepo(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, user.RegistrationParams{Role: "user", Username: testutil.UniqueUsername()})
+	require.NoError(t, err)
+
+	by := uuid.New()
+	reason := "spam"
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)
+
+	suspended, err := repo.Suspend(ctx, created.ID, by, admin.SuspendParams{Reason: &reason, Until: &until})
+	require.NoError(t, err)
+	assert.NotNil(t, suspended.SuspendedAt)
+	require.NotNil(t, suspended.SuspendedUntil)
+	assert.True(t, until.Equal(*suspended.SuspendedUntil))
+	assert.Equal(t, &by, suspended.SuspendedBy)
+	assert.Equal(t, &reason, suspended.SuspensionReason)
+
+	// Suspending twice matches no row while the suspension is in force
+	_, err = repo.Suspend(ctx, created.ID, by, admin.SuspendParams{})
+	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
+
+	lifted, err := repo.Unsuspend(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Nil(t, lifted.SuspendedAt)
+	assert.Nil(t, lifted.SuspendedUntil)
+	assert.Nil(t, lifted.SuspendedBy)
+	assert.Nil(t, lifted.SuspensionReason)
+}
+
+func TestUserRepo_Restore(t *testing.T) {
+	repo := newUserRepo(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, user.RegistrationParams{Role: "user", Username: testutil.UniqueUsername()})
+	require.NoError(t, err)
+
+	_, err = repo.Delete(ctx, created.ID)
+	require.NoError(t, err)
+
+	deleted, err := repo.GetByID(ctx, created.ID, user.WithDeleted(user.DeletedFilterAll))
+	require.NoError(t, err)
+	assert.NotNil(t, deleted.DeletedAt)
+
+	restored, err := repo.Restore(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Nil(t, restored.DeletedAt)
+
+	_, err = repo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+
+	// Restoring an active user matches no row
+	_, err = repo.Restore(ctx, created.ID)
+	assert.ErrorIs(t, err, errx.ErrorUserNotFound)
+}
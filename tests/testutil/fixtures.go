@@ -56,7 +56,7 @@ func CreateSession(t *testing.T, db *pgdbx.DB, userID uuid.UUID) models.Session
 	t.Helper()
 
 	sessionRepo := pg.NewSessionRepo(db)
-	sess, err := sessionRepo.Create(context.Background(), uuid.New(), userID, "testhash")
+	sess, err := sessionRepo.Create(context.Background(), uuid.New(), userID, "testhash", models.SessionClient{}, nil)
 	require.NoError(t, err)
 
 	return sess
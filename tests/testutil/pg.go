@@ -69,10 +69,16 @@ func TruncateTables(t *testing.T, pool *pgxpool.Pool) {
 
 	_, err = conn.Exec(context.Background(), `
 		TRUNCATE TABLE
+			user_identities,
+			user_passkeys,
+			user_mfa_recovery_codes,
+			user_mfa_totp,
 			user_passwords,
 			user_emails,
 			sessions,
 			outbox_events,
+			oauth_clients,
+			auth_events,
 			users
 		RESTART IDENTITY CASCADE
 	`)